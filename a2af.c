@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauA2af(int ndp, double angle, char *sign, int idmsf[4])
@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #cgo LDFLAGS: -lm
+// #include <sofa.h>
+// #include <sofam.h>
+import "C"
+
+//  CgoA2af Decompose radians into degrees, arcminutes, arcseconds, fraction.
+func CgoA2af(ndp int, angle float64) (sign byte, idmsf [4]int) {
+	var cSign C.char
+	var cIdmsf [4]C.int
+	C.iauA2af(C.int(ndp), C.double(angle), &cSign, &cIdmsf[0])
+	return byte(cSign), v4sIntC2Go(cIdmsf)
+}
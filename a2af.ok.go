@@ -1,11 +1,6 @@
 package sofa
 
-// #cgo LDFLAGS: -lm
-// #include <sofa.h>
-// #include <sofam.h>
-import "C"
-
-//  CgoA2af Decompose radians into degrees, arcminutes, arcseconds,
+//  A2af Decompose radians into degrees, arcminutes, arcseconds,
 //  fraction.
 //
 //  - - - - -
@@ -66,20 +61,11 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoA2af Decompose radians into degrees, arcminutes, arcseconds, fraction.
-func CgoA2af(ndp int, angle float64) (sign byte, idmsf [4]int) {
-	var cSign C.char
-	var cIdmsf [4]C.int
-	C.iauA2af(C.int(ndp), C.double(angle), &cSign, &cIdmsf[0])
-	return byte(cSign), v4sIntC2Go(cIdmsf)
-}
-
-//  GoA2af Decompose radians into degrees, arcminutes, arcseconds,
-//  fraction.
-func GoA2af(ndp int, angle float64) (sign byte, idmsf [4]int) {
+//  A2af Decompose radians into degrees, arcminutes, arcseconds, fraction.
+func A2af(ndp int, angle float64) (sign byte, idmsf [4]int) {
 	// Hours to degrees * radians to turns.
 	const F = 15.0 / D2PI
 
 	// Scale then use days to h,m,s function.
-	return GoD2tf(ndp, angle*F)
+	return D2tf(ndp, angle*F)
 }
@@ -23,7 +23,7 @@ func TestA2af(t *testing.T) {
 		fn  func(int, float64) (byte, [4]int)
 	}{
 		{"cgo", CgoA2af},
-		{"go", GoA2af},
+		{"go", A2af},
 	}
 
 	for _, test := range tests {
@@ -50,7 +50,7 @@ func BenchmarkA2af(b *testing.B) {
 		fn  func(int, float64) (byte, [4]int)
 	}{
 		{"cgo", CgoA2af},
-		{"go", GoA2af},
+		{"go", A2af},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauA2tf(int ndp, double angle, char *sign, int ihmsf[4])
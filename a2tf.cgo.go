@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoA2tf Decompose radians into hours, minutes, seconds, fraction.
+func CgoA2tf(ndp int, angle float64) (sign byte, ihmsf [4]int) {
+	var cSign C.char
+	var cIhmsf [4]C.int
+	C.iauA2tf(C.int(ndp), C.double(angle), &cSign, &cIhmsf[0])
+	return byte(cSign), v4sIntC2Go(cIhmsf)
+}
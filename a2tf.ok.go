@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoA2tf Decompose radians into hours, minutes, seconds, fraction.
+//  A2tf Decompose radians into hours, minutes, seconds, fraction.
 //
 //  - - - - -
 //   A 2 t f
@@ -63,17 +60,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoA2tf Decompose radians into hours, minutes, seconds, fraction.
-func CgoA2tf(ndp int, angle float64) (sign byte, ihmsf [4]int) {
-	var cSign C.char
-	var cIhmsf [4]C.int
-	C.iauA2tf(C.int(ndp), C.double(angle), &cSign, &cIhmsf[0])
-	return byte(cSign), v4sIntC2Go(cIhmsf)
-}
-
-// GoA2tf Decompose radians into hours, minutes, seconds, fraction.
-func GoA2tf(ndp int, angle float64) (sign byte, ihmsf [4]int) {
+//  A2tf Decompose radians into hours, minutes, seconds, fraction.
+func A2tf(ndp int, angle float64) (sign byte, ihmsf [4]int) {
 	// Scale then use days to h,m,s function.
-	sign, ihmsf = GoD2tf(ndp, angle/D2PI)
+	sign, ihmsf = D2tf(ndp, angle/D2PI)
 	return
 }
@@ -22,7 +22,7 @@ func TestA2tf(t *testing.T) {
 		fn  func(int, float64) (byte, [4]int)
 	}{
 		{"cgo", CgoA2tf},
-		{"go", GoA2tf},
+		{"go", A2tf},
 	}
 
 	for _, test := range tests {
@@ -44,7 +44,7 @@ func BenchmarkA2tf(b *testing.B) {
 		fn  func(int, float64) (byte, [4]int)
 	}{
 		{"cgo", CgoA2tf},
-		{"go", GoA2tf},
+		{"go", A2tf},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
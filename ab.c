@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauAb(double pnat[3], double v[3], double s, double bm1,
@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAb Apply aberration to transform natural direction into proper
+//  direction.
+func CgoAb(pnat, v [3]float64, s, bm1 float64) (ppr [3]float64) {
+	var cPpr [3]C.double
+	cPnat := v3sGo2C(pnat)
+	cv := v3sGo2C(v)
+	C.iauAb(&cPnat[0], &cv[0], C.double(s), C.double(bm1), &cPpr[0])
+	return v3sC2Go(cPpr)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoAb Apply aberration to transform natural direction into proper
+//  Ab Apply aberration to transform natural direction into proper
 //  direction.
 //
 //  - - -
@@ -60,24 +58,14 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAb Apply aberration to transform natural direction into proper
+//  Ab Apply aberration to transform natural direction into proper
 //  direction.
-func CgoAb(pnat, v [3]float64, s, bm1 float64) (ppr [3]float64) {
-	var cPpr [3]C.double
-	cPnat := v3sGo2C(pnat)
-	cv := v3sGo2C(v)
-	C.iauAb(&cPnat[0], &cv[0], C.double(s), C.double(bm1), &cPpr[0])
-	return v3sC2Go(cPpr)
-}
-
-//  GoAb Apply aberration to transform natural direction into proper
-//  direction.
-func GoAb(pnat, v [3]float64, s, bm1 float64) (ppr [3]float64) {
+func Ab(pnat, v [3]float64, s, bm1 float64) (ppr [3]float64) {
 	var pdv, w1, w2, r2, w, r float64
 	var p [3]float64
 	var i int
 
-	pdv = GoPdp(pnat, v)
+	pdv = Pdp(pnat, v)
 	w1 = 1.0 + pdv/(1.0+bm1)
 	w2 = SRS / s
 	r2 = 0.0
@@ -34,7 +34,7 @@ func TestAb(t *testing.T) {
 		fn  func(a, b [3]float64, c, d float64) [3]float64
 	}{
 		{"cgo", CgoAb},
-		{"go", GoAb},
+		{"go", Ab},
 	}
 
 	for _, test := range tests {
@@ -66,7 +66,7 @@ func BenchmarkAb(b *testing.B) {
 		fn  func(a, b [3]float64, c, d float64) [3]float64
 	}{
 		{"cgo", CgoAb},
-		{"go", GoAb},
+		{"go", Ab},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
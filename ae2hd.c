@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauAe2hd (double az, double el, double phi,
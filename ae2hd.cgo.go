@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAe2hd Horizon to equatorial coordinates:  transform azimuth and
+//  altitude to hour angle and declination.
+func CgoAe2hd(az, el, phi float64) (ha, dec float64) {
+	var cHa, cDec C.double
+	C.iauAe2hd(C.double(az), C.double(el), C.double(phi), &cHa, &cDec)
+	return float64(cHa), float64(cDec)
+}
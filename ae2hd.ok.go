@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoAe2hd Horizon to equatorial coordinates:  transform azimuth and
+//  Ae2hd Horizon to equatorial coordinates:  transform azimuth and
 //  altitude to hour angle and declination.
 //
 //  - - - - - -
@@ -64,17 +62,9 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAe2hd Horizon to equatorial coordinates:  transform azimuth and
+//  Ae2hd Horizon to equatorial coordinates:  transform azimuth and
 //  altitude to hour angle and declination.
-func CgoAe2hd(az, el, phi float64) (ha, dec float64) {
-	var cHa, cDec C.double
-	C.iauAe2hd(C.double(az), C.double(el), C.double(phi), &cHa, &cDec)
-	return float64(cHa), float64(cDec)
-}
-
-// GoAe2hd Horizon to equatorial coordinates:  transform azimuth and
-// altitude to hour angle and declination.
-func GoAe2hd(az, el, phi float64) (ha, dec float64) {
+func Ae2hd(az, el, phi float64) (ha, dec float64) {
 	var sa, ca, se, ce, sp, cp, x, y, z, r float64
 
 	/* Useful trig functions. */
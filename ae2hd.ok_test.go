@@ -26,7 +26,7 @@ func TestAe2hd(t *testing.T) {
 		fn  func(a, b, c float64) (e, f float64)
 	}{
 		{"cgo", CgoAe2hd},
-		{"go", GoAe2hd},
+		{"go", Ae2hd},
 	}
 
 	for _, test := range tests {
@@ -48,7 +48,7 @@ func BenchmarkAe2hd(b *testing.B) {
 		fn  func(a, b, c float64) (e, f float64)
 	}{
 		{"cgo", CgoAe2hd},
-		{"go", GoAe2hd},
+		{"go", Ae2hd},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,23 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include <stdio.h>
+// #include "sofa.h"
+import "C"
+
+//  CgoAf2a Convert degrees, arcminutes, arcseconds to radians.
+func CgoAf2a(s byte, ideg, iamin int, asec float64) (rad float64, err error) {
+	var cRad C.double
+	j := C.iauAf2a(C.char(s), C.int(ideg), C.int(iamin), C.double(asec), &cRad)
+	switch int(j) {
+	case 1:
+		err = errAf2aE1
+	case 2:
+		err = errAf2aE2
+	case 3:
+		err = errAf2aE3
+	}
+	return float64(cRad), err
+}
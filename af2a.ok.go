@@ -1,8 +1,5 @@
 package sofa
 
-// #include <stdio.h>
-// #include "sofa.h"
-import "C"
 import (
 	"errors"
 	"math"
@@ -14,7 +11,7 @@ var (
 	errAf2aE3 = errors.New("asec outside range 0-59.999...")
 )
 
-//  CgoAf2a Convert degrees, arcminutes, arcseconds to radians.
+//  Af2a Convert degrees, arcminutes, arcseconds to radians.
 //
 //  - - - - -
 //   A f 2 a
@@ -56,23 +53,8 @@ var (
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAf2a Convert degrees, arcminutes, arcseconds to radians.
-func CgoAf2a(s byte, ideg, iamin int, asec float64) (rad float64, err error) {
-	var cRad C.double
-	j := C.iauAf2a(C.char(s), C.int(ideg), C.int(iamin), C.double(asec), &cRad)
-	switch int(j) {
-	case 1:
-		err = errAf2aE1
-	case 2:
-		err = errAf2aE2
-	case 3:
-		err = errAf2aE3
-	}
-	return float64(cRad), err
-}
-
-// GoAf2a Convert degrees, arcminutes, arcseconds to radians.
-func GoAf2a(s byte, ideg, iamin int, asec float64) (rad float64, err error) {
+//  Af2a Convert degrees, arcminutes, arcseconds to radians.
+func Af2a(s byte, ideg, iamin int, asec float64) (rad float64, err error) {
 	/* Compute the interval. */
 	var sign = 1.0
 	if s == '-' {
@@ -86,12 +68,15 @@ func GoAf2a(s byte, ideg, iamin int, asec float64) (rad float64, err error) {
 	/* Validate arguments and return status. */
 	if ideg < 0 || ideg > 359 {
 		err = errAf2aE1
+		return
 	}
 	if iamin < 0 || iamin > 59 {
 		err = errAf2aE2
+		return
 	}
 	if asec < 0.0 || asec >= 60.0 {
 		err = errAf2aE3
+		return
 	}
 	return
 }
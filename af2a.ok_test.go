@@ -26,7 +26,7 @@ func TestAf2a(t *testing.T) {
 		fn  func(byte, int, int, float64) (float64, error)
 	}{
 		{"cgo", CgoAf2a},
-		{"go", GoAf2a},
+		{"go", Af2a},
 	}
 
 	for _, test := range tests {
@@ -39,6 +39,9 @@ func TestAf2a(t *testing.T) {
 		}
 
 		vvd(t, a, -0.7893115794313644842, 1e-12, tname, "a")
+
+		_, err = test.fn('+', 360, 60, 60.0)
+		errT(t, errAf2aE1, err, tname, "first error")
 	}
 }
 
@@ -48,7 +51,7 @@ func BenchmarkAf2a(b *testing.B) {
 		fn  func(byte, int, int, float64) (float64, error)
 	}{
 		{"cgo", CgoAf2a},
-		{"go", GoAf2a},
+		{"go", Af2a},
 	}
 
 	for _, test := range tests {
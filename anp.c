@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 double iauAnp(double a)
@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAnp Normalize angle into the range 0 <= a < 2pi.
+func CgoAnp(a float64) float64 {
+
+	cA := C.iauAnp(C.double(a))
+	return float64(cA)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoAnp Normalize angle into the range 0 <= a < 2pi.
+//  Anp Normalize angle into the range 0 <= a < 2pi.
 //
 //  - - - -
 //   A n p
@@ -29,15 +27,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAnp Normalize angle into the range 0 <= a < 2pi.
-func CgoAnp(a float64) float64 {
-
-	cA := C.iauAnp(C.double(a))
-	return float64(cA)
-}
-
-// GoAnp Normalize angle into the range 0 <= a < 2pi.
-func GoAnp(a float64) float64 {
+//  Anp Normalize angle into the range 0 <= a < 2pi.
+func Anp(a float64) float64 {
 
 	a = math.Mod(a, D2PI)
 	if a < 0 {
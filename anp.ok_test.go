@@ -20,7 +20,7 @@ func TestAnp(t *testing.T) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoAnp},
-		{"go", GoAnp},
+		{"go", Anp},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -35,7 +35,7 @@ func BenchmarkAnp(b *testing.B) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoAnp},
-		{"go", GoAnp},
+		{"go", Anp},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
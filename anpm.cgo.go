@@ -0,0 +1,13 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAnpm Normalize angle into the range -pi <= a < +pi.
+func CgoAnpm(a float64) float64 {
+	cA := C.iauAnpm(C.double(a))
+	return float64(cA)
+}
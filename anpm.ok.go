@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoAnpm Normalize angle into the range -pi <= a < +pi.
+//  Anpm Normalize angle into the range -pi <= a < +pi.
 //
 //  - - - - -
 //   A n p m
@@ -27,14 +25,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAnpm Normalize angle into the range -pi <= a < +pi.
-func CgoAnpm(a float64) float64 {
-	cA := C.iauAnpm(C.double(a))
-	return float64(cA)
-}
-
-// GoAnpm Normalize angle into the range -pi <= a < +pi.
-func GoAnpm(a float64) float64 {
+//  Anpm Normalize angle into the range -pi <= a < +pi.
+func Anpm(a float64) float64 {
 	var w float64
 
 	w = math.Mod(a, D2PI)
@@ -20,7 +20,7 @@ func TestAnpm(t *testing.T) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoAnpm},
-		{"go", GoAnpm},
+		{"go", Anpm},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -35,7 +35,7 @@ func BenchmarkAnpm(b *testing.B) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoAnpm},
-		{"go", GoAnpm},
+		{"go", Anpm},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
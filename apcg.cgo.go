@@ -0,0 +1,20 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoApcg For a geocentric observer, prepare star-independent
+//  astrometry parameters for transformations between ICRS and GCRS
+//  coordinates.  The Earth ephemeris is supplied by the caller.
+func CgoApcg(date1, date2 float64, ebpv [2][3]float64, ehp [3]float64,
+	astrom ASTROM) ASTROM {
+	cAstrom := astrGo2C(astrom)
+	cebpv := v3dGo2C(ebpv)
+	cehp := v3sGo2C(ehp)
+	C.iauApcg(C.double(date1), C.double(date2),
+		&cebpv[0], &cehp[0], &cAstrom)
+	return astrC2Go(cAstrom)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoApcg For a geocentric observer, prepare star-independent
+//  Apcg For a geocentric observer, prepare star-independent
 //  astrometry parameters for transformations between ICRS and GCRS
 //  coordinates.  The Earth ephemeris is supplied by the caller.
 //
@@ -112,28 +109,15 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoApcg For a geocentric observer, prepare star-independent
+//  Apcg For a geocentric observer, prepare star-independent
 //  astrometry parameters for transformations between ICRS and GCRS
 //  coordinates.  The Earth ephemeris is supplied by the caller.
-func CgoApcg(date1, date2 float64, ebpv [2][3]float64, ehp [3]float64,
-	astrom ASTROM) ASTROM {
-	cAstrom := astrGo2C(astrom)
-	cebpv := v3dGo2C(ebpv)
-	cehp := v3sGo2C(ehp)
-	C.iauApcg(C.double(date1), C.double(date2),
-		&cebpv[0], &cehp[0], &cAstrom)
-	return astrC2Go(cAstrom)
-}
-
-//  GoApcg For a geocentric observer, prepare star-independent astrometry
-//  parameters for transformations between ICRS and GCRS coordinates.  The
-//  Earth ephemeris is supplied by the caller.
-func GoApcg(date1, date2 float64, ebpv [2][3]float64, ehp [3]float64,
+func Apcg(date1, date2 float64, ebpv [2][3]float64, ehp [3]float64,
 	astrom ASTROM) ASTROM {
 
 	// Geocentric observer {{0,0,0},{0,0,0},{0,0,0}}
 	var pv [2][3]float64
 
 	// Compute the star-independent astrometry parameters.
-	return GoApcs(date1, date2, pv, ebpv, ehp, astrom)
+	return Apcs(date1, date2, pv, ebpv, ehp, astrom)
 }
@@ -39,54 +39,54 @@ func TestApcg(t *testing.T) {
 			d [3]float64, e ASTROM) ASTROM
 	}{
 		{"cgo", CgoApcg},
-		{"go", GoApcg},
+		{"go", Apcg},
 	}
 
 	for _, test := range tests {
 		tname := fname + " " + test.ref
 		astrom := test.fn(date1, date2, ebpv, ehp, astr)
 
-		vvd(t, astrom.pmt, 12.65133794027378508, 1e-11,
+		vvd(t, astrom.Pmt, 12.65133794027378508, 1e-11,
 			tname, "pmt")
 
-		vvd(t, astrom.eb[0], 0.901310875, 1e-12,
+		vvd(t, astrom.Eb[0], 0.901310875, 1e-12,
 			tname, "eb(1)")
-		vvd(t, astrom.eb[1], -0.417402664, 1e-12,
+		vvd(t, astrom.Eb[1], -0.417402664, 1e-12,
 			tname, "eb(2)")
-		vvd(t, astrom.eb[2], -0.180982288, 1e-12,
+		vvd(t, astrom.Eb[2], -0.180982288, 1e-12,
 			tname, "eb(3)")
 
-		vvd(t, astrom.eh[0], 0.8940025429324143045, 1e-12,
+		vvd(t, astrom.Eh[0], 0.8940025429324143045, 1e-12,
 			tname, "eh(1)")
-		vvd(t, astrom.eh[1], -0.4110930268679817955, 1e-12,
+		vvd(t, astrom.Eh[1], -0.4110930268679817955, 1e-12,
 			tname, "eh(2)")
-		vvd(t, astrom.eh[2], -0.1782189004872870264, 1e-12,
+		vvd(t, astrom.Eh[2], -0.1782189004872870264, 1e-12,
 			tname, "eh(3)")
 
-		vvd(t, astrom.em, 1.010465295811013146, 1e-12,
+		vvd(t, astrom.Em, 1.010465295811013146, 1e-12,
 			tname, "em")
 
-		vvd(t, astrom.v[0], 0.4289638913597693554e-4, 1e-16,
+		vvd(t, astrom.V[0], 0.4289638913597693554e-4, 1e-16,
 			tname, "v(1)")
-		vvd(t, astrom.v[1], 0.8115034051581320575e-4, 1e-16,
+		vvd(t, astrom.V[1], 0.8115034051581320575e-4, 1e-16,
 			tname, "v(2)")
-		vvd(t, astrom.v[2], 0.3517555136380563427e-4, 1e-16,
+		vvd(t, astrom.V[2], 0.3517555136380563427e-4, 1e-16,
 			tname, "v(3)")
 
-		vvd(t, astrom.bm1, 0.9999999951686012981, 1e-12,
+		vvd(t, astrom.Bm1, 0.9999999951686012981, 1e-12,
 			tname, "bm1")
 
-		vvd(t, astrom.bpn[0][0], 1.0, 0.0, tname, "bpn(1,1)")
-		vvd(t, astrom.bpn[1][0], 0.0, 0.0, tname, "bpn(2,1)")
-		vvd(t, astrom.bpn[2][0], 0.0, 0.0, tname, "bpn(3,1)")
+		vvd(t, astrom.Bpn[0][0], 1.0, 0.0, tname, "bpn(1,1)")
+		vvd(t, astrom.Bpn[1][0], 0.0, 0.0, tname, "bpn(2,1)")
+		vvd(t, astrom.Bpn[2][0], 0.0, 0.0, tname, "bpn(3,1)")
 
-		vvd(t, astrom.bpn[0][1], 0.0, 0.0, tname, "bpn(1,2)")
-		vvd(t, astrom.bpn[1][1], 1.0, 0.0, tname, "bpn(2,2)")
-		vvd(t, astrom.bpn[2][1], 0.0, 0.0, tname, "bpn(3,2)")
+		vvd(t, astrom.Bpn[0][1], 0.0, 0.0, tname, "bpn(1,2)")
+		vvd(t, astrom.Bpn[1][1], 1.0, 0.0, tname, "bpn(2,2)")
+		vvd(t, astrom.Bpn[2][1], 0.0, 0.0, tname, "bpn(3,2)")
 
-		vvd(t, astrom.bpn[0][2], 0.0, 0.0, tname, "bpn(1,3)")
-		vvd(t, astrom.bpn[1][2], 0.0, 0.0, tname, "bpn(2,3)")
-		vvd(t, astrom.bpn[2][2], 1.0, 0.0, tname, "bpn(3,3)")
+		vvd(t, astrom.Bpn[0][2], 0.0, 0.0, tname, "bpn(1,3)")
+		vvd(t, astrom.Bpn[1][2], 0.0, 0.0, tname, "bpn(2,3)")
+		vvd(t, astrom.Bpn[2][2], 1.0, 0.0, tname, "bpn(3,3)")
 	}
 }
 
@@ -114,7 +114,7 @@ func BenchmarkApcg(b *testing.B) {
 			d [3]float64, e ASTROM) ASTROM
 	}{
 		{"cgo", CgoApcg},
-		{"go", GoApcg},
+		{"go", Apcg},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauApcg13(double date1, double date2, iauASTROM *astrom)
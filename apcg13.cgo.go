@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include <sofa.h>
+import "C"
+
+//  CgoApcg13 For a geocentric observer, prepare star-independent
+//  astrometry parameters for transformations between ICRS and GCRS
+//  coordinates.  The caller supplies the date, and SOFA models are used
+//  to predict the Earth ephemeris.
+func CgoApcg13(date1, date2 float64, astrom ASTROM) ASTROM {
+	cAstrom := astrGo2C(astrom)
+	C.iauApcg13(C.double(date1), C.double(date2), &cAstrom)
+	return astrC2Go(cAstrom)
+}
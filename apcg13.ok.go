@@ -1,9 +1,6 @@
 package sofa
 
-// #include <sofa.h>
-import "C"
-
-//  CgoApcg13 For a geocentric observer, prepare star-independent
+//  Apcg13 For a geocentric observer, prepare star-independent
 //  astrometry parameters for transformations between ICRS and GCRS
 //  coordinates.  The caller supplies the date, and SOFA models are used
 //  to predict the Earth ephemeris.
@@ -116,25 +113,15 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoApcg13 For a geocentric observer, prepare star-independent
-//  astrometry parameters for transformations between ICRS and GCRS
-//  coordinates.  The caller supplies the date, and SOFA models are used
-//  to predict the Earth ephemeris.
-func CgoApcg13(date1, date2 float64, astrom ASTROM) ASTROM {
-	cAstrom := astrGo2C(astrom)
-	C.iauApcg13(C.double(date1), C.double(date2), &cAstrom)
-	return astrC2Go(cAstrom)
-}
-
-//  GoApcg13 For a geocentric observer, prepare star-independent
+//  Apcg13 For a geocentric observer, prepare star-independent
 //  astrometry parameters for transformations between ICRS and GCRS
 //  coordinates.  The caller supplies the date, and SOFA models are used
 //  to predict the Earth ephemeris.
-func GoApcg13(date1, date2 float64, astrom ASTROM) ASTROM {
+func Apcg13(date1, date2 float64, astrom ASTROM) ASTROM {
 
 	// Earth barycentric & heliocentric position/velocity (au, au/d).
-	pvh, pvb, _ := CgoEpv00(date1, date2)
+	pvh, pvb, _ := Epv00(date1, date2)
 
 	// Compute the star-independent astrometry parameters.
-	return GoApcg(date1, date2, pvb, pvh[0], astrom)
+	return Apcg(date1, date2, pvb, pvh[0], astrom)
 }
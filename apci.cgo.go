@@ -0,0 +1,21 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoApci For a terrestrial observer, prepare star-independent
+//  astrometry parameters for transformations between ICRS and
+//  geocentric CIRS coordinates.  The Earth ephemeris and CIP/CIO are
+//  supplied by the caller.
+func CgoApci(date1, date2 float64, ebpv [2][3]float64, ehp [3]float64,
+	x, y, s float64, astrom ASTROM) ASTROM {
+	cAstrom := astrGo2C(astrom)
+	cEbpv := v3dGo2C(ebpv)
+	cEhp := v3sGo2C(ehp)
+	C.iauApci(C.double(date1), C.double(date2), &cEbpv[0], &cEhp[0],
+		C.double(x), C.double(y), C.double(s), &cAstrom)
+	return astrC2Go(cAstrom)
+}
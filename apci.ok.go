@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoApci For a terrestrial observer, prepare star-independent
+//  Apci For a terrestrial observer, prepare star-independent
 //  astrometry parameters for transformations between ICRS and
 //  geocentric CIRS coordinates.  The Earth ephemeris and CIP/CIO are
 //  supplied by the caller.
@@ -126,31 +123,17 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoApci For a terrestrial observer, prepare star-independent
+//  Apci For a terrestrial observer, prepare star-independent
 //  astrometry parameters for transformations between ICRS and
 //  geocentric CIRS coordinates.  The Earth ephemeris and CIP/CIO are
 //  supplied by the caller.
-func CgoApci(date1, date2 float64, ebpv [2][3]float64, ehp [3]float64,
-	x, y, s float64, astrom ASTROM) ASTROM {
-	cAstrom := astrGo2C(astrom)
-	cEbpv := v3dGo2C(ebpv)
-	cEhp := v3sGo2C(ehp)
-	C.iauApci(C.double(date1), C.double(date2), &cEbpv[0], &cEhp[0],
-		C.double(x), C.double(y), C.double(s), &cAstrom)
-	return astrC2Go(cAstrom)
-}
-
-// GoApci For a terrestrial observer, prepare star-independent astrometry
-// parameters for transformations between ICRS and geocentric CIRS
-// coordinates.  The Earth ephemeris and CIP/CIO are supplied by the
-// caller.
-func GoApci(date1, date2 float64, ebpv [2][3]float64, ehp [3]float64,
+func Apci(date1, date2 float64, ebpv [2][3]float64, ehp [3]float64,
 	x, y, s float64, astrom ASTROM) ASTROM {
 
 	// Star-independent astrometry parameters for geocenter.
-	astrom = GoApcg(date1, date2, ebpv, ehp, astrom)
+	astrom = Apcg(date1, date2, ebpv, ehp, astrom)
 
 	// CIO based BPN matrix.
-	astrom.bpn = GoC2ixys(x, y, s)
+	astrom.Bpn = C2ixys(x, y, s)
 	return astrom
 }
@@ -0,0 +1,18 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoApci13 For a terrestrial observer, prepare star-independent
+//  astrometry parameters for transformations between ICRS and
+//  geocentric CIRS coordinates.  The caller supplies the date, and SOFA
+//  models are used to predict the Earth ephemeris and CIP/CIO.
+func CgoApci13(date1, date2 float64, astrom ASTROM) (ASTROM, float64) {
+	var cEo C.double
+	cAstrom := astrGo2C(astrom)
+	C.iauApci13(C.double(date1), C.double(date2), &cAstrom, &cEo)
+	return astrC2Go(cAstrom), float64(cEo)
+}
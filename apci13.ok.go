@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoApci13 For a terrestrial observer, prepare star-independent
+//  Apci13 For a terrestrial observer, prepare star-independent
 //  astrometry parameters for transformations between ICRS and
 //  geocentric CIRS coordinates.  The caller supplies the date, and SOFA
 //  models are used to predict the Earth ephemeris and CIP/CIO.
@@ -121,44 +118,43 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoApci13 For a terrestrial observer, prepare star-independent
+//  Apci13 For a terrestrial observer, prepare star-independent
 //  astrometry parameters for transformations between ICRS and
 //  geocentric CIRS coordinates.  The caller supplies the date, and SOFA
 //  models are used to predict the Earth ephemeris and CIP/CIO.
-func CgoApci13(date1, date2 float64, astrom ASTROM) (ASTROM, float64) {
-	var cEo C.double
-	cAstrom := astrGo2C(astrom)
-	C.iauApci13(C.double(date1), C.double(date2), &cAstrom, &cEo)
-	return astrC2Go(cAstrom), float64(cEo)
+func Apci13(date1, date2 float64, astrom ASTROM) (ASTROM, float64) {
+	var ehpv, ebpv [2][3]float64
+
+	// Earth barycentric & heliocentric position/velocity (au, au/d). 
+	ehpv, ebpv, _ = Epv00(date1, date2)
+
+	return apci13(IAU2006A{}, date1, date2, 0, 0, ehpv, ebpv, astrom)
 }
 
-//  GoApci13 For a terrestrial observer, prepare star-independent
-//  astrometry parameters for transformations between ICRS and
-//  geocentric CIRS coordinates.  The caller supplies the date, and SOFA
-//  models are used to predict the Earth ephemeris and CIP/CIO.
-func GoApci13(date1, date2 float64, astrom ASTROM) (ASTROM, float64) {
+// apci13 is Apci13 given the precession-nutation model, the celestial
+// pole offsets dx,dy and the Earth heliocentric and barycentric
+// position/velocity.
+func apci13(m PNModel, date1, date2, dx, dy float64,
+	ehpv, ebpv [2][3]float64, astrom ASTROM) (ASTROM, float64) {
 	var cr [3][3]float64
-	var ehpv, ebpv [2][3]float64
 	var x, y, s float64
 	var eo float64
 
-	// Earth barycentric & heliocentric position/velocity (au, au/d). 
-	ehpv, ebpv, _ = GoEpv00(date1, date2)
-
-	// Form the equinox based BPN matrix, IAU 2006/2000A. 
-	cr = GoPnm06a(date1, date2)
+	// Form the equinox based BPN matrix of the model. 
+	cr = m.Bpn(date1, date2)
 
-	// Extract CIP X,Y. 
-	x, y = GoBpn2xy(cr)
+	// Extract CIP X,Y, and apply the pole offsets. 
+	x, y = Bpn2xy(cr)
+	cr, x, y = cipOffsets(m, date1, date2, cr, x, y, dx, dy)
 
 	// Obtain CIO locator s. 
-	s = GoS06(date1, date2, x, y)
+	s = m.S(date1, date2, x, y)
 
 	// Compute the star-independent astrometry parameters. 
-	astrom = GoApci(date1, date2, ebpv, ehpv[0], x, y, s, astrom)
+	astrom = Apci(date1, date2, ebpv, ehpv[0], x, y, s, astrom)
 
 	// Equation of the origins. 
-	eo = GoEors(cr, s)
+	eo = Eors(cr, s)
 
 	return astrom, eo
 }
@@ -0,0 +1,44 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoApco For a terrestrial observer, prepare star-independent
+//  astrometry parameters for transformations between ICRS and observed
+//  coordinates.  The caller supplies the Earth ephemeris, the Earth
+//  rotation information and the refraction constants as well as the
+//  site coordinates.
+// void iauApco(double date1, double date2,
+//              double ebpv[2][3], double ehp[3],
+//              double x, double y, double s, double theta,
+//              double elong, double phi, double hm,
+//              double xp, double yp, double sp,
+//              double refa, double refb,
+//              iauASTROM *astrom)
+func CgoApco(date1, date2 float64, ebpv [2][3]float64, ehp [3]float64,
+	x, y, s, theta, elong, phi, hm, xp, yp, sp, refa, refb float64,
+	astr ASTROM) (astrom ASTROM) {
+
+	var cEbpv [2][3]C.double
+	var cEhp [3]C.double
+
+	// Go to C
+	cAstrom := astrGo2C(astr)
+	cEbpv = v3dGo2C(ebpv)
+	cEhp = v3sGo2C(ehp)
+
+	// Apco
+	C.iauApco(C.double(date1), C.double(date2),
+		&cEbpv[0], &cEhp[0],
+		C.double(x), C.double(y), C.double(s), C.double(theta),
+		C.double(elong), C.double(phi), C.double(hm),
+		C.double(xp), C.double(yp), C.double(sp),
+		C.double(refa), C.double(refb),
+		&cAstrom)
+
+	// C to go
+	return astrC2Go(cAstrom)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoApco For a terrestrial observer, prepare star-independent
+//  Apco For a terrestrial observer, prepare star-independent
 //  astrometry parameters for transformations between ICRS and observed
 //  coordinates.  The caller supplies the Earth ephemeris, the Earth
 //  rotation information and the refraction constants as well as the
@@ -159,7 +157,7 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoApco For a terrestrial observer, prepare star-independent
+//  Apco For a terrestrial observer, prepare star-independent
 //  astrometry parameters for transformations between ICRS and observed
 //  coordinates.  The caller supplies the Earth ephemeris, the Earth
 //  rotation information and the refraction constants as well as the
@@ -171,37 +169,7 @@ import "math"
 //              double xp, double yp, double sp,
 //              double refa, double refb,
 //              iauASTROM *astrom)
-func CgoApco(date1, date2 float64, ebpv [2][3]float64, ehp [3]float64,
-	x, y, s, theta, elong, phi, hm, xp, yp, sp, refa, refb float64,
-	astr ASTROM) (astrom ASTROM) {
-
-	var cEbpv [2][3]C.double
-	var cEhp [3]C.double
-
-	// Go to C
-	cAstrom := astrGo2C(astr)
-	cEbpv = v3dGo2C(ebpv)
-	cEhp = v3sGo2C(ehp)
-
-	// Apco
-	C.iauApco(C.double(date1), C.double(date2),
-		&cEbpv[0], &cEhp[0],
-		C.double(x), C.double(y), C.double(s), C.double(theta),
-		C.double(elong), C.double(phi), C.double(hm),
-		C.double(xp), C.double(yp), C.double(sp),
-		C.double(refa), C.double(refb),
-		&cAstrom)
-
-	// C to go
-	return astrC2Go(cAstrom)
-}
-
-//  GoApco For a terrestrial observer, prepare star-independent
-//  astrometry parameters for transformations between ICRS and observed
-//  coordinates.  The caller supplies the Earth ephemeris, the Earth
-//  rotation information and the refraction constants as well as the
-//  site coordinates.
-func GoApco(date1, date2 float64, ebpv [2][3]float64, ehp [3]float64,
+func Apco(date1, date2 float64, ebpv [2][3]float64, ehp [3]float64,
 	x, y, s, theta, elong, phi, hm, xp, yp, sp, refa, refb float64,
 	astrom ASTROM) ASTROM {
 
@@ -210,42 +178,42 @@ func GoApco(date1, date2 float64, ebpv [2][3]float64, ehp [3]float64,
 	var pvc, pv [2][3]float64
 
 	// Longitude with adjustment for TIO locator s'.
-	astrom.along = elong + sp
+	astrom.Along = elong + sp
 
 	// Polar motion, rotated onto the local meridian.
-	sl = math.Sin(astrom.along)
-	cl = math.Cos(astrom.along)
-	astrom.xpl = xp*cl - yp*sl
-	astrom.ypl = xp*sl + yp*cl
+	sl = math.Sin(astrom.Along)
+	cl = math.Cos(astrom.Along)
+	astrom.Xpl = xp*cl - yp*sl
+	astrom.Ypl = xp*sl + yp*cl
 
 	// Functions of latitude.
-	astrom.sphi = math.Sin(phi)
-	astrom.cphi = math.Cos(phi)
+	astrom.Sphi = math.Sin(phi)
+	astrom.Cphi = math.Cos(phi)
 
 	// Refraction constants.
-	astrom.refa = refa
-	astrom.refb = refb
+	astrom.Refa = refa
+	astrom.Refb = refb
 
 	// Local Earth rotation angle.
-	astrom = GoAper(theta, astrom)
+	astrom = Aper(theta, astrom)
 
 	// Disable the (redundant) diurnal aberration step.
-	astrom.diurab = 0.0
+	astrom.Diurab = 0.0
 
 	// CIO based BPN matrix.
-	r = GoC2ixys(x, y, s)
+	r = C2ixys(x, y, s)
 
 	// Observer's geocentric position and velocity (m, m/s, CIRS).
-	pvc = GoPvtob(elong, phi, hm, xp, yp, sp, theta)
+	pvc = Pvtob(elong, phi, hm, xp, yp, sp, theta)
 
 	// Rotate into GCRS.
-	pv = GoTrxpv(r, pvc)
+	pv = Trxpv(r, pvc)
 
 	// ICRS <. GCRS parameters.
-	astrom = GoApcs(date1, date2, pv, ebpv, ehp, astrom)
+	astrom = Apcs(date1, date2, pv, ebpv, ehp, astrom)
 
 	// Store the CIO based BPN matrix.
-	astrom.bpn = r
+	astrom.Bpn = r
 
 	return astrom
 }
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauApco13(double utc1, double utc2, double dut1,
@@ -0,0 +1,38 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import (
+	"github.com/8i8/sofa/en"
+)
+
+//  CgoApco13 is the SOFA C version of Apco13.
+func CgoApco13(utc1, utc2, dut1, elong, phi, hm,
+	xp, yp, phpa, tc, rh, wl float64,
+	astrom ASTROM) (ASTROM, float64, en.ErrNum) {
+
+	var cEo C.double
+	var err en.ErrNum
+	cAstrom := astrGo2C(astrom)
+
+	cI := C.iauApco13(C.double(utc1), C.double(utc2),
+		C.double(dut1), C.double(elong), C.double(phi),
+		C.double(hm), C.double(xp), C.double(yp),
+		C.double(phpa), C.double(tc), C.double(rh),
+		C.double(wl), &cAstrom, &cEo)
+
+	switch int(cI) {
+	case 0:
+	case -1:
+		err = errApco13.Set(-1)
+	case 1:
+		err = errApco13.Set(1)
+	default:
+		err = errApco13.Set(0)
+	}
+
+	return astrC2Go(cAstrom), float64(cEo), err
+}
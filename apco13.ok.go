@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "github.com/8i8/sofa/en"
 
 var errApco13 = en.New(1, "Apco13", []string{
@@ -10,7 +8,7 @@ var errApco13 = en.New(1, "Apco13", []string{
 	"dubious year (Apco14 documentation note 2)",
 })
 
-//  CgoApcp13 For a terrestrial observer, prepare star-independent
+//  Apco13 For a terrestrial observer, prepare star-independent
 //  astrometry parameters for transformations between ICRS and observed
 //  coordinates.  The caller supplies UTC, site coordinates, ambient air
 //  conditions and observing wavelength, and SOFA models are used to
@@ -189,46 +187,27 @@ var errApco13 = en.New(1, "Apco13", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoApcp13 For a terrestrial observer, prepare star-independent
+//  Apco13 For a terrestrial observer, prepare star-independent
 //  astrometry parameters for transformations between ICRS and observed
 //  coordinates.  The caller supplies UTC, site coordinates, ambient air
 //  conditions and observing wavelength, and SOFA models are used to
 //  obtain the Earth ephemeris, CIP/CIO and refraction constants.
-func CgoApco13(utc1, utc2, dut1, elong, phi, hm,
+func Apco13(utc1, utc2, dut1, elong, phi, hm,
 	xp, yp, phpa, tc, rh, wl float64,
-	astrom ASTROM) (ASTROM, float64, en.ErrNum) {
-
-	var cEo C.double
-	var err en.ErrNum
-	cAstrom := astrGo2C(astrom)
-
-	cI := C.iauApco13(C.double(utc1), C.double(utc2),
-		C.double(dut1), C.double(elong), C.double(phi),
-		C.double(hm), C.double(xp), C.double(yp),
-		C.double(phpa), C.double(tc), C.double(rh),
-		C.double(wl), &cAstrom, &cEo)
-
-	switch int(cI) {
-	case 0:
-	case -1:
-		err = errApco13.Set(-1)
-	case 1:
-		err = errApco13.Set(1)
-	default:
-		err = errApco13.Set(0)
-	}
+	astr ASTROM) (astrom ASTROM, eo float64, err en.ErrNum) {
 
-	return astrC2Go(cAstrom), float64(cEo), err
+	astrom, eo, err, _ = apco13(IAU2006A{}, SOFAEphemeris{}, utc1,
+		utc2, dut1, elong, phi, hm, xp, yp, 0, 0, phpa, tc, rh, wl,
+		astr)
+	return
 }
 
-// GoApcp13 For a terrestrial observer, prepare star-independent
-// astrometry parameters for transformations between ICRS and observed
-// coordinates.  The caller supplies UTC, site coordinates, ambient air
-// conditions and observing wavelength, and SOFA models are used to
-// obtain the Earth ephemeris, CIP/CIO and refraction constants.
-func GoApco13(utc1, utc2, dut1, elong, phi, hm,
-	xp, yp, phpa, tc, rh, wl float64,
-	astr ASTROM) (astrom ASTROM, eo float64, err en.ErrNum) {
+// apco13 is Apco13 with the precession-nutation model m, the celestial
+// pole offsets dx,dy and the Earth from eph, the error of which is
+// returned as eerr.  If eerr is not a warning, astrom is not set.
+func apco13(m PNModel, eph Ephemeris, utc1, utc2, dut1, elong, phi,
+	hm, xp, yp, dx, dy, phpa, tc, rh, wl float64,
+	astr ASTROM) (astrom ASTROM, eo float64, err en.ErrNum, eerr error) {
 
 	var tai1, tai2, tt1, tt2, ut11, ut12 float64
 	var ehpv, ebpv [2][3]float64
@@ -236,45 +215,49 @@ func GoApco13(utc1, utc2, dut1, elong, phi, hm,
 	var x, y, s, theta, sp, refa, refb float64
 
 	// UTC to other time scales.
-	tai1, tai2, err = GoUtctai(utc1, utc2)
-	if err != nil && err.Is() < 0 {
+	tai1, tai2, err = Utctai(utc1, utc2)
+	if err != nil && err.Code() < 0 {
 		err = errApco13.Set(-1)
-		return
+		return astr, eo, err, eerr
 	}
-	tt1, tt2, _ = GoTaitt(tai1, tai2)
-	ut11, ut12, err = GoUtcut1(utc1, utc2, dut1)
-	if err != nil && err.Is() < 0 {
+	tt1, tt2, _ = Taitt(tai1, tai2)
+	ut11, ut12, err = Utcut1(utc1, utc2, dut1)
+	if err != nil && err.Code() < 0 {
 		err = errApco13.Set(-1)
-		return
+		return astr, eo, err, eerr
 	}
 
 	// Earth barycentric & heliocentric position/velocity (au, au/d).
-	ehpv, ebpv, _ = GoEpv00(tt1, tt2)
+	ehpv, ebpv, eerr = eph.Epv(tt1, tt2)
+	if eerr != nil && !IsWarning(eerr) {
+		return astr, eo, err, eerr
+	}
 
-	// Form the equinox based BPN matrix, IAU 2006/2000A.
-	r = GoPnm06a(tt1, tt2)
+	// Form the equinox based BPN matrix of the model.
+	r = m.Bpn(tt1, tt2)
 
-	// Extract CIP X,Y.
-	x, y = GoBpn2xy(r)
+	// Extract CIP X,Y, and apply the pole offsets.
+	x, y = Bpn2xy(r)
+	r, x, y = cipOffsets(m, tt1, tt2, r, x, y, dx, dy)
 
 	// Obtain CIO locator s.
-	s = GoS06(tt1, tt2, x, y)
+	s = m.S(tt1, tt2, x, y)
 
 	// Earth rotation angle.
-	theta = GoEra00(ut11, ut12)
+	theta = Era00(ut11, ut12)
 
 	// TIO locator s'.
-	sp = GoSp00(tt1, tt2)
+	sp = Sp00(tt1, tt2)
 
 	// Refraction constants A and B.
-	refa, refb = GoRefco(phpa, tc, rh, wl)
+	refa, refb = Refco(phpa, tc, rh, wl)
 
 	// Compute the star-independent astrometry parameters.
-	astrom = GoApco(tt1, tt2, ebpv, ehpv[0], x, y, s, theta,
+	astrom = Apco(tt1, tt2, ebpv, ehpv[0], x, y, s, theta,
 		elong, phi, hm, xp, yp, sp, refa, refb, astr)
 
 	// Equation of the origins.
-	eo = GoEors(r, s)
+	eo = Eors(r, s)
 
 	return
 }
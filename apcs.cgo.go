@@ -0,0 +1,28 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoApcs For an observer whose geocentric position and velocity are
+//  known, prepare star-independent astrometry parameters for
+//  transformations between ICRS and GCRS.  The Earth ephemeris is
+//  supplied by the caller.
+func CgoApcs(date1, date2 float64, pv, ebpv [2][3]float64,
+	ehp [3]float64, astrom ASTROM) ASTROM {
+
+	// Go into c types.
+	cAstrom := astrGo2C(astrom)
+	cPv := v3dGo2C(pv)
+	cEbpv := v3dGo2C(ebpv)
+	cEhp := v3sGo2C(ehp)
+
+	// Compute the star-independent astrometry parameters.
+	C.iauApcs(C.double(date1), C.double(date2),
+		&cPv[0], &cEbpv[0], &cEhp[0], &cAstrom)
+
+	// C into go types.
+	return astrC2Go(cAstrom)
+}
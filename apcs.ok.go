@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoApcs For an observer whose geocentric position and velocity are
+//  Apcs For an observer whose geocentric position and velocity are
 //  known, prepare star-independent astrometry parameters for
 //  transformations between ICRS and GCRS.  The Earth ephemeris is
 //  supplied by the caller.
@@ -133,34 +131,11 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoApcs For an observer whose geocentric position and velocity are
+//  Apcs For an observer whose geocentric position and velocity are
 //  known, prepare star-independent astrometry parameters for
 //  transformations between ICRS and GCRS.  The Earth ephemeris is
 //  supplied by the caller.
-func CgoApcs(date1, date2 float64, pv, ebpv [2][3]float64,
-	ehp [3]float64, astrom ASTROM) ASTROM {
-
-	// C Output data.
-	var cAstrom C.iauASTROM
-
-	// Go into c types.
-	cPv := v3dGo2C(pv)
-	cEbpv := v3dGo2C(ebpv)
-	cEhp := v3sGo2C(ehp)
-
-	// Compute the star-independent astrometry parameters.
-	C.iauApcs(C.double(date1), C.double(date2),
-		&cPv[0], &cEbpv[0], &cEhp[0], &cAstrom)
-
-	// C into go types.
-	return astrC2Go(cAstrom)
-}
-
-//  GoApcs For an observer whose geocentric position and velocity are
-//  known, prepare star-independent astrometry parameters for
-//  transformations between ICRS and GCRS.  The Earth ephemeris is
-//  supplied by the caller.
-func GoApcs(date1, date2 float64, pv, ebpv [2][3]float64,
+func Apcs(date1, date2 float64, pv, ebpv [2][3]float64,
 	ehp [3]float64, astrom ASTROM) ASTROM {
 
 	// au/d to m/s
@@ -174,7 +149,7 @@ func GoApcs(date1, date2 float64, pv, ebpv [2][3]float64,
 	var pb, vb, ph [3]float64
 
 	// Time since reference epoch, years (for proper motion calculation).
-	astrom.pmt = ((date1 - DJ00) + date2) / DJY
+	astrom.Pmt = ((date1 - DJ00) + date2) / DJY
 
 	// Adjust Earth ephemeris to observer.
 	for i = 0; i < 3; i++ {
@@ -186,20 +161,20 @@ func GoApcs(date1, date2 float64, pv, ebpv [2][3]float64,
 	}
 
 	// Barycentric position of observer (au).
-	astrom.eb = pb
+	astrom.Eb = pb
 
 	// Heliocentric direction and distance (unit vector and au).
-	astrom.em, astrom.eh = GoPn(ph)
+	astrom.Em, astrom.Eh = Pn(ph)
 
 	// Barycentric vel. in units of c, and reciprocal of Lorenz factor.
 	for i = 0; i < 3; i++ {
 		w = vb[i] * CR
-		astrom.v[i] = w
+		astrom.V[i] = w
 		v2 += w * w
 	}
-	astrom.bm1 = math.Sqrt(1.0 - v2)
+	astrom.Bm1 = math.Sqrt(1.0 - v2)
 
 	// Reset the NPB matrix.
-	astrom.bpn = GoIr()
+	astrom.Bpn = Ir()
 	return astrom
 }
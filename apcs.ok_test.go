@@ -45,34 +45,34 @@ func TestApcs(t *testing.T) {
 			e [3]float64, f ASTROM) ASTROM
 	}{
 		{"cgo", CgoApcs},
-		{"go", GoApcs},
+		{"go", Apcs},
 	}
 
 	for _, test := range tests {
 		tname := fname + " " + test.ref
 		astrom := test.fn(date1, date2, pv, ebpv, ehp, astr)
 
-		vvd(t, astrom.pmt, 13.25248468622587269, 1e-11, tname, "pmt")
-		vvd(t, astrom.eb[0], -0.9741827110629881886, 1e-12, tname, "eb(1)")
-		vvd(t, astrom.eb[1], -0.2115130190136415986, 1e-12, tname, "eb(2)")
-		vvd(t, astrom.eb[2], -0.09179840186954412099, 1e-12, tname, "eb(3)")
-		vvd(t, astrom.eh[0], -0.9736425571689454706, 1e-12, tname, "eh(1)")
-		vvd(t, astrom.eh[1], -0.2092452125850435930, 1e-12, tname, "eh(2)")
-		vvd(t, astrom.eh[2], -0.09075578152248299218, 1e-12, tname, "eh(3)")
-		vvd(t, astrom.em, 0.9998233241709796859, 1e-12, tname, "em")
-		vvd(t, astrom.v[0], 0.2078704993282685510e-4, 1e-16, tname, "v(1)")
-		vvd(t, astrom.v[1], -0.8955360106989405683e-4, 1e-16, tname, "v(2)")
-		vvd(t, astrom.v[2], -0.3863338994289409097e-4, 1e-16, tname, "v(3)")
-		vvd(t, astrom.bm1, 0.9999999950277561237, 1e-12, tname, "bm1")
-		vvd(t, astrom.bpn[0][0], 1, 0, tname, "bpn(1,1)")
-		vvd(t, astrom.bpn[1][0], 0, 0, tname, "bpn(2,1)")
-		vvd(t, astrom.bpn[2][0], 0, 0, tname, "bpn(3,1)")
-		vvd(t, astrom.bpn[0][1], 0, 0, tname, "bpn(1,2)")
-		vvd(t, astrom.bpn[1][1], 1, 0, tname, "bpn(2,2)")
-		vvd(t, astrom.bpn[2][1], 0, 0, tname, "bpn(3,2)")
-		vvd(t, astrom.bpn[0][2], 0, 0, tname, "bpn(1,3)")
-		vvd(t, astrom.bpn[1][2], 0, 0, tname, "bpn(2,3)")
-		vvd(t, astrom.bpn[2][2], 1, 0, tname, "bpn(3,3)")
+		vvd(t, astrom.Pmt, 13.25248468622587269, 1e-11, tname, "pmt")
+		vvd(t, astrom.Eb[0], -0.9741827110629881886, 1e-12, tname, "eb(1)")
+		vvd(t, astrom.Eb[1], -0.2115130190136415986, 1e-12, tname, "eb(2)")
+		vvd(t, astrom.Eb[2], -0.09179840186954412099, 1e-12, tname, "eb(3)")
+		vvd(t, astrom.Eh[0], -0.9736425571689454706, 1e-12, tname, "eh(1)")
+		vvd(t, astrom.Eh[1], -0.2092452125850435930, 1e-12, tname, "eh(2)")
+		vvd(t, astrom.Eh[2], -0.09075578152248299218, 1e-12, tname, "eh(3)")
+		vvd(t, astrom.Em, 0.9998233241709796859, 1e-12, tname, "em")
+		vvd(t, astrom.V[0], 0.2078704993282685510e-4, 1e-16, tname, "v(1)")
+		vvd(t, astrom.V[1], -0.8955360106989405683e-4, 1e-16, tname, "v(2)")
+		vvd(t, astrom.V[2], -0.3863338994289409097e-4, 1e-16, tname, "v(3)")
+		vvd(t, astrom.Bm1, 0.9999999950277561237, 1e-12, tname, "bm1")
+		vvd(t, astrom.Bpn[0][0], 1, 0, tname, "bpn(1,1)")
+		vvd(t, astrom.Bpn[1][0], 0, 0, tname, "bpn(2,1)")
+		vvd(t, astrom.Bpn[2][0], 0, 0, tname, "bpn(3,1)")
+		vvd(t, astrom.Bpn[0][1], 0, 0, tname, "bpn(1,2)")
+		vvd(t, astrom.Bpn[1][1], 1, 0, tname, "bpn(2,2)")
+		vvd(t, astrom.Bpn[2][1], 0, 0, tname, "bpn(3,2)")
+		vvd(t, astrom.Bpn[0][2], 0, 0, tname, "bpn(1,3)")
+		vvd(t, astrom.Bpn[1][2], 0, 0, tname, "bpn(2,3)")
+		vvd(t, astrom.Bpn[2][2], 1, 0, tname, "bpn(3,3)")
 	}
 }
 
@@ -107,7 +107,7 @@ func BenchmarkApcs(b *testing.B) {
 			e [3]float64, f ASTROM) ASTROM
 	}{
 		{"cgo", CgoApcs},
-		{"go", GoApcs},
+		{"go", Apcs},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,20 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoApcs13 For an observer whose geocentric position and velocity are
+//  known, prepare star-independent astrometry parameters for
+//  transformations between ICRS and GCRS.  The Earth ephemeris is from
+//  SOFA models.
+func CgoApcs13(date1, date2 float64, pv [2][3]float64,
+	astrom ASTROM) ASTROM {
+
+	cAstrom := astrGo2C(astrom)
+	cPv := v3dGo2C(pv)
+	C.iauApcs13(C.double(date1), C.double(date2), &cPv[0], &cAstrom)
+	return astrC2Go(cAstrom)
+}
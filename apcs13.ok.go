@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoApcs13 For an observer whose geocentric position and velocity are
+//  Apcs13 For an observer whose geocentric position and velocity are
 //  known, prepare star-independent astrometry parameters for
 //  transformations between ICRS and GCRS.  The Earth ephemeris is from
 //  SOFA models.
@@ -123,33 +120,20 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoApcs13 For an observer whose geocentric position and velocity are
-//  known, prepare star-independent astrometry parameters for
-//  transformations between ICRS and GCRS.  The Earth ephemeris is from
-//  SOFA models.
-func CgoApcs13(date1, date2 float64, pv [2][3]float64,
-	astrom ASTROM) ASTROM {
-
-	cAstrom := astrGo2C(astrom)
-	cPv := v3dGo2C(pv)
-	C.iauApcs13(C.double(date1), C.double(date2), &cPv[0], &cAstrom)
-	return astrC2Go(cAstrom)
-}
-
-//  GoApcs13 For an observer whose geocentric position and velocity are
+//  Apcs13 For an observer whose geocentric position and velocity are
 //  known, prepare star-independent astrometry parameters for
 //  transformations between ICRS and GCRS.  The Earth ephemeris is from
 //  SOFA models.
-func GoApcs13(date1, date2 float64, pv [2][3]float64,
+func Apcs13(date1, date2 float64, pv [2][3]float64,
 	astrom ASTROM) ASTROM {
 
 	var ehpv, ebpv [2][3]float64
 
 	// Earth barycentric & heliocentric position/velocity (au, au/d).
-	ehpv, ebpv, _ = GoEpv00(date1, date2)
+	ehpv, ebpv, _ = Epv00(date1, date2)
 
 	// Compute the star-independent astrometry parameters.
-	astrom = GoApcs(date1, date2, pv, ebpv, ehpv[0], astrom)
+	astrom = Apcs(date1, date2, pv, ebpv, ehpv[0], astrom)
 
 	return astrom
 }
@@ -34,52 +34,52 @@ func TestApcs13(t *testing.T) {
 			a4 ASTROM) ASTROM
 	}{
 		{"cgo", CgoApcs13},
-		{"go", GoApcs13},
+		{"go", Apcs13},
 	}
 
 	for _, test := range tests {
 		tname := fname + " " + test.ref
 		astrom := test.fn(date1, date2, pv, astr)
 
-		vvd(t, astrom.pmt, 12.65133794027378508, 1e-11,
+		vvd(t, astrom.Pmt, 12.65133794027378508, 1e-11,
 			tname, "pmt")
 
-		vvd(t, astrom.eb[0], 0.9012691529025250644, 1e-12,
+		vvd(t, astrom.Eb[0], 0.9012691529025250644, 1e-12,
 			tname, "eb(1)")
-		vvd(t, astrom.eb[1], -0.4173999812023194317, 1e-12,
+		vvd(t, astrom.Eb[1], -0.4173999812023194317, 1e-12,
 			tname, "eb(2)")
-		vvd(t, astrom.eb[2], -0.1809906511146429670, 1e-12,
+		vvd(t, astrom.Eb[2], -0.1809906511146429670, 1e-12,
 			tname, "eb(3)")
 
-		vvd(t, astrom.eh[0], 0.8939939101760130792, 1e-12,
+		vvd(t, astrom.Eh[0], 0.8939939101760130792, 1e-12,
 			tname, "eh(1)")
-		vvd(t, astrom.eh[1], -0.4111053891734021478, 1e-12,
+		vvd(t, astrom.Eh[1], -0.4111053891734021478, 1e-12,
 			tname, "eh(2)")
-		vvd(t, astrom.eh[2], -0.1782336880636997374, 1e-12,
+		vvd(t, astrom.Eh[2], -0.1782336880636997374, 1e-12,
 			tname, "eh(3)")
 
-		vvd(t, astrom.em, 1.010428384373491095, 1e-12,
+		vvd(t, astrom.Em, 1.010428384373491095, 1e-12,
 			tname, "em")
 
-		vvd(t, astrom.v[0], 0.4279877294121697570e-4, 1e-16,
+		vvd(t, astrom.V[0], 0.4279877294121697570e-4, 1e-16,
 			tname, "v(1)")
-		vvd(t, astrom.v[1], 0.7963255087052120678e-4, 1e-16,
+		vvd(t, astrom.V[1], 0.7963255087052120678e-4, 1e-16,
 			tname, "v(2)")
-		vvd(t, astrom.v[2], 0.3517564013384691531e-4, 1e-16,
+		vvd(t, astrom.V[2], 0.3517564013384691531e-4, 1e-16,
 			tname, "v(3)")
 
-		vvd(t, astrom.bm1, 0.9999999952947980978, 1e-12,
+		vvd(t, astrom.Bm1, 0.9999999952947980978, 1e-12,
 			tname, "bm1")
 
-		vvd(t, astrom.bpn[0][0], 1, 0, tname, "bpn(1,1)")
-		vvd(t, astrom.bpn[1][0], 0, 0, tname, "bpn(2,1)")
-		vvd(t, astrom.bpn[2][0], 0, 0, tname, "bpn(3,1)")
-		vvd(t, astrom.bpn[0][1], 0, 0, tname, "bpn(1,2)")
-		vvd(t, astrom.bpn[1][1], 1, 0, tname, "bpn(2,2)")
-		vvd(t, astrom.bpn[2][1], 0, 0, tname, "bpn(3,2)")
-		vvd(t, astrom.bpn[0][2], 0, 0, tname, "bpn(1,3)")
-		vvd(t, astrom.bpn[1][2], 0, 0, tname, "bpn(2,3)")
-		vvd(t, astrom.bpn[2][2], 1, 0, tname, "bpn(3,3)")
+		vvd(t, astrom.Bpn[0][0], 1, 0, tname, "bpn(1,1)")
+		vvd(t, astrom.Bpn[1][0], 0, 0, tname, "bpn(2,1)")
+		vvd(t, astrom.Bpn[2][0], 0, 0, tname, "bpn(3,1)")
+		vvd(t, astrom.Bpn[0][1], 0, 0, tname, "bpn(1,2)")
+		vvd(t, astrom.Bpn[1][1], 1, 0, tname, "bpn(2,2)")
+		vvd(t, astrom.Bpn[2][1], 0, 0, tname, "bpn(3,2)")
+		vvd(t, astrom.Bpn[0][2], 0, 0, tname, "bpn(1,3)")
+		vvd(t, astrom.Bpn[1][2], 0, 0, tname, "bpn(2,3)")
+		vvd(t, astrom.Bpn[2][2], 1, 0, tname, "bpn(3,3)")
 	}
 }
 
@@ -103,7 +103,7 @@ func BenchmarkApcs13(b *testing.B) {
 			a4 ASTROM) ASTROM
 	}{
 		{"cgo", CgoApcs13},
-		{"go", GoApcs13},
+		{"go", Apcs13},
 	}
 
 	for _, test := range tests {
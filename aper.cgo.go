@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAper In the star-independent astrometry parameters, update only
+//  the Earth rotation angle, supplied by the caller explicitly.
+func CgoAper(theta float64, astrom ASTROM) ASTROM {
+	cAstrom := astrGo2C(astrom)
+	C.iauAper(C.double(theta), &cAstrom)
+	return astrC2Go(cAstrom)
+}
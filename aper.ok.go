@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoAper In the star-independent astrometry parameters, update only
+//  Aper In the star-independent astrometry parameters, update only
 //  the Earth rotation angle, supplied by the caller explicitly.
 //
 //  - - - - -
@@ -101,17 +98,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAper In the star-independent astrometry parameters, update only
-//  the Earth rotation angle, supplied by the caller explicitly.
-func CgoAper(theta float64, astrom ASTROM) ASTROM {
-	cAstrom := astrGo2C(astrom)
-	C.iauAper(C.double(theta), &cAstrom)
-	return astrC2Go(cAstrom)
-}
-
-//  GoAper In the star-independent astrometry parameters, update only
+//  Aper In the star-independent astrometry parameters, update only
 //  the Earth rotation angle, supplied by the caller explicitly.
-func GoAper(theta float64, astrom ASTROM) ASTROM {
-	astrom.eral = theta + astrom.along
+func Aper(theta float64, astrom ASTROM) ASTROM {
+	astrom.Eral = theta + astrom.Along
 	return astrom
 }
@@ -18,7 +18,7 @@ func TestAper(t *testing.T) {
 	var theta float64
 	var astrom ASTROM
 
-	astrom.along = 1.234
+	astrom.Along = 1.234
 	theta = 5.678
 
 	tests := []struct {
@@ -26,13 +26,13 @@ func TestAper(t *testing.T) {
 		fn  func(float64, ASTROM) ASTROM
 	}{
 		{"cgo", CgoAper},
-		{"go", GoAper},
+		{"go", Aper},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
 		astrom2 := test.fn(theta, astrom)
 
-		vvd(t, astrom2.eral, 6.912000000000000000, 1e-12,
+		vvd(t, astrom2.Eral, 6.912000000000000000, 1e-12,
 			tname, "pmt")
 	}
 }
@@ -41,7 +41,7 @@ func BenchmarkAper(b *testing.B) {
 	var theta float64
 	var astrom ASTROM
 
-	astrom.along = 1.234
+	astrom.Along = 1.234
 	theta = 5.678
 
 	tests := []struct {
@@ -49,7 +49,7 @@ func BenchmarkAper(b *testing.B) {
 		fn  func(float64, ASTROM) ASTROM
 	}{
 		{"cgo", CgoAper},
-		{"go", GoAper},
+		{"go", Aper},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauAper13(double ut11, double ut12, iauASTROM *astrom)
@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAper13 In the star-independent astrometry parameters, update only
+//  the Earth rotation angle.  The caller provides UT1, (n.b. not UTC).
+//void iauAper13(double ut11, double ut12, iauASTROM *astrom)
+func CgoAper13(ut11, ut12 float64, astrom ASTROM) ASTROM {
+	cAstrom := astrGo2C(astrom)
+	C.iauAper(C.iauEra00(C.double(ut11), C.double(ut12)), &cAstrom)
+	return astrC2Go(cAstrom)
+}
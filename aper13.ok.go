@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoAper13 In the star-independent astrometry parameters, update only
+//  Aper13 In the star-independent astrometry parameters, update only
 //  the Earth rotation angle.  The caller provides UT1, (n.b. not UTC).
 //
 //  - - - - - - -
@@ -120,17 +117,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAper13 In the star-independent astrometry parameters, update only
+//  Aper13 In the star-independent astrometry parameters, update only
 //  the Earth rotation angle.  The caller provides UT1, (n.b. not UTC).
 //void iauAper13(double ut11, double ut12, iauASTROM *astrom)
-func CgoAper13(ut11, ut12 float64, astrom ASTROM) ASTROM {
-	cAstrom := astrGo2C(astrom)
-	C.iauAper(C.iauEra00(C.double(ut11), C.double(ut12)), &cAstrom)
-	return astrC2Go(cAstrom)
-}
-
-//  GoAper13 In the star-independent astrometry parameters, update only
-//  the Earth rotation angle.  The caller provides UT1, (n.b. not UTC).
-func GoAper13(ut11, ut12 float64, astrom ASTROM) ASTROM {
-	return GoAper(GoEra00(ut11, ut12), astrom)
+func Aper13(ut11, ut12 float64, astrom ASTROM) ASTROM {
+	return Aper(Era00(ut11, ut12), astrom)
 }
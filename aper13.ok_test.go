@@ -18,7 +18,7 @@ func TestAper13(t *testing.T) {
 	var ut11, ut12 float64
 	var astrom ASTROM
 
-	astrom.along = 1.234
+	astrom.Along = 1.234
 	ut11 = 2456165.5
 	ut12 = 0.401182685
 
@@ -27,7 +27,7 @@ func TestAper13(t *testing.T) {
 		fn  func(a1, a2 float64, a3 ASTROM) ASTROM
 	}{
 		{"cgo", CgoAper13},
-		{"go", GoAper13},
+		{"go", Aper13},
 	}
 
 	for _, test := range tests {
@@ -35,7 +35,7 @@ func TestAper13(t *testing.T) {
 
 		astr := test.fn(ut11, ut12, astrom)
 
-		vvd(t, astr.eral, 3.316236661789694933, 1e-12,
+		vvd(t, astr.Eral, 3.316236661789694933, 1e-12,
 			tname, "pmt")
 	}
 }
@@ -44,7 +44,7 @@ func BenchmarkAper13(b *testing.B) {
 	var ut11, ut12 float64
 	var astrom ASTROM
 
-	astrom.along = 1.234
+	astrom.Along = 1.234
 	ut11 = 2456165.5
 	ut12 = 0.401182685
 
@@ -53,7 +53,7 @@ func BenchmarkAper13(b *testing.B) {
 		fn  func(a1, a2 float64, a3 ASTROM) ASTROM
 	}{
 		{"cgo", CgoAper13},
-		{"go", GoAper13},
+		{"go", Aper13},
 	}
 
 	for _, test := range tests {
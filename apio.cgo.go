@@ -0,0 +1,25 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoApio For a terrestrial observer, prepare star-independent
+//  astrometry parameters for transformations between CIRS and observed
+//  coordinates.  The caller supplies the Earth orientation information
+//  and the refraction constants as well as the site coordinates.
+func CgoApio(
+	sp, theta,
+	elong, phi, hm, xp, yp,
+	refa, refb float64,
+	astrom ASTROM,
+) ASTROM {
+	cAstrom := astrGo2C(astrom)
+	C.iauApio(C.double(sp), C.double(theta),
+		C.double(elong), C.double(phi), C.double(hm), C.double(xp), C.double(yp),
+		C.double(refa), C.double(refb),
+		&cAstrom)
+	return astrC2Go(cAstrom)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoApio For a terrestrial observer, prepare star-independent
+//  Apio For a terrestrial observer, prepare star-independent
 //  astrometry parameters for transformations between CIRS and observed
 //  coordinates.  The caller supplies the Earth orientation information
 //  and the refraction constants as well as the site coordinates.
@@ -123,29 +121,11 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoApio For a terrestrial observer, prepare star-independent
+//  Apio For a terrestrial observer, prepare star-independent
 //  astrometry parameters for transformations between CIRS and observed
 //  coordinates.  The caller supplies the Earth orientation information
 //  and the refraction constants as well as the site coordinates.
-func CgoApio(
-	sp, theta,
-	elong, phi, hm, xp, yp,
-	refa, refb float64,
-	astrom ASTROM,
-) ASTROM {
-	cAstrom := astrGo2C(astrom)
-	C.iauApio(C.double(sp), C.double(theta),
-		C.double(elong), C.double(phi), C.double(hm), C.double(xp), C.double(yp),
-		C.double(refa), C.double(refb),
-		&cAstrom)
-	return astrC2Go(cAstrom)
-}
-
-//  GoApio For a terrestrial observer, prepare star-independent
-//  astrometry parameters for transformations between CIRS and observed
-//  coordinates.  The caller supplies the Earth orientation information
-//  and the refraction constants as well as the site coordinates.
-func GoApio(
+func Apio(
 	sp, theta,
 	elong, phi, hm, xp, yp,
 	refa, refb float64,
@@ -155,28 +135,28 @@ func GoApio(
 	var pv [2][3]float64
 
 	// Longitude with adjustment for TIO locator s'.
-	astrom.along = elong + sp
+	astrom.Along = elong + sp
 
 	// Polar motion, rotated onto the local meridian.
-	sl = math.Sin(astrom.along)
-	cl = math.Cos(astrom.along)
-	astrom.xpl = xp*cl - yp*sl
-	astrom.ypl = xp*sl + yp*cl
+	sl = math.Sin(astrom.Along)
+	cl = math.Cos(astrom.Along)
+	astrom.Xpl = xp*cl - yp*sl
+	astrom.Ypl = xp*sl + yp*cl
 
 	// Functions of latitude.
-	astrom.sphi = math.Sin(phi)
-	astrom.cphi = math.Cos(phi)
+	astrom.Sphi = math.Sin(phi)
+	astrom.Cphi = math.Cos(phi)
 
 	// Observer's geocentric position and velocity (m, m/s, CIRS).
-	pv = GoPvtob(elong, phi, hm, xp, yp, sp, theta)
+	pv = Pvtob(elong, phi, hm, xp, yp, sp, theta)
 
 	// Magnitude of diurnal aberration vector.
-	astrom.diurab = math.Sqrt(pv[1][0]*pv[1][0]+pv[1][1]*pv[1][1]) / CMPS
+	astrom.Diurab = math.Sqrt(pv[1][0]*pv[1][0]+pv[1][1]*pv[1][1]) / CMPS
 
 	// Refraction constants.
-	astrom.refa = refa
-	astrom.refb = refb
+	astrom.Refa = refa
+	astrom.Refb = refb
 
 	// Local Earth rotation angle.
-	return GoAper(theta, astrom)
+	return Aper(theta, astrom)
 }
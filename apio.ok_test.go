@@ -34,7 +34,7 @@ func TestApio(t *testing.T) {
 			a10 ASTROM) ASTROM
 	}{
 		{"cgo", CgoApio},
-		{"go", GoApio},
+		{"go", Apio},
 	}
 
 	for _, test := range tests {
@@ -44,23 +44,23 @@ func TestApio(t *testing.T) {
 			elong, phi, hm, xp, yp,
 			refa, refb, astrom)
 
-		vvd(t, astrom.along, -0.5278008060301974337, 1e-12,
+		vvd(t, astrom.Along, -0.5278008060301974337, 1e-12,
 			tname, "along")
-		vvd(t, astrom.xpl, 0.1133427418174939329e-5, 1e-17,
+		vvd(t, astrom.Xpl, 0.1133427418174939329e-5, 1e-17,
 			tname, "xpl")
-		vvd(t, astrom.ypl, 0.1453347595745898629e-5, 1e-17,
+		vvd(t, astrom.Ypl, 0.1453347595745898629e-5, 1e-17,
 			tname, "ypl")
-		vvd(t, astrom.sphi, -0.9440115679003211329, 1e-12,
+		vvd(t, astrom.Sphi, -0.9440115679003211329, 1e-12,
 			tname, "sphi")
-		vvd(t, astrom.cphi, 0.3299123514971474711, 1e-12,
+		vvd(t, astrom.Cphi, 0.3299123514971474711, 1e-12,
 			tname, "cphi")
-		vvd(t, astrom.diurab, 0.5135843661699913529e-6, 1e-12,
+		vvd(t, astrom.Diurab, 0.5135843661699913529e-6, 1e-12,
 			tname, "diurab")
-		vvd(t, astrom.eral, 2.617608903969802566, 1e-12,
+		vvd(t, astrom.Eral, 2.617608903969802566, 1e-12,
 			tname, "eral")
-		vvd(t, astrom.refa, 0.2014187790000000000e-3, 1e-15,
+		vvd(t, astrom.Refa, 0.2014187790000000000e-3, 1e-15,
 			tname, "refa")
-		vvd(t, astrom.refb, -0.2361408310000000000e-6, 1e-18,
+		vvd(t, astrom.Refb, -0.2361408310000000000e-6, 1e-18,
 			tname, "refb")
 	}
 }
@@ -85,7 +85,7 @@ func BenchmarkApio(b *testing.B) {
 			a10 ASTROM) ASTROM
 	}{
 		{"cgo", CgoApio},
-		{"go", GoApio},
+		{"go", Apio},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,39 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoApio13 For a terrestrial observer, prepare star-independent
+//  astrometry parameters for transformations between CIRS and observed
+//  coordinates.  The caller supplies UTC, site coordinates, ambient air
+//  conditions and observing wavelength.
+// int iauApio13(double utc1, double utc2, double dut1,
+//               double elong, double phi, double hm, double xp, double yp,
+//               double phpa, double tc, double rh, double wl,
+//               iauASTROM *astrom)
+func CgoApio13(utc1, utc2, dut1,
+	elong, phi, hm, xp, yp,
+	phpa, tc, rh, wl float64,
+	astrom ASTROM,
+) (ASTROM, error) {
+	var err error
+	cAstrom := astrGo2C(astrom)
+	cI := C.iauApio13(
+		C.double(utc1), C.double(utc2), C.double(dut1),
+		C.double(elong), C.double(phi), C.double(hm), C.double(xp), C.double(yp),
+		C.double(phpa), C.double(tc), C.double(rh), C.double(wl),
+		&cAstrom)
+	switch int(cI) {
+	case 0:
+	case 1:
+		err = errApio13Warn
+	case -1:
+		err = errApio13E1
+	default:
+		err = errAdmin
+	}
+	return astrC2Go(cAstrom), err
+}
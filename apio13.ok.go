@@ -1,13 +1,11 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "errors"
 
 var errApio13Warn = errors.New("dubious year (apio13 documentaion note 2)")
 var errApio13E1 = errors.New("unacceptable date")
 
-//  CgoApio13 For a terrestrial observer, prepare star-independent
+//  Apio13 For a terrestrial observer, prepare star-independent
 //  astrometry parameters for transformations between CIRS and observed
 //  coordinates.  The caller supplies UTC, site coordinates, ambient air
 //  conditions and observing wavelength.
@@ -175,7 +173,7 @@ var errApio13E1 = errors.New("unacceptable date")
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoApio13 For a terrestrial observer, prepare star-independent
+//  Apio13 For a terrestrial observer, prepare star-independent
 //  astrometry parameters for transformations between CIRS and observed
 //  coordinates.  The caller supplies UTC, site coordinates, ambient air
 //  conditions and observing wavelength.
@@ -183,65 +181,39 @@ var errApio13E1 = errors.New("unacceptable date")
 //               double elong, double phi, double hm, double xp, double yp,
 //               double phpa, double tc, double rh, double wl,
 //               iauASTROM *astrom)
-func CgoApio13(utc1, utc2, dut1,
+func Apio13(utc1, utc2, dut1,
 	elong, phi, hm, xp, yp,
 	phpa, tc, rh, wl float64,
 	astrom ASTROM,
 ) (ASTROM, error) {
-	var err error
-	cAstrom := astrGo2C(astrom)
-	cI := C.iauApio13(
-		C.double(utc1), C.double(utc2), C.double(dut1),
-		C.double(elong), C.double(phi), C.double(hm), C.double(xp), C.double(yp),
-		C.double(phpa), C.double(tc), C.double(rh), C.double(wl),
-		&cAstrom)
-	switch int(cI) {
-	case 0:
-	case 1:
-		err = errApio13Warn
-	case -1:
-		err = errApio13E1
-	default:
-		err = errAdmin
-	}
-	return astrC2Go(cAstrom), err
-}
-
-//  GoApio13 For a terrestrial observer, prepare star-independent
-//  astrometry parameters for transformations between CIRS and observed
-//  coordinates.  The caller supplies UTC, site coordinates, ambient air
-//  conditions and observing wavelength.
-func GoApio13(utc1, utc2, dut1,
-	elong, phi, hm, xp, yp,
-	phpa, tc, rh, wl float64,
-	astrom ASTROM,
-) (ASTROM, error) {
-	var tai1, tai2, tt1, tt2, ut11, ut12,
-		sp, theta, refa, refb float64
+	var tt1, tt2, ut11, ut12, sp, theta, refa, refb float64
 	var err error
 
 	// UTC to other time scales.
-	tai1, tai2, err = GoUtctai(utc1, utc2)
-	if err != nil && !errors.Is(err, errApio13Warn) {
+	tai1, tai2, j := Utctai(utc1, utc2)
+	if j != nil && j.Code() < 0 {
 		return astrom, errApio13E1
 	}
-	tt1, tt2, _ = GoTaitt(tai1, tai2)
-	ut11, ut12, err = GoUtcut1(utc1, utc2, dut1)
-	if err != nil && !errors.Is(err, errApio13Warn) {
+	tt1, tt2, _ = Taitt(tai1, tai2)
+	ut11, ut12, j = Utcut1(utc1, utc2, dut1)
+	if j != nil && j.Code() < 0 {
 		return astrom, errApio13E1
 	}
+	if j != nil {
+		err = errApio13Warn
+	}
 
 	// TIO locator s'.
-	sp = GoSp00(tt1, tt2)
+	sp = Sp00(tt1, tt2)
 
 	// Earth rotation angle.
-	theta = GoEra00(ut11, ut12)
+	theta = Era00(ut11, ut12)
 
 	// Refraction constants A and B.
-	refa, refb = GoRefco(phpa, tc, rh, wl)
+	refa, refb = Refco(phpa, tc, rh, wl)
 
 	// CIRS <-> observed astrometry parameters.
-	astrom = GoApio(
+	astrom = Apio(
 		sp, theta, elong, phi, hm, xp, yp, refa, refb, astrom)
 
 	// Return any warning status.
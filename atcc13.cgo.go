@@ -0,0 +1,23 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAtcc13 Transform a star's ICRS catalog entry (epoch J2000.0)
+//  into ICRS astrometric place.
+// void iauAtcc13(double rc, double dc,
+//                double pr, double pd, double px, double rv,
+//                double date1, double date2,
+//                double *ra, double *da)
+func CgoAtcc13(rc, dc, pr, pd, px, rv, date1, date2 float64) (
+	ra, da float64) {
+	var cRa, cDa C.double
+	C.iauAtcc13(C.double(rc), C.double(dc),
+		C.double(pr), C.double(pd), C.double(px), C.double(rv),
+		C.double(date1), C.double(date2),
+		&cRa, &cDa)
+	return float64(cRa), float64(cDa)
+}
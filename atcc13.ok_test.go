@@ -0,0 +1,79 @@
+package sofa
+
+import "testing"
+
+//
+//  - - - - - - - - - - -
+//   T e s t A t c c 1 3
+//  - - - - - - - - - - -
+//
+//  Test Atcc13 function.
+//
+//  Called:  Atcc13, vvd
+//
+//  This revision:  2021 April 18
+//
+func TestAtcc13(t *testing.T) {
+	const fname = "Atcc13"
+	var rc, dc, pr, pd, px, rv, date1, date2, ra, da float64
+
+	rc = 2.71
+	dc = 0.174
+	pr = 1e-5
+	pd = 5e-6
+	px = 0.1
+	rv = 55.0
+	date1 = 2456165.5
+	date2 = 0.401182685
+
+	tests := []struct {
+		ref string
+		fn  func(a1, a2, a3, a4, a5, a6, a7, a8 float64) (
+			b1, b2 float64)
+	}{
+		{"cgo", CgoAtcc13},
+		{"go", Atcc13},
+	}
+
+	for _, test := range tests {
+		tname := fname + " " + test.ref
+
+		ra, da = test.fn(rc, dc, pr, pd, px, rv,
+			date1, date2)
+
+		vvd(t, ra, 2.710126504531372384, 1e-12,
+			tname, "ra")
+		vvd(t, da, 0.1740632537628350152, 1e-12,
+			tname, "da")
+	}
+}
+
+func BenchmarkAtcc13(b *testing.B) {
+	var rc, dc, pr, pd, px, rv, date1, date2 float64
+
+	rc = 2.71
+	dc = 0.174
+	pr = 1e-5
+	pd = 5e-6
+	px = 0.1
+	rv = 55.0
+	date1 = 2456165.5
+	date2 = 0.401182685
+
+	tests := []struct {
+		ref string
+		fn  func(a1, a2, a3, a4, a5, a6, a7, a8 float64) (
+			b1, b2 float64)
+	}{
+		{"cgo", CgoAtcc13},
+		{"go", Atcc13},
+	}
+
+	for _, test := range tests {
+		b.Run(test.ref, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				test.fn(rc, dc, pr, pd, px, rv, date1, date2)
+			}
+		})
+	}
+}
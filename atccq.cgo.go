@@ -0,0 +1,23 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAtccq Quick transformation of a star's ICRS catalog entry
+//  (epoch J2000.0) into ICRS astrometric place, given precomputed
+//  star-independent astrometry parameters.
+// void iauAtccq(double rc, double dc,
+//               double pr, double pd, double px, double rv,
+//               iauASTROM *astrom, double *ra, double *da)
+func CgoAtccq(rc, dc, pr, pd, px, rv float64,
+	astrom ASTROM) (ra, da float64) {
+	var cRa, cDa C.double
+	cAstrom := astrGo2C(astrom)
+	C.iauAtccq(C.double(rc), C.double(dc), C.double(pr),
+		C.double(pd), C.double(px), C.double(rv),
+		&cAstrom, &cRa, &cDa)
+	return float64(cRa), float64(cDa)
+}
@@ -0,0 +1,21 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAtci13 Transform ICRS star data, epoch J2000.0, to CIRS.
+func CgoAtci13(
+	rc, dc,
+	pr, pd, px, rv,
+	date1, date2 float64,
+) (ri, di, eo float64) {
+	var cRi, cDi, cEo C.double
+	C.iauAtci13(C.double(rc), C.double(dc),
+		C.double(pr), C.double(pd), C.double(px), C.double(rv),
+		C.double(date1), C.double(date2),
+		&cRi, &cDi, &cEo)
+	return float64(cRi), float64(cDi), float64(cEo)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoAtci13 Transform ICRS star data, epoch J2000.0, to CIRS.
+//  Atci13 Transform ICRS star data, epoch J2000.0, to CIRS.
 //
 //  - - - - - - -
 //   A t c i 1 3
@@ -87,22 +84,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAtci13 Transform ICRS star data, epoch J2000.0, to CIRS.
-func CgoAtci13(
-	rc, dc,
-	pr, pd, px, rv,
-	date1, date2 float64,
-) (ri, di, eo float64) {
-	var cRi, cDi, cEo C.double
-	C.iauAtci13(C.double(rc), C.double(dc),
-		C.double(pr), C.double(pd), C.double(px), C.double(rv),
-		C.double(date1), C.double(date2),
-		&cRi, &cDi, &cEo)
-	return float64(cRi), float64(cDi), float64(cEo)
-}
-
-//  GoAtci13 Transform ICRS star data, epoch J2000.0, to CIRS.
-func GoAtci13(
+//  Atci13 Transform ICRS star data, epoch J2000.0, to CIRS.
+func Atci13(
 	rc, dc,
 	pr, pd, px, rv,
 	date1, date2 float64,
@@ -112,9 +95,9 @@ func GoAtci13(
 	var astrom ASTROM
 
 	// The transformation parameters.
-	astrom, eo = GoApci13(date1, date2, astrom)
+	astrom, eo = Apci13(date1, date2, astrom)
 
 	// ICRS (epoch J2000.0) to CIRS.
-	ri ,di = GoAtciq(rc, dc, pr, pd, px, rv, astrom)
+	ri ,di = Atciq(rc, dc, pr, pd, px, rv, astrom)
 	return 
 }
@@ -32,7 +32,7 @@ func TestAtci13(t *testing.T) {
 			b1, b2, b3 float64)
 	}{
 		{"cgo", CgoAtci13},
-		//{"go", GoAtci13},
+		//{"go", Atci13},
 	}
 
 	for _, test := range tests {
@@ -68,7 +68,7 @@ func BenchmarkAtci13(b *testing.B) {
 			b1, b2, b3 float64)
 	}{
 		{"cgo", CgoAtci13},
-		{"go", GoAtci13},
+		{"go", Atci13},
 	}
 
 	for _, test := range tests {
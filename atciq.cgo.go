@@ -0,0 +1,22 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAtciq Quick ICRS, epoch J2000.0, to CIRS transformation, given
+//  precomputed star-independent astrometry parameters.
+// void iauAtciq(double rc, double dc,
+//               double pr, double pd, double px, double rv,
+//               iauASTROM *astrom, double *ri, double *di)
+func CgoAtciq(rc, dc, pr, pd, px, rv float64,
+	astrom ASTROM) (ri, di float64) {
+	var cRi, cDi C.double
+	cAstrom := astrGo2C(astrom)
+	C.iauAtciq(C.double(rc), C.double(dc), C.double(pr),
+		C.double(pd), C.double(px), C.double(rv),
+		&cAstrom, &cRi, &cDi)
+	return float64(cRi), float64(cDi)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoAtciq Quick ICRS, epoch J2000.0, to CIRS transformation, given
+//  Atciq Quick ICRS, epoch J2000.0, to CIRS transformation, given
 //  precomputed star-independent astrometry parameters.
 //
 //  - - - - - -
@@ -74,42 +71,30 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAtciq Quick ICRS, epoch J2000.0, to CIRS transformation, given
+//  Atciq Quick ICRS, epoch J2000.0, to CIRS transformation, given
 //  precomputed star-independent astrometry parameters.
 // void iauAtciq(double rc, double dc,
 //               double pr, double pd, double px, double rv,
 //               iauASTROM *astrom, double *ri, double *di)
-func CgoAtciq(rc, dc, pr, pd, px, rv float64,
-	astrom ASTROM) (ri, di float64) {
-	var cRi, cDi C.double
-	cAstrom := astrGo2C(astrom)
-	C.iauAtciq(C.double(rc), C.double(dc), C.double(pr),
-		C.double(pd), C.double(px), C.double(rv),
-		&cAstrom, &cRi, &cDi)
-	return float64(cRi), float64(cDi)
-}
-
-//  GoAtciq Quick ICRS, epoch J2000.0, to CIRS transformation, given
-//  precomputed star-independent astrometry parameters.
-func GoAtciq(rc, dc, pr, pd, px, rv float64,
+func Atciq(rc, dc, pr, pd, px, rv float64,
 	astrom ASTROM) (ri, di float64) {
 	var pco, pnat, ppr, pi [3]float64
 	var w float64
 
 	// Proper motion and parallax, giving BCRS coordinate direction.
-	pco = GoPmpx(rc, dc, pr, pd, px, rv, astrom.pmt, astrom.eb)
+	pco = Pmpx(rc, dc, pr, pd, px, rv, astrom.Pmt, astrom.Eb)
 
 	// Light deflection by the Sun, giving BCRS natural direction.
-	pnat = GoLdsun(pco, astrom.eh, astrom.em)
+	pnat = Ldsun(pco, astrom.Eh, astrom.Em)
 
 	// Aberration, giving GCRS proper direction.
-	ppr = GoAb(pnat, astrom.v, astrom.em, astrom.bm1)
+	ppr = Ab(pnat, astrom.V, astrom.Em, astrom.Bm1)
 
 	// Bias-precession-nutation, giving CIRS proper direction.
-	pi =  GoRxp(astrom.bpn, ppr)
+	pi =  Rxp(astrom.Bpn, ppr)
 
 	// CIRS RA,Dec.
-	w, di = GoC2s(pi)
-	ri = GoAnp(w)
+	w, di = C2s(pi)
+	ri = Anp(w)
 	return
 }
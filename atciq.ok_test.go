@@ -33,7 +33,7 @@ func TestAtciq(t *testing.T) {
 		fnAssist func(a1, a2 float64, a3 ASTROM) (ASTROM, float64)
 	}{
 		{"cgo", CgoAtciq, CgoApci13},
-		{"go", GoAtciq, GoApci13},
+		{"go", Atciq, Apci13},
 	}
 
 	for _, test := range tests {
@@ -66,7 +66,7 @@ func BenchmarkAtciq(b *testing.B) {
 		fnAssist func(a1, a2 float64, a3 ASTROM) (ASTROM, float64)
 	}{
 		{"cgo", CgoAtciq, CgoApci13},
-		{"go", GoAtciq, GoApci13},
+		{"go", Atciq, Apci13},
 	}
 
 	for _, test := range tests {
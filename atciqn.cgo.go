@@ -0,0 +1,21 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAtciqn Quick ICRS, epoch J2000.0, to CIRS transformation, given
+//  precomputed star-independent astrometry parameters plus a list of
+//  light- deflecting bodies.
+func CgoAtciqn(rc, dc, pr, pd, px, rv float64,
+	astrom ASTROM, n int, b []LDBODY) (ri, di float64) {
+	var cRi, cDi C.double
+	cB := ldbodiesGo2C(n, b)
+	cAstrom := astrGo2C(astrom)
+	C.iauAtciqn(C.double(rc), C.double(dc), C.double(pr),
+		C.double(pd), C.double(px), C.double(rv),
+		&cAstrom, C.int(n), cB, &cRi, &cDi)
+	return float64(cRi), float64(cDi)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoAtciqn Quick ICRS, epoch J2000.0, to CIRS transformation, given
+//  Atciqn Quick ICRS, epoch J2000.0, to CIRS transformation, given
 //  precomputed star-independent astrometry parameters plus a list of
 //  light- deflecting bodies.
 //
@@ -111,47 +108,30 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAtciqn Quick ICRS, epoch J2000.0, to CIRS transformation, given
-//  precomputed star-independent astrometry parameters plus a list of
-//  light- deflecting bodies.
-func CgoAtciqn(rc, dc, pr, pd, px, rv float64,
-	astrom ASTROM, n int, b []LDBODY) (ri, di float64) {
-	var cRi, cDi C.double
-	cB := make([]C.iauLDBODY, n)
-	for i := 0; i < n; i++ {
-		cB[i] = ldbodyGo2C(b[i])
-	}
-	cAstrom := astrGo2C(astrom)
-	C.iauAtciqn(C.double(rc), C.double(dc), C.double(pr),
-		C.double(pd), C.double(px), C.double(rv),
-		&cAstrom, C.int(n), &cB[0], &cRi, &cDi)
-	return float64(cRi), float64(cDi)
-}
-
-//  GoAtciqn Quick ICRS, epoch J2000.0, to CIRS transformation, given
+//  Atciqn Quick ICRS, epoch J2000.0, to CIRS transformation, given
 //  precomputed star-independent astrometry parameters plus a list of
 //  light- deflecting bodies.
-func GoAtciqn(rc, dc, pr, pd, px, rv float64,
+func Atciqn(rc, dc, pr, pd, px, rv float64,
 	astrom ASTROM, n int, b []LDBODY) (ri, di float64) {
 
 	var pco, pnat, ppr, pi [3]float64
 	var w float64
 
 	// Proper motion and parallax, giving BCRS coordinate direction.
-	pco = GoPmpx(rc, dc, pr, pd, px, rv, astrom.pmt, astrom.eb)
+	pco = Pmpx(rc, dc, pr, pd, px, rv, astrom.Pmt, astrom.Eb)
 
 	// Light deflection, giving BCRS natural direction.
-	pnat = GoLdn(n, b, astrom.eb, pco)
+	pnat = Ldn(n, b, astrom.Eb, pco)
 
 	// Aberration, giving GCRS proper direction.
-	ppr = GoAb(pnat, astrom.v, astrom.em, astrom.bm1)
+	ppr = Ab(pnat, astrom.V, astrom.Em, astrom.Bm1)
 
 	// Bias-precession-nutation, giving CIRS proper direction.
-	pi = GoRxp(astrom.bpn, ppr)
+	pi = Rxp(astrom.Bpn, ppr)
 
 	// CIRS RA,Dec.
-	w, di = GoC2s(pi)
-	ri = GoAnp(w)
+	w, di = C2s(pi)
+	ri = Anp(w)
 
 	return
 }
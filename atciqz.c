@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauAtciqz(double rc, double dc, iauASTROM *astrom,
@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAtciqz Quick ICRS to CIRS transformation, given precomputed star-
+//  independent astrometry parameters, and assuming zero parallax and
+//  proper motion.
+func CgoAtciqz(rc, dc float64, astrom ASTROM) (ri, di float64) {
+	var cRi, cDi C.double
+	cAstrom := astrGo2C(astrom)
+	C.iauAtciqz(C.double(rc), C.double(dc), &cAstrom, &cRi, &cDi)
+	return float64(cRi), float64(cDi)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoAtciqz Quick ICRS to CIRS transformation, given precomputed star-
+//  Atciqz Quick ICRS to CIRS transformation, given precomputed star-
 //  independent astrometry parameters, and assuming zero parallax and
 //  proper motion.
 //
@@ -74,37 +71,27 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAtciqz Quick ICRS to CIRS transformation, given precomputed star-
-//  independent astrometry parameters, and assuming zero parallax and
-//  proper motion.
-func CgoAtciqz(rc, dc float64, astrom ASTROM) (ri, di float64) {
-	var cRi, cDi C.double
-	cAstrom := astrGo2C(astrom)
-	C.iauAtciqz(C.double(rc), C.double(dc), &cAstrom, &cRi, &cDi)
-	return float64(cRi), float64(cDi)
-}
-
-//  GoAtciqz Quick ICRS to CIRS transformation, given precomputed star-
+//  Atciqz Quick ICRS to CIRS transformation, given precomputed star-
 //  independent astrometry parameters, and assuming zero parallax and
 //  proper motion.
-func GoAtciqz(rc, dc float64, astrom ASTROM) (ri, di float64) {
+func Atciqz(rc, dc float64, astrom ASTROM) (ri, di float64) {
 	var pco, pnat, ppr, pi [3]float64
 	var w float64
 
 	// BCRS coordinate direction (unit vector).
-	pco = GoS2c(rc, dc)
+	pco = S2c(rc, dc)
 
 	// Light deflection by the Sun, giving BCRS natural direction.
-	pnat = GoLdsun(pco, astrom.eh, astrom.em)
+	pnat = Ldsun(pco, astrom.Eh, astrom.Em)
 
 	// Aberration, giving GCRS proper direction.
-	ppr = GoAb(pnat, astrom.v, astrom.em, astrom.bm1)
+	ppr = Ab(pnat, astrom.V, astrom.Em, astrom.Bm1)
 
 	// Bias-precession-nutation, giving CIRS proper direction.
-	pi = GoRxp(astrom.bpn, ppr)
+	pi = Rxp(astrom.Bpn, ppr)
 
 	// CIRS RA,Dec.
-	w, di = GoC2s(pi)
-	ri = GoAnp(w)
+	w, di = C2s(pi)
+	ri = Anp(w)
 	return
 }
@@ -29,7 +29,7 @@ func TestAtciqz(t *testing.T) {
 		fnAssist func(a1, a2 float64, a3 ASTROM) (ASTROM, float64)
 	}{
 		{"cgo", CgoAtciqz, CgoApci13},
-		{"go", GoAtciqz, GoApci13},
+		{"go", Atciqz, Apci13},
 	}
 
 	for _, test := range tests {
@@ -58,7 +58,7 @@ func BenchmarkAtciqz(b *testing.B) {
 		fnAssist func(a1, a2 float64, a3 ASTROM) (ASTROM, float64)
 	}{
 		{"cgo", CgoAtciqz, CgoApci13},
-		{"go", GoAtciqz, GoApci13},
+		{"go", Atciqz, Apci13},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,38 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import (
+	"github.com/8i8/sofa/en"
+)
+
+//  CgoAtco13 ICRS RA,Dec to observed place.  The caller supplies UTC,
+//  site coordinates, ambient air conditions and observing wavelength.
+func CgoAtco13(rc, dc, pr, pd, px, rv, utc1, utc2, dut1, elong, phi, hm,
+	xp, yp, phpa, tc, rh, wl float64) (
+	aob, zob, hob, dob, rob, eo float64, err en.ErrNum) {
+
+	var cAob, cZob, cHob, cDob, cRob, cEo C.double
+	cI := C.iauAtco13(
+		C.double(rc), C.double(dc), C.double(pr), C.double(pd),
+		C.double(px), C.double(rv), C.double(utc1),
+		C.double(utc2), C.double(dut1), C.double(elong),
+		C.double(phi), C.double(hm), C.double(xp), C.double(yp),
+		C.double(phpa), C.double(tc), C.double(rh),
+		C.double(wl),
+		&cAob, &cZob, &cHob, &cDob, &cRob, &cEo)
+	switch int(cI) {
+	case 1:
+		err = errAtco13.Set(1)
+	case 0:
+	case -1:
+		err = errAtco13.Set(-1)
+	default:
+		err = errAtco13.Set(0)
+	}
+	return float64(cAob), float64(cZob), float64(cHob),
+		float64(cDob), float64(cRob), float64(cEo), err
+}
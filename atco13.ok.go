@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"github.com/8i8/sofa/en"
 )
@@ -12,7 +10,7 @@ var errAtco13 = en.New(1, "Atco13", []string{
 	"dubious year (Note 4)",
 })
 
-//  CgoAtco13 ICRS RA,Dec to observed place.  The caller supplies UTC,
+//  Atco13 ICRS RA,Dec to observed place.  The caller supplies UTC,
 //  site coordinates, ambient air conditions and observing wavelength.
 //
 //  - - - - - - -
@@ -166,37 +164,9 @@ var errAtco13 = en.New(1, "Atco13", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAtco13 ICRS RA,Dec to observed place.  The caller supplies UTC,
+//  Atco13 ICRS RA,Dec to observed place.  The caller supplies UTC,
 //  site coordinates, ambient air conditions and observing wavelength.
-func CgoAtco13(rc, dc, pr, pd, px, rv, utc1, utc2, dut1, elong, phi, hm,
-	xp, yp, phpa, tc, rh, wl float64) (
-	aob, zob, hob, dob, rob, eo float64, err en.ErrNum) {
-
-	var cAob, cZob, cHob, cDob, cRob, cEo C.double
-	cI := C.iauAtco13(
-		C.double(rc), C.double(dc), C.double(pr), C.double(pd),
-		C.double(px), C.double(rv), C.double(utc1),
-		C.double(utc2), C.double(dut1), C.double(elong),
-		C.double(phi), C.double(hm), C.double(xp), C.double(yp),
-		C.double(phpa), C.double(tc), C.double(rh),
-		C.double(wl),
-		&cAob, &cZob, &cHob, &cDob, &cRob, &cEo)
-	switch int(cI) {
-	case 1:
-		err = errAtco13.Set(1)
-	case 0:
-	case -1:
-		err = errAtco13.Set(-1)
-	default:
-		err = errAtco13.Set(0)
-	}
-	return float64(cAob), float64(cZob), float64(cHob),
-		float64(cDob), float64(cRob), float64(cEo), err
-}
-
-//  GoAtco13 ICRS RA,Dec to observed place.  The caller supplies UTC,
-//  site coordinates, ambient air conditions and observing wavelength.
-func GoAtco13(rc, dc, pr, pd, px, rv, utc1, utc2, dut1, elong, phi, hm,
+func Atco13(rc, dc, pr, pd, px, rv, utc1, utc2, dut1, elong, phi, hm,
 	xp, yp, phpa, tc, rh, wl float64) (
 	aob, zob, hob, dob, rob, eo float64, err en.ErrNum) {
 
@@ -204,12 +174,12 @@ func GoAtco13(rc, dc, pr, pd, px, rv, utc1, utc2, dut1, elong, phi, hm,
 	var ri, di float64
 
 	// Star-independent astrometry parameters.
-	astrom, eo, err = GoApco13(utc1, utc2, dut1, elong, phi, hm,
+	astrom, eo, err = Apco13(utc1, utc2, dut1, elong, phi, hm,
 		xp, yp, phpa, tc, rh, wl, astrom)
 
 	// Abort if bad UTC.
 	if err != nil {
-		if err.Is() < 0 {
+		if err.Code() < 0 {
 			err = errAtco13.Set(-1)
 			return
 		}
@@ -217,10 +187,10 @@ func GoAtco13(rc, dc, pr, pd, px, rv, utc1, utc2, dut1, elong, phi, hm,
 	}
 
 	// Transform ICRS to CIRS.
-	ri, di = GoAtciq(rc, dc, pr, pd, px, rv, astrom)
+	ri, di = Atciq(rc, dc, pr, pd, px, rv, astrom)
 
 	// Transform CIRS to observed.
-	aob, zob, hob, dob, rob = GoAtioq(ri, di, astrom)
+	aob, zob, hob, dob, rob = Atioq(ri, di, astrom)
 
 	// Return OK/warning status.
 	return
@@ -50,7 +50,7 @@ func TestAtco13(t *testing.T) {
 			c1, c2, c3, c4, c5, c6 float64, c7 en.ErrNum)
 	}{
 		{"cgo", CgoAtco13},
-		{"go", GoAtco13},
+		{"go", Atco13},
 	}
 
 	for _, test := range tests {
@@ -107,7 +107,7 @@ func BenchmarkAtco13(b *testing.B) {
 			c1, c2, c3, c4, c5, c6 float64, c7 en.ErrNum)
 	}{
 		{"cgo", CgoAtco13},
-		{"go", GoAtco13},
+		{"go", Atco13},
 	}
 
 	for _, test := range tests {
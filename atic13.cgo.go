@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAtic13 Transform star RA,Dec from geocentric CIRS to ICRS
+//  astrometric.
+func CgoAtic13(ri, di, date1, date2 float64) (rc, dc, eo float64) {
+	var cRc, cDc, cEo C.double
+	C.iauAtic13(C.double(ri), C.double(di), C.double(date1),
+		C.double(date2), &cRc, &cDc, &cEo)
+	return float64(cRc), float64(cDc), float64(cEo)
+}
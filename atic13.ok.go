@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoAtic13 Transform star RA,Dec from geocentric CIRS to ICRS
+//  Atic13 Transform star RA,Dec from geocentric CIRS to ICRS
 //  astrometric.
 //
 //  - - - - - - -
@@ -83,27 +80,18 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAtic13 Transform star RA,Dec from geocentric CIRS to ICRS
-//  astrometric.
-func CgoAtic13(ri, di, date1, date2 float64) (rc, dc, eo float64) {
-	var cRc, cDc, cEo C.double
-	C.iauAtic13(C.double(ri), C.double(di), C.double(date1),
-		C.double(date2), &cRc, &cDc, &cEo)
-	return float64(cRc), float64(cDc), float64(cEo)
-}
-
-//  GoAtic13 Transform star RA,Dec from geocentric CIRS to ICRS
+//  Atic13 Transform star RA,Dec from geocentric CIRS to ICRS
 //  astrometric.
-func GoAtic13(ri, di, date1, date2 float64) (rc, dc, eo float64) {
+func Atic13(ri, di, date1, date2 float64) (rc, dc, eo float64) {
 
 	// Star-independent astrometry parameters
 	var astrom ASTROM
 
 	// Star-independent astrometry parameters.
-	astrom, eo = GoApci13(date1, date2, astrom)
+	astrom, eo = Apci13(date1, date2, astrom)
 
 	// CIRS to ICRS astrometric.
-	rc, dc = GoAticq(ri, di, astrom)
+	rc, dc = Aticq(ri, di, astrom)
 
 	// Finished.
 	return
@@ -27,7 +27,7 @@ func TestAtic13(t *testing.T) {
 		fn  func(a1, a2, a3, a4 float64) (c1, c2, c3 float64)
 	}{
 		{"cgo", CgoAtic13},
-		{"go", GoAtic13},
+		{"go", Atic13},
 	}
 
 	for _, test := range tests {
@@ -53,7 +53,7 @@ func BenchmarkAtic13(b *testing.B) {
 		fn  func(a1, a2, a3, a4 float64) (c1, c2, c3 float64)
 	}{
 		{"cgo", CgoAtic13},
-		{"go", GoAtic13},
+		{"go", Atic13},
 	}
 
 	for _, test := range tests {
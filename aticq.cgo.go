@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAticq Quick CIRS RA,Dec to ICRS astrometric place, given the
+//  star- independent astrometry parameters.
+func CgoAticq(ri, di float64, astrom ASTROM) (rc, dc float64) {
+	var cRc, cDc C.double
+	cAstrom := astrGo2C(astrom)
+	C.iauAticq(C.double(ri), C.double(di), &cAstrom, &cRc, &cDc)
+	return float64(cRc), float64(cDc)
+}
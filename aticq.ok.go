@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoAticq Quick CIRS RA,Dec to ICRS astrometric place, given the
+//  Aticq Quick CIRS RA,Dec to ICRS astrometric place, given the
 //  star- independent astrometry parameters.
 //
 //  - - - - - -
@@ -71,28 +69,19 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAticq Quick CIRS RA,Dec to ICRS astrometric place, given the
+//  Aticq Quick CIRS RA,Dec to ICRS astrometric place, given the
 //  star- independent astrometry parameters.
-func CgoAticq(ri, di float64, astrom ASTROM) (rc, dc float64) {
-	var cRc, cDc C.double
-	cAstrom := astrGo2C(astrom)
-	C.iauAticq(C.double(ri), C.double(di), &cAstrom, &cRc, &cDc)
-	return float64(cRc), float64(cDc)
-}
-
-//  GoAticq Quick CIRS RA,Dec to ICRS astrometric place, given the
-//  star- independent astrometry parameters.
-func GoAticq(ri, di float64, astrom ASTROM) (rc, dc float64) {
+func Aticq(ri, di float64, astrom ASTROM) (rc, dc float64) {
 
 	var j, i int
 	var pi, ppr, pnat, pco, d, before, after [3]float64
 	var w, r2, r float64
 
 	// CIRS RA,Dec to Cartesian. 
-	pi = GoS2c(ri, di)
+	pi = S2c(ri, di)
 
 	// Bias-precession-nutation, giving GCRS proper direction. 
-	ppr = GoTrxp(astrom.bpn, pi)
+	ppr = Trxp(astrom.Bpn, pi)
 
 	// Aberration, giving GCRS natural direction. 
 	for j = 0; j < 2; j++ {
@@ -106,7 +95,7 @@ func GoAticq(ri, di float64, astrom ASTROM) (rc, dc float64) {
 		for i = 0; i < 3; i++ {
 			before[i] /= r
 		}
-		after = GoAb(before, astrom.v, astrom.em, astrom.bm1)
+		after = Ab(before, astrom.V, astrom.Em, astrom.Bm1)
 		r2 = 0.0
 		for i = 0; i < 3; i++ {
 			d[i] = after[i] - before[i]
@@ -134,7 +123,7 @@ func GoAticq(ri, di float64, astrom ASTROM) (rc, dc float64) {
 		for i = 0; i < 3; i++ {
 			before[i] /= r
 		}
-		after = GoLdsun(before, astrom.eh, astrom.em)
+		after = Ldsun(before, astrom.Eh, astrom.Em)
 		r2 = 0.0
 		for i = 0; i < 3; i++ {
 			d[i] = after[i] - before[i]
@@ -149,8 +138,8 @@ func GoAticq(ri, di float64, astrom ASTROM) (rc, dc float64) {
 	}
 
 	// ICRS astrometric RA,Dec. 
-	w, dc = GoC2s(pco)
-	rc = GoAnp(w)
+	w, dc = C2s(pco)
+	rc = Anp(w)
 
 	// Finished. 
 	return
@@ -31,7 +31,7 @@ func TestAticq(t *testing.T) {
 			ASTROM, float64)
 	}{
 		{"cgo", CgoAticq, CgoApci13},
-		{"go", GoAticq, GoApci13},
+		{"go", Aticq, Apci13},
 	}
 
 	for _, test := range tests {
@@ -62,7 +62,7 @@ func BenchmarkAticq(b *testing.B) {
 			ASTROM, float64)
 	}{
 		{"cgo", CgoAticq, CgoApci13},
-		{"go", GoAticq, GoApci13},
+		{"go", Aticq, Apci13},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,20 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAticqn Quick CIRS to ICRS astrometric place transformation, given
+//  the star- independent astrometry parameters plus a list of
+//  light-deflecting bodies.
+func CgoAticqn(ri, di float64, astrom ASTROM, n int, b []LDBODY) (
+	rc, dc float64) {
+	var cRc, cDc C.double
+	cAstrom := astrGo2C(astrom)
+	cLDBODY := ldbodiesGo2C(n, b)
+	C.iauAticqn(C.double(ri), C.double(di), &cAstrom, C.int(n),
+		cLDBODY, &cRc, &cDc)
+	return float64(cRc), float64(cDc)
+}
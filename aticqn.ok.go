@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoAticqn Quick CIRS to ICRS astrometric place transformation, given
+//  Aticqn Quick CIRS to ICRS astrometric place transformation, given
 //  the star- independent astrometry parameters plus a list of
 //  light-deflecting bodies.
 //
@@ -109,26 +107,10 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAticqn Quick CIRS to ICRS astrometric place transformation, given
+//  Aticqn Quick CIRS to ICRS astrometric place transformation, given
 //  the star- independent astrometry parameters plus a list of
 //  light-deflecting bodies.
-func CgoAticqn(ri, di float64, astrom ASTROM, n int, b []LDBODY) (
-	rc, dc float64) {
-	var cRc, cDc C.double
-	cAstrom := astrGo2C(astrom)
-	cLDBODY := make([]C.iauLDBODY, n)
-	for i := 0; i < n; i++ {
-		cLDBODY[i] = ldbodyGo2C(b[i])
-	}
-	C.iauAticqn(C.double(ri), C.double(di), &cAstrom, C.int(n),
-		&cLDBODY[0], &cRc, &cDc)
-	return float64(cRc), float64(cDc)
-}
-
-//  GoAticqn Quick CIRS to ICRS astrometric place transformation, given
-//  the star- independent astrometry parameters plus a list of
-//  light-deflecting bodies.
-func GoAticqn(ri, di float64, astrom ASTROM, n int, b []LDBODY) (
+func Aticqn(ri, di float64, astrom ASTROM, n int, b []LDBODY) (
 	rc, dc float64) {
 
 	var j, i int
@@ -136,10 +118,10 @@ func GoAticqn(ri, di float64, astrom ASTROM, n int, b []LDBODY) (
 	var w, r2, r float64
 
 	// CIRS RA,Dec to Cartesian.
-	pi = GoS2c(ri, di)
+	pi = S2c(ri, di)
 
 	// Bias-precession-nutation, giving GCRS proper direction.
-	ppr = GoTrxp(astrom.bpn, pi)
+	ppr = Trxp(astrom.Bpn, pi)
 
 	// Aberration, giving GCRS natural direction.
 	for j = 0; j < 2; j++ {
@@ -153,7 +135,7 @@ func GoAticqn(ri, di float64, astrom ASTROM, n int, b []LDBODY) (
 		for i = 0; i < 3; i++ {
 			before[i] /= r
 		}
-		after = GoAb(before, astrom.v, astrom.em, astrom.bm1)
+		after = Ab(before, astrom.V, astrom.Em, astrom.Bm1)
 		r2 = 0.0
 		for i = 0; i < 3; i++ {
 			d[i] = after[i] - before[i]
@@ -180,7 +162,7 @@ func GoAticqn(ri, di float64, astrom ASTROM, n int, b []LDBODY) (
 		for i = 0; i < 3; i++ {
 			before[i] /= r
 		}
-		after = GoLdn(n, b, astrom.eb, before)
+		after = Ldn(n, b, astrom.Eb, before)
 		r2 = 0.0
 		for i = 0; i < 3; i++ {
 			d[i] = after[i] - before[i]
@@ -195,8 +177,8 @@ func GoAticqn(ri, di float64, astrom ASTROM, n int, b []LDBODY) (
 	}
 
 	// ICRS astrometric RA,Dec.
-	w, dc = GoC2s(pco)
-	rc = GoAnp(w)
+	w, dc = C2s(pco)
+	rc = Anp(w)
 
 	// Finished.
 	return
@@ -0,0 +1,34 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAtio13 CIRS RA,Dec to observed place.  The caller supplies UTC,
+//  site coordinates, ambient air conditions and observing wavelength.
+func CgoAtio13(ri, di, utc1, utc2, dut1, elong, phi, hm, xp, yp, phpa,
+	tc, rh, wl float64) (aob, zob, hob, dob, rob float64,
+	err error) {
+
+	var cAob, cZob, cHob, cDob, cRob C.double
+	cI := C.iauAtio13(C.double(ri), C.double(di), C.double(utc1),
+		C.double(utc2), C.double(dut1), C.double(elong),
+		C.double(phi), C.double(hm), C.double(xp), C.double(yp),
+		C.double(phpa), C.double(tc), C.double(rh),
+		C.double(wl),
+		&cAob, &cZob, &cHob, &cDob, &cRob)
+
+	switch int(cI) {
+	case 0:
+	case 1:
+		err = errAtio13Warn
+	case -1:
+		err = errAtio13E1
+	default:
+		err = errAdmin
+	}
+	return float64(cAob), float64(cZob), float64(cHob),
+		float64(cDob), float64(cRob), err
+}
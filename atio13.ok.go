@@ -1,13 +1,11 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "errors"
 
 var errAtio13Warn = errors.New("dubious year (Note 2)")
 var errAtio13E1 = errors.New("unacceptable date")
 
-//  CgoAtio13 CIRS RA,Dec to observed place.  The caller supplies UTC,
+//  Atio13 CIRS RA,Dec to observed place.  The caller supplies UTC,
 //  site coordinates, ambient air conditions and observing wavelength.
 //
 //  - - - - - - -
@@ -145,47 +143,20 @@ var errAtio13E1 = errors.New("unacceptable date")
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAtio13 CIRS RA,Dec to observed place.  The caller supplies UTC,
+//  Atio13 CIRS RA,Dec to observed place.  The caller supplies UTC,
 //  site coordinates, ambient air conditions and observing wavelength.
-func CgoAtio13(ri, di, utc1, utc2, dut1, elong, phi, hm, xp, yp, phpa,
-	tc, rh, wl float64) (aob, zob, hob, dob, rob float64,
-	err error) {
-
-	var cAob, cZob, cHob, cDob, cRob C.double
-	cI := C.iauAtio13(C.double(ri), C.double(di), C.double(utc1),
-		C.double(utc2), C.double(dut1), C.double(elong),
-		C.double(phi), C.double(hm), C.double(xp), C.double(yp),
-		C.double(phpa), C.double(tc), C.double(rh),
-		C.double(wl),
-		&cAob, &cZob, &cHob, &cDob, &cRob)
-
-	switch int(cI) {
-	case 0:
-	case 1:
-		err = errAtio13Warn
-	case -1:
-		err = errAtio13E1
-	default:
-		err = errAdmin
-	}
-	return float64(cAob), float64(cZob), float64(cHob),
-		float64(cDob), float64(cRob), err
-}
-
-//  GoAtio13 CIRS RA,Dec to observed place.  The caller supplies UTC,
-//  site coordinates, ambient air conditions and observing wavelength.
-func GoAtio13(ri, di, utc1, utc2, dut1, elong, phi, hm, xp, yp, phpa,
+func Atio13(ri, di, utc1, utc2, dut1, elong, phi, hm, xp, yp, phpa,
 	tc, rh, wl float64) (aob, zob, hob, dob, rob float64,
 	err error) {
 	var astrom ASTROM
 
 	// Star-independent astrometry parameters for CIRS->observed.
-	astrom, err = GoApio13(utc1, utc2, dut1, elong, phi, hm, xp, yp,
+	astrom, err = Apio13(utc1, utc2, dut1, elong, phi, hm, xp, yp,
 		phpa, tc, rh, wl, astrom)
 
 	// Abort if bad UTC.
 	if err != nil {
-		if !errors.Is(err, errAtio13Warn) {
+		if errors.Is(err, errApio13E1) {
 			err = errAtio13E1
 			return
 		}
@@ -193,7 +164,7 @@ func GoAtio13(ri, di, utc1, utc2, dut1, elong, phi, hm, xp, yp, phpa,
 	}
 
 	// Transform CIRS to observed.
-	aob, zob, hob, dob, rob = GoAtioq(ri, di, astrom)
+	aob, zob, hob, dob, rob = Atioq(ri, di, astrom)
 
 	// Return OK/warning status.
 	return
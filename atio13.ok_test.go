@@ -41,7 +41,7 @@ func TestAtio13(t *testing.T) {
 			c1, c2, c3, c4, c5 float64, c6 error)
 	}{
 		{"cgo", CgoAtio13},
-		{"go", GoAtio13},
+		{"go", Atio13},
 	}
 
 	for _, test := range tests {
@@ -85,7 +85,7 @@ func BenchmarkAtio13(b *testing.B) {
 				c1, c2, c3, c4, c5 float64, c6 error)
 	}{
 		{"cgo", CgoAtio13},
-		{"go", GoAtio13},
+		{"go", Atio13},
 	}
 
 	for _, test := range tests {
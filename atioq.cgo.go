@@ -0,0 +1,19 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAtioq Quick CIRS to observed place transformation.
+func CgoAtioq(ri, di float64, astrom ASTROM) (
+	aob, zob, hob, dob, rob float64) {
+
+	var cAob, cZob, cHob, cDob, cRob C.double
+	cAstrom := astrGo2C(astrom)
+	C.iauAtioq(C.double(ri), C.double(di), &cAstrom, &cAob, &cZob,
+		&cHob, &cDob, &cRob)
+	return float64(cAob), float64(cZob), float64(cHob),
+		float64(cDob), float64(cRob)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoAtioq Quick CIRS to observed place transformation.
+//  Atioq Quick CIRS to observed place transformation.
 //
 //  - - - - - -
 //   A t i o q
@@ -106,20 +104,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAtioq Quick CIRS to observed place transformation.
-func CgoAtioq(ri, di float64, astrom ASTROM) (
-	aob, zob, hob, dob, rob float64) {
-
-	var cAob, cZob, cHob, cDob, cRob C.double
-	cAstrom := astrGo2C(astrom)
-	C.iauAtioq(C.double(ri), C.double(di), &cAstrom, &cAob, &cZob,
-		&cHob, &cDob, &cRob)
-	return float64(cAob), float64(cZob), float64(cHob),
-		float64(cDob), float64(cRob)
-}
-
-//  GoAtioq Quick CIRS to observed place transformation.
-func GoAtioq(ri, di float64, astrom ASTROM) (
+//  Atioq Quick CIRS to observed place transformation.
+func Atioq(ri, di float64, astrom ASTROM) (
 	aob, zob, hob, dob, rob float64) {
 
 	// Minimum cos(alt) and sin(alt) for refraction purposes
@@ -132,26 +118,26 @@ func GoAtioq(ri, di float64, astrom ASTROM) (
 		xaeo, yaeo, zaeo, zdobs, hmobs, dcobs, raobs float64
 
 	// CIRS RA,Dec to Cartesian -HA,Dec.
-	v = GoS2c(ri-astrom.eral, di)
+	v = S2c(ri-astrom.Eral, di)
 	x = v[0]
 	y = v[1]
 	z = v[2]
 
 	// Polar motion.
-	xhd = x + astrom.xpl*z
-	yhd = y - astrom.ypl*z
-	zhd = z - astrom.xpl*x + astrom.ypl*y
+	xhd = x + astrom.Xpl*z
+	yhd = y - astrom.Ypl*z
+	zhd = z - astrom.Xpl*x + astrom.Ypl*y
 
 	// Diurnal aberration.
-	f = (1.0 - astrom.diurab*yhd)
+	f = (1.0 - astrom.Diurab*yhd)
 	xhdt = f * xhd
-	yhdt = f * (yhd + astrom.diurab)
+	yhdt = f * (yhd + astrom.Diurab)
 	zhdt = f * zhd
 
 	// Cartesian -HA,Dec to Cartesian Az,El (S=0,E=90).
-	xaet = astrom.sphi*xhdt - astrom.cphi*zhdt
+	xaet = astrom.Sphi*xhdt - astrom.Cphi*zhdt
 	yaet = yhdt
-	zaet = astrom.cphi*xhdt + astrom.sphi*zhdt
+	zaet = astrom.Cphi*xhdt + astrom.Sphi*zhdt
 
 	// Azimuth (N=0,E=90).
 	if xaet != 0.0 || yaet != 0.0 {
@@ -176,9 +162,9 @@ func GoAtioq(ri, di float64, astrom ASTROM) (
 
 	// A*tan(z)+B*tan^3(z) model, with Newton-Raphson correction.
 	tz = r / z
-	w = astrom.refb * tz * tz
-	del = (astrom.refa + w) * tz /
-		(1.0 + (astrom.refa+3.0*w)/(z*z))
+	w = astrom.Refb * tz * tz
+	del = (astrom.Refa + w) * tz /
+		(1.0 + (astrom.Refa+3.0*w)/(z*z))
 
 		// Apply the change, giving observed vector.
 	cosdel = 1.0 - del*del/2.0
@@ -191,22 +177,22 @@ func GoAtioq(ri, di float64, astrom ASTROM) (
 	zdobs = math.Atan2(math.Sqrt(xaeo*xaeo+yaeo*yaeo), zaeo)
 
 	// Az/El vector to HA,Dec vector (both right-handed).
-	v[0] = astrom.sphi*xaeo + astrom.cphi*zaeo
+	v[0] = astrom.Sphi*xaeo + astrom.Cphi*zaeo
 	v[1] = yaeo
-	v[2] = -astrom.cphi*xaeo + astrom.sphi*zaeo
+	v[2] = -astrom.Cphi*xaeo + astrom.Sphi*zaeo
 
 	// To spherical -HA,Dec.
-	hmobs, dcobs = GoC2s(v)
+	hmobs, dcobs = C2s(v)
 
 	// Right ascension (with respect to CIO).
-	raobs = astrom.eral + hmobs
+	raobs = astrom.Eral + hmobs
 
 	// Return the results.
-	aob = GoAnp(azobs)
+	aob = Anp(azobs)
 	zob = zdobs
 	hob = -hmobs
 	dob = dcobs
-	rob = GoAnp(raobs)
+	rob = Anp(raobs)
 
 	// Finished.
 	return
@@ -43,7 +43,7 @@ func TestAtioq(t *testing.T) {
 			a11, a12 float64, a13 ASTROM) (ASTROM, error)
 	}{
 		{"cgo", CgoAtioq, CgoApio13},
-		{"go", GoAtioq, GoApio13},
+		{"go", Atioq, Apio13},
 	}
 
 	for _, test := range tests {
@@ -99,7 +99,7 @@ func BenchmarkAtioq(b *testing.B) {
 			a11, a12 float64, a13 ASTROM) (ASTROM, error)
 	}{
 		{"cgo", CgoAtioq, CgoApio13},
-		{"go", GoAtioq, GoApio13},
+		{"go", Atioq, Apio13},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauAtoc13(const char *type, double ob1, double ob2,
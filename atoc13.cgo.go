@@ -0,0 +1,33 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAtoc13 Observed place at a groundbased site to to ICRS
+//  astrometric RA,Dec.  The caller supplies UTC, site coordinates,
+//  ambient air conditions and observing wavelength.
+func CgoAtoc13(t string, ob1, ob2, utc1, utc2, dut1, elong, phi, hm, xp,
+	yp, phpa, tc, rh, wl float64) (rc, dc float64, err error) {
+
+	var cRc, cDc C.double
+	cC := C.char(t[0])
+	cI := C.iauAtoc13(&cC, C.double(ob1), C.double(ob2),
+		C.double(utc1), C.double(utc2), C.double(dut1),
+		C.double(elong), C.double(phi), C.double(hm),
+		C.double(xp), C.double(yp), C.double(phpa),
+		C.double(tc), C.double(rh), C.double(wl), &cRc, &cDc)
+
+	switch int(cI) {
+	case 0:
+	case 1:
+		err = errAtoc13Warn
+	case -1:
+		err = errAtoc13E1
+	default:
+		err = errAdmin
+	}
+	return float64(cRc), float64(cDc), err
+}
@@ -1,13 +1,11 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "errors"
 
 var errAtoc13Warn = errors.New("dubious year (Note 4)")
 var errAtoc13E1 = errors.New("unacceptable date")
 
-//  CgoAtoc13 Observed place at a groundbased site to to ICRS
+//  Atoc13 Observed place at a groundbased site to to ICRS
 //  astrometric RA,Dec.  The caller supplies UTC, site coordinates,
 //  ambient air conditions and observing wavelength.
 //
@@ -153,48 +151,22 @@ var errAtoc13E1 = errors.New("unacceptable date")
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAtoc13 Observed place at a groundbased site to to ICRS
+//  Atoc13 Observed place at a groundbased site to to ICRS
 //  astrometric RA,Dec.  The caller supplies UTC, site coordinates,
 //  ambient air conditions and observing wavelength.
-func CgoAtoc13(t string, ob1, ob2, utc1, utc2, dut1, elong, phi, hm, xp,
-	yp, phpa, tc, rh, wl float64) (rc, dc float64, err error) {
-
-	var cRc, cDc C.double
-	cC := C.char(t[0])
-	cI := C.iauAtoc13(&cC, C.double(ob1), C.double(ob2),
-		C.double(utc1), C.double(utc2), C.double(dut1),
-		C.double(elong), C.double(phi), C.double(hm),
-		C.double(xp), C.double(yp), C.double(phpa),
-		C.double(tc), C.double(rh), C.double(wl), &cRc, &cDc)
-
-	switch int(cI) {
-	case 0:
-	case 1:
-		err = errAtoc13Warn
-	case -1:
-		err = errAtoc13E1
-	default:
-		err = errAdmin
-	}
-	return float64(cRc), float64(cDc), err
-}
-
-//  GoAtoc13 Observed place at a groundbased site to to ICRS
-//  astrometric RA,Dec.  The caller supplies UTC, site coordinates,
-//  ambient air conditions and observing wavelength.
-func GoAtoc13(t string, ob1, ob2, utc1, utc2, dut1, elong, phi, hm, xp,
+func Atoc13(t string, ob1, ob2, utc1, utc2, dut1, elong, phi, hm, xp,
 	yp, phpa, tc, rh, wl float64) (rc, dc float64, err error) {
 
 	var astrom ASTROM
 	var ri, di float64
 
 	// Star-independent astrometry parameters.
-	astrom, _, err = GoApco13(utc1, utc2, dut1, elong, phi, hm, xp,
+	astrom, _, j := Apco13(utc1, utc2, dut1, elong, phi, hm, xp,
 		yp, phpa, tc, rh, wl, astrom)
 
 	// Abort if bad UTC.
-	if err != nil {
-		if !errors.Is(err, errAtoc13Warn) {
+	if j != nil {
+		if j.Code() < 0 {
 			err = errAtoc13E1
 			return
 		}
@@ -202,10 +174,10 @@ func GoAtoc13(t string, ob1, ob2, utc1, utc2, dut1, elong, phi, hm, xp,
 	}
 
 	// Transform observed to CIRS.
-	ri, di = GoAtoiq(t, ob1, ob2, astrom)
+	ri, di = Atoiq(t, ob1, ob2, astrom)
 
 	// Transform CIRS to ICRS.
-	rc, dc = GoAticq(ri, di, astrom)
+	rc, dc = Aticq(ri, di, astrom)
 
 	// Return OK/warning status.
 	return
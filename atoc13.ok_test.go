@@ -39,7 +39,7 @@ func TestAtoc13(t *testing.T) {
 			c1, c2 float64, err error)
 	}{
 		{"cgo", CgoAtoc13},
-		{"go", GoAtoc13},
+		{"go", Atoc13},
 	}
 
 	for _, test := range tests {
@@ -67,6 +67,10 @@ func TestAtoc13(t *testing.T) {
 		vvd(t, rc, 2.709956744660731630, 1e-12, tname, "A/rc")
 		vvd(t, dc, 0.1741696500896438970, 1e-12, tname, "A/dc")
 		errT(t, nil, err, tname, "A/j")
+
+		_, _, err = test.fn("A", ob1, ob2, 2378496.5, 0.25, dut1,
+			elong, phi, hm, xp, yp, phpa, tc, rh, wl)
+		errT(t, errAtoc13Warn, err, tname, "dubious j")
 	}
 }
 
@@ -94,7 +98,7 @@ func BenchmarkAtoc13(b *testing.B) {
 			c1, c2 float64, err error)
 	}{
 		{"cgo", CgoAtoc13},
-		{"go", GoAtoc13},
+		{"go", Atoc13},
 	}
 
 	for _, test := range tests {
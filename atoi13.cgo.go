@@ -0,0 +1,30 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAtoi13 Observed place to CIRS.  The caller supplies UTC, site
+//  coordinates, ambient air conditions and observing wavelength.
+func CgoAtoi13(t string, ob1, ob2, utc1, utc2, dut1, elong, phi, hm, xp,
+	yp, phpa, tc, rh, wl float64) (ri, di float64, err error) {
+	var cRi, cDi C.double
+	cC := C.char(t[0])
+	cI := C.iauAtoi13(&cC, C.double(ob1), C.double(ob2),
+		C.double(utc1), C.double(utc2), C.double(dut1), C.double(elong),
+		C.double(phi), C.double(hm), C.double(xp), C.double(yp),
+		C.double(phpa), C.double(tc), C.double(rh), C.double(wl),
+		&cRi, &cDi)
+	switch int(cI) {
+	case 0:
+	case 1:
+		err = errAtoi13Warn
+	case -1:
+		err = errAtoi13E1
+	default:
+		err = errAdmin
+	}
+	return float64(cRi), float64(cDi), err
+}
@@ -1,13 +1,11 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "errors"
 
 var errAtoi13Warn = errors.New("dubious yesr (Note 2)")
 var errAtoi13E1 = errors.New("unacceptable date")
 
-//  CgoAtoi13 Observed place to CIRS.  The caller supplies UTC, site
+//  Atoi13 Observed place to CIRS.  The caller supplies UTC, site
 //  coordinates, ambient air conditions and observing wavelength.
 //
 //  - - - - - - -
@@ -152,42 +150,19 @@ var errAtoi13E1 = errors.New("unacceptable date")
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAtoi13 Observed place to CIRS.  The caller supplies UTC, site
+//  Atoi13 Observed place to CIRS.  The caller supplies UTC, site
 //  coordinates, ambient air conditions and observing wavelength.
-func CgoAtoi13(t string, ob1, ob2, utc1, utc2, dut1, elong, phi, hm, xp,
-	yp, phpa, tc, rh, wl float64) (ri, di float64, err error) {
-	var cRi, cDi C.double
-	cC := C.char(t[0])
-	cI := C.iauAtoi13(&cC, C.double(ob1), C.double(ob2),
-		C.double(utc1), C.double(utc2), C.double(dut1), C.double(elong),
-		C.double(phi), C.double(hm), C.double(xp), C.double(yp),
-		C.double(phpa), C.double(tc), C.double(rh), C.double(wl),
-		&cRi, &cDi)
-	switch int(cI) {
-	case 0:
-	case 1:
-		err = errAtoi13Warn
-	case -1:
-		err = errAtoi13E1
-	default:
-		err = errAdmin
-	}
-	return float64(cRi), float64(cDi), err
-}
-
-//  GoAtoi13 Observed place to CIRS.  The caller supplies UTC, site
-//  coordinates, ambient air conditions and observing wavelength.
-func GoAtoi13(t string, ob1, ob2, utc1, utc2, dut1, elong, phi, hm, xp,
+func Atoi13(t string, ob1, ob2, utc1, utc2, dut1, elong, phi, hm, xp,
 	yp, phpa, tc, rh, wl float64) (ri, di float64, err error) {
 	var astrom ASTROM
 
 	// Star-independent astrometry parameters for CIRS->observed.
-	astrom, err = GoApio13(utc1, utc2, dut1, elong, phi, hm, xp, yp,
+	astrom, err = Apio13(utc1, utc2, dut1, elong, phi, hm, xp, yp,
 		phpa, tc, rh, wl, astrom)
 
 	// Abort if bad UTC.
 	if err != nil {
-		if !errors.Is(err, errAtoi13Warn) {
+		if errors.Is(err, errApio13E1) {
 			err = errAtoi13E1
 			return
 		}
@@ -195,7 +170,7 @@ func GoAtoi13(t string, ob1, ob2, utc1, utc2, dut1, elong, phi, hm, xp,
 	}
 
 	// Transform observed to CIRS.
-	ri, di = GoAtoiq(t, ob1, ob2, astrom)
+	ri, di = Atoiq(t, ob1, ob2, astrom)
 
 	// Return OK/warning status.
 	return
@@ -39,7 +39,7 @@ func TestAtoi13(t *testing.T) {
 			c1, c2 float64, c3 error)
 	}{
 		{"cgo", CgoAtoi13},
-		{"go", GoAtoi13},
+		{"go", Atoi13},
 	}
 
 	for _, test := range tests {
@@ -94,7 +94,7 @@ func BenchmarkAtoi13(b *testing.B) {
 			c1, c2 float64, c3 error)
 	}{
 		{"cgo", CgoAtoi13},
-		{"go", GoAtoi13},
+		{"go", Atoi13},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,20 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoAtoiq Quick observed place to CIRS, given the star-independent
+//  astrometry parameters.
+func CgoAtoiq(t string, ob1, ob2 float64, astrom ASTROM) (
+	ri, di float64) {
+
+	var cRi, cDi C.double
+	cAstrom := astrGo2C(astrom)
+	cC := C.char(t[0])
+	C.iauAtoiq(&cC, C.double(ob1), C.double(ob2),
+		&cAstrom, &cRi, &cDi)
+	return float64(cRi), float64(cDi)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoAtoiq Quick observed place to CIRS, given the star-independent
+//  Atoiq Quick observed place to CIRS, given the star-independent
 //  astrometry parameters.
 //
 //  - - - - - -
@@ -96,22 +94,9 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoAtoiq Quick observed place to CIRS, given the star-independent
+//  Atoiq Quick observed place to CIRS, given the star-independent
 //  astrometry parameters.
-func CgoAtoiq(t string, ob1, ob2 float64, astrom ASTROM) (
-	ri, di float64) {
-
-	var cRi, cDi C.double
-	cAstrom := astrGo2C(astrom)
-	cC := C.char(t[0])
-	C.iauAtoiq(&cC, C.double(ob1), C.double(ob2),
-		&cAstrom, &cRi, &cDi)
-	return float64(cRi), float64(cDi)
-}
-
-//  GoAtoiq Quick observed place to CIRS, given the star-independent
-//  astrometry parameters.
-func GoAtoiq(t string, ob1, ob2 float64, astrom ASTROM) (
+func Atoiq(t string, ob1, ob2 float64, astrom ASTROM) (
 	ri, di float64) {
 
 	var c int
@@ -129,8 +114,8 @@ func GoAtoiq(t string, ob1, ob2 float64, astrom ASTROM) (
 	c2 = ob2
 
 	// Sin, math.Cos of latitude.
-	sphi = astrom.sphi
-	cphi = astrom.cphi
+	sphi = astrom.Sphi
+	cphi = astrom.Cphi
 
 	// Standardize coordinate type.
 	if c == 'r' || c == 'R' {
@@ -152,11 +137,11 @@ func GoAtoiq(t string, ob1, ob2 float64, astrom ASTROM) (
 
 		// If RA,Dec, convert to HA,Dec.
 		if c == 'R' {
-			c1 = astrom.eral - c1
+			c1 = astrom.Eral - c1
 		}
 
 		// To Cartesian -HA,Dec.
-		v = GoS2c(-c1, c2)
+		v = S2c(-c1, c2)
 		xmhdo = v[0]
 		ymhdo = v[1]
 		zmhdo = v[2]
@@ -181,8 +166,8 @@ func GoAtoiq(t string, ob1, ob2 float64, astrom ASTROM) (
 	// ----------
 
 	// Fast algorithm umath.Sing two constant model.
-	refa = astrom.refa
-	refb = astrom.refb
+	refa = astrom.Refa
+	refb = astrom.Refb
 	tz = sz / zaeo
 	dref = (refa + refb*tz*tz) * tz
 	zdt = zdo + dref
@@ -199,24 +184,24 @@ func GoAtoiq(t string, ob1, ob2 float64, astrom ASTROM) (
 	zmhda = -cphi*xaet + sphi*zaet
 
 	// Diurnal aberration.
-	f = (1.0 + astrom.diurab*ymhda)
+	f = (1.0 + astrom.Diurab*ymhda)
 	xhd = f * xmhda
-	yhd = f * (ymhda - astrom.diurab)
+	yhd = f * (ymhda - astrom.Diurab)
 	zhd = f * zmhda
 
 	// Polar motion.
-	xpl = astrom.xpl
-	ypl = astrom.ypl
+	xpl = astrom.Xpl
+	ypl = astrom.Ypl
 	w = xpl*xhd - ypl*yhd + zhd
 	v[0] = xhd - xpl*w
 	v[1] = yhd + ypl*w
 	v[2] = w - (xpl*xpl+ypl*ypl)*zhd
 
 	// To spherical -HA,Dec.
-	hma, di = GoC2s(v)
+	hma, di = C2s(v)
 
 	// Right ascension.
-	ri = GoAnp(astrom.eral + hma)
+	ri = Anp(astrom.Eral + hma)
 
 	// Finished.
 	return
@@ -41,7 +41,7 @@ func TestAtoiq(t *testing.T) {
 			a11, a12 float64, a13 ASTROM) (ASTROM, error)
 	}{
 		{"cgo", CgoAtoiq, CgoApio13},
-		{"go", GoAtoiq, GoApio13},
+		{"go", Atoiq, Apio13},
 	}
 
 	for _, test := range tests {
@@ -106,7 +106,7 @@ func BenchmarkAtoiq(b *testing.B) {
 			a11, a12 float64, a13 ASTROM) (ASTROM, error)
 	}{
 		{"cgo", CgoAtoiq, CgoApio13},
-		{"go", GoAtoiq, GoApio13},
+		{"go", Atoiq, Apio13},
 	}
 
 	for _, test := range tests {
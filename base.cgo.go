@@ -0,0 +1,175 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+// v2sC2Go translates a vector from cgo into go.
+func v2sC2Go(in [2]C.double) (out [2]float64) {
+	out[0] = float64(in[0])
+	out[1] = float64(in[1])
+	return
+}
+
+// v3sC2GO translates a 3d vector from cgo into go.
+func v3sC2Go(in [3]C.double) (out [3]float64) {
+	out[0] = float64(in[0])
+	out[1] = float64(in[1])
+	out[2] = float64(in[2])
+	return
+}
+
+// v3sGo2C translates a 3d vector from go into cgo.
+func v3sGo2C(in [3]float64) (out [3]C.double) {
+	out[0] = C.double(in[0])
+	out[1] = C.double(in[1])
+	out[2] = C.double(in[2])
+	return
+}
+
+// v3dC2Go translates a 3d vector pair from cgo into go.
+func v3dC2Go(in [2][3]C.double) (out [2][3]float64) {
+	out[0][0] = float64(in[0][0])
+	out[0][1] = float64(in[0][1])
+	out[0][2] = float64(in[0][2])
+	out[1][0] = float64(in[1][0])
+	out[1][1] = float64(in[1][1])
+	out[1][2] = float64(in[1][2])
+	return
+}
+
+// v3dGo2C translates a 3d vector pair from go into cgo.
+func v3dGo2C(in [2][3]float64) (out [2][3]C.double) {
+	out[0][0] = C.double(in[0][0])
+	out[0][1] = C.double(in[0][1])
+	out[0][2] = C.double(in[0][2])
+	out[1][0] = C.double(in[1][0])
+	out[1][1] = C.double(in[1][1])
+	out[1][2] = C.double(in[1][2])
+	return
+}
+
+// v3tC2Go translates a 3d vector triple from cgo into go.
+func v3tC2Go(in [3][3]C.double) (out [3][3]float64) {
+	out[0][0] = float64(in[0][0])
+	out[0][1] = float64(in[0][1])
+	out[0][2] = float64(in[0][2])
+	out[1][0] = float64(in[1][0])
+	out[1][1] = float64(in[1][1])
+	out[1][2] = float64(in[1][2])
+	out[2][0] = float64(in[2][0])
+	out[2][1] = float64(in[2][1])
+	out[2][2] = float64(in[2][2])
+	return
+}
+
+// v3tGo2C translates a 3d vector triple from go into cgo.
+func v3tGo2C(in [3][3]float64) (out [3][3]C.double) {
+	out[0][0] = C.double(in[0][0])
+	out[0][1] = C.double(in[0][1])
+	out[0][2] = C.double(in[0][2])
+	out[1][0] = C.double(in[1][0])
+	out[1][1] = C.double(in[1][1])
+	out[1][2] = C.double(in[1][2])
+	out[2][0] = C.double(in[2][0])
+	out[2][1] = C.double(in[2][1])
+	out[2][2] = C.double(in[2][2])
+	return
+}
+
+// v4sIntC2Go translates a 1d 3d vector from cgo into go.
+func v4sIntC2Go(in [4]C.int) (out [4]int) {
+	out[0] = int(in[0])
+	out[1] = int(in[1])
+	out[2] = int(in[2])
+	out[3] = int(in[3])
+	return
+}
+
+// v4sIntGo2C translates a 1d 3d vector from go into cgo.
+func v4sIntGo2C(in [4]int) (out [4]C.int) {
+	out[0] = C.int(in[0])
+	out[0] = C.int(in[0])
+	out[0] = C.int(in[0])
+	out[0] = C.int(in[0])
+	return
+}
+
+// astrGo2C translates an ASTROM from go into cgo.
+func astrGo2C(in ASTROM) (out C.iauASTROM) {
+
+	out.pmt = C.double(in.Pmt)
+	out.eb = v3sGo2C(in.Eb)
+	out.eh = v3sGo2C(in.Eh)
+	out.em = C.double(in.Em)
+	out.v = v3sGo2C(in.V)
+	out.bm1 = C.double(in.Bm1)
+	out.bpn = v3tGo2C(in.Bpn)
+	out.along = C.double(in.Along)
+	out.phi = C.double(in.Phi)
+	out.xpl = C.double(in.Xpl)
+	out.ypl = C.double(in.Ypl)
+	out.sphi = C.double(in.Sphi)
+	out.cphi = C.double(in.Cphi)
+	out.diurab = C.double(in.Diurab)
+	out.eral = C.double(in.Eral)
+	out.refa = C.double(in.Refa)
+	out.refb = C.double(in.Refb)
+
+	return
+}
+
+// astrC2Go translates an iauASTROM from cgo into go.
+func astrC2Go(in C.iauASTROM) (out ASTROM) {
+
+	out.Pmt = float64(in.pmt)
+	out.Eb = v3sC2Go(in.eb)
+	out.Eh = v3sC2Go(in.eh)
+	out.Em = float64(in.em)
+	out.V = v3sC2Go(in.v)
+	out.Bm1 = float64(in.bm1)
+	out.Bpn = v3tC2Go(in.bpn)
+	out.Along = float64(in.along)
+	out.Phi = float64(in.phi)
+	out.Xpl = float64(in.xpl)
+	out.Ypl = float64(in.ypl)
+	out.Sphi = float64(in.sphi)
+	out.Cphi = float64(in.cphi)
+	out.Diurab = float64(in.diurab)
+	out.Eral = float64(in.eral)
+	out.Refa = float64(in.refa)
+	out.Refb = float64(in.refb)
+
+	return
+}
+
+// ldbodyGo2C translates an LDBODY from go into cgo.
+func ldbodyGo2C(in LDBODY) (out C.iauLDBODY) {
+	out.bm = C.double(in.Bm)
+	out.dl = C.double(in.Dl)
+	out.pv = v3dGo2C(in.Pv)
+	return
+}
+
+// ldbodiesGo2C translates the first n bodies of b into cgo, returning
+// nil when there are none.
+func ldbodiesGo2C(n int, b []LDBODY) *C.iauLDBODY {
+	if n <= 0 {
+		return nil
+	}
+	out := make([]C.iauLDBODY, n)
+	for i := range out {
+		out[i] = ldbodyGo2C(b[i])
+	}
+	return &out[0]
+}
+
+// ldbodyC2Go( translates an iauLDBODY from cgo into go.
+func ldbodyC2Go(in C.iauLDBODY) (out LDBODY) {
+	out.Bm = float64(in.bm)
+	out.Dl = float64(in.dl)
+	out.Pv = v3dC2Go(in.pv)
+	return
+}
@@ -69,7 +69,7 @@ func viv(t *testing.T, ival, ivalok int, fname, test string) {
 func vvd(t *testing.T, val, valok, dval float64, fname, test string) {
 	var a, f float64 // Absolute and fractional error.
 	a = val - valok
-	if a != 0.0 && math.Abs(a) > math.Abs(dval) {
+	if math.IsNaN(a) || a != 0.0 && math.Abs(a) > math.Abs(dval) {
 		f = math.Abs(valok / a)
 		log.Output(2, fmt.Sprintf(
 			"%s failed: %s want %.20f got %.20f (1/%.3f)",
@@ -118,12 +118,12 @@ func errEN(t *testing.T, want int, err en.ErrNum, tname, msg string) {
 		t.Fail()
 		return
 	}	
-	if err.Is() != want {
+	if err.Code() != want {
 		log.Output(2, fmt.Sprintf("%s failed: want %d got %d",
-			tname+ " " + msg, want, err.Is()))
+			tname+ " " + msg, want, err.Code()))
 		t.Fail()
 	} else if *verbose {
 		log.Output(2, fmt.Sprintf("%s passed: want %d got %d",
-			tname+ " " + msg, want, err.Is()))
+			tname+ " " + msg, want, err.Code()))
 	}
 }
@@ -0,0 +1,419 @@
+package sofa
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+)
+
+var errBatchLength = errors.New("batch slices differ in length")
+
+// The batch transformations apply the quick functions to many stars at
+// once.  The stars are held as a struct of arrays, one slice for each
+// argument of the scalar function, and the results are written to
+// slices given by the caller, so that no memory is allocated.  Each
+// result is that of the scalar function, bit for bit.  The work is
+// divided among the goroutines of a Pool, or done by the caller for
+// small batches.
+
+// Catalog is a set of stars, as the arguments of Atciq.  The slices
+// of proper motion, parallax and radial velocity may be nil, for zero.
+type Catalog struct {
+	RA, Dec     []float64 // ICRS at J2000.0 (radians)
+	PMRA, PMDec []float64 // proper motion (radians/year, RA as dRA/dt)
+	Parallax    []float64 // arcsec
+	RV          []float64 // radial velocity (km/s, +ve if receding)
+}
+
+// Len returns the number of stars, that of RA.
+func (c Catalog) Len() int {
+	return len(c.RA)
+}
+
+// check returns an error unless the slices of c are of length n or, the
+// optional ones, nil.
+func (c Catalog) check(n int) error {
+	if len(c.RA) != n || len(c.Dec) != n {
+		return errBatchLength
+	}
+	for _, s := range [][]float64{c.PMRA, c.PMDec, c.Parallax, c.RV} {
+		if s != nil && len(s) != n {
+			return errBatchLength
+		}
+	}
+	return nil
+}
+
+// at returns the star i.
+func (c Catalog) at(i int) (rc, dc, pr, pd, px, rv float64) {
+	rc, dc = c.RA[i], c.Dec[i]
+	if c.PMRA != nil {
+		pr = c.PMRA[i]
+	}
+	if c.PMDec != nil {
+		pd = c.PMDec[i]
+	}
+	if c.Parallax != nil {
+		px = c.Parallax[i]
+	}
+	if c.RV != nil {
+		rv = c.RV[i]
+	}
+	return
+}
+
+// Observed is a set of observed places, as the results of Atioq.  A
+// nil slice is not written.
+type Observed struct {
+	Az  []float64 // azimuth (radians, N=0,E=90)
+	ZD  []float64 // zenith distance (radians)
+	HA  []float64 // hour angle (radians)
+	Dec []float64 // declination (radians)
+	RA  []float64 // right ascension (CIO-based, radians)
+}
+
+func (o Observed) check(n int) error {
+	for _, s := range [][]float64{o.Az, o.ZD, o.HA, o.Dec, o.RA} {
+		if s != nil && len(s) != n {
+			return errBatchLength
+		}
+	}
+	return nil
+}
+
+// Vectors is a set of 3-vectors, one slice for each component.
+type Vectors struct {
+	X, Y, Z []float64
+}
+
+// Len returns the number of vectors, that of X.
+func (v Vectors) Len() int {
+	return len(v.X)
+}
+
+func (v Vectors) check(n int) error {
+	if len(v.X) != n || len(v.Y) != n || len(v.Z) != n {
+		return errBatchLength
+	}
+	return nil
+}
+
+func (v Vectors) at(i int) [3]float64 {
+	return [3]float64{v.X[i], v.Y[i], v.Z[i]}
+}
+
+func (v Vectors) set(i int, p [3]float64) {
+	v.X[i], v.Y[i], v.Z[i] = p[0], p[1], p[2]
+}
+
+// equal returns an error unless the slices are all of length n.
+func equal(n int, s ...[]float64) error {
+	for _, x := range s {
+		if len(x) != n {
+			return errBatchLength
+		}
+	}
+	return nil
+}
+
+// Pool is a set of goroutines among which the batch transformations
+// divide their work.  It is safe for concurrent use.
+type Pool struct {
+	work chan batchWork
+	n    int
+}
+
+// minBatch is the fewest stars worth handing to a goroutine.
+const minBatch = 256
+
+// NewPool starts a pool of n goroutines, or of GOMAXPROCS if n is not
+// positive.
+func NewPool(n int) *Pool {
+	if n <= 0 {
+		n = runtime.GOMAXPROCS(0)
+	}
+	p := &Pool{work: make(chan batchWork, n), n: n}
+	for i := 0; i < n; i++ {
+		go func() {
+			for w := range p.work {
+				w.job.run(w.lo, w.hi)
+				w.job.wg.Done()
+			}
+		}()
+	}
+	return p
+}
+
+// Close stops the goroutines of the pool once their work is done.  The
+// pool must not be used after.
+func (p *Pool) Close() {
+	close(p.work)
+}
+
+var defaultPool struct {
+	once sync.Once
+	p    *Pool
+}
+
+// pool returns p, or if p is nil the default pool, which is started
+// with GOMAXPROCS goroutines on first use.
+func pool(p *Pool) *Pool {
+	if p != nil {
+		return p
+	}
+	defaultPool.once.Do(func() {
+		defaultPool.p = NewPool(0)
+	})
+	return defaultPool.p
+}
+
+// batchKind is the transformation of a batchJob.
+type batchKind int
+
+const (
+	batchAtciq batchKind = iota
+	batchAtciqz
+	batchAticq
+	batchAtioq
+	batchAtoiq
+	batchPmpx
+	batchLdsun
+	batchAb
+)
+
+// batchJob holds the arguments of a batch transformation.  Jobs are
+// recycled through jobs so that a batch allocates nothing.
+type batchJob struct {
+	kind   batchKind
+	wg     sync.WaitGroup
+	astrom ASTROM
+	c      Catalog
+	in     [2][]float64
+	out    [2][]float64
+	obs    Observed
+	t      string
+	vin    Vectors
+	vout   Vectors
+	v      [3]float64
+	s1, s2 float64
+}
+
+// batchWork is the part lo to hi of the stars of a job.
+type batchWork struct {
+	job    *batchJob
+	lo, hi int
+}
+
+var jobs = sync.Pool{New: func() interface{} { return new(batchJob) }}
+
+// do runs the job j for n stars, in parts among the goroutines of p,
+// and returns it to jobs.
+func (p *Pool) do(j *batchJob, n int) {
+	parts := (n + minBatch - 1) / minBatch
+	if parts > p.n {
+		parts = p.n
+	}
+	if parts <= 1 {
+		j.run(0, n)
+	} else {
+		j.wg.Add(parts)
+		lo := 0
+		for i := 0; i < parts; i++ {
+			hi := lo + (n-lo)/(parts-i)
+			p.work <- batchWork{j, lo, hi}
+			lo = hi
+		}
+		j.wg.Wait()
+	}
+	*j = batchJob{}
+	jobs.Put(j)
+}
+
+// run transforms the stars lo to hi of the job.
+func (j *batchJob) run(lo, hi int) {
+	a := &j.astrom
+	switch j.kind {
+	case batchAtciq:
+		for i := lo; i < hi; i++ {
+			rc, dc, pr, pd, px, rv := j.c.at(i)
+			j.out[0][i], j.out[1][i] = Atciq(rc, dc, pr, pd, px, rv, *a)
+		}
+	case batchAtciqz:
+		for i := lo; i < hi; i++ {
+			j.out[0][i], j.out[1][i] = Atciqz(j.in[0][i], j.in[1][i], *a)
+		}
+	case batchAticq:
+		for i := lo; i < hi; i++ {
+			j.out[0][i], j.out[1][i] = Aticq(j.in[0][i], j.in[1][i], *a)
+		}
+	case batchAtioq:
+		o := j.obs
+		for i := lo; i < hi; i++ {
+			aob, zob, hob, dob, rob := Atioq(j.in[0][i], j.in[1][i], *a)
+			if o.Az != nil {
+				o.Az[i] = aob
+			}
+			if o.ZD != nil {
+				o.ZD[i] = zob
+			}
+			if o.HA != nil {
+				o.HA[i] = hob
+			}
+			if o.Dec != nil {
+				o.Dec[i] = dob
+			}
+			if o.RA != nil {
+				o.RA[i] = rob
+			}
+		}
+	case batchAtoiq:
+		for i := lo; i < hi; i++ {
+			j.out[0][i], j.out[1][i] = Atoiq(j.t, j.in[0][i], j.in[1][i],
+				*a)
+		}
+	case batchPmpx:
+		for i := lo; i < hi; i++ {
+			rc, dc, pr, pd, px, rv := j.c.at(i)
+			j.vout.set(i, Pmpx(rc, dc, pr, pd, px, rv, j.s1, j.v))
+		}
+	case batchLdsun:
+		for i := lo; i < hi; i++ {
+			j.vout.set(i, Ldsun(j.vin.at(i), j.v, j.s1))
+		}
+	case batchAb:
+		for i := lo; i < hi; i++ {
+			j.vout.set(i, Ab(j.vin.at(i), j.v, j.s1, j.s2))
+		}
+	}
+}
+
+// newJob returns a job of the kind k from jobs.
+func newJob(k batchKind) *batchJob {
+	j := jobs.Get().(*batchJob)
+	j.kind = k
+	return j
+}
+
+// AtciqBatch is Atciq for the stars of c, the CIRS places of which are
+// written to ri, di.  The work is divided among the goroutines of p,
+// or of a default pool if p is nil.
+func AtciqBatch(p *Pool, c Catalog, astrom ASTROM, ri, di []float64) error {
+	n := c.Len()
+	if err := c.check(n); err != nil {
+		return err
+	}
+	if err := equal(n, ri, di); err != nil {
+		return err
+	}
+	j := newJob(batchAtciq)
+	j.c, j.astrom, j.out = c, astrom, [2][]float64{ri, di}
+	pool(p).do(j, n)
+	return nil
+}
+
+// AtciqzBatch is Atciqz for the places rc, dc, the CIRS places of which
+// are written to ri, di.
+func AtciqzBatch(p *Pool, rc, dc []float64, astrom ASTROM, ri,
+	di []float64) error {
+	return batch2(p, batchAtciqz, rc, dc, astrom, ri, di)
+}
+
+// AticqBatch is Aticq for the CIRS places ri, di, the ICRS places of
+// which are written to rc, dc.
+func AticqBatch(p *Pool, ri, di []float64, astrom ASTROM, rc,
+	dc []float64) error {
+	return batch2(p, batchAticq, ri, di, astrom, rc, dc)
+}
+
+// AtioqBatch is Atioq for the CIRS places ri, di, the observed places
+// of which are written to ob.
+func AtioqBatch(p *Pool, ri, di []float64, astrom ASTROM,
+	ob Observed) error {
+	n := len(ri)
+	if err := equal(n, di); err != nil {
+		return err
+	}
+	if err := ob.check(n); err != nil {
+		return err
+	}
+	j := newJob(batchAtioq)
+	j.in, j.astrom, j.obs = [2][]float64{ri, di}, astrom, ob
+	pool(p).do(j, n)
+	return nil
+}
+
+// AtoiqBatch is Atoiq for the observed places ob1, ob2, all of the type
+// t, the CIRS places of which are written to ri, di.
+func AtoiqBatch(p *Pool, t string, ob1, ob2 []float64, astrom ASTROM,
+	ri, di []float64) error {
+	n := len(ob1)
+	if err := equal(n, ob2, ri, di); err != nil {
+		return err
+	}
+	j := newJob(batchAtoiq)
+	j.t, j.in, j.astrom = t, [2][]float64{ob1, ob2}, astrom
+	j.out = [2][]float64{ri, di}
+	pool(p).do(j, n)
+	return nil
+}
+
+// batch2 runs a job of the kind k from the places a, b to the places
+// x, y.
+func batch2(p *Pool, k batchKind, a, b []float64, astrom ASTROM, x,
+	y []float64) error {
+	n := len(a)
+	if err := equal(n, b, x, y); err != nil {
+		return err
+	}
+	j := newJob(k)
+	j.in, j.astrom, j.out = [2][]float64{a, b}, astrom, [2][]float64{x, y}
+	pool(p).do(j, n)
+	return nil
+}
+
+// PmpxBatch is Pmpx for the stars of c, the coordinate directions of
+// which are written to pco.
+func PmpxBatch(p *Pool, c Catalog, pmt float64, pob [3]float64,
+	pco Vectors) error {
+	n := c.Len()
+	if err := c.check(n); err != nil {
+		return err
+	}
+	if err := pco.check(n); err != nil {
+		return err
+	}
+	j := newJob(batchPmpx)
+	j.c, j.s1, j.v, j.vout = c, pmt, pob, pco
+	pool(p).do(j, n)
+	return nil
+}
+
+// LdsunBatch is Ldsun for the directions p of the sources, the
+// deflected directions of which are written to p1.
+func LdsunBatch(pl *Pool, p Vectors, e [3]float64, em float64,
+	p1 Vectors) error {
+	return batchVectors(pl, batchLdsun, p, e, em, 0, p1)
+}
+
+// AbBatch is Ab for the natural directions pnat, the proper directions
+// of which are written to ppr.
+func AbBatch(p *Pool, pnat Vectors, v [3]float64, s, bm1 float64,
+	ppr Vectors) error {
+	return batchVectors(p, batchAb, pnat, v, s, bm1, ppr)
+}
+
+// batchVectors runs a job of the kind k from the vectors in to out.
+func batchVectors(p *Pool, k batchKind, in Vectors, v [3]float64, s1,
+	s2 float64, out Vectors) error {
+	n := in.Len()
+	if err := in.check(n); err != nil {
+		return err
+	}
+	if err := out.check(n); err != nil {
+		return err
+	}
+	j := newJob(k)
+	j.vin, j.v, j.s1, j.s2, j.vout = in, v, s1, s2, out
+	pool(p).do(j, n)
+	return nil
+}
@@ -0,0 +1,154 @@
+package sofa
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// batchStars returns n random stars, and the astrometry parameters of
+// the Apco13 test.
+func batchStars(n int) (Catalog, ASTROM) {
+	r := rand.New(rand.NewSource(1))
+	c := Catalog{RA: make([]float64, n), Dec: make([]float64, n),
+		PMRA: make([]float64, n), PMDec: make([]float64, n),
+		Parallax: make([]float64, n), RV: make([]float64, n)}
+	for i := 0; i < n; i++ {
+		c.RA[i] = r.Float64() * D2PI
+		c.Dec[i] = math.Asin(2.0*r.Float64() - 1.0)
+		c.PMRA[i] = r.NormFloat64() * 1e-8
+		c.PMDec[i] = r.NormFloat64() * 1e-8
+		c.Parallax[i] = r.Float64() * 0.1
+		c.RV[i] = r.NormFloat64() * 30.0
+	}
+	astrom, _, _ := Apco13(2456384.5, 0.969254051, 0.1550675,
+		-0.527800806, -1.2345856, 2738.0, 2.47230737e-7, 1.82640464e-6,
+		731.0, 12.8, 0.59, 0.55, ASTROM{})
+	return c, astrom
+}
+
+// same reports a failure unless got is val bit for bit.
+func same(t *testing.T, got, val float64, fname, test string, i int) {
+	t.Helper()
+	if math.Float64bits(got) != math.Float64bits(val) {
+		t.Errorf("%s failed: %s[%d] want %v got %v", fname, test, i, val,
+			got)
+	}
+}
+
+func newVectors(n int) Vectors {
+	return Vectors{make([]float64, n), make([]float64, n),
+		make([]float64, n)}
+}
+
+func TestBatch(t *testing.T) {
+	const n = 3001
+	c, astrom := batchStars(n)
+	p := NewPool(4)
+	defer p.Close()
+
+	ri, di := make([]float64, n), make([]float64, n)
+	errT(t, nil, AtciqBatch(p, c, astrom, ri, di), "AtciqBatch", "err")
+	zi, zd := make([]float64, n), make([]float64, n)
+	errT(t, nil, AtciqzBatch(nil, c.RA, c.Dec, astrom, zi, zd),
+		"AtciqzBatch", "err")
+	rc, dc := make([]float64, n), make([]float64, n)
+	errT(t, nil, AticqBatch(p, ri, di, astrom, rc, dc), "AticqBatch",
+		"err")
+	ob := Observed{Az: make([]float64, n), ZD: make([]float64, n),
+		RA: make([]float64, n)}
+	errT(t, nil, AtioqBatch(p, ri, di, astrom, ob), "AtioqBatch", "err")
+	oi, od := make([]float64, n), make([]float64, n)
+	errT(t, nil, AtoiqBatch(p, "A", ob.Az, ob.ZD, astrom, oi, od),
+		"AtoiqBatch", "err")
+	for i := 0; i < n; i++ {
+		r, d := Atciq(c.RA[i], c.Dec[i], c.PMRA[i], c.PMDec[i],
+			c.Parallax[i], c.RV[i], astrom)
+		same(t, ri[i], r, "AtciqBatch", "ri", i)
+		same(t, di[i], d, "AtciqBatch", "di", i)
+		r, d = Atciqz(c.RA[i], c.Dec[i], astrom)
+		same(t, zi[i], r, "AtciqzBatch", "ri", i)
+		same(t, zd[i], d, "AtciqzBatch", "di", i)
+		r, d = Aticq(ri[i], di[i], astrom)
+		same(t, rc[i], r, "AticqBatch", "rc", i)
+		same(t, dc[i], d, "AticqBatch", "dc", i)
+		aob, zob, _, _, rob := Atioq(ri[i], di[i], astrom)
+		same(t, ob.Az[i], aob, "AtioqBatch", "aob", i)
+		same(t, ob.ZD[i], zob, "AtioqBatch", "zob", i)
+		same(t, ob.RA[i], rob, "AtioqBatch", "rob", i)
+		r, d = Atoiq("A", aob, zob, astrom)
+		same(t, oi[i], r, "AtoiqBatch", "ri", i)
+		same(t, od[i], d, "AtoiqBatch", "di", i)
+	}
+
+	// The vector functions.
+	pco, p1, ppr := newVectors(n), newVectors(n), newVectors(n)
+	errT(t, nil, PmpxBatch(p, c, astrom.Pmt, astrom.Eb, pco),
+		"PmpxBatch", "err")
+	errT(t, nil, LdsunBatch(p, pco, astrom.Eh, astrom.Em, p1),
+		"LdsunBatch", "err")
+	errT(t, nil, AbBatch(p, p1, astrom.V, astrom.Em, astrom.Bm1, ppr),
+		"AbBatch", "err")
+	for i := 0; i < n; i++ {
+		v := Pmpx(c.RA[i], c.Dec[i], c.PMRA[i], c.PMDec[i],
+			c.Parallax[i], c.RV[i], astrom.Pmt, astrom.Eb)
+		w := Ldsun(v, astrom.Eh, astrom.Em)
+		x := Ab(w, astrom.V, astrom.Em, astrom.Bm1)
+		for k := 0; k < 3; k++ {
+			same(t, pco.at(i)[k], v[k], "PmpxBatch", "pco", i)
+			same(t, p1.at(i)[k], w[k], "LdsunBatch", "p1", i)
+			same(t, ppr.at(i)[k], x[k], "AbBatch", "ppr", i)
+		}
+	}
+
+	// Optional slices, and slices of the wrong length.
+	c.PMRA, c.PMDec, c.Parallax, c.RV = nil, nil, nil, nil
+	errT(t, nil, AtciqBatch(p, c, astrom, ri, di), "AtciqBatch", "nil")
+	r, d := Atciq(c.RA[7], c.Dec[7], 0, 0, 0, 0, astrom)
+	same(t, ri[7], r, "AtciqBatch", "nil ri", 7)
+	same(t, di[7], d, "AtciqBatch", "nil di", 7)
+	errT(t, errBatchLength, AtciqBatch(p, c, astrom, ri[1:], di),
+		"AtciqBatch", "length")
+	c.RV = ri[1:]
+	errT(t, errBatchLength, AtciqBatch(p, c, astrom, ri, di),
+		"AtciqBatch", "catalog length")
+	errT(t, errBatchLength, AtioqBatch(p, ri, di, astrom,
+		Observed{HA: ri[1:]}), "AtioqBatch", "length")
+	errT(t, errBatchLength, AbBatch(p, p1, astrom.V, astrom.Em,
+		astrom.Bm1, Vectors{X: ri}), "AbBatch", "length")
+}
+
+func TestBatchAllocs(t *testing.T) {
+	const n = 10000
+	c, astrom := batchStars(n)
+	ri, di := make([]float64, n), make([]float64, n)
+	AtciqBatch(nil, c, astrom, ri, di)
+	a := testing.AllocsPerRun(20, func() {
+		AtciqBatch(nil, c, astrom, ri, di)
+	})
+	if a != 0 {
+		t.Errorf("AtciqBatch: %v allocations", a)
+	}
+}
+
+func BenchmarkAtciqBatch(b *testing.B) {
+	const n = 50000
+	c, astrom := batchStars(n)
+	ri, di := make([]float64, n), make([]float64, n)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		AtciqBatch(nil, c, astrom, ri, di)
+	}
+}
+
+func BenchmarkAtciqScalar(b *testing.B) {
+	const n = 50000
+	c, astrom := batchStars(n)
+	ri, di := make([]float64, n), make([]float64, n)
+	for i := 0; i < b.N; i++ {
+		for k := 0; k < n; k++ {
+			ri[k], di[k] = Atciq(c.RA[k], c.Dec[k], c.PMRA[k], c.PMDec[k],
+				c.Parallax[k], c.RV[k], astrom)
+		}
+	}
+}
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauBi00(double *dpsibi, double *depsbi, double *dra)
@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoBi00 is the SOFA C version of Bi00.
+func CgoBi00(dpsibi, depsbi, dra float64) (a, b, c float64) {
+	cA := C.double(dpsibi)
+	cB := C.double(depsbi)
+	cC := C.double(dra)
+	C.iauBi00(&cA, &cB, &cC)
+	return float64(cA), float64(cB), float64(cC)
+}
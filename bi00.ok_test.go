@@ -22,7 +22,7 @@ func TestBi00(t *testing.T) {
 		fn  func(a, b, c float64) (d, e, f float64)
 	}{
 		{"cgo", CgoBi00},
-		{"go", GoBi00},
+		{"go", Bi00},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -42,7 +42,7 @@ func BenchmarkBi00(b *testing.B) {
 		fn  func(a, b, c float64) (d, e, f float64)
 	}{
 		{"cgo", CgoBi00},
-		{"go", GoBi00},
+		{"go", Bi00},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
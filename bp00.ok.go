@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoBp00 Frame bias and precession, IAU 2000.
+//  Bp00 Frame bias and precession, IAU 2000.
 //
 //  - - - - -
 //   B p 0 0
@@ -82,16 +80,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoBp00 Frame bias and precession, IAU 2000.
-func CgoBp00(date1, date2 float64) (rb, rp, rbp [3][3]float64) {
-	var cRb, cRp, cRbp [3][3]C.double
-	C.iauBp00(C.double(date1), C.double(date2),
-		&cRb[0], &cRp[0], &cRbp[0])
-	return v3tC2Go(cRb), v3tC2Go(cRp), v3tC2Go(cRbp)
-}
-
-//  GoBp00 Frame bias and precession, IAU 2000.
-func GoBp00(date1, date2 float64) (rb, rp, rbp [3][3]float64) {
+//  Bp00 Frame bias and precession, IAU 2000.
+func Bp00(date1, date2 float64) (rb, rp, rbp [3][3]float64) {
 	// J2000.0 obliquity (Lieske et al. 1977)
 	const EPS0 = 84381.448 * DAS2R
 
@@ -102,7 +92,7 @@ func GoBp00(date1, date2 float64) (rb, rp, rbp [3][3]float64) {
 	t = ((date1 - DJ00) + date2) / DJC
 
 	// Frame bias.
-	dpsibi, depsbi, dra0 = GoBi00(dpsibi, depsbi, dra0)
+	dpsibi, depsbi, dra0 = Bi00(dpsibi, depsbi, dra0)
 
 	// Precession angles (Lieske et al. 1977)
 	psia77 = (5038.7784 + (-1.07259+(-0.001147)*t)*t) * t * DAS2R
@@ -110,24 +100,24 @@ func GoBp00(date1, date2 float64) (rb, rp, rbp [3][3]float64) {
 	chia = (10.5526 + (-2.38064+(-0.001125)*t)*t) * t * DAS2R
 
 	// Apply IAU 2000 precession corrections.
-	dpsipr, depspr = GoPr00(date1, date2)
+	dpsipr, depspr = Pr00(date1, date2)
 	psia = psia77 + dpsipr
 	oma = oma77 + depspr
 
 	// Frame bias matrix: GCRS to J2000.0.
-	rb = GoIr()
-	rb = GoRz(dra0, rb)
-	rb = GoRy(dpsibi*math.Sin(EPS0), rb)
-	rb = GoRx(-depsbi, rb)
+	rb = Ir()
+	rb = Rz(dra0, rb)
+	rb = Ry(dpsibi*math.Sin(EPS0), rb)
+	rb = Rx(-depsbi, rb)
 
 	// Precession matrix: J2000.0 to mean of date.
-	rp = GoIr()
-	rp = GoRx(EPS0, rp)
-	rp = GoRz(-psia, rp)
-	rp = GoRx(-oma, rp)
-	rp = GoRz(chia, rp)
+	rp = Ir()
+	rp = Rx(EPS0, rp)
+	rp = Rz(-psia, rp)
+	rp = Rx(-oma, rp)
+	rp = Rz(chia, rp)
 
 	// Bias-precession matrix: GCRS to mean of date.
-	rbp = GoRxr(rp, rb)
+	rbp = Rxr(rp, rb)
 	return
 }
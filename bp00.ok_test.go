@@ -20,7 +20,7 @@ func TestBp00(t *testing.T) {
 		fn  func(a, b float64) (c, d, e [3][3]float64)
 	}{
 		{"cgo", CgoBp00},
-		{"go", GoBp00},
+		{"go", Bp00},
 	}
 
 	for _, test := range tests {
@@ -92,7 +92,7 @@ func BenchmarkBp00(b *testing.B) {
 		fn  func(a, b float64) (c, d, e [3][3]float64)
 	}{
 		{"cgo", CgoBp00},
-		{"go", GoBp00},
+		{"go", Bp00},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoBp06 Frame bias and precession, IAU 2006.
+func CgoBp06(date1, date2 float64) (rb, rp, rbp [3][3]float64) {
+	var cRb, cRp, cRbp [3][3]C.double
+	C.iauBp06(C.double(date1), C.double(date2),
+		&cRb[0], &cRp[0], &cRbp[0])
+	return v3tC2Go(cRb), v3tC2Go(cRp), v3tC2Go(cRbp)
+}
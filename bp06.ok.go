@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoBp06 Frame bias and precession, IAU 2006.
+//  Bp06 Frame bias and precession, IAU 2006.
 //
 //  - - - - -
 //   B p 0 6
@@ -75,29 +72,21 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoBp06 Frame bias and precession, IAU 2006.
-func CgoBp06(date1, date2 float64) (rb, rp, rbp [3][3]float64) {
-	var cRb, cRp, cRbp [3][3]C.double
-	C.iauBp06(C.double(date1), C.double(date2),
-		&cRb[0], &cRp[0], &cRbp[0])
-	return v3tC2Go(cRb), v3tC2Go(cRp), v3tC2Go(cRbp)
-}
-
-//  GoBp06 Frame bias and precession, IAU 2006.
-func GoBp06(date1, date2 float64) (rb, rp, rbp [3][3]float64) {
+//  Bp06 Frame bias and precession, IAU 2006.
+func Bp06(date1, date2 float64) (rb, rp, rbp [3][3]float64) {
 	var gamb, phib, psib, epsa float64
 	var rbt [3][3]float64
 
 	// B matrix. 
-	gamb, phib, psib, epsa = GoPfw06(DJM0, DJM00)
-	rb = GoFw2m(gamb, phib, psib, epsa)
+	gamb, phib, psib, epsa = Pfw06(DJM0, DJM00)
+	rb = Fw2m(gamb, phib, psib, epsa)
 
 	// PxB matrix. 
-	rbp = GoPmat06(date1, date2)
+	rbp = Pmat06(date1, date2)
 
 	// P matrix. 
-	rbt = GoTr(rb)
-	rp = GoRxr(rbp, rbt)
+	rbt = Tr(rb)
+	rp = Rxr(rbp, rbt)
 
 	return
 
@@ -22,7 +22,7 @@ func TestBp06(t *testing.T) {
 		fn  func(a1, a2 float64) (c1, c2, c3 [3][3]float64)
 	}{
 		{"cgo", CgoBp06},
-		{"go", GoBp06},
+		{"go", Bp06},
 	}
 
 	for _, test := range tests {
@@ -94,7 +94,7 @@ func BenchmarkBp06(b *testing.B) {
 		fn  func(a1, a2 float64) (c1, c2, c3 [3][3]float64)
 	}{
 		{"cgo", CgoBp06},
-		{"go", GoBp06},
+		{"go", Bp06},
 	}
 
 	for _, test := range tests {
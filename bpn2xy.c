@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauBpn2xy(double rbpn[3][3], double *x, double *y)
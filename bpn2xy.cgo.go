@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoBpn2xy Extract from the bias-precession-nutation matrix the X,Y
+//  coordinates of the Celestial Intermediate Pole.
+func CgoBpn2xy(rbpn [3][3]float64) (x, y float64) {
+	cX := C.double(x)
+	cY := C.double(y)
+	cRbpn := v3tGo2C(rbpn)
+	C.iauBpn2xy(&cRbpn[0], &cX, &cY)
+	return float64(cX), float64(cY)
+}
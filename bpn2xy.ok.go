@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoBpn2xy Extract from the bias-precession-nutation matrix the X,Y
+//  Bpn2xy Extract from the bias-precession-nutation matrix the X,Y
 //  coordinates of the Celestial Intermediate Pole.
 //
 //  - - - - - - -
@@ -49,19 +46,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoBpn2xy Extract from the bias-precession-nutation matrix the X,Y
-//  coordinates of the Celestial Intermediate Pole.
-func CgoBpn2xy(rbpn [3][3]float64) (x, y float64) {
-	cX := C.double(x)
-	cY := C.double(y)
-	cRbpn := v3tGo2C(rbpn)
-	C.iauBpn2xy(&cRbpn[0], &cX, &cY)
-	return float64(cX), float64(cY)
-}
-
-//  GoBpn2xy Extract from the bias-precession-nutation matrix the X,Y
+//  Bpn2xy Extract from the bias-precession-nutation matrix the X,Y
 //  coordinates of the Celestial Intermediate Pole.
-func GoBpn2xy(rbpn [3][3]float64) (x, y float64) {
+func Bpn2xy(rbpn [3][3]float64) (x, y float64) {
 	// Extract the X,Y coordinates.
 	x = rbpn[2][0]
 	y = rbpn[2][1]
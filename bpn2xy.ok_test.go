@@ -34,7 +34,7 @@ func TestBpn2xy(t *testing.T) {
 		fn  func([3][3]float64) (a, b float64)
 	}{
 		{"cgo", CgoBpn2xy},
-		{"go", GoBpn2xy},
+		{"go", Bpn2xy},
 	}
 
 	for _, test := range tests {
@@ -66,7 +66,7 @@ func BenchmarkBpn2xy(b *testing.B) {
 		fn  func([3][3]float64) (a, b float64)
 	}{
 		{"cgo", CgoBpn2xy},
-		{"go", GoBpn2xy},
+		{"go", Bpn2xy},
 	}
 
 	for _, test := range tests {
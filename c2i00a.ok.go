@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoC2i00a Form the celestial-to-intermediate matrix for a given date
+//  C2i00a Form the celestial-to-intermediate matrix for a given date
 //  using the IAU 2000A precession-nutation model.
 //
 //  - - - - - - -
@@ -80,23 +77,15 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoC2i00a Form the celestial-to-intermediate matrix for a given date
-//  using the IAU 2000A precession-nutation model.
-func CgoC2i00a(date1, date2 float64) (rc2i [3][3]float64) {
-	var cRc2i [3][3]C.double
-	C.iauC2i00a(C.double(date1), C.double(date2), &cRc2i[0])
-	return v3tC2Go(cRc2i)
-}
-
-//  GoC2i00a Form the celestial-to-intermediate matrix for a given date
+//  C2i00a Form the celestial-to-intermediate matrix for a given date
 //  using the IAU 2000A precession-nutation model.
-func GoC2i00a(date1, date2 float64) (rc2i [3][3]float64) {
+func C2i00a(date1, date2 float64) (rc2i [3][3]float64) {
 	var rbpn [3][3]float64
 
 	// Obtain the celestial-to-true matrix (IAU 2000A).
-	rbpn = GoPnm00a(date1, date2)
+	rbpn = Pnm00a(date1, date2)
 
 	// Form the celestial-to-intermediate matrix.
-	rc2i = GoC2ibpn(date1, date2, rbpn)
+	rc2i = C2ibpn(date1, date2, rbpn)
 	return
 }
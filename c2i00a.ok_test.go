@@ -22,7 +22,7 @@ func TestC2i00a(t *testing.T) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoC2i00a},
-		{"go", GoC2i00a},
+		{"go", C2i00a},
 	}
 
 	for _, test := range tests {
@@ -59,7 +59,7 @@ func BenchmarkC2i00a(b *testing.B) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoC2i00a},
-		{"go", GoC2i00a},
+		{"go", C2i00a},
 	}
 
 	for _, test := range tests {
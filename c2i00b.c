@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauC2i00b(double date1, double date2, double rc2i[3][3])
@@ -22,7 +22,7 @@ func TestC2i00b(t *testing.T) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoC2i00b},
-		{"go", GoC2i00b},
+		{"go", C2i00b},
 	}
 
 	for _, test := range tests {
@@ -59,7 +59,7 @@ func BenchmarkC2i00b(b *testing.B) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoC2i00b},
-		{"go", GoC2i00b},
+		{"go", C2i00b},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoC2i06a Form the celestial-to-intermediate matrix for a given date
+//  using the IAU 2006 precession and IAU 2000A nutation models.
+func CgoC2i06a(date1, date2 float64) (rc2i [3][3]float64) {
+	var cRc2i [3][3]C.double
+	C.iauC2i06a(C.double(date1), C.double(date2), &cRc2i[0])
+	return v3tC2Go(cRc2i)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoC2i06a Form the celestial-to-intermediate matrix for a given date
+//  C2i06a Form the celestial-to-intermediate matrix for a given date
 //  using the IAU 2006 precession and IAU 2000A nutation models.
 //
 //  - - - - - - -
@@ -71,31 +68,23 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoC2i06a Form the celestial-to-intermediate matrix for a given date
-//  using the IAU 2006 precession and IAU 2000A nutation models.
-func CgoC2i06a(date1, date2 float64) (rc2i [3][3]float64) {
-	var cRc2i [3][3]C.double
-	C.iauC2i06a(C.double(date1), C.double(date2), &cRc2i[0])
-	return v3tC2Go(cRc2i)
-}
-
-//  GoC2i06a Form the celestial-to-intermediate matrix for a given date
+//  C2i06a Form the celestial-to-intermediate matrix for a given date
 //  using the IAU 2006 precession and IAU 2000A nutation models.
-func GoC2i06a(date1, date2 float64) (rc2i [3][3]float64) {
+func C2i06a(date1, date2 float64) (rc2i [3][3]float64) {
 
 	var rbpn [3][3]float64
 	var x, y, s float64
 
 	// Obtain the celestial-to-true matrix (IAU 2006/2000A).
-	rbpn = GoPnm06a(date1, date2)
+	rbpn = Pnm06a(date1, date2)
 
 	// Extract the X,Y coordinates.
-	x, y = GoBpn2xy(rbpn)
+	x, y = Bpn2xy(rbpn)
 
 	// Obtain the CIO locator.
-	s = GoS06(date1, date2, x, y)
+	s = S06(date1, date2, x, y)
 
 	// Form the celestial-to-intermediate matrix.
-	rc2i = GoC2ixys(x, y, s)
+	rc2i = C2ixys(x, y, s)
 	return
 }
@@ -22,7 +22,7 @@ func TestC2i06a(t *testing.T) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoC2i06a},
-		{"go", GoC2i06a},
+		{"go", C2i06a},
 	}
 
 	for _, test := range tests {
@@ -58,7 +58,7 @@ func BenchmarkC2i06a(b *testing.B) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoC2i06a},
-		{"go", GoC2i06a},
+		{"go", C2i06a},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauC2ibpn(double date1, double date2, double rbpn[3][3],
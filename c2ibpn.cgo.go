@@ -0,0 +1,18 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoC2ibpn Form the celestial-to-intermediate matrix for a given date
+//  given the bias-precession-nutation matrix.  IAU 2000.
+func CgoC2ibpn(date1, date2 float64, rbpn [3][3]float64) (
+	rc2i [3][3]float64) {
+	var cRc2i [3][3]C.double
+	cRbpn := v3tGo2C(rbpn)
+	C.iauC2ibpn(C.double(date1), C.double(date2), &cRbpn[0],
+		&cRc2i[0])
+	return v3tC2Go(cRc2i)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoC2ibpn Form the celestial-to-intermediate matrix for a given date
+//  C2ibpn Form the celestial-to-intermediate matrix for a given date
 //  given the bias-precession-nutation matrix.  IAU 2000.
 //
 //  - - - - - - -
@@ -82,28 +79,17 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoC2ibpn Form the celestial-to-intermediate matrix for a given date
-//  given the bias-precession-nutation matrix.  IAU 2000.
-func CgoC2ibpn(date1, date2 float64, rbpn [3][3]float64) (
-	rc2i [3][3]float64) {
-	var cRc2i [3][3]C.double
-	cRbpn := v3tGo2C(rbpn)
-	C.iauC2ibpn(C.double(date1), C.double(date2), &cRbpn[0],
-		&cRc2i[0])
-	return v3tC2Go(cRc2i)
-}
-
-//  GoC2ibpn Form the celestial-to-intermediate matrix for a given date
+//  C2ibpn Form the celestial-to-intermediate matrix for a given date
 //  given the bias-precession-nutation matrix.  IAU 2000.
-func GoC2ibpn(date1, date2 float64, rbpn [3][3]float64) (
+func C2ibpn(date1, date2 float64, rbpn [3][3]float64) (
 	rc2i [3][3]float64) {
 	var x, y float64
 
 	// Extract the X,Y coordinates.
-	x, y = GoBpn2xy(rbpn)
+	x, y = Bpn2xy(rbpn)
 
 	// Form the celestial-to-intermediate matrix (n.b. IAU 2000
 	// specific).
-	rc2i = GoC2ixy(date1, date2, x, y)
+	rc2i = C2ixy(date1, date2, x, y)
 	return
 }
@@ -34,7 +34,7 @@ func TestC2ibpn(t *testing.T) {
 		fn  func(a1, a2 float64, a3 [3][3]float64) [3][3]float64
 	}{
 		{"cgo", CgoC2ibpn},
-		{"go", GoC2ibpn},
+		{"go", C2ibpn},
 	}
 
 	for _, test := range tests {
@@ -85,7 +85,7 @@ func BenchmarkC2ibpn(b *testing.B) {
 		fn  func(a1, a2 float64, a3 [3][3]float64) [3][3]float64
 	}{
 		{"cgo", CgoC2ibpn},
-		{"go", GoC2ibpn},
+		{"go", C2ibpn},
 	}
 
 	for _, test := range tests {
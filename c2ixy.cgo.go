@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoC2ixy Form the celestial to intermediate-frame-of-date matrix for
+//  a given date when the CIP X,Y coordinates are known.  IAU 2000.
+func CgoC2ixy(date1, date2, x, y float64) (rc2i [3][3]float64) {
+	var cRc2i [3][3]C.double
+	C.iauC2ixy(C.double(date1), C.double(date2), C.double(x),
+		C.double(y), &cRc2i[0])
+	return v3tC2Go(cRc2i)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoC2ixy Form the celestial to intermediate-frame-of-date matrix for
+//  C2ixy Form the celestial to intermediate-frame-of-date matrix for
 //  a given date when the CIP X,Y coordinates are known.  IAU 2000.
 //
 //  - - - - - -
@@ -76,19 +73,10 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoC2ixy Form the celestial to intermediate-frame-of-date matrix for
-//  a given date when the CIP X,Y coordinates are known.  IAU 2000.
-func CgoC2ixy(date1, date2, x, y float64) (rc2i [3][3]float64) {
-	var cRc2i [3][3]C.double
-	C.iauC2ixy(C.double(date1), C.double(date2), C.double(x),
-		C.double(y), &cRc2i[0])
-	return v3tC2Go(cRc2i)
-}
-
-//  GoC2ixy Form the celestial to intermediate-frame-of-date matrix for
+//  C2ixy Form the celestial to intermediate-frame-of-date matrix for
 //  a given date when the CIP X,Y coordinates are known.  IAU 2000.
-func GoC2ixy(date1, date2, x, y float64) (rc2i [3][3]float64) {
+func C2ixy(date1, date2, x, y float64) (rc2i [3][3]float64) {
 	// Compute s and then the matrix.
-	rc2i = GoC2ixys(x, y, GoS00(date1, date2, x, y))
+	rc2i = C2ixys(x, y, S00(date1, date2, x, y))
 	return
 }
@@ -26,7 +26,7 @@ func TestC2ixy(t *testing.T) {
 		fn  func(a1, a2, a3, a4 float64) [3][3]float64
 	}{
 		{"cgo", CgoC2ixy},
-		{"go", GoC2ixy},
+		{"go", C2ixy},
 	}
 
 	for _, test := range tests {
@@ -68,7 +68,7 @@ func BenchmarkC2ixy(b *testing.B) {
 		fn  func(a1, a2, a3, a4 float64) [3][3]float64
 	}{
 		{"cgo", CgoC2ixy},
-		{"go", GoC2ixy},
+		{"go", C2ixy},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoC2ixys Form the celestial to intermediate-frame-of-date matrix
+//  given the CIP X,Y and the CIO locator s.
+func CgoC2ixys(x, y, s float64) (rc2i [3][3]float64) {
+	var cRc2i [3][3]C.double
+	C.iauC2ixys(C.double(x), C.double(y), C.double(s), &cRc2i[0])
+	return v3tC2Go(cRc2i)
+}
@@ -27,7 +27,7 @@ func TestC2ixys(t *testing.T) {
 		fn  func(a, b, c float64) [3][3]float64
 	}{
 		{"cgo", CgoC2ixys},
-		{"go", GoC2ixys},
+		{"go", C2ixys},
 	}
 
 	for _, test := range tests {
@@ -60,7 +60,7 @@ func BenchmarkC2ixys(b *testing.B) {
 		fn  func(a, b, c float64) [3][3]float64
 	}{
 		{"cgo", CgoC2ixys},
-		{"go", GoC2ixys},
+		{"go", C2ixys},
 	}
 
 	for _, test := range tests {
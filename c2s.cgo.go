@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoC2s P-vector to spherical coordinates.
+func CgoC2s(p [3]float64) (theta, phi float64) {
+	var cTheta, cPhi C.double
+	cP := v3sGo2C(p)
+	C.iauC2s(&cP[0], &cTheta, &cPhi)
+	return float64(cTheta), float64(cPhi)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoC2s P-vector to spherical coordinates.
+//  C2s P-vector to spherical coordinates.
 //
 //  - - - -
 //   C 2 s
@@ -36,16 +34,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoC2s P-vector to spherical coordinates.
-func CgoC2s(p [3]float64) (theta, phi float64) {
-	var cTheta, cPhi C.double
-	cP := v3sGo2C(p)
-	C.iauC2s(&cP[0], &cTheta, &cPhi)
-	return float64(cTheta), float64(cPhi)
-}
-
-//  GoC2s P-vector to spherical coordinates.
-func GoC2s(p [3]float64) (theta, phi float64) {
+//  C2s P-vector to spherical coordinates.
+func C2s(p [3]float64) (theta, phi float64) {
 
 	var x, y, z, d2 float64
 
@@ -26,7 +26,7 @@ func TestC2s(t *testing.T) {
 		fn  func([3]float64) (b1, b2 float64)
 	}{
 		{"cgo", CgoC2s},
-		{"go", GoC2s},
+		{"go", C2s},
 	}
 
 	for _, test := range tests {
@@ -50,7 +50,7 @@ func BenchmarkC2s(b *testing.B) {
 		fn  func([3]float64) (b1, b2 float64)
 	}{
 		{"cgo", CgoC2s},
-		{"go", GoC2s},
+		{"go", C2s},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoC2t00a Form the celestial to terrestrial matrix given the date,
+//  the UT1 and the polar motion, using the IAU 2000A nutation model.
+func CgoC2t00a(tta, ttb, uta, utb, xp, yp float64) (
+	rc2t [3][3]float64) {
+	var cRc2t [3][3]C.double
+	C.iauC2t00a(C.double(tta), C.double(ttb), C.double(uta),
+		C.double(utb), C.double(xp), C.double(yp), &cRc2t[0])
+	return v3tC2Go(cRc2t)
+}
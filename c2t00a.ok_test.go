@@ -30,7 +30,7 @@ func TestC2t00a(t *testing.T) {
 		fn  func(a1, a2, a3, a4, a5, a6 float64) [3][3]float64
 	}{
 		{"cgo", CgoC2t00a},
-		{"go", GoC2t00a},
+		{"go", C2t00a},
 	}
 
 	for _, test := range tests {
@@ -76,7 +76,7 @@ func BenchmarkC2t00a(b *testing.B) {
 		fn  func(a1, a2, a3, a4, a5, a6 float64) [3][3]float64
 	}{
 		{"cgo", CgoC2t00a},
-		{"go", GoC2t00a},
+		{"go", C2t00a},
 	}
 
 	for _, test := range tests {
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoC2t00b Form the celestial to terrestrial matrix given the date,
+//  C2t00b Form the celestial to terrestrial matrix given the date,
 //  the UT1 and the polar motion, using the IAU 2000B nutation model.
 //
 //  - - - - - - -
@@ -84,19 +81,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoC2t00b Form the celestial to terrestrial matrix given the date,
-//  the UT1 and the polar motion, using the IAU 2000B nutation model.
-func CgoC2t00b(tta, ttb, uta, utb, xp, yp float64) (
-	rc2t [3][3]float64) {
-	var cRc2t [3][3]C.double
-	C.iauC2t00b(C.double(tta), C.double(ttb), C.double(uta),
-		C.double(utb), C.double(xp), C.double(yp), &cRc2t[0])
-	return v3tC2Go(cRc2t)
-}
-
-//  GoC2t00b Form the celestial to terrestrial matrix given the date,
+//  C2t00b Form the celestial to terrestrial matrix given the date,
 //  the UT1 and the polar motion, using the IAU 2000B nutation model.
-func GoC2t00b(tta, ttb, uta, utb, xp, yp float64) (
+func C2t00b(tta, ttb, uta, utb, xp, yp float64) (
 	rc2t [3][3]float64) {
 
 	var rc2i, rpom [3][3]float64
@@ -104,15 +91,15 @@ func GoC2t00b(tta, ttb, uta, utb, xp, yp float64) (
 
 	// Form the celestial-to-intermediate matrix for this TT (IAU
 	// 2000B).
-	rc2i = GoC2i00b(tta, ttb)
+	rc2i = C2i00b(tta, ttb)
 
 	// Predict the Earth rotation angle for this UT1.
-	era = GoEra00(uta, utb)
+	era = Era00(uta, utb)
 
 	// Form the polar motion matrix (neglecting s').
-	rpom = GoPom00(xp, yp, 0.0)
+	rpom = Pom00(xp, yp, 0.0)
 
 	// Combine to form the celestial-to-terrestrial matrix.
-	rc2t = GoC2tcio(rc2i, era, rpom)
+	rc2t = C2tcio(rc2i, era, rpom)
 	return
 }
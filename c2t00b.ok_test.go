@@ -30,7 +30,7 @@ func TestC2t00b(t *testing.T) {
 		fn  func(a1, a2, a3, a4, a5, a6 float64) [3][3]float64
 	}{
 		{"cgo", CgoC2t00b},
-		{"go", GoC2t00b},
+		{"go", C2t00b},
 	}
 
 	for _, test := range tests {
@@ -76,7 +76,7 @@ func BenchmarkC2t00b(b *testing.B) {
 		fn  func(a1, a2, a3, a4, a5, a6 float64) [3][3]float64
 	}{
 		{"cgo", CgoC2t00b},
-		{"go", GoC2t00b},
+		{"go", C2t00b},
 	}
 
 	for _, test := range tests {
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoC2t06a Form the celestial to terrestrial matrix given the date,
+//  C2t06a Form the celestial to terrestrial matrix given the date,
 //  the UT1 and the polar motion, using the IAU 2006 precession and IAU
 //  2000A nutation models.
 //
@@ -83,36 +80,26 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoC2t06a Form the celestial to terrestrial matrix given the date,
-//  the UT1 and the polar motion, using the IAU 2006 precession and IAU
-//  2000A nutation models.
-func CgoC2t06a(tta, ttb, uta, utb, xp, yp float64) (rc2t [3][3]float64) {
-	var cRc2t [3][3]C.double
-	C.iauC2t06a(C.double(tta), C.double(ttb), C.double(uta),
-		C.double(utb), C.double(xp), C.double(yp), &cRc2t[0])
-	return v3tC2Go(cRc2t)
-}
-
-//  GoC2t06a Form the celestial to terrestrial matrix given the date,
+//  C2t06a Form the celestial to terrestrial matrix given the date,
 //  the UT1 and the polar motion, using the IAU 2006 precession and IAU
 //  2000A nutation models.
-func GoC2t06a(tta, ttb, uta, utb, xp, yp float64) (rc2t [3][3]float64) {
+func C2t06a(tta, ttb, uta, utb, xp, yp float64) (rc2t [3][3]float64) {
 	var rc2i, rpom [3][3]float64
 	var era, sp float64
 
 	// Form the celestial-to-intermediate matrix for this TT. 
-	rc2i = GoC2i06a(tta, ttb)
+	rc2i = C2i06a(tta, ttb)
 
 	// Predict the Earth rotation angle for this UT1. 
-	era = GoEra00(uta, utb)
+	era = Era00(uta, utb)
 
 	// Estimate s'. 
-	sp = GoSp00(tta, ttb)
+	sp = Sp00(tta, ttb)
 
 	// Form the polar motion matrix. 
-	rpom = GoPom00(xp, yp, sp)
+	rpom = Pom00(xp, yp, sp)
 
 	// Combine to form the celestial-to-terrestrial matrix. 
-	rc2t = GoC2tcio(rc2i, era, rpom)
+	rc2t = C2tcio(rc2i, era, rpom)
 	return
 }
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauC2tcio(double rc2i[3][3], double era, double rpom[3][3],
@@ -0,0 +1,18 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoC2tcio Assemble the celestial to terrestrial matrix from
+//  CIO-based components (the celestial-to-intermediate matrix, the
+//  Earth Rotation Angle and the polar motion matrix).
+func CgoC2tcio(rc2i [3][3]float64, era float64, rpom [3][3]float64) (
+	rc2t [3][3]float64) {
+	var cRc2t [3][3]C.double
+	cRc2i, cRpom := v3tGo2C(rc2i), v3tGo2C(rpom)
+	C.iauC2tcio(&cRc2i[0], C.double(era), &cRpom[0], &cRc2t[0])
+	return v3tC2Go(cRc2t)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoC2tcio Assemble the celestial to terrestrial matrix from
+//  C2tcio Assemble the celestial to terrestrial matrix from
 //  CIO-based components (the celestial-to-intermediate matrix, the
 //  Earth Rotation Angle and the polar motion matrix).
 //
@@ -63,24 +60,13 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoC2tcio Assemble the celestial to terrestrial matrix from
-//  CIO-based components (the celestial-to-intermediate matrix, the
-//  Earth Rotation Angle and the polar motion matrix).
-func CgoC2tcio(rc2i [3][3]float64, era float64, rpom [3][3]float64) (
-	rc2t [3][3]float64) {
-	var cRc2t [3][3]C.double
-	cRc2i, cRpom := v3tGo2C(rc2i), v3tGo2C(rpom)
-	C.iauC2tcio(&cRc2i[0], C.double(era), &cRpom[0], &cRc2t[0])
-	return v3tC2Go(cRc2t)
-}
-
-//  GoC2tcio Assemble the celestial to terrestrial matrix from
+//  C2tcio Assemble the celestial to terrestrial matrix from
 //  CIO-based components (the celestial-to-intermediate matrix, the
 //  Earth Rotation Angle and the polar motion matrix).
-func GoC2tcio(rc2i [3][3]float64, era float64, rpom [3][3]float64) (
+func C2tcio(rc2i [3][3]float64, era float64, rpom [3][3]float64) (
 	rc2t [3][3]float64) {
 
 	// Construct the matrix.
-	rc2t = GoRxr(rpom, GoRz(era, rc2i))
+	rc2t = Rxr(rpom, Rz(era, rc2i))
 	return
 }
@@ -49,7 +49,7 @@ func TestC2tcio(t *testing.T) {
 		fn  func([3][3]float64, float64, [3][3]float64) [3][3]float64
 	}{
 		{"cgo", CgoC2tcio},
-		{"go", GoC2tcio},
+		{"go", C2tcio},
 	}
 
 	for _, test := range tests {
@@ -115,7 +115,7 @@ func BenchmarkC2tcio(b *testing.B) {
 		fn  func([3][3]float64, float64, [3][3]float64) [3][3]float64
 	}{
 		{"cgo", CgoC2tcio},
-		{"go", GoC2tcio},
+		{"go", C2tcio},
 	}
 
 	for _, test := range tests {
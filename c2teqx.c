@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauC2teqx(double rbpn[3][3], double gst, double rpom[3][3],
@@ -0,0 +1,18 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoC2teqx Assemble the celestial to terrestrial matrix from
+//  equinox-based components (the celestial-to-true matrix, the
+//  Greenwich Apparent Sidereal Time and the polar motion matrix).
+func CgoC2teqx(rbpn [3][3]float64, gst float64, rpom [3][3]float64) (
+	rc2t [3][3]float64) {
+	var cRc2t [3][3]C.double
+	cRbpn, cRpom := v3tGo2C(rbpn), v3tGo2C(rpom)
+	C.iauC2teqx(&cRbpn[0], C.double(gst), &cRpom[0], &cRc2t[0])
+	return v3tC2Go(cRc2t)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoC2teqx Assemble the celestial to terrestrial matrix from
+//  C2teqx Assemble the celestial to terrestrial matrix from
 //  equinox-based components (the celestial-to-true matrix, the
 //  Greenwich Apparent Sidereal Time and the polar motion matrix).
 //
@@ -63,24 +60,13 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoC2teqx Assemble the celestial to terrestrial matrix from
-//  equinox-based components (the celestial-to-true matrix, the
-//  Greenwich Apparent Sidereal Time and the polar motion matrix).
-func CgoC2teqx(rbpn [3][3]float64, gst float64, rpom [3][3]float64) (
-	rc2t [3][3]float64) {
-	var cRc2t [3][3]C.double
-	cRbpn, cRpom := v3tGo2C(rbpn), v3tGo2C(rpom)
-	C.iauC2teqx(&cRbpn[0], C.double(gst), &cRpom[0], &cRc2t[0])
-	return v3tC2Go(cRc2t)
-}
-
-//  GoC2teqx Assemble the celestial to terrestrial matrix from
+//  C2teqx Assemble the celestial to terrestrial matrix from
 //  equinox-based components (the celestial-to-true matrix, the
 //  Greenwich Apparent Sidereal Time and the polar motion matrix).
-func GoC2teqx(rbpn [3][3]float64, gst float64, rpom [3][3]float64) (
+func C2teqx(rbpn [3][3]float64, gst float64, rpom [3][3]float64) (
 	rc2t [3][3]float64) {
 
 	// Construct the matrix.
-	rc2t = GoRxr(rpom, GoRz(gst, rbpn))
+	rc2t = Rxr(rpom, Rz(gst, rbpn))
 	return
 }
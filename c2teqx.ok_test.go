@@ -49,7 +49,7 @@ func TestC2teqx(t *testing.T) {
 		fn  func([3][3]float64, float64, [3][3]float64) [3][3]float64
 	}{
 		{"cgo", CgoC2teqx},
-		{"go", GoC2teqx},
+		{"go", C2teqx},
 	}
 
 	for _, test := range tests {
@@ -115,7 +115,7 @@ func BenchmarkC2teqx(b *testing.B) {
 		fn  func([3][3]float64, float64, [3][3]float64) [3][3]float64
 	}{
 		{"cgo", CgoC2teqx},
-		{"go", GoC2teqx},
+		{"go", C2teqx},
 	}
 
 	for _, test := range tests {
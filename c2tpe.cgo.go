@@ -0,0 +1,18 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoC2tpe Form the celestial to terrestrial matrix given the date,
+//  the UT1, the nutation and the polar motion.  IAU 2000.
+func CgoC2tpe(tta, ttb, uta, utb, dpsi, deps, xp, yp float64) (
+	rc2t [3][3]float64) {
+	var cRc2t [3][3]C.double
+	C.iauC2tpe(C.double(tta), C.double(ttb), C.double(uta),
+		C.double(utb), C.double(dpsi), C.double(deps),
+		C.double(xp), C.double(yp), &cRc2t[0])
+	return v3tC2Go(cRc2t)
+}
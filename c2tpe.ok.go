@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoC2tpe Form the celestial to terrestrial matrix given the date,
+//  C2tpe Form the celestial to terrestrial matrix given the date,
 //  the UT1, the nutation and the polar motion.  IAU 2000.
 //
 //  - - - - - -
@@ -93,41 +90,30 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoC2tpe Form the celestial to terrestrial matrix given the date,
-//  the UT1, the nutation and the polar motion.  IAU 2000.
-func CgoC2tpe(tta, ttb, uta, utb, dpsi, deps, xp, yp float64) (
-	rc2t [3][3]float64) {
-	var cRc2t [3][3]C.double
-	C.iauC2tpe(C.double(tta), C.double(ttb), C.double(uta),
-		C.double(utb), C.double(dpsi), C.double(deps),
-		C.double(xp), C.double(yp), &cRc2t[0])
-	return v3tC2Go(cRc2t)
-}
-
-//  GoC2tpe Form the celestial to terrestrial matrix given the date,
+//  C2tpe Form the celestial to terrestrial matrix given the date,
 //  the UT1, the nutation and the polar motion.  IAU 2000.
-func GoC2tpe(tta, ttb, uta, utb, dpsi, deps, xp, yp float64) (
+func C2tpe(tta, ttb, uta, utb, dpsi, deps, xp, yp float64) (
 	rc2t [3][3]float64) {
 
 	var epsa, gmst, ee, sp float64
 	var rbpn, rpom [3][3]float64
 
 	// Form the celestial-to-true matrix for this TT. 
-	epsa, _, _, _, _, rbpn = GoPn00(tta, ttb, dpsi, deps)
+	epsa, _, _, _, _, rbpn = Pn00(tta, ttb, dpsi, deps)
 
 	// Predict the Greenwich Mean Sidereal Time for this UT1 and TT. 
-	gmst = GoGmst00(uta, utb, tta, ttb)
+	gmst = Gmst00(uta, utb, tta, ttb)
 
 	// Predict the equation of the equinoxes given TT and nutation. 
-	ee = GoEe00(tta, ttb, epsa, dpsi)
+	ee = Ee00(tta, ttb, epsa, dpsi)
 
 	// Estimate s'. 
-	sp = GoSp00(tta, ttb)
+	sp = Sp00(tta, ttb)
 
 	// Form the polar motion matrix. 
-	rpom = GoPom00(xp, yp, sp)
+	rpom = Pom00(xp, yp, sp)
 
 	// Combine to form the celestial-to-terrestrial matrix. 
-	rc2t = GoC2teqx(rbpn, gmst+ee, rpom)
+	rc2t = C2teqx(rbpn, gmst+ee, rpom)
 	return
 }
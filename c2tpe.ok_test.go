@@ -33,7 +33,7 @@ func TestC2tpe(t *testing.T) {
 			a8 float64) [3][3]float64
 	}{
 		{"cgo", CgoC2tpe},
-		{"go", GoC2tpe},
+		{"go", C2tpe},
 	}
 
 	for _, test := range tests {
@@ -82,7 +82,7 @@ func BenchmarkC2tpe(b *testing.B) {
 			a8 float64) [3][3]float64
 	}{
 		{"cgo", CgoC2tpe},
-		{"go", GoC2tpe},
+		{"go", C2tpe},
 	}
 
 	for _, test := range tests {
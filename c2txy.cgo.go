@@ -0,0 +1,18 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoC2txy Form the celestial to terrestrial matrix given the date,
+//  the UT1, the CIP coordinates and the polar motion.  IAU 2000.
+func CgoC2txy(tta, ttb, uta, utb, x, y, xp, yp float64) (
+	rc2t [3][3]float64) {
+	var cRc2t [3][3]C.double
+	C.iauC2txy(C.double(tta), C.double(ttb), C.double(uta),
+		C.double(utb), C.double(x), C.double(y), C.double(xp),
+		C.double(yp), &cRc2t[0])
+	return v3tC2Go(cRc2t)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoC2txy Form the celestial to terrestrial matrix given the date,
+//  C2txy Form the celestial to terrestrial matrix given the date,
 //  the UT1, the CIP coordinates and the polar motion.  IAU 2000.
 //
 //  - - - - - -
@@ -89,37 +86,26 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoC2txy Form the celestial to terrestrial matrix given the date,
-//  the UT1, the CIP coordinates and the polar motion.  IAU 2000.
-func CgoC2txy(tta, ttb, uta, utb, x, y, xp, yp float64) (
-	rc2t [3][3]float64) {
-	var cRc2t [3][3]C.double
-	C.iauC2txy(C.double(tta), C.double(ttb), C.double(uta),
-		C.double(utb), C.double(x), C.double(y), C.double(xp),
-		C.double(yp), &cRc2t[0])
-	return v3tC2Go(cRc2t)
-}
-
-//  GoC2txy Form the celestial to terrestrial matrix given the date,
+//  C2txy Form the celestial to terrestrial matrix given the date,
 //  the UT1, the CIP coordinates and the polar motion.  IAU 2000.
-func GoC2txy(tta, ttb, uta, utb, x, y, xp, yp float64) (
+func C2txy(tta, ttb, uta, utb, x, y, xp, yp float64) (
 	rc2t [3][3]float64) {
 	var rc2i, rpom [3][3]float64
 	var era, sp float64
 
 	// Form the celestial-to-intermediate matrix for this TT. 
-	rc2i = GoC2ixy(tta, ttb, x, y)
+	rc2i = C2ixy(tta, ttb, x, y)
 
 	// Predict the Earth rotation angle for this UT1. 
-	era = GoEra00(uta, utb)
+	era = Era00(uta, utb)
 
 	// Estimate s'. 
-	sp = GoSp00(tta, ttb)
+	sp = Sp00(tta, ttb)
 
 	// Form the polar motion matrix. 
-	rpom = GoPom00(xp, yp, sp)
+	rpom = Pom00(xp, yp, sp)
 
 	// Combine to form the celestial-to-terrestrial matrix. 
-	rc2t = GoC2tcio(rc2i, era, rpom)
+	rc2t = C2tcio(rc2i, era, rpom)
 	return
 }
@@ -33,7 +33,7 @@ func TestC2txy(t *testing.T) {
 			a8 float64) [3][3]float64
 	}{
 		{"cgo", CgoC2txy},
-		{"go", GoC2txy},
+		{"go", C2txy},
 	}
 
 	for _, test := range tests {
@@ -81,7 +81,7 @@ func BenchmarkC2txy(b *testing.B) {
 			a8 float64) [3][3]float64
 	}{
 		{"cgo", CgoC2txy},
-		{"go", GoC2txy},
+		{"go", C2txy},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauCal2jd(int iy, int im, int id, double *djm0, double *djm)
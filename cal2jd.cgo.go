@@ -0,0 +1,18 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include <sofa.h>
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoCal2jd returns the MJD, Modified Julian Date of the given date.
+func CgoCal2jd(iy, im, id int) (djm0, djm float64, err en.ErrNum) {
+	var cDjm0, cDjm C.double
+	cI := C.iauCal2jd(C.int(iy), C.int(im), C.int(id), &cDjm0, &cDjm)
+	if n := int(cI); n != 0 {
+		err = errCal2jd.Set(n)
+	}
+	return float64(cDjm0), float64(cDjm), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include <sofa.h>
-import "C"
 import (
 	"errors"
 
@@ -13,12 +11,12 @@ var errCal2jdE2 = errors.New("bad month (JD not computed)")
 var errCal2jdE3 = errors.New("bad day (JD computed)")
 
 var errCal2jd = en.New(3, "cal2jd", []string{
-	"bad year (cal2jd documentation note 3: JD not computed)",
-	"bad month (JD not computed)",
 	"bad day (JD computed)",
+	"bad month (JD not computed)",
+	"bad year (cal2jd documentation note 3: JD not computed)",
 })
 
-//  CgoCal2jd returns the MJD, Modified Julian Date of the given date.
+//  Cal2jd returns the MJD, Modified Julian Date of the given date.
 //
 //  - - - - - - -
 //   C a l 2 j d
@@ -71,18 +69,8 @@ var errCal2jd = en.New(3, "cal2jd", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoCal2jd returns the MJD, Modified Julian Date of the given date.
-func CgoCal2jd(iy, im, id int) (djm0, djm float64, err en.ErrNum) {
-	var cDjm0, cDjm C.double
-	cI := C.iauCal2jd(C.int(iy), C.int(im), C.int(id), &cDjm0, &cDjm)
-	if n := int(cI); n != 0 {
-		err = errCal2jd.Set(n)
-	}
-	return float64(cDjm0), float64(cDjm), err
-}
-
-// GoCal2jd returns the MJD, Modified Julian Date of the given date.
-func GoCal2jd(iy, im, id int) (djm0, djm float64, err en.ErrNum) {
+//  Cal2jd returns the MJD, Modified Julian Date of the given date.
+func Cal2jd(iy, im, id int) (djm0, djm float64, err en.ErrNum) {
 	var ly, my int
 	var iypmy int // was a long in c code
 
@@ -103,14 +91,13 @@ func GoCal2jd(iy, im, id int) (djm0, djm float64, err en.ErrNum) {
 	}
 
 	// If February in a leap year, 1, otherwise 0.
-	if (im == 2) && ((iy % 4) == 0) && ((iy%100 != 0) || ((iy % 400) != 0)) {
+	if (im == 2) && ((iy % 4) == 0) && ((iy%100 != 0) || ((iy % 400) == 0)) {
 		ly = 1
 	}
 
 	// Validate day, taking into account leap years.
 	if (id < 1) || (id > (mtab[im-1] + ly)) {
 		err = errCal2jd.Set(-3)
-		return
 	}
 
 	// Return result.
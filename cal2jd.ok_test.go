@@ -24,7 +24,7 @@ func TestCal2jd(t *testing.T) {
 		fn  func(a, b, c int) (d, e float64, err en.ErrNum)
 	}{
 		{"cgo", CgoCal2jd},
-		{"go", GoCal2jd},
+		{"go", Cal2jd},
 	}
 
 	for _, test := range tests {
@@ -41,6 +41,16 @@ func TestCal2jd(t *testing.T) {
 		vvd(t, djm0, 2400000.5, 0.0, tname, "djm0")
 		// expect 52791.0, 0.0
 		vvd(t, djm, 52791.0, 0.0, tname, "djm")
+
+		// 2000 is a leap year, 1900 is not.
+		_, djm, err = test.fn(2000, 2, 29)
+		vvd(t, djm, 51603.0, 0.0, tname, "2000 djm")
+		errT(t, nil, err, tname, "2000 err")
+		_, djm, err = test.fn(1900, 2, 29)
+		vvd(t, djm, 15079.0, 0.0, tname, "1900 djm")
+		errEN(t, -3, err, tname, "1900 err")
+		_, _, err = test.fn(2003, 13, 1)
+		errEN(t, -2, err, tname, "month err")
 	}
 }
 
@@ -50,7 +60,7 @@ func BenchmarkCal2jd(b *testing.B) {
 		fn  func(a, b, c int) (d, e float64, err en.ErrNum)
 	}{
 		{"cgo", CgoCal2jd},
-		{"go", GoCal2jd},
+		{"go", Cal2jd},
 	}
 
 	for _, test := range tests {
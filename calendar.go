@@ -0,0 +1,316 @@
+package sofa
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	errCalYear  = errors.New("year outside range -1000000 to +1000000")
+	errCalMonth = errors.New("bad month")
+	errCalDay   = errors.New("bad day")
+	errCalGap   = errors.New("date omitted at the calendar reform")
+	errCalJD    = errors.New("Julian Date outside the calendar range")
+	errCalBC    = errors.New("BC/AD year must be 1 or more")
+	errCalWeek  = errors.New("bad ISO week or weekday")
+)
+
+// Years handled by Calendar, which keeps the day numbers well within
+// the range of an int.
+const calMaxYear = 1000000
+
+// Calendar is a civil calendar that is Julian before a reform and
+// Gregorian from it on.  Reform is the Julian Day Number of the first
+// Gregorian day.
+type Calendar struct {
+	Reform int
+}
+
+var (
+	// Gregorian is the proleptic Gregorian calendar, as used by
+	// Cal2jd and Jd2cal.
+	Gregorian = Calendar{Reform: math.MinInt32}
+
+	// Julian is the proleptic Julian calendar.
+	Julian = Calendar{Reform: math.MaxInt32}
+
+	// Reform1582 changes to the Gregorian calendar after 1582
+	// October 4, the next day being October 15.
+	Reform1582 = Calendar{Reform: 2299161}
+
+	// Reform1752 changes to the Gregorian calendar after 1752
+	// September 2, as in Great Britain and its colonies.
+	Reform1752 = Calendar{Reform: 2361222}
+)
+
+// NewCalendar returns the calendar that changes from Julian to
+// Gregorian with the Gregorian date iy, im, id as its first day.
+func NewCalendar(iy, im, id int) (Calendar, error) {
+	if err := checkDate(iy, im, id, false); err != nil {
+		return Calendar{}, err
+	}
+	return Calendar{Reform: gregorianJDN(iy, im, id)}, nil
+}
+
+// fdiv is integer division rounding towards minus infinity.
+func fdiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// fmod is the remainder of fdiv, which has the sign of b.
+func fmod(a, b int) int {
+	return a - b*fdiv(a, b)
+}
+
+func gregorianLeap(iy int) bool {
+	return fmod(iy, 4) == 0 && (fmod(iy, 100) != 0 || fmod(iy, 400) == 0)
+}
+
+func julianLeap(iy int) bool {
+	return fmod(iy, 4) == 0
+}
+
+var monthDays = [12]int{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+// checkDate validates a date in the Julian or Gregorian calendar.
+func checkDate(iy, im, id int, julian bool) error {
+	if iy < -calMaxYear || iy > calMaxYear {
+		return errCalYear
+	}
+	if im < 1 || im > 12 {
+		return errCalMonth
+	}
+	n := monthDays[im-1]
+	if im == 2 && ((julian && julianLeap(iy)) ||
+		(!julian && gregorianLeap(iy))) {
+		n++
+	}
+	if id < 1 || id > n {
+		return errCalDay
+	}
+	return nil
+}
+
+// gregorianJDN and julianJDN return the Julian Day Number of a date,
+// for any year.
+func gregorianJDN(iy, im, id int) int {
+	a := fdiv(14-im, 12)
+	y := iy + 4800 - a
+	m := im + 12*a - 3
+	return id + fdiv(153*m+2, 5) + 365*y + fdiv(y, 4) - fdiv(y, 100) +
+		fdiv(y, 400) - 32045
+}
+
+func julianJDN(iy, im, id int) int {
+	a := fdiv(14-im, 12)
+	y := iy + 4800 - a
+	m := im + 12*a - 3
+	return id + fdiv(153*m+2, 5) + 365*y + fdiv(y, 4) - 32083
+}
+
+// jdnDate returns the date of a Julian Day Number in the Julian or
+// Gregorian calendar.
+func jdnDate(j int, julian bool) (iy, im, id int) {
+	var b, c int
+	if julian {
+		c = j + 32082
+	} else {
+		a := j + 32044
+		b = fdiv(4*a+3, 146097)
+		c = a - fdiv(146097*b, 4)
+	}
+	d := fdiv(4*c+3, 1461)
+	e := c - fdiv(1461*d, 4)
+	m := fdiv(5*e+2, 153)
+	id = e - fdiv(153*m+2, 5) + 1
+	im = m + 3 - 12*fdiv(m, 10)
+	iy = 100*b + d - 4800 + fdiv(m, 10)
+	return
+}
+
+// JDN returns the Julian Day Number of a date in the calendar.  A date
+// that the reform omitted is an error.
+func (c Calendar) JDN(iy, im, id int) (int, error) {
+	gerr := checkDate(iy, im, id, false)
+	if gerr == nil {
+		if j := gregorianJDN(iy, im, id); j >= c.Reform {
+			return j, nil
+		}
+	}
+	jerr := checkDate(iy, im, id, true)
+	if jerr == nil {
+		if j := julianJDN(iy, im, id); j < c.Reform {
+			return j, nil
+		}
+	}
+
+	// A Julian leap day after the reform, or no such date.
+	switch {
+	case gerr != nil:
+		return 0, gerr
+	case jerr != nil:
+		return 0, jerr
+	}
+	return 0, errCalGap
+}
+
+// Date returns the date of a Julian Day Number in the calendar.
+func (c Calendar) Date(j int) (iy, im, id int) {
+	return jdnDate(j, j < c.Reform)
+}
+
+// Cal2jd returns the Julian Date of 0h on a date in the calendar, as
+// Cal2jd does for the Gregorian calendar:  djm0 is the MJD zero
+// point and djm the Modified Julian Date.
+func (c Calendar) Cal2jd(iy, im, id int) (djm0, djm float64, err error) {
+	j, err := c.JDN(iy, im, id)
+	if err != nil {
+		return 0, 0, err
+	}
+	return DJM0, float64(j) - 2400001.0, nil
+}
+
+// jdn returns the Julian Day Number and fraction of a day from 0h of
+// the 2-part Julian Date dj1+dj2.
+func jdn(dj1, dj2 float64) (int, float64, error) {
+	dj := dj1 + dj2
+	if math.IsNaN(dj) || math.Abs(dj) > 365.25*calMaxYear {
+		return 0, 0, errCalJD
+	}
+	f1 := math.Floor(dj1)
+	f2 := math.Floor(dj2)
+	f := (dj1 - f1) + (dj2 - f2) + 0.5
+	ff := math.Floor(f)
+	return int(f1) + int(f2) + int(ff), f - ff, nil
+}
+
+// Jd2cal returns the date in the calendar and the fraction of the day
+// of the 2-part Julian Date dj1+dj2, as Jd2cal does for the
+// Gregorian calendar.
+func (c Calendar) Jd2cal(dj1, dj2 float64) (iy, im, id int, fd float64,
+	err error) {
+	j, fd, err := jdn(dj1, dj2)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	iy, im, id = c.Date(j)
+	return iy, im, id, fd, nil
+}
+
+// DayOfYear returns the day of the year, from 1, of a date in the
+// calendar.  In the year of a reform the days omitted are not counted.
+func (c Calendar) DayOfYear(iy, im, id int) (int, error) {
+	j, err := c.JDN(iy, im, id)
+	if err != nil {
+		return 0, err
+	}
+	j0, err := c.JDN(iy, 1, 1)
+	if err != nil {
+		return 0, err
+	}
+	return j - j0 + 1, nil
+}
+
+// YearDay returns the date of day doy, from 1, of the year iy in the
+// calendar.
+func (c Calendar) YearDay(iy, doy int) (im, id int, err error) {
+	j0, err := c.JDN(iy, 1, 1)
+	if err != nil {
+		return 0, 0, err
+	}
+	y, im, id := c.Date(j0 + doy - 1)
+	if doy < 1 || y != iy {
+		return 0, 0, errCalDay
+	}
+	return im, id, nil
+}
+
+// DaysInYear returns the number of days in the year iy of the calendar.
+func (c Calendar) DaysInYear(iy int) (int, error) {
+	j0, err := c.JDN(iy, 1, 1)
+	if err != nil {
+		return 0, err
+	}
+	j1, err := c.JDN(iy+1, 1, 1)
+	if err != nil {
+		return 0, err
+	}
+	return j1 - j0, nil
+}
+
+// Weekday returns the ISO 8601 day of the week, 1 for Monday to 7 for
+// Sunday, of a Julian Day Number.
+func Weekday(j int) int {
+	return fmod(j, 7) + 1
+}
+
+// isoWeeks returns the number of ISO weeks in the Gregorian year iy.
+func isoWeeks(iy int) int {
+	wd := Weekday(gregorianJDN(iy, 1, 1))
+	if wd == 4 || (wd == 3 && gregorianLeap(iy)) {
+		return 53
+	}
+	return 52
+}
+
+// IsoWeek returns the ISO 8601 week date, the week-numbering year, the
+// week and the day of the week, of a Gregorian date.
+func IsoWeek(iy, im, id int) (wy, wn, wd int, err error) {
+	if err = checkDate(iy, im, id, false); err != nil {
+		return
+	}
+	j := gregorianJDN(iy, im, id)
+	wd = Weekday(j)
+	doy := j - gregorianJDN(iy, 1, 1) + 1
+	wy = iy
+	wn = fdiv(doy-wd+10, 7)
+	switch {
+	case wn < 1:
+		wy--
+		wn = isoWeeks(wy)
+	case wn > isoWeeks(iy):
+		wy++
+		wn = 1
+	}
+	return wy, wn, wd, nil
+}
+
+// IsoWeekDate returns the Gregorian date of an ISO 8601 week date.
+func IsoWeekDate(wy, wn, wd int) (iy, im, id int, err error) {
+	if wy < -calMaxYear || wy > calMaxYear {
+		return 0, 0, 0, errCalYear
+	}
+	if wn < 1 || wn > isoWeeks(wy) || wd < 1 || wd > 7 {
+		return 0, 0, 0, errCalWeek
+	}
+
+	// Week 1 is the week with January 4th in it.
+	j4 := gregorianJDN(wy, 1, 4)
+	j := j4 - Weekday(j4) + 1 + 7*(wn-1) + wd - 1
+	iy, im, id = jdnDate(j, false)
+	return iy, im, id, nil
+}
+
+// YearBCAD returns the astronomical year iy, in which 1 BC is year 0,
+// as a year of the BC/AD convention.
+func YearBCAD(iy int) (year int, bc bool) {
+	if iy < 1 {
+		return 1 - iy, true
+	}
+	return iy, false
+}
+
+// YearAstronomical returns the astronomical year of a BC or AD year.
+func YearAstronomical(year int, bc bool) (int, error) {
+	if year < 1 {
+		return 0, errCalBC
+	}
+	if bc {
+		return 1 - year, nil
+	}
+	return year, nil
+}
@@ -0,0 +1,159 @@
+package sofa
+
+import "testing"
+
+func TestCalendarGregorian(t *testing.T) {
+	const fname = "Calendar Gregorian"
+
+	// Agrees with Cal2jd and Jd2cal where they apply.
+	for _, iy := range []int{-4799, -1000, 0, 1582, 2003, 2100} {
+		for _, md := range [][2]int{{1, 1}, {2, 28}, {6, 30}, {12, 31}} {
+			want1, want2, _ := Cal2jd(iy, md[0], md[1])
+			d1, d2, err := Gregorian.Cal2jd(iy, md[0], md[1])
+			errT(t, nil, err, fname, "cal2jd")
+			vvd(t, d1, want1, 0.0, fname, "djm0")
+			vvd(t, d2, want2, 0.0, fname, "djm")
+
+			wy, wm, wd, wf, _ := Jd2cal(d1, d2+0.25)
+			y, m, d, f, err := Gregorian.Jd2cal(d1, d2+0.25)
+			errT(t, nil, err, fname, "jd2cal")
+			viv(t, y, wy, fname, "y")
+			viv(t, m, wm, fname, "m")
+			viv(t, d, wd, fname, "d")
+			vvd(t, f, wf, 1e-9, fname, "fd")
+		}
+	}
+
+	// And goes further back.
+	d1, d2, err := Gregorian.Cal2jd(-10000, 3, 1)
+	errT(t, nil, err, fname, "early")
+	y, m, d, f, err := Gregorian.Jd2cal(d1, d2)
+	errT(t, nil, err, fname, "early")
+	viv(t, y, -10000, fname, "early y")
+	viv(t, m, 3, fname, "early m")
+	viv(t, d, 1, fname, "early d")
+	vvd(t, f, 0.0, 0.0, fname, "early fd")
+
+	_, _, err = Gregorian.Cal2jd(2003, 2, 29)
+	errT(t, errCalDay, err, fname, "feb 29")
+	_, _, err = Gregorian.Cal2jd(2003, 13, 1)
+	errT(t, errCalMonth, err, fname, "month")
+}
+
+func TestCalendarReform(t *testing.T) {
+	const fname = "Calendar reform"
+	tests := []struct {
+		ref        string
+		cal        Calendar
+		iy, im, id int
+		jdn        int
+		err        error
+	}{
+		{"julian epoch", Julian, -4712, 1, 1, 0, nil},
+		{"1582 last julian", Reform1582, 1582, 10, 4, 2299160, nil},
+		{"1582 first gregorian", Reform1582, 1582, 10, 15, 2299161, nil},
+		{"1582 gap", Reform1582, 1582, 10, 10, 0, errCalGap},
+		{"1500 feb 29", Reform1582, 1500, 2, 29, 2268992, nil},
+		{"1700 feb 29", Reform1582, 1700, 2, 29, 0, errCalDay},
+		{"1700 feb 29 britain", Reform1752, 1700, 2, 29, 2342042, nil},
+		{"1752 last julian", Reform1752, 1752, 9, 2, 2361221, nil},
+		{"1752 first gregorian", Reform1752, 1752, 9, 14, 2361222, nil},
+	}
+
+	for _, test := range tests {
+		tname := fname + " " + test.ref
+		j, err := test.cal.JDN(test.iy, test.im, test.id)
+		errT(t, test.err, err, tname, "err")
+		if test.err != nil {
+			continue
+		}
+		viv(t, j, test.jdn, tname, "jdn")
+
+		y, m, d := test.cal.Date(j)
+		viv(t, y, test.iy, tname, "y")
+		viv(t, m, test.im, tname, "m")
+		viv(t, d, test.id, tname, "d")
+	}
+
+	c, err := NewCalendar(1582, 10, 15)
+	errT(t, nil, err, fname, "new")
+	viv(t, c.Reform, Reform1582.Reform, fname, "new")
+}
+
+func TestDayOfYear(t *testing.T) {
+	const fname = "DayOfYear"
+
+	n, _ := Reform1582.DaysInYear(1582)
+	viv(t, n, 355, fname, "1582 days")
+	n, _ = Reform1752.DaysInYear(1752)
+	viv(t, n, 355, fname, "1752 days")
+	n, _ = Julian.DaysInYear(1900)
+	viv(t, n, 366, fname, "julian 1900")
+	n, _ = Gregorian.DaysInYear(1900)
+	viv(t, n, 365, fname, "gregorian 1900")
+
+	doy, err := Reform1582.DayOfYear(1582, 10, 15)
+	errT(t, nil, err, fname, "err")
+	viv(t, doy, 278, fname, "1582")
+
+	im, id, err := Reform1582.YearDay(1582, 278)
+	errT(t, nil, err, fname, "err")
+	viv(t, im, 10, fname, "month")
+	viv(t, id, 15, fname, "day")
+
+	_, _, err = Gregorian.YearDay(2003, 366)
+	errT(t, errCalDay, err, fname, "366")
+}
+
+func TestIsoWeek(t *testing.T) {
+	const fname = "IsoWeek"
+	tests := []struct {
+		iy, im, id int
+		wy, wn, wd int
+	}{
+		{2008, 12, 29, 2009, 1, 1},
+		{2010, 1, 3, 2009, 53, 7},
+		{2005, 1, 1, 2004, 53, 6},
+		{2000, 1, 1, 1999, 52, 6},
+		{2020, 12, 31, 2020, 53, 4},
+		{2021, 1, 4, 2021, 1, 1},
+	}
+
+	for _, test := range tests {
+		wy, wn, wd, err := IsoWeek(test.iy, test.im, test.id)
+		errT(t, nil, err, fname, "err")
+		viv(t, wy, test.wy, fname, "wy")
+		viv(t, wn, test.wn, fname, "wn")
+		viv(t, wd, test.wd, fname, "wd")
+
+		iy, im, id, err := IsoWeekDate(test.wy, test.wn, test.wd)
+		errT(t, nil, err, fname, "err")
+		viv(t, iy, test.iy, fname, "iy")
+		viv(t, im, test.im, fname, "im")
+		viv(t, id, test.id, fname, "id")
+	}
+
+	_, _, _, err := IsoWeekDate(2021, 53, 1)
+	errT(t, errCalWeek, err, fname, "week 53")
+}
+
+func TestYearBCAD(t *testing.T) {
+	const fname = "YearBCAD"
+
+	y, bc := YearBCAD(0)
+	viv(t, y, 1, fname, "0")
+	if !bc {
+		t.Errorf("%s: year 0 is 1 BC", fname)
+	}
+	y, bc = YearBCAD(2000)
+	viv(t, y, 2000, fname, "2000")
+	if bc {
+		t.Errorf("%s: year 2000 is AD", fname)
+	}
+
+	iy, err := YearAstronomical(44, true)
+	errT(t, nil, err, fname, "44 BC")
+	viv(t, iy, -43, fname, "44 BC")
+	_, err = YearAstronomical(0, false)
+	errT(t, errCalBC, err, fname, "0 AD")
+}
@@ -0,0 +1,351 @@
+package sofa
+
+import (
+	"fmt"
+	"math"
+)
+
+// Unix and NTP epochs.
+const (
+	// DJ1970 is the Julian Date of the Unix and PTP epoch, 1970
+	// January 1.
+	DJ1970 = 2440587.5
+
+	// NTPUnix is the number of seconds from the NTP prime epoch, 1900
+	// January 1, to the Unix epoch.
+	NTPUnix = 2208988800
+)
+
+// LeapPolicy is the way a clock that counts 86400 seconds a day, as
+// Unix and NTP clocks do, passes a leap second.
+type LeapPolicy int
+
+const (
+	// LeapStep repeats (or skips) a second at the end of the day, so
+	// that the leap second itself has the Unix time of the following
+	// second.
+	LeapStep LeapPolicy = iota
+
+	// LeapSmear24h slews the clock linearly over the 24 hours from
+	// noon before to noon after the leap second, as the Google and
+	// Amazon time services do.
+	LeapSmear24h
+
+	// LeapUTCSLS slews the clock linearly over the last 1000 seconds
+	// of the day, as proposed for UTC-SLS.
+	LeapUTCSLS
+)
+
+// window returns the start and end of the slew of the policy, in
+// seconds from the end of the day with the leap second.
+func (p LeapPolicy) window() (ws, we float64) {
+	switch p {
+	case LeapSmear24h:
+		return -43200.0, 43200.0
+	case LeapUTCSLS:
+		return -1000.0, 0.0
+	}
+	return 0.0, 0.0
+}
+
+func (p LeapPolicy) String() string {
+	switch p {
+	case LeapStep:
+		return "step"
+	case LeapSmear24h:
+		return "24h smear"
+	case LeapUTCSLS:
+		return "UTC-SLS"
+	}
+	return fmt.Sprintf("LeapPolicy(%d)", int(p))
+}
+
+// AmbiguousTimeError is returned when a timestamp does not identify a
+// unique instant.  Under LeapStep the Unix time of a second after a
+// leap second is also that of the leap second, and the Unix time of a
+// second removed by a negative leap second belongs to no instant.
+type AmbiguousTimeError struct {
+	Stamp string // the timestamp
+	Times []Time // the instants that it can be, in order
+}
+
+func (e *AmbiguousTimeError) Error() string {
+	if len(e.Times) == 0 {
+		return fmt.Sprintf("%s: no such UTC time", e.Stamp)
+	}
+	return fmt.Sprintf("%s: ambiguous across a leap second, %d readings",
+		e.Stamp, len(e.Times))
+}
+
+// Clock converts the timestamps of a computer clock to and from Time,
+// using the leap second table Leap, or the installed table if nil.
+type Clock struct {
+	Policy LeapPolicy
+	Leap   LeapSeconds
+}
+
+func (c Clock) leap() LeapSeconds {
+	if c.Leap == nil {
+		return CurrentLeapSeconds()
+	}
+	return c.Leap
+}
+
+// dat returns TAI-UTC (s) at the fraction fd of Unix day n.
+func (c Clock) dat(n int64, fd float64) (float64, error) {
+	iy, im, id, _, err := Jd2cal(DJ1970+float64(n), 0.5)
+	if err != nil {
+		return 0, err
+	}
+	d, derr := c.leap().Dat(iy, im, id, fd)
+	if derr != nil && derr.Code() < 0 {
+		return 0, derr
+	}
+	return d, nil
+}
+
+// jump returns TAI-UTC just before the end of Unix day n and the step
+// in TAI-UTC there, as found by Dtf2d.
+func (c Clock) jump(n int64) (before, dleap float64, err error) {
+	dat0, err := c.dat(n, 0.0)
+	if err != nil {
+		return
+	}
+	dat12, err := c.dat(n, 0.5)
+	if err != nil {
+		return
+	}
+	dat24, err := c.dat(n+1, 0.0)
+	if err != nil {
+		return
+	}
+	before = 2.0*dat12 - dat0
+	return before, dat24 - before, nil
+}
+
+// offset returns TAI minus the clock reading (s) at second sod of Unix
+// day n.  For a second repeated by LeapStep, alt is the offset of the
+// leap second; for a second removed by a negative leap second, gap is
+// set.
+func (c Clock) offset(n int64, sod float64) (off, alt float64,
+	twice, gap bool, err error) {
+	ws, we := c.Policy.window()
+
+	// The end of yesterday and of today.
+	for _, b := range []int64{n, n + 1} {
+		before, dleap, err := c.jump(b - 1)
+		if err != nil {
+			return 0, 0, false, false, err
+		}
+		if dleap == 0.0 {
+			continue
+		}
+		x := float64(n-b)*DAYSEC + sod
+		if x >= ws && x < we {
+			return before + dleap*(x-ws)/(we-ws), 0, false, false,
+				nil
+		}
+		if c.Policy == LeapStep {
+			if dleap > 0.0 && x >= 0.0 && x < dleap {
+				return before + dleap, before, true, false, nil
+			}
+			if dleap < 0.0 && x >= dleap && x < 0.0 {
+				return before, 0, false, true, nil
+			}
+		}
+	}
+	d, err := c.dat(n, sod/DAYSEC)
+	return d, 0, false, false, err
+}
+
+// utc returns the UTC of TAI d1+d2.
+func (c Clock) utc(d1, d2 float64) Time {
+	u1, u2, _ := TaiutcWith(c.leap(), d1, d2)
+	return Time{JD1: u1, JD2: u2, Scale: ScaleUTC}
+}
+
+// fromSeconds returns the UTC of the clock reading sod seconds into
+// Unix day n.
+func (c Clock) fromSeconds(stamp string, n int64, sod float64) (
+	Time, error) {
+	off, alt, twice, gap, err := c.offset(n, sod)
+	if err != nil {
+		return Time{}, fmt.Errorf("%s: %w", stamp, err)
+	}
+	d1 := DJ1970 + float64(n)
+	t := c.utc(d1, (sod+off)/DAYSEC)
+	switch {
+	case twice:
+		return t, &AmbiguousTimeError{stamp, []Time{
+			c.utc(d1, (sod+alt)/DAYSEC), t}}
+	case gap:
+		return t, &AmbiguousTimeError{stamp, nil}
+	}
+	return t, nil
+}
+
+// norm returns Unix day n and seconds sod with sod within the day.
+func norm(n int64, sod float64) (int64, float64) {
+	fd := math.Floor(sod / DAYSEC)
+	return n + int64(fd), sod - fd*DAYSEC
+}
+
+// seconds returns the clock reading of t as a Unix day and seconds of
+// that day.  leap is set under LeapStep when t is in a leap second,
+// which has the reading of the second that follows.
+func (c Clock) seconds(t Time) (n int64, sod float64, leap bool,
+	err error) {
+	conv := &TimeConverter{Leap: c.leap()}
+
+	// Under LeapStep the clock reads UTC, apart from leap seconds.
+	if c.Policy == LeapStep {
+		u, err := t.ToWith(conv, ScaleUTC)
+		if err != nil && !IsWarning(err) {
+			return 0, 0, false, err
+		}
+		a := u.JD1 - DJ1970
+		fn := math.Floor(a)
+		n, f := norm(int64(fn), ((a-fn)+u.JD2)*DAYSEC)
+		_, dleap, err := c.jump(n)
+		if err != nil {
+			return 0, 0, false, err
+		}
+		sod = f / DAYSEC * (DAYSEC + dleap)
+		if sod >= DAYSEC {
+			return n + 1, sod - DAYSEC, true, nil
+		}
+		return n, sod, false, nil
+	}
+
+	tai, err := t.ToWith(conv, ScaleTAI)
+	if err != nil && !IsWarning(err) {
+		return 0, 0, false, err
+	}
+	a := tai.JD1 - DJ1970
+	fn := math.Floor(a)
+	n = int64(fn)
+	tsod := ((a - fn) + tai.JD2) * DAYSEC
+
+	// Solve clock = TAI - offset(clock), which converges quickly
+	// because the slews are slow.
+	sod = tsod
+	for i := 0; i < 10; i++ {
+		off, _, _, _, err := c.offset(norm(n, sod))
+		if err != nil {
+			return 0, 0, false, err
+		}
+		next := tsod - off
+		if next == sod {
+			break
+		}
+		sod = next
+	}
+	n, sod = norm(n, sod)
+	return n, sod, false, nil
+}
+
+// split returns seconds as whole seconds and nanoseconds.
+func split(n int64, sod float64) (sec, nsec int64) {
+	w := math.Floor(sod)
+	sec = n*86400 + int64(w)
+	nsec = int64(math.Floor((sod-w)*1e9 + 0.5))
+	if nsec >= 1e9 {
+		sec++
+		nsec -= 1e9
+	}
+	return
+}
+
+// FromUnix returns the UTC of the Unix time sec seconds and nsec
+// nanoseconds.  An *AmbiguousTimeError, with the result one of the
+// instants it lists, reports a Unix time that does not identify one
+// instant.
+func (c Clock) FromUnix(sec, nsec int64) (Time, error) {
+	n := sec / 86400
+	s := sec % 86400
+	if s < 0 {
+		n--
+		s += 86400
+	}
+	return c.fromSeconds(fmt.Sprintf("unix %d.%09d", sec, nsec), n,
+		float64(s)+float64(nsec)/1e9)
+}
+
+// Unix returns the Unix time of t.  Under LeapStep a leap second has
+// the Unix time of the second that follows it, and the
+// *AmbiguousTimeError of that Unix time is returned with the result.
+func (c Clock) Unix(t Time) (sec, nsec int64, err error) {
+	n, sod, leap, err := c.seconds(t)
+	if err != nil {
+		return 0, 0, err
+	}
+	sec, nsec = split(n, sod)
+	if leap {
+		_, err = c.FromUnix(sec, nsec)
+	}
+	return sec, nsec, err
+}
+
+// FromNTP returns the UTC of the NTP 64 bit timestamp ts, of 32 bits
+// of seconds and 32 bits of fraction, in the NTP era era.  Era 0 began
+// in 1900 and era 1 begins in 2036 February.
+func (c Clock) FromNTP(ts uint64, era int) (Time, error) {
+	s := int64(ts>>32) + int64(era)<<32 - NTPUnix
+	f := float64(ts&0xffffffff) / (1 << 32)
+	n := s / 86400
+	r := s % 86400
+	if r < 0 {
+		n--
+		r += 86400
+	}
+	return c.fromSeconds(fmt.Sprintf("ntp %d:%#016x", era, ts), n,
+		float64(r)+f)
+}
+
+// NTP returns the NTP 64 bit timestamp of t and its era.
+func (c Clock) NTP(t Time) (ts uint64, era int, err error) {
+	n, sod, leap, err := c.seconds(t)
+	if err != nil {
+		return 0, 0, err
+	}
+	w := math.Floor(sod)
+	f := uint64(math.Floor((sod-w)*(1<<32) + 0.5))
+	s := n*86400 + int64(w) + NTPUnix
+	if f >= 1<<32 {
+		s++
+		f -= 1 << 32
+	}
+	era = int(s >> 32)
+	ts = uint64(s&0xffffffff)<<32 | f
+	if leap {
+		_, err = c.FromNTP(ts, era)
+	}
+	return ts, era, err
+}
+
+// FromPTP returns the TAI of the PTP timestamp sec seconds and nsec
+// nanoseconds.  PTP counts TAI seconds from 1970 January 1 TAI and so
+// has no leap seconds.
+func FromPTP(sec, nsec int64) Time {
+	n := sec / 86400
+	s := sec % 86400
+	if s < 0 {
+		n--
+		s += 86400
+	}
+	return Time{JD1: DJ1970 + float64(n),
+		JD2: (float64(s) + float64(nsec)/1e9) / DAYSEC, Scale: ScaleTAI}
+}
+
+// PTP returns the PTP timestamp of t, converting t to TAI with
+// DefaultTimeConverter.
+func (t Time) PTP() (sec, nsec int64, err error) {
+	tai, err := t.To(ScaleTAI)
+	if err != nil && !IsWarning(err) {
+		return 0, 0, err
+	}
+	a := tai.JD1 - DJ1970
+	fn := math.Floor(a)
+	sec, nsec = split(int64(fn), ((a-fn)+tai.JD2)*DAYSEC)
+	return sec, nsec, err
+}
@@ -0,0 +1,141 @@
+package sofa
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// unixLeap is the Unix time of 2017 January 1, after a leap second.
+const unixLeap = 1483228800
+
+func iso(t *testing.T, tm Time, ndp int) string {
+	s, err := tm.ISO8601(ndp)
+	if err != nil && !IsWarning(err) {
+		t.Fatalf("ISO8601: %v", err)
+	}
+	return s
+}
+
+func TestClockFromUnix(t *testing.T) {
+	const fname = "Clock.FromUnix"
+	tests := []struct {
+		policy    LeapPolicy
+		sec, nsec int64
+		want      string
+	}{
+		{LeapStep, unixLeap - 1, 5e8, "2016-12-31T23:59:59.500"},
+		{LeapStep, unixLeap + 1, 0, "2017-01-01T00:00:01.000"},
+		{LeapStep, 0, 0, "1970-01-01T00:00:00.000"},
+		{LeapSmear24h, unixLeap - 43200, 0, "2016-12-31T12:00:00.000"},
+		{LeapSmear24h, unixLeap - 1, 0, "2016-12-31T23:59:59.500"},
+		{LeapSmear24h, unixLeap, 0, "2016-12-31T23:59:60.500"},
+		{LeapSmear24h, unixLeap + 43200, 0, "2017-01-01T12:00:00.000"},
+		{LeapUTCSLS, unixLeap - 1000, 0, "2016-12-31T23:43:20.000"},
+		{LeapUTCSLS, unixLeap - 500, 0, "2016-12-31T23:51:40.500"},
+		{LeapUTCSLS, unixLeap - 1, 0, "2016-12-31T23:59:59.999"},
+		{LeapUTCSLS, unixLeap, 0, "2017-01-01T00:00:00.000"},
+	}
+
+	for _, test := range tests {
+		tname := fname + " " + test.policy.String() + " " + test.want
+		c := Clock{Policy: test.policy}
+
+		tm, err := c.FromUnix(test.sec, test.nsec)
+		errT(t, nil, err, tname, "err")
+		if s := iso(t, tm, 3); s != test.want {
+			t.Errorf("%s: got %s", tname, s)
+		}
+
+		// And back.
+		sec, nsec, err := c.Unix(tm)
+		errT(t, nil, err, tname, "err")
+		viv(t, int(sec-test.sec), 0, tname, "sec")
+		viv(t, int(nsec/1000), int(test.nsec/1000), tname, "nsec")
+	}
+}
+
+func TestClockAmbiguous(t *testing.T) {
+	const fname = "Clock ambiguous"
+	c := Clock{Policy: LeapStep}
+
+	// The second after the leap second has the same Unix time.
+	tm, err := c.FromUnix(unixLeap, 5e8)
+	var aerr *AmbiguousTimeError
+	if !errors.As(err, &aerr) {
+		t.Fatalf("%s: want AmbiguousTimeError got %v", fname, err)
+	}
+	viv(t, len(aerr.Times), 2, fname, "readings")
+	if s := iso(t, aerr.Times[0], 1); s != "2016-12-31T23:59:60.5" {
+		t.Errorf("%s: first reading %s", fname, s)
+	}
+	if s := iso(t, aerr.Times[1], 1); s != "2017-01-01T00:00:00.5" {
+		t.Errorf("%s: second reading %s", fname, s)
+	}
+	if tm != aerr.Times[1] {
+		t.Errorf("%s: result is not the later reading", fname)
+	}
+
+	// The leap second itself.
+	leap, _ := ParseISO8601("2016-12-31T23:59:60.25", ScaleUTC)
+	sec, nsec, err := c.Unix(leap)
+	if !errors.As(err, &aerr) {
+		t.Errorf("%s: want AmbiguousTimeError got %v", fname, err)
+	}
+	viv(t, int(sec-unixLeap), 0, fname, "leap sec")
+	viv(t, int(nsec), 25e7, fname, "leap nsec")
+
+	// A negative leap second at the end of 2025.
+	tbl, err := LoadLeapSeconds(strings.NewReader(leapIERS +
+		"    61041.0    1  1 2026       36\n"))
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	c.Leap = tbl
+	_, err = c.FromUnix(1767225599, 0)
+	if !errors.As(err, &aerr) || len(aerr.Times) != 0 {
+		t.Errorf("%s: want a missing second got %v", fname, err)
+	}
+	_, err = c.FromUnix(1767225598, 0)
+	errT(t, nil, err, fname, "before gap")
+}
+
+func TestClockNTP(t *testing.T) {
+	const fname = "Clock.NTP"
+	c := Clock{}
+
+	tm, err := c.FromNTP(3692217601<<32|1<<31, 0)
+	errT(t, nil, err, fname, "err")
+	if s := iso(t, tm, 1); s != "2017-01-01T00:00:01.5" {
+		t.Errorf("%s: got %s", fname, s)
+	}
+	ts, era, err := c.NTP(tm)
+	errT(t, nil, err, fname, "err")
+	viv(t, era, 0, fname, "era")
+	if ts != 3692217601<<32|1<<31 {
+		t.Errorf("%s: got %#x", fname, ts)
+	}
+
+	// Era 1 begins in 2036.
+	tm, _ = c.FromNTP(0, 1)
+	if s := iso(t, tm, 0); s != "2036-02-07T06:28:16" {
+		t.Errorf("%s: era 1 began %s", fname, s)
+	}
+	_, era, _ = c.NTP(tm)
+	viv(t, era, 1, fname, "era 1")
+}
+
+func TestPTP(t *testing.T) {
+	const fname = "PTP"
+
+	tm := FromPTP(unixLeap+37, 0)
+	u, err := tm.To(ScaleUTC)
+	errT(t, nil, err, fname, "err")
+	if s := iso(t, u, 3); s != "2017-01-01T00:00:00.000" {
+		t.Errorf("%s: got %s", fname, s)
+	}
+	sec, nsec, err := u.PTP()
+	errT(t, nil, err, fname, "err")
+	viv(t, int(sec-unixLeap), 37, fname, "sec")
+	viv(t, int(nsec), 0, fname, "nsec")
+}
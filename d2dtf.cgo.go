@@ -0,0 +1,32 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "stdlib.h"
+// #include "sofa.h"
+import "C"
+import "unsafe"
+
+//  CgoD2dtf Format for output a 2-part Julian Date (or in the case of
+//  UTC a quasi-JD form that includes special provision for leap
+//  seconds).
+func CgoD2dtf(scale string, ndp int, d1, d2 float64) (
+	iy, im, id int, ihmsf [4]int, err error) {
+	var cIhmsf [4]C.int
+	cS := C.CString(scale)
+	cIy, cIm, cId := C.int(iy), C.int(im), C.int(id)
+	cI := C.iauD2dtf(cS, C.int(ndp), C.double(d1),
+		C.double(d2), &cIy, &cIm, &cId, &cIhmsf[0])
+	C.free(unsafe.Pointer(cS))
+	switch int(cI) {
+	case 0:
+	case 1:
+		err = errD2dtfWarn
+	case -1:
+		err = errD2dtfE1
+	default:
+		err = errAdmin
+	}
+	return int(cIy), int(cIm), int(cId), v4sIntC2Go(cIhmsf), err
+}
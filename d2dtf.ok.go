@@ -1,18 +1,16 @@
 package sofa
 
-// #include "stdlib.h"
-// #include "sofa.h"
-import "C"
 import (
 	"errors"
 	"strings"
-	"unsafe"
+
+	"github.com/8i8/sofa/en"
 )
 
 var errD2dtfWarn = errors.New("dubious year (Note 5)")
 var errD2dtfE1 = errors.New("unacceptable date (Note 4)")
 
-//  CgoD2dtf Format for output a 2-part Julian Date (or in the case of
+//  D2dtf Format for output a 2-part Julian Date (or in the case of
 //  UTC a quasi-JD form that includes special provision for leap
 //  seconds).
 //
@@ -91,45 +89,29 @@ var errD2dtfE1 = errors.New("unacceptable date (Note 4)")
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoD2dtf Format for output a 2-part Julian Date (or in the case of
+//  D2dtf Format for output a 2-part Julian Date (or in the case of
 //  UTC a quasi-JD form that includes special provision for leap
 //  seconds).
-func CgoD2dtf(scale string, ndp int, d1, d2 float64) (
+func D2dtf(scale string, ndp int, d1, d2 float64) (
 	iy, im, id int, ihmsf [4]int, err error) {
-	var cIhmsf [4]C.int
-	cS := C.CString(scale)
-	cIy, cIm, cId := C.int(iy), C.int(im), C.int(id)
-	cI := C.iauD2dtf(cS, C.int(ndp), C.double(d1),
-		C.double(d2), &cIy, &cIm, &cId, &cIhmsf[0])
-	C.free(unsafe.Pointer(cS))
-	switch int(cI) {
-	case 0:
-	case 1:
-		err = errD2dtfWarn
-	case -1:
-		err = errD2dtfWarn
-	default:
-		err = errAdmin
-	}
-	return int(cIy), int(cIm), int(cId), v4sIntC2Go(cIhmsf), err
+	return D2dtfWith(CurrentLeapSeconds(), scale, ndp, d1, d2)
 }
 
-//  GoD2dtf Format for output a 2-part Julian Date (or in the case of
-//  UTC a quasi-JD form that includes special provision for leap
-//  seconds).
-func GoD2dtf(scale string, ndp int, d1, d2 float64) (
+//  D2dtfWith is D2dtf with Delta(AT) taken from ls.
+func D2dtfWith(ls LeapSeconds, scale string, ndp int, d1, d2 float64) (
 	iy, im, id int, ihmsf [4]int, err error) {
 	var leap bool
 	var iy1, im1, id1, iy2, im2, id2, i int
 	var ihmsf1 [4]int
 	var a1, b1, fd, dat0, dat12, dat24, dleap float64
+	var js, jw en.ErrNum
 
 	// The two-part JD.
 	a1 = d1
 	b1 = d2
 
 	// Provisional calendar date.
-	iy1, im1, id1, fd, err = GoJd2cal(a1, b1)
+	iy1, im1, id1, fd, err = Jd2cal(a1, b1)
 	if err != nil {
 		err = errD2dtfE1
 		return
@@ -140,38 +122,38 @@ func GoD2dtf(scale string, ndp int, d1, d2 float64) (
 	if strings.Compare(scale, "UTC") == 0 {
 
 		// TAI-UTC at 0h today.
-		dat0, err = GoDat(iy1, im1, id1, 0.0)
-		if err != nil {
-			if !errors.Is(err, errD2dtfWarn) {
-				err = errD2dtfE1
-				return
-			}
-			err = errD2dtfWarn
+		dat0, js = ls.Dat(iy1, im1, id1, 0.0)
+		if js != nil && js.Code() < 0 {
+			err = errD2dtfE1
+			return
+		}
+		if jw == nil {
+			jw = js
 		}
 
 		// TAI-UTC at 12h today (to detect drift).
-		dat12, err = GoDat(iy1, im1, id1, 0.5)
-		if err != nil {
-			if !errors.Is(err, errD2dtfWarn) {
-				err = errD2dtfE1
-				return
-			}
-			err = errD2dtfWarn
+		dat12, js = ls.Dat(iy1, im1, id1, 0.5)
+		if js != nil && js.Code() < 0 {
+			err = errD2dtfE1
+			return
+		}
+		if jw == nil {
+			jw = js
 		}
 
 		// TAI-UTC at 0h tomorrow (to detect jumps).
-		iy2, im2, id2, _, err = GoJd2cal(a1+1.5, b1-fd)
+		iy2, im2, id2, _, err = Jd2cal(a1+1.5, b1-fd)
 		if err != nil {
 			err = errD2dtfE1
 			return
 		}
-		dat24, err = GoDat(iy2, im2, id2, 0.0)
-		if err != nil {
-			if !errors.Is(err, errD2dtfWarn) {
-				err = errD2dtfE1
-				return
-			}
-			err = errD2dtfWarn
+		dat24, js = ls.Dat(iy2, im2, id2, 0.0)
+		if js != nil && js.Code() < 0 {
+			err = errD2dtfE1
+			return
+		}
+		if jw == nil {
+			jw = js
 		}
 
 		// Any sudden change in TAI-UTC (seconds).
@@ -186,19 +168,16 @@ func GoD2dtf(scale string, ndp int, d1, d2 float64) (
 	}
 
 	// Provisional time of day.
-	_, ihmsf1 = GoD2tf(ndp, fd)
+	_, ihmsf1 = D2tf(ndp, fd)
 
 	// Has the (rounded) time gone past 24h?
 	if ihmsf1[0] > 23 {
 
 		// Yes.  We probably need tomorrow's calendar date.
-		iy2, im2, id2, _, err = GoJd2cal(a1+1.5, b1-fd)
+		iy2, im2, id2, _, err = Jd2cal(a1+1.5, b1-fd)
 		if err != nil {
-			if !errors.Is(err, errD2dtfWarn) {
-				err = errD2dtfE1
-				return
-			}
-			err = errD2dtfWarn
+			err = errD2dtfE1
+			return
 		}
 
 		// Is today a leap second day?
@@ -255,6 +234,9 @@ func GoD2dtf(scale string, ndp int, d1, d2 float64) (
 		ihmsf[i] = ihmsf1[i]
 	}
 
-	// Status.
+	// Status, the first warning from Delta(AT).
+	if jw != nil {
+		err = errD2dtfWarn
+	}
 	return
 }
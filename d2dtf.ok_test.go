@@ -1,6 +1,10 @@
 package sofa
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/8i8/sofa/en"
+)
 
 //
 //  - - - - - - - - - -
@@ -25,7 +29,7 @@ func TestD2dtf(t *testing.T) {
 			int, int, int, [4]int, error)
 	}{
 		{"cgo", CgoD2dtf},
-		{"go", GoD2dtf},
+		{"go", D2dtf},
 	}
 
 	for _, test := range tests {
@@ -42,7 +46,42 @@ func TestD2dtf(t *testing.T) {
 		viv(t, ihmsf[2], 60, tname, "s")
 		viv(t, ihmsf[3], 13599, tname, "f")
 		errT(t, nil, err, tname, "err")
+
+		// A date beyond the leap second table is dubious.
+		iy, _, _, _, err = test.fn("UTC", 0, 2400000.5, 62000.25)
+		viv(t, iy, 2028, tname, "y dubious")
+		if err == nil {
+			t.Errorf("%s: want dubious year warning", tname)
+		}
+	}
+}
+
+// dubiousDay is the built-in Delta(AT) with a warning on one day.
+type dubiousDay struct{ iy, im, id int }
+
+func (d dubiousDay) Dat(iy, im, id int, fd float64) (float64, en.ErrNum) {
+	deltat, err := builtinLeap.Dat(iy, im, id, fd)
+	if iy == d.iy && im == d.im && id == d.id {
+		err = errDat.Set(1)
 	}
+	return deltat, err
+}
+
+func TestD2dtfWith(t *testing.T) {
+	const fname = "D2dtfWith"
+
+	// A warning for today is kept although tomorrow is clean.
+	ls := dubiousDay{2020, 3, 10}
+	iy, im, id, _, err := D2dtfWith(ls, "UTC", 0, 2400000.5, 58918.5)
+	viv(t, iy, 2020, fname, "y")
+	viv(t, im, 3, fname, "mo")
+	viv(t, id, 10, fname, "d")
+	errT(t, errD2dtfWarn, err, fname, "today")
+
+	_, _, _, _, err = D2dtfWith(ls, "UTC", 0, 2400000.5, 58917.5)
+	errT(t, errD2dtfWarn, err, fname, "tomorrow")
+	_, _, _, _, err = D2dtfWith(ls, "UTC", 0, 2400000.5, 58920.5)
+	errT(t, nil, err, fname, "clean")
 }
 
 func BenchmarkD2dtf(b *testing.B) {
@@ -52,7 +91,7 @@ func BenchmarkD2dtf(b *testing.B) {
 			int, int, int, [4]int, error)
 	}{
 		{"cgo", CgoD2dtf},
-		{"go", GoD2dtf},
+		{"go", D2dtf},
 	}
 
 	for _, test := range tests {
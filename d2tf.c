@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauD2tf(int ndp, double days, char *sign, int ihmsf[4])
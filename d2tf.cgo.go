@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include <sofa.h>
+// #include <sofam.h>
+import "C"
+
+//  CgoD2tf Decompose days to hours, minutes, seconds, fraction.
+func CgoD2tf(ndp int, days float64) (sign byte, ihmsf [4]int) {
+	var cSign C.char
+	var cIhmsf [4]C.int
+	C.iauD2tf(C.int(ndp), C.double(days), &cSign, &cIhmsf[0])
+	return byte(cSign), v4sIntC2Go(cIhmsf)
+}
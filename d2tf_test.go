@@ -24,7 +24,7 @@ func TestD2tf(t *testing.T) {
 		fn  func(int, float64) (byte, [4]int)
 	}{
 		{"cgo", CgoD2tf},
-		{"go", GoD2tf},
+		{"go", D2tf},
 	}
 
 	for _, test := range tests {
@@ -48,7 +48,7 @@ func BenchmarkD2tf(b *testing.B) {
 		fn  func(int, float64) (byte, [4]int)
 	}{
 		{"cgo", CgoD2tf},
-		{"go", GoD2tf},
+		{"go", D2tf},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauDat(int iy, int im, int id, double fd, double *deltat)
@@ -0,0 +1,19 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoDat For a given UTC date, calculate Delta(AT) = TAI-UTC.
+func CgoDat(iy, im, id int, fd float64) (deltat float64, err en.ErrNum) {
+	var cDeltat C.double
+	cI := C.iauDat(C.int(iy), C.int(im), C.int(id), C.double(fd),
+		&cDeltat)
+	if n := int(cI); n != 0 {
+		err = errDat.Set(n)
+	}
+	return float64(cDeltat), err
+}
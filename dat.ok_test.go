@@ -24,7 +24,7 @@ func TestDat(t *testing.T) {
 			b1 float64, b2 en.ErrNum)
 	}{
 		{"cgo", CgoDat},
-		{"go", GoDat},
+		{"go", Dat},
 	}
 
 	for _, test := range tests {
@@ -41,6 +41,9 @@ func TestDat(t *testing.T) {
 		deltat, err = test.fn(2017, 9, 1, 0.0)
 		vvd(t, deltat, 37.0, 0.0, tname, "d3")
 		errT(t, nil, err, tname, "j3")
+
+		_, err = test.fn(2003, 13, 1, 0.0)
+		errEN(t, -2, err, tname, "j4")
 	}
 }
 
@@ -51,7 +54,7 @@ func BenchmarkDat(b *testing.B) {
 			b1 float64, b2 en.ErrNum)
 	}{
 		{"cgo", CgoDat},
-		{"go", GoDat},
+		{"go", Dat},
 	}
 
 	for _, test := range tests {
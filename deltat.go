@@ -0,0 +1,426 @@
+package sofa
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/8i8/sofa/en"
+)
+
+var (
+	errDeltaTSpline = errors.New("Delta T spline needs at least two knots")
+	errDeltaTFormat = errors.New("unrecognised Delta T spline row")
+)
+
+// DeltaTModel is a model of Delta T = TT-UT1 (seconds) as a function of
+// the epoch, in years.  Julian epochs, as from Epj, are adequate for
+// all of the models.
+type DeltaTModel interface {
+	DeltaT(year float64) float64
+}
+
+// DeltaTAt returns Delta T from the model m at the 2-part Julian Date
+// d1+d2.
+func DeltaTAt(m DeltaTModel, d1, d2 float64) float64 {
+	return m.DeltaT(Epj(d1, d2))
+}
+
+// Ttut1DT Time scale transformation:  Terrestrial Time, TT, to
+// Universal Time, UT1, with Delta T taken from the model m.
+func Ttut1DT(m DeltaTModel, tt1, tt2 float64) (
+	ut11, ut12 float64, err en.ErrNum) {
+	return Ttut1(tt1, tt2, DeltaTAt(m, tt1, tt2))
+}
+
+// Ut1ttDT Time scale transformation:  Universal Time, UT1, to
+// Terrestrial Time, TT, with Delta T taken from the model m.
+func Ut1ttDT(m DeltaTModel, ut11, ut12 float64) (
+	tt1, tt2 float64, err en.ErrNum) {
+	return Ut1tt(ut11, ut12, DeltaTAt(m, ut11, ut12))
+}
+
+// Lunar tidal accelerations (arcsec/cy^2) assumed by the models.
+const (
+	// NDotMS2004 is used by Morrison & Stephenson (2004) and so by
+	// the Espenak & Meeus polynomials.
+	NDotMS2004 = -26.0
+
+	// NDotSMH2016 is used by Stephenson, Morrison & Hohenkerk (2016).
+	NDotSMH2016 = -25.82
+
+	// NDotDE430 is that of the JPL DE430 and later ephemerides.
+	NDotDE430 = -25.80
+
+	// NDotELP is that of the ELP 2000-82 lunar theory.
+	NDotELP = -25.858
+)
+
+// DeltaTTidal corrects a Delta T model for a different lunar tidal
+// acceleration, as is needed when Delta T is to be used with a lunar
+// ephemeris other than the one used to derive the model.
+type DeltaTTidal struct {
+	Model DeltaTModel // the model to be corrected
+	From  float64     // tidal acceleration assumed by Model (arcsec/cy^2)
+	To    float64     // tidal acceleration of the ephemeris (arcsec/cy^2)
+}
+
+// DeltaT returns the corrected Delta T (seconds) at the given epoch;
+// see Morrison & Stephenson (2004), equation 4.
+func (m DeltaTTidal) DeltaT(year float64) float64 {
+	u := (year - 1955.0) / 100.0
+	return m.Model.DeltaT(year) - 0.91072*(m.To-m.From)*u*u
+}
+
+// DeltaTParabola is a long term model of the form
+//
+//	Delta T = A + B*u^2,  u = (year-Epoch)/100
+//
+// which represents the secular increase due to tidal braking.
+type DeltaTParabola struct {
+	Epoch float64 // epoch of the vertex (year)
+	A     float64 // Delta T at the vertex (s)
+	B     float64 // coefficient of u^2 (s)
+}
+
+var (
+	// LongTermMS2004 is the parabola of Morrison & Stephenson (2004).
+	LongTermMS2004 = DeltaTParabola{Epoch: 1820.0, A: -20.0, B: 32.0}
+
+	// LongTermSMH2016 is the parabola of Stephenson, Morrison &
+	// Hohenkerk (2016).
+	LongTermSMH2016 = DeltaTParabola{Epoch: 1825.0, A: -320.0, B: 32.5}
+)
+
+// DeltaT returns Delta T (seconds) at the given epoch.
+func (m DeltaTParabola) DeltaT(year float64) float64 {
+	u := (year - m.Epoch) / 100.0
+	return m.A + m.B*u*u
+}
+
+// EspenakMeeus is the set of polynomial expressions for Delta T of
+// Espenak & Meeus (2006), fitted to Morrison & Stephenson (2004) and
+// valid from -1999 to +3000.  They assume a lunar tidal acceleration
+// of NDotMS2004.
+type EspenakMeeus struct{}
+
+// DeltaT returns Delta T (seconds) at the given epoch.
+func (EspenakMeeus) DeltaT(y float64) float64 {
+	var t, u float64
+
+	switch {
+	case y < -500.0:
+		return LongTermMS2004.DeltaT(y)
+
+	case y < 500.0:
+		u = y / 100.0
+		return 10583.6 + u*(-1014.41+u*(33.78311+u*(-5.952053+
+			u*(-0.1798452+u*(0.022174192+u*0.0090316521)))))
+
+	case y < 1600.0:
+		u = (y - 1000.0) / 100.0
+		return 1574.2 + u*(-556.01+u*(71.23472+u*(0.319781+
+			u*(-0.8503463+u*(-0.005050998+u*0.0083572073)))))
+
+	case y < 1700.0:
+		t = y - 1600.0
+		return 120.0 + t*(-0.9808+t*(-0.01532+t/7129.0))
+
+	case y < 1800.0:
+		t = y - 1700.0
+		return 8.83 + t*(0.1603+t*(-0.0059285+t*(0.00013336-
+			t/1174000.0)))
+
+	case y < 1860.0:
+		t = y - 1800.0
+		return 13.72 + t*(-0.332447+t*(0.0068612+t*(0.0041116+
+			t*(-0.00037436+t*(0.0000121272+t*(-0.0000001699+
+				t*0.000000000875))))))
+
+	case y < 1900.0:
+		t = y - 1860.0
+		return 7.62 + t*(0.5737+t*(-0.251754+t*(0.01680668+
+			t*(-0.0004473624+t/233174.0))))
+
+	case y < 1920.0:
+		t = y - 1900.0
+		return -2.79 + t*(1.494119+t*(-0.0598939+t*(0.0061966-
+			t*0.000197)))
+
+	case y < 1941.0:
+		t = y - 1920.0
+		return 21.20 + t*(0.84493+t*(-0.076100+t*0.0020936))
+
+	case y < 1961.0:
+		t = y - 1950.0
+		return 29.07 + t*(0.407+t*(-1.0/233.0+t/2547.0))
+
+	case y < 1986.0:
+		t = y - 1975.0
+		return 45.45 + t*(1.067+t*(-1.0/260.0-t/718.0))
+
+	case y < 2005.0:
+		t = y - 2000.0
+		return 63.86 + t*(0.3345+t*(-0.060374+t*(0.0017275+
+			t*(0.000651814+t*0.00002373599))))
+
+	case y < 2050.0:
+		t = y - 2000.0
+		return 62.92 + t*(0.32217+t*0.005589)
+
+	case y < 2150.0:
+		return LongTermMS2004.DeltaT(y) - 0.5628*(2150.0-y)
+	}
+	return LongTermMS2004.DeltaT(y)
+}
+
+// DeltaTSegment is one piece of a piecewise cubic model of Delta T,
+//
+//	Delta T = A0 + A1*t + A2*t^2 + A3*t^3,  t = (year-Y0)/(Y1-Y0)
+//
+// the form in which Stephenson, Morrison & Hohenkerk (2016) publish
+// their spline.
+type DeltaTSegment struct {
+	Y0, Y1         float64 // span of the segment (years)
+	A0, A1, A2, A3 float64 // coefficients (s)
+}
+
+// DeltaTSpline is a piecewise cubic model of Delta T, with other
+// models used before and after the span that it covers.
+type DeltaTSpline struct {
+	Segments []DeltaTSegment // contiguous segments in date order
+	Before   DeltaTModel     // model for earlier dates
+	After    DeltaTModel     // model for later dates
+}
+
+// DeltaT returns Delta T (seconds) at the given epoch.
+func (m *DeltaTSpline) DeltaT(year float64) float64 {
+	n := len(m.Segments)
+	switch {
+	case n == 0:
+		return m.After.DeltaT(year)
+	case year < m.Segments[0].Y0:
+		return m.Before.DeltaT(year)
+	case year > m.Segments[n-1].Y1:
+		return m.After.DeltaT(year)
+	}
+	i := sort.Search(n, func(i int) bool {
+		return m.Segments[i].Y1 >= year
+	})
+	s := m.Segments[i]
+	t := (year - s.Y0) / (s.Y1 - s.Y0)
+	return s.A0 + t*(s.A1+t*(s.A2+t*s.A3))
+}
+
+// NewDeltaTSpline returns the natural cubic spline through the
+// tabulated values dt (seconds) at the given epochs, which must
+// increase.
+func NewDeltaTSpline(years, dt []float64, before, after DeltaTModel) (
+	*DeltaTSpline, error) {
+
+	var n int
+	var h, a, b, c, r, m []float64
+
+	n = len(years)
+	if n < 2 || len(dt) != n {
+		return nil, errDeltaTSpline
+	}
+
+	// Second derivatives from the tridiagonal system, with zero
+	// curvature at both ends.
+	h = make([]float64, n-1)
+	for i := range h {
+		h[i] = years[i+1] - years[i]
+		if h[i] <= 0 {
+			return nil, errDeltaTSpline
+		}
+	}
+	a = make([]float64, n)
+	b = make([]float64, n)
+	c = make([]float64, n)
+	r = make([]float64, n)
+	m = make([]float64, n)
+	b[0], b[n-1] = 1, 1
+	for i := 1; i < n-1; i++ {
+		a[i] = h[i-1]
+		b[i] = 2 * (h[i-1] + h[i])
+		c[i] = h[i]
+		r[i] = 6 * ((dt[i+1]-dt[i])/h[i] - (dt[i]-dt[i-1])/h[i-1])
+	}
+	for i := 1; i < n; i++ {
+		w := a[i] / b[i-1]
+		b[i] -= w * c[i-1]
+		r[i] -= w * r[i-1]
+	}
+	m[n-1] = r[n-1] / b[n-1]
+	for i := n - 2; i >= 0; i-- {
+		m[i] = (r[i] - c[i]*m[i+1]) / b[i]
+	}
+
+	// Express each interval in the normalised form.
+	s := &DeltaTSpline{Before: before, After: after}
+	for i := 0; i < n-1; i++ {
+		hh := h[i] * h[i]
+		s.Segments = append(s.Segments, DeltaTSegment{
+			Y0: years[i],
+			Y1: years[i+1],
+			A0: dt[i],
+			A1: dt[i+1] - dt[i] - hh*(2*m[i]+m[i+1])/6,
+			A2: hh * m[i] / 2,
+			A3: hh * (m[i+1] - m[i]) / 6,
+		})
+	}
+	return s, nil
+}
+
+// ParseDeltaTSpline reads a piecewise cubic Delta T model as published
+// by Stephenson, Morrison & Hohenkerk (2016) and its addenda, one
+// segment per line:  Y0, Y1, A0, A1, A2, A3.  Lines starting with '#'
+// and any leading row index are ignored.  The long term parabola of the
+// same paper is used outside the span of the table.  The 2020 revision
+// is built in as StephensonMorrisonHohenkerk2016.
+func ParseDeltaTSpline(r io.Reader) (*DeltaTSpline, error) {
+	s := &DeltaTSpline{Before: LongTermSMH2016, After: LongTermSMH2016}
+	sc := bufio.NewScanner(r)
+	for ln := 1; sc.Scan(); ln++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		f := strings.Fields(line)
+		if len(f) == 7 {
+			f = f[1:]
+		}
+		if len(f) != 6 {
+			return nil, fmt.Errorf("line %d: %w", ln, errDeltaTFormat)
+		}
+		v, err := floats(f)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", ln, err)
+		}
+		if n := len(s.Segments); v[1] <= v[0] ||
+			n > 0 && s.Segments[n-1].Y1 != v[0] {
+			return nil, fmt.Errorf("line %d: %w", ln, errDeltaTFormat)
+		}
+		s.Segments = append(s.Segments, DeltaTSegment{
+			v[0], v[1], v[2], v[3], v[4], v[5]})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if len(s.Segments) == 0 {
+		return nil, errDeltaTSpline
+	}
+	return s, nil
+}
+
+// ms2004 holds the Delta T values derived from historical records by
+// Morrison & Stephenson (2004), with those from 1700 onward being
+// observed values.
+var ms2004 = [...][2]float64{
+	{-500, 17190}, {-400, 15530}, {-300, 14080}, {-200, 12790},
+	{-100, 11640}, {0, 10580}, {100, 9600}, {200, 8640},
+	{300, 7680}, {400, 6700}, {500, 5710}, {600, 4740},
+	{700, 3810}, {800, 2960}, {900, 2200}, {1000, 1570},
+	{1100, 1090}, {1200, 740}, {1300, 490}, {1400, 320},
+	{1500, 200}, {1600, 120}, {1700, 9}, {1750, 13},
+	{1800, 14}, {1850, 7}, {1900, -3}, {1950, 29},
+	{1955, 31.1}, {1960, 33.2}, {1965, 35.7}, {1970, 40.2},
+	{1975, 45.5}, {1980, 50.5}, {1985, 54.3}, {1990, 56.9},
+	{1995, 60.8}, {2000, 63.8}, {2005, 64.7},
+}
+
+// MorrisonStephenson2004 is the natural cubic spline through the Delta T
+// values of Morrison & Stephenson (2004), from -500 to 2005, with their
+// long term parabola before and the Espenak & Meeus extrapolation after.
+// It assumes a lunar tidal acceleration of NDotMS2004.
+var MorrisonStephenson2004 = func() *DeltaTSpline {
+	var y, dt []float64
+	for _, v := range ms2004 {
+		y = append(y, v[0])
+		dt = append(dt, v[1])
+	}
+	s, err := NewDeltaTSpline(y, dt, LongTermMS2004, EspenakMeeus{})
+	if err != nil {
+		panic(err)
+	}
+	return s
+}()
+
+// smh2016 is the cubic spline of Delta T of Stephenson, Morrison &
+// Hohenkerk (2016), Table S15, as revised in their 2020 addendum, from
+// -720 to 2019:  Y0, Y1, A0, A1, A2, A3 as for DeltaTSegment.
+var smh2016 = [...]DeltaTSegment{
+	{-720.0, -100.0, 20371.848, -9999.586, 776.247, 409.160},
+	{-100.0, 400.0, 11557.668, -5822.270, 1303.151, -503.428},
+	{400.0, 1000.0, 6535.116, -5671.519, -298.291, 1085.087},
+	{1000.0, 1150.0, 1650.393, -753.210, 184.811, -25.346},
+	{1150.0, 1300.0, 1056.647, -459.628, 108.771, -24.641},
+	{1300.0, 1500.0, 681.149, -421.345, 61.953, -29.414},
+	{1500.0, 1600.0, 292.343, -192.841, -6.572, 16.197},
+	{1600.0, 1650.0, 109.127, -78.697, 10.505, 3.018},
+	{1650.0, 1720.0, 43.952, -68.089, 38.333, -2.127},
+	{1720.0, 1800.0, 12.068, 2.507, 41.731, -37.939},
+	{1800.0, 1810.0, 18.367, -3.481, -1.126, 1.918},
+	{1810.0, 1820.0, 15.678, 0.021, 4.629, -3.812},
+	{1820.0, 1830.0, 16.516, -2.157, -6.806, 3.250},
+	{1830.0, 1840.0, 10.804, -6.018, 2.944, -0.096},
+	{1840.0, 1850.0, 7.634, -0.416, 2.658, -0.539},
+	{1850.0, 1855.0, 9.338, 1.642, 0.261, -0.883},
+	{1855.0, 1860.0, 10.357, -0.486, -2.389, 1.558},
+	{1860.0, 1865.0, 9.040, -0.591, 2.284, -2.477},
+	{1865.0, 1870.0, 8.255, -3.456, -5.148, 2.720},
+	{1870.0, 1875.0, 2.371, -5.593, 3.011, -0.914},
+	{1875.0, 1880.0, -1.126, -2.314, 0.269, -0.039},
+	{1880.0, 1885.0, -3.210, -1.893, 0.152, 0.563},
+	{1885.0, 1890.0, -4.388, 0.101, 1.842, -1.438},
+	{1890.0, 1895.0, -3.884, -0.531, -2.474, 1.871},
+	{1895.0, 1900.0, -5.017, 0.134, 3.138, -0.232},
+	{1900.0, 1905.0, -1.977, 5.715, 2.443, -1.257},
+	{1905.0, 1910.0, 4.923, 6.828, -1.329, 0.720},
+	{1910.0, 1915.0, 11.142, 6.330, 0.831, -0.825},
+	{1915.0, 1920.0, 17.479, 5.518, -1.643, 0.262},
+	{1920.0, 1925.0, 21.617, 3.020, -0.856, 0.008},
+	{1925.0, 1930.0, 23.789, 1.333, -0.831, 0.127},
+	{1930.0, 1935.0, 24.418, 0.052, -0.449, 0.142},
+	{1935.0, 1940.0, 24.164, -0.419, -0.022, 0.702},
+	{1940.0, 1945.0, 24.426, 1.645, 2.086, -1.106},
+	{1945.0, 1950.0, 27.050, 2.499, -1.232, 0.614},
+	{1950.0, 1953.0, 28.932, 1.127, 0.220, -0.277},
+	{1953.0, 1956.0, 30.002, 0.737, -0.610, 0.631},
+	{1956.0, 1959.0, 30.760, 1.409, 1.282, -0.799},
+	{1959.0, 1962.0, 32.652, 1.577, -1.115, 0.507},
+	{1962.0, 1965.0, 33.621, 0.868, 0.406, 0.199},
+	{1965.0, 1968.0, 35.093, 2.275, 1.002, -0.414},
+	{1968.0, 1971.0, 37.956, 3.035, -0.242, 0.202},
+	{1971.0, 1974.0, 40.951, 3.157, 0.364, -0.229},
+	{1974.0, 1977.0, 44.244, 3.198, -0.323, 0.172},
+	{1977.0, 1980.0, 47.291, 3.069, 0.193, -0.192},
+	{1980.0, 1983.0, 50.361, 2.878, -0.384, 0.081},
+	{1983.0, 1986.0, 52.936, 2.354, -0.140, -0.166},
+	{1986.0, 1989.0, 54.984, 1.577, -0.637, 0.448},
+	{1989.0, 1992.0, 56.373, 1.649, 0.708, -0.277},
+	{1992.0, 1995.0, 58.453, 2.235, -0.121, 0.111},
+	{1995.0, 1998.0, 60.678, 2.324, 0.210, -0.315},
+	{1998.0, 2001.0, 62.898, 1.804, -0.729, 0.109},
+	{2001.0, 2004.0, 64.083, 0.674, -0.402, 0.199},
+	{2004.0, 2007.0, 64.553, 0.466, 0.194, -0.017},
+	{2007.0, 2010.0, 65.197, 0.804, 0.144, -0.084},
+	{2010.0, 2013.0, 66.061, 0.839, -0.109, 0.128},
+	{2013.0, 2016.0, 66.920, 1.007, 0.277, -0.095},
+	{2016.0, 2019.0, 68.109, 1.277, -0.007, -0.139},
+}
+
+// StephensonMorrisonHohenkerk2016 is the Delta T spline of Stephenson,
+// Morrison & Hohenkerk (2016) and its 2020 addendum, from -720 to 2019,
+// with their long term parabola before and the Espenak & Meeus
+// extrapolation after.  It assumes a lunar tidal acceleration of
+// NDotSMH2016.
+var StephensonMorrisonHohenkerk2016 = &DeltaTSpline{
+	Segments: smh2016[:],
+	Before:   LongTermSMH2016,
+	After:    EspenakMeeus{},
+}
@@ -0,0 +1,113 @@
+package sofa
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEspenakMeeus(t *testing.T) {
+	const fname = "EspenakMeeus"
+	var m EspenakMeeus
+
+	vvd(t, m.DeltaT(-1000.0), -20.0+32.0*28.2*28.2, 1e-9, fname, "-1000")
+	vvd(t, m.DeltaT(0.0), 10583.6, 1e-9, fname, "0")
+	vvd(t, m.DeltaT(1000.0), 1574.2, 1e-9, fname, "1000")
+	vvd(t, m.DeltaT(1900.0), -2.79, 1e-9, fname, "1900")
+	vvd(t, m.DeltaT(1950.0), 29.07, 1e-9, fname, "1950")
+	vvd(t, m.DeltaT(2000.0), 63.86, 1e-9, fname, "2000")
+	vvd(t, m.DeltaT(2010.0), 66.7006, 1e-9, fname, "2010")
+	vvd(t, m.DeltaT(2200.0), -20.0+32.0*3.8*3.8, 1e-9, fname, "2200")
+
+	// The pieces join to within a few tenths of a second.
+	for _, y := range []float64{500, 1600, 1700, 1800, 1860, 1900,
+		1920, 1941, 1961, 1986, 2005, 2050, 2150} {
+		vvd(t, m.DeltaT(y-1e-9), m.DeltaT(y), 1.0, fname, "join")
+	}
+}
+
+func TestMorrisonStephenson2004(t *testing.T) {
+	const fname = "MorrisonStephenson2004"
+	m := MorrisonStephenson2004
+
+	// The spline passes through the tabulated values.
+	for _, v := range ms2004 {
+		vvd(t, m.DeltaT(v[0]), v[1], 1e-9, fname, "knot")
+	}
+
+	// And agrees with the Espenak & Meeus fit to the same data.
+	var em EspenakMeeus
+	vvd(t, m.DeltaT(250.0), em.DeltaT(250.0), 20.0, fname, "250")
+	vvd(t, m.DeltaT(1425.0), em.DeltaT(1425.0), 10.0, fname, "1425")
+	vvd(t, m.DeltaT(-1000.0), em.DeltaT(-1000.0), 1e-9, fname, "before")
+	vvd(t, m.DeltaT(2020.0), em.DeltaT(2020.0), 1e-9, fname, "after")
+}
+
+func TestStephensonMorrisonHohenkerk2016(t *testing.T) {
+	const fname = "StephensonMorrisonHohenkerk2016"
+	m := StephensonMorrisonHohenkerk2016
+
+	// The segments join, and the spline meets the observed values.
+	for i := 1; i < len(smh2016); i++ {
+		y := smh2016[i].Y0
+		vvd(t, m.DeltaT(y-1e-9), m.DeltaT(y), 2e-3, fname, "join")
+	}
+	vvd(t, m.DeltaT(-720.0), 20371.848, 1e-9, fname, "-720")
+	vvd(t, m.DeltaT(1900.0), -1.977, 1e-9, fname, "1900")
+	vvd(t, m.DeltaT(2000.0), 63.8, 0.2, fname, "2000")
+	vvd(t, m.DeltaT(2019.0), 69.24, 1e-9, fname, "2019")
+	vvd(t, m.DeltaT(-1000.0), LongTermSMH2016.DeltaT(-1000.0), 1e-9,
+		fname, "before")
+	vvd(t, m.DeltaT(2020.0), EspenakMeeus{}.DeltaT(2020.0), 1e-9, fname,
+		"after")
+}
+
+func TestDeltaTTidal(t *testing.T) {
+	const fname = "DeltaTTidal"
+
+	// The Espenak & Meeus correction for ELP 2000-82.
+	m := DeltaTTidal{EspenakMeeus{}, NDotMS2004, NDotELP}
+	for _, y := range []float64{-1000, 0, 1955, 2500} {
+		c := -0.000012932 * (y - 1955.0) * (y - 1955.0)
+		vvd(t, m.DeltaT(y), EspenakMeeus{}.DeltaT(y)+c,
+			1e-4*(1-c), fname, "elp")
+	}
+}
+
+func TestParseDeltaTSpline(t *testing.T) {
+	const fname = "ParseDeltaTSpline"
+	const data = `# i  Ki    Ki+1   a0      a1     a2    a3
+1   1000.0 1100.0 1000.0 -100.0  0.0  0.0
+2   1100.0 1200.0  900.0  -50.0  5.0 -1.0
+`
+	m, err := ParseDeltaTSpline(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	vvd(t, m.DeltaT(1050.0), 950.0, 1e-12, fname, "1050")
+	vvd(t, m.DeltaT(1150.0), 900.0-25.0+1.25-0.125, 1e-12, fname, "1150")
+	vvd(t, m.DeltaT(1200.0), 854.0, 1e-12, fname, "1200")
+	vvd(t, m.DeltaT(0.0), LongTermSMH2016.DeltaT(0.0), 1e-12, fname,
+		"before")
+
+	_, err = ParseDeltaTSpline(strings.NewReader(
+		"1000 1100 1 2 3 4\n1101 1200 1 2 3 4\n"))
+	if err == nil {
+		t.Errorf("%s: want error for a gap", fname)
+	}
+}
+
+func TestTtut1DT(t *testing.T) {
+	const fname = "Ttut1DT"
+	var m EspenakMeeus
+
+	dt := DeltaTAt(m, 2400000.5, 45678.9)
+	u1, u2, err := Ttut1DT(m, 2400000.5, 45678.9)
+	errT(t, nil, err, fname, "err")
+	vvd(t, u1, 2400000.5, 1e-6, fname, "ut11")
+	vvd(t, u2, 45678.9-dt/DAYSEC, 1e-12, fname, "ut12")
+
+	t1, t2, err := Ut1ttDT(m, u1, u2)
+	errT(t, nil, err, fname, "err")
+	vvd(t, t1, 2400000.5, 1e-6, fname, "tt1")
+	vvd(t, t2, 45678.9, 1e-11, fname, "tt2")
+}
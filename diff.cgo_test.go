@@ -0,0 +1,622 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+//go:generate go run ./internal/gendiff
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/8i8/sofa/en"
+)
+
+// The differential tests call each Go function and its Cgo twin with
+// the same inputs, drawn at random from physically meaningful domains
+// and their edges, and compare the results.  A normal test run only
+// replays the regression vectors in testdata/diff; the random search is
+// run with
+//
+//	go test -run TestDiff -diff -v
+//
+// which logs a report of the largest differences of each function, and
+// with -diff.record writes the inputs that differ to testdata/diff.
+// Inputs at which a function is ill-conditioned, and the known
+// deviations of Go from C, are counted apart and do not fail; the
+// triage of the differences found is in testdata/diff/REPORT.md.
+var (
+	diffRun    = flag.Bool("diff", false, "run the differential tests")
+	diffN      = flag.Int("diff.n", 1000, "inputs per function")
+	diffSeed   = flag.Int64("diff.seed", 1, "random seed")
+	diffRecord = flag.Bool("diff.record", false,
+		"record inputs that differ as regression vectors")
+	diffULP = flag.Uint64("diff.ulp", 1<<16,
+		"ULPs that two results may differ by")
+	diffAbs = flag.Float64("diff.abs", 1e-12,
+		"absolute difference below which results agree")
+)
+
+const diffDir = "testdata/diff"
+
+// diffPair is a Go function and its Cgo twin, the domains of the
+// arguments and which of the results are angles.
+type diffPair struct {
+	name   string
+	cgo    interface{}
+	gofn   interface{}
+	in     []string
+	angles []bool
+}
+
+// diffSpec is the domain of a scalar argument, uniform between lo and
+// hi, with a quarter of the draws taken from edges.
+type diffSpec struct {
+	lo, hi float64
+	edges  []float64
+}
+
+var diffSpecs = map[string]diffSpec{
+	"any": {-10.0, 10.0, []float64{0.0, -D2PI, DPI, 1e-300}},
+	"jd": {2305447.5, 2524593.5, []float64{
+		2457754.5, 2457204.5, 2441317.5, 2436934.5, 2400000.5,
+		2299160.5, 2451545.0, 2415020.5, 2488069.5, 0.0, -68569.5,
+		5373484.5}},
+	"fd":        {0.0, 1.0, []float64{0.0, 0.5, 1.0 - 1e-12, -1e-9}},
+	"epoch":     {1600.0, 2200.0, []float64{2000.0, 1950.0, 1900.0, -4000.0}},
+	"centuries": {-2.0, 2.0, []float64{0.0, -60.0, 60.0}},
+	"days":      {-1000.0, 1000.0, []float64{0.0, 1e-9}},
+	"deltat":    {30.0, 80.0, []float64{0.0, 69.184}},
+	"dut1":      {-0.9, 0.9, []float64{0.0, -0.99999}},
+	"ra":        {0.0, D2PI, []float64{0.0, DPI, D2PI, -DPI}},
+	"dec": {-DPI / 2, DPI / 2, []float64{0.0, DPI / 2, -DPI / 2,
+		DPI/2 - 1e-12, -DPI/2 + 1e-12}},
+	"pm":          {-1e-5, 1e-5, []float64{0.0}},
+	"px":          {0.0, 1.0, []float64{0.0, 1e-9, 1e-6, 2.0}},
+	"rv":          {-100.0, 100.0, []float64{0.0, 3e5}},
+	"height":      {0.0, 5000.0, []float64{0.0, -400.0, 1e5}},
+	"polar":       {-1e-6, 1e-6, []float64{0.0}},
+	"pressure":    {500.0, 1100.0, []float64{0.0, 1013.25}},
+	"temperature": {-20.0, 40.0, []float64{0.0, -150.0, 200.0}},
+	"humidity":    {0.0, 1.0, []float64{0.0, 1.0}},
+	"wavelength":  {0.3, 20.0, []float64{0.1, 100.0, 1e5}},
+	"refraction":  {0.0, 3e-4, []float64{0.0}},
+	"small":       {-1e-3, 1e-3, []float64{0.0}},
+	"obliquity":   {-0.5, 0.5, []float64{0.0, 0.4090928}},
+	"seconds":     {0.0, 60.0, []float64{0.0, 59.999999, 60.0, -1.0}},
+
+	// Integers.
+	"int":       {-10.0, 10.0, []float64{0.0}},
+	"ndp":       {-5.0, 9.0, []float64{-10.0, 0.0, 9.0}},
+	"year":      {-4000.0, 3000.0, []float64{-4799.0, -4800.0, 1972.0, 2016.0}},
+	"month":     {1.0, 12.0, []float64{0.0, 13.0}},
+	"day":       {1.0, 31.0, []float64{0.0, 29.0, 32.0}},
+	"hour":      {0.0, 23.0, []float64{-1.0, 24.0}},
+	"minute":    {0.0, 59.0, []float64{-1.0, 60.0}},
+	"degrees":   {0.0, 359.0, []float64{-1.0, 360.0}},
+	"planet":    {1.0, 8.0, []float64{0.0, 9.0}},
+	"ellipsoid": {1.0, 3.0, []float64{0.0, 4.0}},
+}
+
+// diffChoices are the values of the string and byte arguments.
+var diffChoices = map[string][]string{
+	"scale":   {"UTC", "TAI", "TT"},
+	"obstype": {"R", "H", "A", "r", "h", "a"},
+	"sign":    {"+", "-", " "},
+}
+
+func (s diffSpec) float(r *rand.Rand) float64 {
+	if len(s.edges) > 0 && r.Intn(4) == 0 {
+		return s.edges[r.Intn(len(s.edges))]
+	}
+	return s.lo + r.Float64()*(s.hi-s.lo)
+}
+
+func (s diffSpec) int(r *rand.Rand) int {
+	if len(s.edges) > 0 && r.Intn(4) == 0 {
+		return int(s.edges[r.Intn(len(s.edges))])
+	}
+	return int(s.lo) + r.Intn(int(s.hi-s.lo)+1)
+}
+
+// diffVector returns a vector of random direction and a modulus from
+// lo to hi, spread evenly in its logarithm.
+func diffVector(r *rand.Rand, lo, hi float64) (v [3]float64) {
+	switch r.Intn(8) {
+	case 0:
+		return
+	case 1:
+		v[2] = hi
+		return
+	}
+	m := lo * math.Pow(hi/lo, r.Float64())
+	v = S2c(r.Float64()*D2PI, math.Asin(2.0*r.Float64()-1.0))
+	return Sxp(m, v)
+}
+
+// diffInput returns a random value of the domain d and the type t.
+func diffInput(r *rand.Rand, d string, t reflect.Type) reflect.Value {
+	v := reflect.New(t).Elem()
+	switch d {
+	case "vector":
+		v.Set(reflect.ValueOf(diffVector(r, 1e-3, 1e3)))
+	case "pv":
+		p := diffVector(r, 0.1, 50.0)
+		u := diffVector(r, 1e-4, 0.03)
+		v.Set(reflect.ValueOf([2][3]float64{p, u}))
+	case "matrix":
+		m := Rz(r.Float64()*D2PI, Ry(r.Float64()*DPI-DPI/2,
+			Rx(r.Float64()*D2PI, Ir())))
+		v.Set(reflect.ValueOf(m))
+	case "astrom":
+		var astrom ASTROM
+		astrom, _, _ = Apco13(diffSpecs["jd"].lo+r.Float64()*73000.0,
+			r.Float64(), r.Float64()-0.5, r.Float64()*D2PI,
+			r.Float64()*DPI-DPI/2, r.Float64()*5000.0,
+			r.Float64()*1e-6, r.Float64()*1e-6, 500.0+600.0*r.Float64(),
+			r.Float64()*40.0, r.Float64(), 0.55, astrom)
+		v.Set(reflect.ValueOf(astrom))
+	case "bodies":
+		b, _ := Ldbodies(2415020.5+r.Float64()*73000.0, 0.0)
+		v.Set(reflect.ValueOf(b))
+	case "nbodies":
+		// Set from the bodies by diffInputs.
+	default:
+		if c, ok := diffChoices[d]; ok {
+			s := c[r.Intn(len(c))]
+			if t.Kind() == reflect.String {
+				v.SetString(s)
+			} else {
+				v.SetUint(uint64(s[0]))
+			}
+			break
+		}
+		s, ok := diffSpecs[d]
+		if !ok {
+			panic("no domain " + d)
+		}
+		if t.Kind() == reflect.Int {
+			v.SetInt(int64(s.int(r)))
+		} else {
+			v.SetFloat(s.float(r))
+		}
+	}
+	return v
+}
+
+// diffInputs returns random arguments for the pair p.
+func diffInputs(r *rand.Rand, p diffPair) []reflect.Value {
+	ft := reflect.TypeOf(p.gofn)
+	args := make([]reflect.Value, ft.NumIn())
+	for i := range args {
+		args[i] = diffInput(r, p.in[i], ft.In(i))
+	}
+	for i, d := range p.in {
+		if d != "nbodies" {
+			continue
+		}
+		for _, a := range args {
+			if b, ok := a.Interface().([]LDBODY); ok {
+				args[i].SetInt(int64(r.Intn(len(b) + 1)))
+			}
+		}
+	}
+	return args
+}
+
+// diffIllConditioned are the inputs at which a function is
+// ill-conditioned, so that Go and C may differ by more than the
+// tolerances through the last bit of a sine or cosine.  Such a
+// difference is counted but does not fail; testdata/diff/REPORT.md
+// gives the reason for each.
+var diffIllConditioned = map[string]func(a []reflect.Value) bool{
+	"A2af": func(a []reflect.Value) bool { return a[0].Int() >= 8 },
+	"A2tf": func(a []reflect.Value) bool { return a[0].Int() >= 8 },
+	"Pb06": func(a []reflect.Value) bool {
+		return math.Abs(a[0].Float()+a[1].Float()-DJ00) < 10.0*DJY
+	},
+	"Fk52h":  diffRelativistic(4, 5),
+	"H2fk5":  diffRelativistic(4, 5),
+	"Fk524":  diffRelativistic(4, 5),
+	"Fk425":  diffRelativistic(4, 5),
+	"Starpv": diffRelativistic(4, 5),
+	"Starpm": diffRelativistic(4, 5),
+	"Pmsafe": diffRelativistic(4, 5),
+	"Atoiq": diffObserved(0, 1, 2, func(a []reflect.Value) (float64,
+		float64) {
+		astrom := a[3].Interface().(ASTROM)
+		return math.Atan2(astrom.Sphi, astrom.Cphi), astrom.Eral
+	}),
+	"Atoi13": diffObserved(0, 1, 2, diffSite13),
+	"Atoc13": diffObserved(0, 1, 2, diffSite13),
+	"Atio13": diffObserved(-1, 0, 1, func(a []reflect.Value) (float64,
+		float64) {
+		return a[6].Float(), 0.0
+	}),
+}
+
+// diffRelativistic reports whether the star of parallax a[px] and
+// radial velocity a[rv] has no radial velocity, a radial velocity of
+// half c or more, or is so far that its proper motion is a large
+// fraction of c.
+func diffRelativistic(px, rv int) func(a []reflect.Value) bool {
+	return func(a []reflect.Value) bool {
+		v := math.Abs(a[rv].Float())
+		return v == 0.0 || v >= DC*DAU/DAYSEC/2e3 || a[px].Float() < 1e-5
+	}
+}
+
+// diffObserved reports whether the observed direction of type a[typ],
+// a[ob1] and a[ob2], is at or below the horizon, where the refraction
+// is unbounded, or at a pole, where the azimuth or hour angle is
+// undefined, or whether the site is at a pole.  A negative typ is for
+// CIRS RA,Dec, where only the poles are looked at.
+func diffObserved(typ, ob1, ob2 int,
+	site func(a []reflect.Value) (phi, eral float64)) func(
+	a []reflect.Value) bool {
+	pole := func(x float64) bool {
+		return math.Abs(math.Abs(x)-DPI/2) < 1e-9
+	}
+	return func(a []reflect.Value) bool {
+		phi, eral := site(a)
+		o1, o2 := a[ob1].Float(), a[ob2].Float()
+		if pole(phi) {
+			return true
+		}
+		var t byte = 'R'
+		if typ >= 0 {
+			t = a[typ].String()[0] | 0x20
+		}
+		if t == 'a' {
+			return math.Abs(o2) > 89.0*DD2R
+		}
+		if pole(o2) {
+			return true
+		}
+		if typ < 0 {
+			return false
+		}
+		ha := o1
+		if t == 'r' {
+			ha = eral - o1
+		}
+		cz := math.Sin(phi)*math.Sin(o2) +
+			math.Cos(phi)*math.Cos(o2)*math.Cos(ha)
+		return cz < math.Cos(89.0*DD2R)
+	}
+}
+
+// diffSite13 returns the latitude and the approximate local Earth
+// rotation angle of the arguments of Atoi13 and Atoc13.
+func diffSite13(a []reflect.Value) (phi, eral float64) {
+	return a[7].Float(), Era00(a[3].Float(), a[4].Float()) + a[6].Float()
+}
+
+// diffErrs numbers the errors that are not ErrNum, which the Go
+// functions and their Cgo twins return as the same sentinels.
+var diffErrs = map[string]float64{}
+
+// diffErrCode returns the SOFA status of err if it is an ErrNum, and
+// otherwise a number of its own for each message, so that a plain
+// warning is not taken for a plain error.
+func diffErrCode(err error) float64 {
+	var e en.ErrNum
+	if err == nil || errors.As(err, &e) {
+		return float64(en.Code(err))
+	}
+	msg := err.Error()
+	if _, ok := diffErrs[msg]; !ok {
+		diffErrs[msg] = float64(1000 + len(diffErrs))
+	}
+	return diffErrs[msg]
+}
+
+// diffKnown are the known deviations of the Go functions from C, at
+// which a difference is counted but does not fail.  Only the status,
+// the last result, may differ, and only by Go warning where C does not:
+// C returns the status of its last call of iauDat, or of iauJd2cal,
+// dropping an earlier "dubious year", whereas Go keeps the first
+// warning.
+var diffKnown = map[string]func(c, g []float64) bool{
+	"D2dtf":  diffFirstWarning(diffErrCode(errD2dtfWarn)),
+	"Utctai": diffFirstWarning(1),
+	"Taiutc": diffFirstWarning(1),
+	"Utcut1": diffFirstWarning(1),
+}
+
+// diffFirstWarning reports whether c and g differ only in a last
+// result that is warn for Go and 0 for C.
+func diffFirstWarning(warn float64) func(c, g []float64) bool {
+	return func(c, g []float64) bool {
+		n := len(g) - 1
+		if n < 0 || g[n] != warn || c[n] != 0 {
+			return false
+		}
+		for i := 0; i < n; i++ {
+			if ulps(c[i], g[i]) > *diffULP &&
+				!(math.Abs(c[i]-g[i]) <= *diffAbs) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// diffFlatten appends the scalars of v to out.
+func diffFlatten(v reflect.Value, out []float64) []float64 {
+	if v.Type() == reflect.TypeOf((*en.ErrNum)(nil)).Elem() ||
+		v.Type() == reflect.TypeOf((*error)(nil)).Elem() {
+		var err error
+		if !v.IsNil() {
+			err = v.Interface().(error)
+		}
+		return append(out, diffErrCode(err))
+	}
+	switch v.Kind() {
+	case reflect.Float64:
+		return append(out, v.Float())
+	case reflect.Int:
+		return append(out, float64(v.Int()))
+	case reflect.Uint8:
+		return append(out, float64(v.Uint()))
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			out = diffFlatten(v.Index(i), out)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			out = diffFlatten(v.Field(i), out)
+		}
+	}
+	return out
+}
+
+// ulps returns the number of float64 values between a and b.
+func ulps(a, b float64) uint64 {
+	if a == b || (math.IsNaN(a) && math.IsNaN(b)) {
+		return 0
+	}
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.MaxUint64
+	}
+	ord := func(x float64) int64 {
+		i := int64(math.Float64bits(x))
+		if i < 0 {
+			i = math.MinInt64 - i
+		}
+		return i
+	}
+	d := ord(a) - ord(b)
+	if d < 0 {
+		d = -d
+	}
+	return uint64(d)
+}
+
+// diffStats are the largest differences found for a pair.
+type diffStats struct {
+	name      string
+	cases     int
+	ulp       uint64
+	abs       float64
+	arcsec    float64
+	exact     int // results that must match exactly but do not
+	panics    int
+	differing int
+	illcond   int // differing inputs at which p is ill-conditioned
+	known     int // differing inputs that are known deviations
+}
+
+// diffCall calls f with args, returning its results flattened, with
+// the results that are angles marked, or the panic.
+func diffCall(f interface{}, args []reflect.Value, angles []bool) (
+	out []float64, angle, exact []bool, perr interface{}) {
+	defer func() {
+		perr = recover()
+	}()
+	for i, v := range reflect.ValueOf(f).Call(args) {
+		n := len(out)
+		out = diffFlatten(v, out)
+		k := v.Kind()
+		for j := n; j < len(out); j++ {
+			angle = append(angle, i < len(angles) && angles[i])
+			exact = append(exact, k != reflect.Float64 &&
+				k != reflect.Array && k != reflect.Struct)
+		}
+	}
+	return
+}
+
+// compare runs p with args and adds the differences to s.  It returns
+// false if the results differ by more than the tolerances.
+func (s *diffStats) compare(p diffPair, args []reflect.Value) bool {
+	s.cases++
+	cout, _, _, cerr := diffCall(p.cgo, args, p.angles)
+	gout, angle, exact, gerr := diffCall(p.gofn, args, p.angles)
+	if cerr != nil || gerr != nil {
+		s.panics++
+		s.differing++
+		return false
+	}
+	ok := true
+	for i := range cout {
+		c, g := cout[i], gout[i]
+		if exact[i] {
+			if c != g {
+				s.exact++
+				ok = false
+			}
+			continue
+		}
+		u := ulps(c, g)
+		if u == 0 {
+			continue
+		}
+		a := math.Abs(c - g)
+		if u > s.ulp {
+			s.ulp = u
+		}
+		if a > s.abs || math.IsNaN(a) {
+			s.abs = a
+		}
+		if angle[i] && a*DR2AS > s.arcsec {
+			s.arcsec = a * DR2AS
+		}
+		if u > *diffULP && !(a <= *diffAbs) {
+			ok = false
+		}
+	}
+	if !ok {
+		if known := diffKnown[p.name]; known != nil && known(cout, gout) {
+			s.known++
+			return true
+		}
+		if ill := diffIllConditioned[p.name]; ill != nil && ill(args) {
+			s.illcond++
+			return true
+		}
+		s.differing++
+	}
+	return ok
+}
+
+// diffVectors reads the regression vectors of a pair.
+func diffVectors(name string) ([][]json.RawMessage, error) {
+	b, err := ioutil.ReadFile(filepath.Join(diffDir, name+".json"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var vecs [][]json.RawMessage
+	err = json.Unmarshal(b, &vecs)
+	return vecs, err
+}
+
+// diffDecode returns the arguments of a regression vector.
+func diffDecode(p diffPair, vec []json.RawMessage) ([]reflect.Value,
+	error) {
+	ft := reflect.TypeOf(p.gofn)
+	if len(vec) != ft.NumIn() {
+		return nil, fmt.Errorf("%d arguments, want %d", len(vec),
+			ft.NumIn())
+	}
+	args := make([]reflect.Value, len(vec))
+	for i, raw := range vec {
+		v := reflect.New(ft.In(i))
+		if err := json.Unmarshal(raw, v.Interface()); err != nil {
+			return nil, err
+		}
+		args[i] = v.Elem()
+	}
+	return args, nil
+}
+
+// diffSave adds the arguments of failing cases to the regression
+// vectors of a pair.
+func diffSave(name string, failed [][]reflect.Value) error {
+	vecs, err := diffVectors(name)
+	if err != nil {
+		return err
+	}
+	for _, args := range failed {
+		var vec []json.RawMessage
+		for _, a := range args {
+			b, err := json.Marshal(a.Interface())
+			if err != nil {
+				return err
+			}
+			vec = append(vec, b)
+		}
+		vecs = append(vecs, vec)
+	}
+	b, err := json.MarshalIndent(vecs, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(diffDir, 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(diffDir, name+".json"),
+		append(b, '\n'), 0644)
+}
+
+func TestDiffRegression(t *testing.T) {
+	for _, p := range diffPairs {
+		vecs, err := diffVectors(p.name)
+		if err != nil {
+			t.Errorf("%s: %v", p.name, err)
+			continue
+		}
+		s := diffStats{name: p.name}
+		for i, vec := range vecs {
+			args, err := diffDecode(p, vec)
+			if err != nil {
+				t.Errorf("%s vector %d: %v", p.name, i, err)
+				continue
+			}
+			if !s.compare(p, args) {
+				t.Errorf("%s vector %d: Go and C differ: %v", p.name,
+					i, args)
+			}
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	if !*diffRun {
+		t.Skip("run with -diff")
+	}
+	var stats []diffStats
+	for i, p := range diffPairs {
+		r := rand.New(rand.NewSource(*diffSeed + int64(i)))
+		s := diffStats{name: p.name}
+		var failed [][]reflect.Value
+		for n := 0; n < *diffN; n++ {
+			args := diffInputs(r, p)
+			if !s.compare(p, args) && len(failed) < 10 {
+				failed = append(failed, args)
+			}
+		}
+		if len(failed) > 0 {
+			t.Errorf("%s: Go and C differ for %d of %d inputs",
+				p.name, s.differing, s.cases)
+			if *diffRecord {
+				if err := diffSave(p.name, failed); err != nil {
+					t.Error(err)
+				}
+			}
+		}
+		stats = append(stats, s)
+	}
+
+	sort.SliceStable(stats, func(i, j int) bool {
+		if stats[i].differing != stats[j].differing {
+			return stats[i].differing > stats[j].differing
+		}
+		return stats[i].ulp > stats[j].ulp
+	})
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n%-10s %6s %6s %6s %6s %6s %6s %12s %10s %10s\n",
+		"function", "cases", "differ", "ill", "known", "status",
+		"panic", "max ulp", "max abs", "arcsec")
+	for _, s := range stats {
+		fmt.Fprintf(&b,
+			"%-10s %6d %6d %6d %6d %6d %6d %12d %10.3g %10.3g\n",
+			s.name, s.cases, s.differing, s.illcond, s.known, s.exact,
+			s.panics, s.ulp, s.abs, s.arcsec)
+	}
+	t.Log(b.String())
+}
@@ -0,0 +1,732 @@
+// Code generated by gendiff; DO NOT EDIT.
+
+//go:build cgo
+// +build cgo
+
+package sofa
+
+var diffPairs = []diffPair{
+	{"A2af", CgoA2af, A2af,
+		[]string{"ndp", "any"},
+		[]bool{false, false}},
+	{"A2tf", CgoA2tf, A2tf,
+		[]string{"ndp", "any"},
+		[]bool{false, false}},
+	{"Ab", CgoAb, Ab,
+		[]string{"vector", "vector", "small", "any"},
+		[]bool{false}},
+	{"Ae2hd", CgoAe2hd, Ae2hd,
+		[]string{"ra", "ra", "dec"},
+		[]bool{true, true}},
+	{"Af2a", CgoAf2a, Af2a,
+		[]string{"sign", "degrees", "minute", "seconds"},
+		[]bool{true, false}},
+	{"Anp", CgoAnp, Anp,
+		[]string{"ra"},
+		[]bool{false}},
+	{"Anpm", CgoAnpm, Anpm,
+		[]string{"ra"},
+		[]bool{false}},
+	{"Apcg", CgoApcg, Apcg,
+		[]string{"jd", "fd", "pv", "vector", "astrom"},
+		[]bool{false}},
+	{"Apcg13", CgoApcg13, Apcg13,
+		[]string{"jd", "fd", "astrom"},
+		[]bool{false}},
+	{"Apci", CgoApci, Apci,
+		[]string{"jd", "fd", "pv", "vector", "small", "small", "small", "astrom"},
+		[]bool{false}},
+	{"Apci13", CgoApci13, Apci13,
+		[]string{"jd", "fd", "astrom"},
+		[]bool{false, false}},
+	{"Apco", CgoApco, Apco,
+		[]string{"jd", "fd", "pv", "vector", "small", "small", "small", "ra", "ra", "dec", "height", "polar", "polar", "polar", "refraction", "refraction", "astrom"},
+		[]bool{false}},
+	{"Apco13", CgoApco13, Apco13,
+		[]string{"jd", "fd", "dut1", "ra", "dec", "height", "polar", "polar", "pressure", "temperature", "humidity", "wavelength", "astrom"},
+		[]bool{false, false, false}},
+	{"Apcs", CgoApcs, Apcs,
+		[]string{"jd", "fd", "pv", "pv", "vector", "astrom"},
+		[]bool{false}},
+	{"Apcs13", CgoApcs13, Apcs13,
+		[]string{"jd", "fd", "pv", "astrom"},
+		[]bool{false}},
+	{"Aper", CgoAper, Aper,
+		[]string{"ra", "astrom"},
+		[]bool{false}},
+	{"Aper13", CgoAper13, Aper13,
+		[]string{"jd", "fd", "astrom"},
+		[]bool{false}},
+	{"Apio", CgoApio, Apio,
+		[]string{"polar", "ra", "ra", "dec", "height", "polar", "polar", "refraction", "refraction", "astrom"},
+		[]bool{false}},
+	{"Apio13", CgoApio13, Apio13,
+		[]string{"jd", "fd", "dut1", "ra", "dec", "height", "polar", "polar", "pressure", "temperature", "humidity", "wavelength", "astrom"},
+		[]bool{false, false}},
+	{"Atcc13", CgoAtcc13, Atcc13,
+		[]string{"ra", "dec", "pm", "pm", "px", "rv", "jd", "fd"},
+		[]bool{true, false}},
+	{"Atccq", CgoAtccq, Atccq,
+		[]string{"ra", "dec", "pm", "pm", "px", "rv", "astrom"},
+		[]bool{true, false}},
+	{"Atci13", CgoAtci13, Atci13,
+		[]string{"ra", "dec", "pm", "pm", "px", "rv", "jd", "fd"},
+		[]bool{true, true, true}},
+	{"Atciq", CgoAtciq, Atciq,
+		[]string{"ra", "dec", "pm", "pm", "px", "rv", "astrom"},
+		[]bool{true, true}},
+	{"Atciqn", CgoAtciqn, Atciqn,
+		[]string{"ra", "dec", "pm", "pm", "px", "rv", "astrom", "nbodies", "bodies"},
+		[]bool{true, true}},
+	{"Atciqz", CgoAtciqz, Atciqz,
+		[]string{"ra", "dec", "astrom"},
+		[]bool{true, true}},
+	{"Atco13", CgoAtco13, Atco13,
+		[]string{"ra", "dec", "pm", "pm", "px", "rv", "jd", "fd", "dut1", "ra", "dec", "height", "polar", "polar", "pressure", "temperature", "humidity", "wavelength"},
+		[]bool{true, true, true, true, true, true, false}},
+	{"Atic13", CgoAtic13, Atic13,
+		[]string{"ra", "dec", "jd", "fd"},
+		[]bool{true, true, true}},
+	{"Aticq", CgoAticq, Aticq,
+		[]string{"ra", "dec", "astrom"},
+		[]bool{true, true}},
+	{"Aticqn", CgoAticqn, Aticqn,
+		[]string{"ra", "dec", "astrom", "nbodies", "bodies"},
+		[]bool{true, true}},
+	{"Atio13", CgoAtio13, Atio13,
+		[]string{"ra", "dec", "jd", "fd", "dut1", "ra", "dec", "height", "polar", "polar", "pressure", "temperature", "humidity", "wavelength"},
+		[]bool{true, true, true, true, true, false}},
+	{"Atioq", CgoAtioq, Atioq,
+		[]string{"ra", "dec", "astrom"},
+		[]bool{true, true, true, true, true}},
+	{"Atoc13", CgoAtoc13, Atoc13,
+		[]string{"obstype", "ra", "dec", "jd", "fd", "dut1", "ra", "dec", "height", "polar", "polar", "pressure", "temperature", "humidity", "wavelength"},
+		[]bool{true, true, false}},
+	{"Atoi13", CgoAtoi13, Atoi13,
+		[]string{"obstype", "ra", "dec", "jd", "fd", "dut1", "ra", "dec", "height", "polar", "polar", "pressure", "temperature", "humidity", "wavelength"},
+		[]bool{true, true, false}},
+	{"Atoiq", CgoAtoiq, Atoiq,
+		[]string{"obstype", "ra", "dec", "astrom"},
+		[]bool{true, true}},
+	{"Bi00", CgoBi00, Bi00,
+		[]string{"small", "small", "pm"},
+		[]bool{true, true, false}},
+	{"Bp00", CgoBp00, Bp00,
+		[]string{"jd", "fd"},
+		[]bool{false, false, false}},
+	{"Bp06", CgoBp06, Bp06,
+		[]string{"jd", "fd"},
+		[]bool{false, false, false}},
+	{"Bpn2xy", CgoBpn2xy, Bpn2xy,
+		[]string{"matrix"},
+		[]bool{true, true}},
+	{"C2i00a", CgoC2i00a, C2i00a,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"C2i00b", CgoC2i00b, C2i00b,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"C2i06a", CgoC2i06a, C2i06a,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"C2ibpn", CgoC2ibpn, C2ibpn,
+		[]string{"jd", "fd", "matrix"},
+		[]bool{false}},
+	{"C2ixy", CgoC2ixy, C2ixy,
+		[]string{"jd", "fd", "small", "small"},
+		[]bool{false}},
+	{"C2ixys", CgoC2ixys, C2ixys,
+		[]string{"small", "small", "small"},
+		[]bool{false}},
+	{"C2s", CgoC2s, C2s,
+		[]string{"vector"},
+		[]bool{true, true}},
+	{"C2t00a", CgoC2t00a, C2t00a,
+		[]string{"jd", "fd", "jd", "fd", "polar", "polar"},
+		[]bool{false}},
+	{"C2t00b", CgoC2t00b, C2t00b,
+		[]string{"jd", "fd", "jd", "fd", "polar", "polar"},
+		[]bool{false}},
+	{"C2t06a", CgoC2t06a, C2t06a,
+		[]string{"jd", "fd", "jd", "fd", "polar", "polar"},
+		[]bool{false}},
+	{"C2tcio", CgoC2tcio, C2tcio,
+		[]string{"matrix", "ra", "matrix"},
+		[]bool{false}},
+	{"C2teqx", CgoC2teqx, C2teqx,
+		[]string{"matrix", "ra", "matrix"},
+		[]bool{false}},
+	{"C2tpe", CgoC2tpe, C2tpe,
+		[]string{"jd", "fd", "jd", "fd", "small", "small", "polar", "polar"},
+		[]bool{false}},
+	{"C2txy", CgoC2txy, C2txy,
+		[]string{"jd", "fd", "jd", "fd", "small", "small", "polar", "polar"},
+		[]bool{false}},
+	{"Cal2jd", CgoCal2jd, Cal2jd,
+		[]string{"year", "month", "day"},
+		[]bool{false, false, false}},
+	{"D2dtf", CgoD2dtf, D2dtf,
+		[]string{"scale", "ndp", "jd", "fd"},
+		[]bool{false, false, false, false, false}},
+	{"D2tf", CgoD2tf, D2tf,
+		[]string{"ndp", "days"},
+		[]bool{false, false}},
+	{"Dat", CgoDat, Dat,
+		[]string{"year", "month", "day", "fd"},
+		[]bool{false, false}},
+	{"Dtdb", CgoDtdb, Dtdb,
+		[]string{"jd", "fd", "any", "ra", "any", "any"},
+		[]bool{false}},
+	{"Dtf2d", CgoDtf2d, Dtf2d,
+		[]string{"scale", "year", "month", "day", "hour", "minute", "seconds"},
+		[]bool{false, false, false}},
+	{"Eceq06", CgoEceq06, Eceq06,
+		[]string{"jd", "fd", "ra", "dec"},
+		[]bool{true, true}},
+	{"Ecm06", CgoEcm06, Ecm06,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Ee00", CgoEe00, Ee00,
+		[]string{"jd", "fd", "obliquity", "small"},
+		[]bool{false}},
+	{"Ee00a", CgoEe00a, Ee00a,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Ee00b", CgoEe00b, Ee00b,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Ee06a", CgoEe06a, Ee06a,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Eect00", CgoEect00, Eect00,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Eform", CgoEform, Eform,
+		[]string{"ellipsoid"},
+		[]bool{true, false, false}},
+	{"Eo06a", CgoEo06a, Eo06a,
+		[]string{"jd", "fd"},
+		[]bool{true}},
+	{"Eors", CgoEors, Eors,
+		[]string{"matrix", "small"},
+		[]bool{true}},
+	{"Epb", CgoEpb, Epb,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Epb2jd", CgoEpb2jd, Epb2jd,
+		[]string{"epoch"},
+		[]bool{false, false}},
+	{"Epj", CgoEpj, Epj,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Epj2jd", CgoEpj2jd, Epj2jd,
+		[]string{"epoch"},
+		[]bool{false, false}},
+	{"Epv00", CgoEpv00, Epv00,
+		[]string{"jd", "fd"},
+		[]bool{false, false, false}},
+	{"Eqec06", CgoEqec06, Eqec06,
+		[]string{"jd", "fd", "ra", "dec"},
+		[]bool{true, true}},
+	{"Eqeq94", CgoEqeq94, Eqeq94,
+		[]string{"jd", "fd"},
+		[]bool{true}},
+	{"Era00", CgoEra00, Era00,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Fad03", CgoFad03, Fad03,
+		[]string{"centuries"},
+		[]bool{false}},
+	{"Fae03", CgoFae03, Fae03,
+		[]string{"centuries"},
+		[]bool{false}},
+	{"Faf03", CgoFaf03, Faf03,
+		[]string{"centuries"},
+		[]bool{false}},
+	{"Faju03", CgoFaju03, Faju03,
+		[]string{"centuries"},
+		[]bool{false}},
+	{"Fal03", CgoFal03, Fal03,
+		[]string{"centuries"},
+		[]bool{false}},
+	{"Falp03", CgoFalp03, Falp03,
+		[]string{"centuries"},
+		[]bool{false}},
+	{"Fama03", CgoFama03, Fama03,
+		[]string{"centuries"},
+		[]bool{false}},
+	{"Fame03", CgoFame03, Fame03,
+		[]string{"centuries"},
+		[]bool{false}},
+	{"Fane03", CgoFane03, Fane03,
+		[]string{"centuries"},
+		[]bool{false}},
+	{"Faom03", CgoFaom03, Faom03,
+		[]string{"centuries"},
+		[]bool{false}},
+	{"Fapa03", CgoFapa03, Fapa03,
+		[]string{"centuries"},
+		[]bool{false}},
+	{"Fasa03", CgoFasa03, Fasa03,
+		[]string{"centuries"},
+		[]bool{false}},
+	{"Faur03", CgoFaur03, Faur03,
+		[]string{"centuries"},
+		[]bool{false}},
+	{"Fave03", CgoFave03, Fave03,
+		[]string{"centuries"},
+		[]bool{false}},
+	{"Fk425", CgoFk425, Fk425,
+		[]string{"ra", "dec", "pm", "pm", "px", "rv"},
+		[]bool{true, true, false, false, false, false}},
+	{"Fk45z", CgoFk45z, Fk45z,
+		[]string{"ra", "dec", "epoch"},
+		[]bool{true, true}},
+	{"Fk524", CgoFk524, Fk524,
+		[]string{"ra", "dec", "pm", "pm", "px", "rv"},
+		[]bool{true, true, false, false, false, false}},
+	{"Fk52h", CgoFk52h, Fk52h,
+		[]string{"ra", "dec", "pm", "pm", "px", "rv"},
+		[]bool{true, true, false, false, false, false}},
+	{"Fk54z", CgoFk54z, Fk54z,
+		[]string{"ra", "dec", "epoch"},
+		[]bool{true, true, false, false}},
+	{"Fk5hip", CgoFk5hip, Fk5hip,
+		[]string(nil),
+		[]bool{false, false}},
+	{"Fk5hz", CgoFk5hz, Fk5hz,
+		[]string{"ra", "dec", "jd", "fd"},
+		[]bool{true, true}},
+	{"Fw2m", CgoFw2m, Fw2m,
+		[]string{"obliquity", "obliquity", "obliquity", "obliquity"},
+		[]bool{false}},
+	{"Fw2xy", CgoFw2xy, Fw2xy,
+		[]string{"obliquity", "obliquity", "obliquity", "obliquity"},
+		[]bool{true, true}},
+	{"G2icrs", CgoG2icrs, G2icrs,
+		[]string{"ra", "dec"},
+		[]bool{true, true}},
+	{"Gc2gd", CgoGc2gd, Gc2gd,
+		[]string{"ellipsoid", "vector"},
+		[]bool{true, true, false, false}},
+	{"Gc2gde", CgoGc2gde, Gc2gde,
+		[]string{"ra", "any", "vector"},
+		[]bool{true, true, false, false}},
+	{"Gd2gc", CgoGd2gc, Gd2gc,
+		[]string{"ellipsoid", "ra", "dec", "height"},
+		[]bool{false, false}},
+	{"Gd2gce", CgoGd2gce, Gd2gce,
+		[]string{"ra", "any", "ra", "dec", "height"},
+		[]bool{false, false}},
+	{"Gmst00", CgoGmst00, Gmst00,
+		[]string{"jd", "fd", "jd", "fd"},
+		[]bool{false}},
+	{"Gmst06", CgoGmst06, Gmst06,
+		[]string{"jd", "fd", "jd", "fd"},
+		[]bool{false}},
+	{"Gmst82", CgoGmst82, Gmst82,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Gst00a", CgoGst00a, Gst00a,
+		[]string{"jd", "fd", "jd", "fd"},
+		[]bool{true}},
+	{"Gst00b", CgoGst00b, Gst00b,
+		[]string{"jd", "fd"},
+		[]bool{true}},
+	{"Gst06", CgoGst06, Gst06,
+		[]string{"jd", "fd", "jd", "fd", "matrix"},
+		[]bool{false}},
+	{"Gst06a", CgoGst06a, Gst06a,
+		[]string{"jd", "fd", "jd", "fd"},
+		[]bool{false}},
+	{"Gst94", CgoGst94, Gst94,
+		[]string{"jd", "fd"},
+		[]bool{true}},
+	{"H2fk5", CgoH2fk5, H2fk5,
+		[]string{"humidity", "any", "pm", "pm", "px", "rv"},
+		[]bool{true, true, false, false, false, false}},
+	{"Hd2ae", CgoHd2ae, Hd2ae,
+		[]string{"ra", "dec", "dec"},
+		[]bool{true, true}},
+	{"Hd2pa", CgoHd2pa, Hd2pa,
+		[]string{"ra", "dec", "dec"},
+		[]bool{false}},
+	{"Hfk5z", CgoHfk5z, Hfk5z,
+		[]string{"humidity", "any", "jd", "fd"},
+		[]bool{true, true, false, false}},
+	{"Icrs2g", CgoIcrs2g, Icrs2g,
+		[]string{"ra", "dec"},
+		[]bool{true, true}},
+	{"Ir", CgoIr, Ir,
+		[]string(nil),
+		[]bool{false}},
+	{"Jd2cal", CgoJd2cal, Jd2cal,
+		[]string{"jd", "fd"},
+		[]bool{false, false, false, false, false}},
+	{"Jdcalf", CgoJdcalf, Jdcalf,
+		[]string{"ndp", "jd", "fd"},
+		[]bool{false, false}},
+	{"Ld", CgoLd, Ld,
+		[]string{"any", "vector", "vector", "vector", "any", "small"},
+		[]bool{false}},
+	{"Ldn", CgoLdn, Ldn,
+		[]string{"nbodies", "bodies", "vector", "vector"},
+		[]bool{false}},
+	{"Ldsun", CgoLdsun, Ldsun,
+		[]string{"vector", "vector", "any"},
+		[]bool{false}},
+	{"Lteceq", CgoLteceq, Lteceq,
+		[]string{"epoch", "ra", "dec"},
+		[]bool{true, true}},
+	{"Ltecm", CgoLtecm, Ltecm,
+		[]string{"epoch"},
+		[]bool{false}},
+	{"Lteqec", CgoLteqec, Lteqec,
+		[]string{"epoch", "ra", "dec"},
+		[]bool{true, true}},
+	{"Ltp", CgoLtp, Ltp,
+		[]string{"epoch"},
+		[]bool{false}},
+	{"Ltpb", CgoLtpb, Ltpb,
+		[]string{"epoch"},
+		[]bool{false}},
+	{"Ltpecl", CgoLtpecl, Ltpecl,
+		[]string{"epoch"},
+		[]bool{false}},
+	{"Ltpequ", CgoLtpequ, Ltpequ,
+		[]string{"epoch"},
+		[]bool{false}},
+	{"Moon98", CgoMoon98, Moon98,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Num00a", CgoNum00a, Num00a,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Num00b", CgoNum00b, Num00b,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Num06a", CgoNum06a, Num06a,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Numat", CgoNumat, Numat,
+		[]string{"obliquity", "small", "small"},
+		[]bool{false}},
+	{"Nut00a", CgoNut00a, Nut00a,
+		[]string{"jd", "fd"},
+		[]bool{true, true}},
+	{"Nut00b", CgoNut00b, Nut00b,
+		[]string{"jd", "fd"},
+		[]bool{true, true}},
+	{"Nut06a", CgoNut06a, Nut06a,
+		[]string{"jd", "fd"},
+		[]bool{true, true}},
+	{"Nut80", CgoNut80, Nut80,
+		[]string{"jd", "fd"},
+		[]bool{true, true}},
+	{"Nutm80", CgoNutm80, Nutm80,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Obl06", CgoObl06, Obl06,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Obl80", CgoObl80, Obl80,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"P06e", CgoP06e, P06e,
+		[]string{"jd", "fd"},
+		[]bool{false, false, false, false, false, false, false, true, false, false, false, false, false, false, true, true}},
+	{"P2pv", CgoP2pv, P2pv,
+		[]string{"vector"},
+		[]bool{false}},
+	{"P2s", CgoP2s, P2s,
+		[]string{"vector"},
+		[]bool{true, true, false}},
+	{"Pap", CgoPap, Pap,
+		[]string{"vector", "vector"},
+		[]bool{false}},
+	{"Pas", CgoPas, Pas,
+		[]string{"ra", "dec", "ra", "dec"},
+		[]bool{false}},
+	{"Pb06", CgoPb06, Pb06,
+		[]string{"jd", "fd"},
+		[]bool{false, false, false}},
+	{"Pdp", CgoPdp, Pdp,
+		[]string{"vector", "vector"},
+		[]bool{false}},
+	{"Pfw06", CgoPfw06, Pfw06,
+		[]string{"jd", "fd"},
+		[]bool{true, true, true, true}},
+	{"Plan94", CgoPlan94, Plan94,
+		[]string{"jd", "fd", "planet"},
+		[]bool{false, false}},
+	{"Pm", CgoPm, Pm,
+		[]string{"vector"},
+		[]bool{false}},
+	{"Pmat00", CgoPmat00, Pmat00,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Pmat06", CgoPmat06, Pmat06,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Pmat76", CgoPmat76, Pmat76,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Pmp", CgoPmp, Pmp,
+		[]string{"vector", "vector"},
+		[]bool{false}},
+	{"Pmpx", CgoPmpx, Pmpx,
+		[]string{"ra", "dec", "pm", "pm", "px", "rv", "any", "vector"},
+		[]bool{false}},
+	{"Pmsafe", CgoPmsafe, Pmsafe,
+		[]string{"ra", "dec", "pm", "pm", "px", "rv", "jd", "fd", "jd", "fd"},
+		[]bool{false, false, false, false, false, false, false}},
+	{"Pn", CgoPn, Pn,
+		[]string{"vector"},
+		[]bool{false, false}},
+	{"Pn00", CgoPn00, Pn00,
+		[]string{"jd", "fd", "small", "small"},
+		[]bool{true, false, false, false, false, false}},
+	{"Pn00a", CgoPn00a, Pn00a,
+		[]string{"jd", "fd"},
+		[]bool{true, true, true, false, false, false, false, false}},
+	{"Pn00b", CgoPn00b, Pn00b,
+		[]string{"jd", "fd"},
+		[]bool{true, true, true, false, false, false, false, false}},
+	{"Pn06", CgoPn06, Pn06,
+		[]string{"jd", "fd", "small", "small"},
+		[]bool{true, false, false, false, false, false}},
+	{"Pn06a", CgoPn06a, Pn06a,
+		[]string{"jd", "fd"},
+		[]bool{true, true, true, false, false, false, false, false}},
+	{"Pnm00a", CgoPnm00a, Pnm00a,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Pnm00b", CgoPnm00b, Pnm00b,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Pnm06a", CgoPnm06a, Pnm06a,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Pnm80", CgoPnm80, Pnm80,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Pom00", CgoPom00, Pom00,
+		[]string{"polar", "polar", "polar"},
+		[]bool{false}},
+	{"Ppp", CgoPpp, Ppp,
+		[]string{"vector", "vector"},
+		[]bool{false}},
+	{"Ppsp", CgoPpsp, Ppsp,
+		[]string{"vector", "any", "vector"},
+		[]bool{false}},
+	{"Pr00", CgoPr00, Pr00,
+		[]string{"jd", "fd"},
+		[]bool{false, false}},
+	{"Prec76", CgoPrec76, Prec76,
+		[]string{"jd", "fd", "jd", "fd"},
+		[]bool{false, false, true}},
+	{"Pv2p", CgoPv2p, Pv2p,
+		[]string{"pv"},
+		[]bool{false}},
+	{"Pv2s", CgoPv2s, Pv2s,
+		[]string{"pv"},
+		[]bool{true, true, false, false, false, false}},
+	{"Pvdpv", CgoPvdpv, Pvdpv,
+		[]string{"pv", "pv"},
+		[]bool{false}},
+	{"Pvm", CgoPvm, Pvm,
+		[]string{"pv"},
+		[]bool{false, true}},
+	{"Pvmpv", CgoPvmpv, Pvmpv,
+		[]string{"pv", "pv"},
+		[]bool{false}},
+	{"Pvppv", CgoPvppv, Pvppv,
+		[]string{"pv", "pv"},
+		[]bool{false}},
+	{"Pvstar", CgoPvstar, Pvstar,
+		[]string{"pv"},
+		[]bool{true, true, false, false, false, false, false}},
+	{"Pvtob", CgoPvtob, Pvtob,
+		[]string{"ra", "dec", "height", "polar", "polar", "polar", "ra"},
+		[]bool{false}},
+	{"Pvu", CgoPvu, Pvu,
+		[]string{"days", "pv"},
+		[]bool{false}},
+	{"Pvup", CgoPvup, Pvup,
+		[]string{"days", "pv"},
+		[]bool{false}},
+	{"Pvxpv", CgoPvxpv, Pvxpv,
+		[]string{"pv", "pv"},
+		[]bool{false}},
+	{"Pxp", CgoPxp, Pxp,
+		[]string{"vector", "vector"},
+		[]bool{false}},
+	{"Refco", CgoRefco, Refco,
+		[]string{"pressure", "temperature", "humidity", "wavelength"},
+		[]bool{false, false}},
+	{"Rm2v", CgoRm2v, Rm2v,
+		[]string{"matrix"},
+		[]bool{false}},
+	{"Rv2m", CgoRv2m, Rv2m,
+		[]string{"vector"},
+		[]bool{false}},
+	{"Rx", CgoRx, Rx,
+		[]string{"dec", "matrix"},
+		[]bool{false}},
+	{"Rxp", CgoRxp, Rxp,
+		[]string{"matrix", "vector"},
+		[]bool{false}},
+	{"Rxpv", CgoRxpv, Rxpv,
+		[]string{"matrix", "pv"},
+		[]bool{false}},
+	{"Rxr", CgoRxr, Rxr,
+		[]string{"matrix", "matrix"},
+		[]bool{false}},
+	{"Ry", CgoRy, Ry,
+		[]string{"ra", "matrix"},
+		[]bool{false}},
+	{"Rz", CgoRz, Rz,
+		[]string{"obliquity", "matrix"},
+		[]bool{false}},
+	{"S00", CgoS00, S00,
+		[]string{"jd", "fd", "small", "small"},
+		[]bool{false}},
+	{"S00a", CgoS00a, S00a,
+		[]string{"jd", "fd"},
+		[]bool{true}},
+	{"S00b", CgoS00b, S00b,
+		[]string{"jd", "fd"},
+		[]bool{true}},
+	{"S06", CgoS06, S06,
+		[]string{"jd", "fd", "small", "small"},
+		[]bool{true}},
+	{"S06a", CgoS06a, S06a,
+		[]string{"jd", "fd"},
+		[]bool{true}},
+	{"S2c", CgoS2c, S2c,
+		[]string{"ra", "dec"},
+		[]bool{false}},
+	{"S2p", CgoS2p, S2p,
+		[]string{"ra", "dec", "any"},
+		[]bool{false}},
+	{"S2pv", CgoS2pv, S2pv,
+		[]string{"ra", "dec", "any", "any", "pm", "ra"},
+		[]bool{false}},
+	{"S2xpv", CgoS2xpv, S2xpv,
+		[]string{"any", "any", "pv"},
+		[]bool{false}},
+	{"Sepp", CgoSepp, Sepp,
+		[]string{"vector", "vector"},
+		[]bool{false}},
+	{"Seps", CgoSeps, Seps,
+		[]string{"ra", "dec", "ra", "dec"},
+		[]bool{false}},
+	{"Sp00", CgoSp00, Sp00,
+		[]string{"jd", "fd"},
+		[]bool{false}},
+	{"Starpm", CgoStarpm, Starpm,
+		[]string{"ra", "dec", "pm", "pm", "px", "rv", "jd", "fd", "jd", "fd"},
+		[]bool{false, false, false, false, false, false, false}},
+	{"Starpv", CgoStarpv, Starpv,
+		[]string{"ra", "dec", "pm", "pm", "px", "rv"},
+		[]bool{false, false}},
+	{"Sxp", CgoSxp, Sxp,
+		[]string{"any", "vector"},
+		[]bool{true}},
+	{"Sxpv", CgoSxpv, Sxpv,
+		[]string{"any", "pv"},
+		[]bool{false}},
+	{"Taitt", CgoTaitt, Taitt,
+		[]string{"jd", "fd"},
+		[]bool{false, false, false}},
+	{"Taiut1", CgoTaiut1, Taiut1,
+		[]string{"jd", "fd", "dut1"},
+		[]bool{false, false, false}},
+	{"Taiutc", CgoTaiutc, Taiutc,
+		[]string{"jd", "fd"},
+		[]bool{false, false, false}},
+	{"Tcbtdb", CgoTcbtdb, Tcbtdb,
+		[]string{"jd", "fd"},
+		[]bool{false, false, false}},
+	{"Tcgtt", CgoTcgtt, Tcgtt,
+		[]string{"jd", "fd"},
+		[]bool{false, false, false}},
+	{"Tdbtcb", CgoTdbtcb, Tdbtcb,
+		[]string{"jd", "fd"},
+		[]bool{false, false, false}},
+	{"Tdbtt", CgoTdbtt, Tdbtt,
+		[]string{"jd", "fd", "any"},
+		[]bool{false, false, false}},
+	{"Tf2a", CgoTf2a, Tf2a,
+		[]string{"sign", "hour", "minute", "seconds"},
+		[]bool{true, false}},
+	{"Tf2d", CgoTf2d, Tf2d,
+		[]string{"sign", "hour", "minute", "seconds"},
+		[]bool{false, false}},
+	{"Tpors", CgoTpors, Tpors,
+		[]string{"small", "small", "ra", "dec"},
+		[]bool{false, false, false, false, false}},
+	{"Tporv", CgoTporv, Tporv,
+		[]string{"small", "small", "vector"},
+		[]bool{false, false, false}},
+	{"Tpsts", CgoTpsts, Tpsts,
+		[]string{"small", "small", "ra", "dec"},
+		[]bool{true, true}},
+	{"Tpstv", CgoTpstv, Tpstv,
+		[]string{"small", "small", "vector"},
+		[]bool{false}},
+	{"Tpxes", CgoTpxes, Tpxes,
+		[]string{"ra", "dec", "ra", "dec"},
+		[]bool{true, true, false}},
+	{"Tpxev", CgoTpxev, Tpxev,
+		[]string{"vector", "vector"},
+		[]bool{true, true, false}},
+	{"Tr", CgoTr, Tr,
+		[]string{"matrix"},
+		[]bool{false}},
+	{"Trxp", CgoTrxp, Trxp,
+		[]string{"matrix", "vector"},
+		[]bool{false}},
+	{"Trxpv", CgoTrxpv, Trxpv,
+		[]string{"matrix", "pv"},
+		[]bool{false}},
+	{"Tttai", CgoTttai, Tttai,
+		[]string{"jd", "fd"},
+		[]bool{false, false, false}},
+	{"Tttcg", CgoTttcg, Tttcg,
+		[]string{"jd", "fd"},
+		[]bool{false, false, false}},
+	{"Tttdb", CgoTttdb, Tttdb,
+		[]string{"jd", "fd", "any"},
+		[]bool{false, false, false}},
+	{"Ttut1", CgoTtut1, Ttut1,
+		[]string{"jd", "fd", "deltat"},
+		[]bool{false, false, false}},
+	{"Ut1tai", CgoUt1tai, Ut1tai,
+		[]string{"jd", "fd", "dut1"},
+		[]bool{false, false, false}},
+	{"Ut1tt", CgoUt1tt, Ut1tt,
+		[]string{"jd", "fd", "deltat"},
+		[]bool{false, false, false}},
+	{"Ut1utc", CgoUt1utc, Ut1utc,
+		[]string{"jd", "fd", "dut1"},
+		[]bool{false, false, false}},
+	{"Utctai", CgoUtctai, Utctai,
+		[]string{"jd", "fd"},
+		[]bool{false, false, false}},
+	{"Utcut1", CgoUtcut1, Utcut1,
+		[]string{"jd", "fd", "dut1"},
+		[]bool{false, false, false}},
+	{"Xy06", CgoXy06, Xy06,
+		[]string{"jd", "fd"},
+		[]bool{true, true}},
+	{"Xys00a", CgoXys00a, Xys00a,
+		[]string{"jd", "fd"},
+		[]bool{true, true, true}},
+	{"Xys00b", CgoXys00b, Xys00b,
+		[]string{"jd", "fd"},
+		[]bool{true, true, true}},
+	{"Xys06a", CgoXys06a, Xys06a,
+		[]string{"jd", "fd"},
+		[]bool{true, true, true}},
+}
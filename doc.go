@@ -0,0 +1,10 @@
+// Package sofa is a Go translation of the IAU SOFA (Standards of
+// Fundamental Astronomy) library.
+//
+// The functions have the names of their SOFA counterparts without the
+// iau prefix, Atco13 for iauAtco13, and are written in Go, so that the
+// package builds with CGO_ENABLED=0.  When cgo is enabled the SOFA C
+// sources are compiled in as well, and each function has a Cgo twin,
+// CgoAtco13, that calls the C library; the tests check the two against
+// each other.
+package sofa
@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoDtdb An approximation to TDB-TT, the difference between
+//  barycentric dynamical time and terrestrial time, for an observer on
+//  the Earth.
+func CgoDtdb(date1, date2, ut, elong, u, v float64) float64 {
+	var cF C.double
+	cF = C.iauDtdb(C.double(date1), C.double(date2), C.double(ut),
+		C.double(elong), C.double(u), C.double(v))
+	return float64(cF)
+}
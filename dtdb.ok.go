@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoDtdb An approximation to TDB-TT, the difference between
+//  Dtdb An approximation to TDB-TT, the difference between
 //  barycentric dynamical time and terrestrial time, for an observer on
 //  the Earth.
 //
@@ -170,20 +168,10 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoDtdb An approximation to TDB-TT, the difference between
+//  Dtdb An approximation to TDB-TT, the difference between
 //  barycentric dynamical time and terrestrial time, for an observer on
 //  the Earth.
-func CgoDtdb(date1, date2, ut, elong, u, v float64) float64 {
-	var cF C.double
-	cF = C.iauDtdb(C.double(date1), C.double(date2), C.double(ut),
-		C.double(elong), C.double(u), C.double(v))
-	return float64(cF)
-}
-
-//  GoDtdb An approximation to TDB-TT, the difference between
-//  barycentric dynamical time and terrestrial time, for an observer on
-//  the Earth.
-func GoDtdb(date1, date2, ut, elong, u, v float64) float64 {
+func Dtdb(date1, date2, ut, elong, u, v float64) float64 {
 
 	var t, tsol, w, elsun, emsun, d, elj, els, wt, w0, w1, w2, w3, w4,
 		wf, wj float64
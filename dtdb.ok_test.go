@@ -22,7 +22,7 @@ func TestDtdb(t *testing.T) {
 		fn  func(a1, a2, a3, a4, a5, a6 float64) float64
 	}{
 		{"cgo", CgoDtdb},
-		{"go", GoDtdb},
+		{"go", Dtdb},
 	}
 
 	for _, test := range tests {
@@ -42,7 +42,7 @@ func BenchmarkDtdb(b *testing.B) {
 		fn  func(a1, a2, a3, a4, a5, a6 float64) float64
 	}{
 		{"cgo", CgoDtdb},
-		{"go", GoDtdb},
+		{"go", Dtdb},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,31 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "stdlib.h"
+// #include "sofa.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/8i8/sofa/en"
+)
+
+//  CgoDtf2d Encode date and time fields into 2-part Julian Date (or in
+//  the case of UTC a quasi-JD form that includes special provision for
+//  leap seconds).
+func CgoDtf2d(scale string, iy, im, id, ihr, imn int, sec float64) (
+	d1, d2 float64, err en.ErrNum) {
+	var cD1, cD2 C.double
+	cS := C.CString(scale)
+	cI := C.iauDtf2d(cS, C.int(iy), C.int(im), C.int(id), C.int(ihr),
+		C.int(imn), C.double(sec), &cD1, &cD2)
+	C.free(unsafe.Pointer(cS))
+	switch int(cI) {
+	case 0:
+	default:
+		err = errDtf2d.Set(int(cI))
+	}
+	return float64(cD1), float64(cD2), err
+}
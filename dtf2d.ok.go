@@ -1,11 +1,7 @@
 package sofa
 
-// #include "stdlib.h"
-// #include "sofa.h"
-import "C"
 import (
 	"strings"
-	"unsafe"
 
 	"github.com/8i8/sofa/en"
 )
@@ -33,7 +29,7 @@ var errDtf2d = en.New(6, "dtf2d", []string{
 	"time is after end of day (Note 5) and dubious year (Note 6)",
 })
 
-//  CgoDtf2d Encode date and time fields into 2-part Julian Date (or in
+//  Dtf2d Encode date and time fields into 2-part Julian Date (or in
 //  the case of UTC a quasi-JD form that includes special provision for
 //  leap seconds).
 //
@@ -115,37 +111,27 @@ var errDtf2d = en.New(6, "dtf2d", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoDtf2d Encode date and time fields into 2-part Julian Date (or in
+//  Dtf2d Encode date and time fields into 2-part Julian Date (or in
 //  the case of UTC a quasi-JD form that includes special provision for
 //  leap seconds).
-func CgoDtf2d(scale string, iy, im, id, ihr, imn int, sec float64) (
+func Dtf2d(scale string, iy, im, id, ihr, imn int, sec float64) (
 	d1, d2 float64, err en.ErrNum) {
-	var cD1, cD2 C.double
-	cS := C.CString(scale)
-	cI := C.iauDtf2d(cS, C.int(iy), C.int(im), C.int(id), C.int(ihr),
-		C.int(imn), C.double(sec), &cD1, &cD2)
-	C.free(unsafe.Pointer(cS))
-	switch int(cI) {
-	case 0:
-	default:
-		err = errDtf2d.Set(int(cI))
-	}
-	return float64(cD1), float64(cD2), err
+	return Dtf2dWith(CurrentLeapSeconds(), scale,
+		iy, im, id, ihr, imn, sec)
 }
 
-//  GoDtf2d Encode date and time fields into 2-part Julian Date (or in
-//  the case of UTC a quasi-JD form that includes special provision for
-//  leap seconds).
-func GoDtf2d(scale string, iy, im, id, ihr, imn int, sec float64) (
+//  Dtf2dWith is Dtf2d with Delta(AT) taken from ls.
+func Dtf2dWith(ls LeapSeconds, scale string,
+	iy, im, id, ihr, imn int, sec float64) (
 	d1, d2 float64, err en.ErrNum) {
 
-	var iy2, im2, id2 int
+	var iy2, im2, id2, js int
 	var dj, w, day, seclim, dat0, dat12, dat24, dleap, time float64
 
 	// Today's Julian Day Number.
-	dj, w, err = GoCal2jd(iy, im, id)
+	dj, w, err = Cal2jd(iy, im, id)
 	if err != nil {
-		err = errDtf2d.Set(1)
+		err = errDtf2d.Set(err.Code()).Wrap(err)
 		return
 	}
 	dj += w
@@ -158,40 +144,38 @@ func GoDtf2d(scale string, iy, im, id, ihr, imn int, sec float64) (
 	if strings.Compare(scale, "UTC") == 0 {
 
 		// TAI-UTC at 0h today.
-		dat0, err = GoDat(iy, im, id, 0.0)
+		dat0, err = ls.Dat(iy, im, id, 0.0)
 		if err != nil {
-			if err.Is() < 0 {
-				err = errDtf2d.Wrap(err)
+			if err.Code() < 0 {
+				err = errDtf2d.Set(err.Code()).Wrap(err)
 				return
 			}
-			err = errDtf2d.Wrap(err)
+			js = 1
 		}
 
 		// TAI-UTC at 12h today (to detect drift).
-		dat12, err = GoDat(iy, im, id, 0.5)
+		dat12, err = ls.Dat(iy, im, id, 0.5)
 		if err != nil {
-			if err.Is() < 0 {
-				err = errDtf2d.Wrap(err)
+			if err.Code() < 0 {
+				err = errDtf2d.Set(err.Code()).Wrap(err)
 				return
 			}
-			err = errDtf2d.Wrap(err)
+			js = 1
 		}
 
 		// TAI-UTC at 0h tomorrow (to detect jumps).
-		iy2, im2, id2, w, err = GoJd2cal(dj, 1.5)
+		iy2, im2, id2, w, err = Jd2cal(dj, 1.5)
 		if err != nil {
-			err = errDtf2d.Wrap(err)
+			err = errDtf2d.Set(-1).Wrap(err)
 			return
 		}
-
-		dat24, err = GoDat(iy2, im2, id2, 0.0)
+		dat24, err = ls.Dat(iy2, im2, id2, 0.0)
 		if err != nil {
-			//if !errors.Is(err, errDatWarn) {
-			if err.Is() < 0 {
-				err = errDtf2d.Wrap(err)
+			if err.Code() < 0 {
+				err = errDtf2d.Set(err.Code()).Wrap(err)
 				return
 			}
-			err = errDtf2d.Wrap(err)
+			js = 1
 		}
 
 		// Any sudden change in TAI-UTC between today and tomorrow.
@@ -211,18 +195,22 @@ func GoDtf2d(scale string, iy, im, id, ihr, imn int, sec float64) (
 		if imn >= 0 && imn <= 59 {
 			if sec >= 0 {
 				if sec >= seclim {
-					err = errDtf2d.Add(err, 2)
+					js += 2
 				}
 			} else {
-				err = err.Set(-6)
+				js = -6
 			}
 		} else {
-			err = err.Set(-5)
+			js = -5
 		}
 	} else {
-		err = err.Set(-4)
+		js = -4
+	}
+	err = nil
+	if js != 0 {
+		err = errDtf2d.Set(js)
 	}
-	if err != nil && err.Is() < 0 {
+	if js < 0 {
 		return
 	}
 
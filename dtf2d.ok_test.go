@@ -26,7 +26,7 @@ func TestDtf2d(t *testing.T) {
 			c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoDtf2d},
-		{"go", GoDtf2d},
+		{"go", Dtf2d},
 	}
 
 	for _, test := range tests {
@@ -37,6 +37,13 @@ func TestDtf2d(t *testing.T) {
 		vvd(t, u1+u2, 2449534.49999, 1e-6, tname, "u")
 		// viv(t, j, 0, "iauDtf2d", "j")
 		errT(t, nil, err, tname, "err")
+
+		_, _, err = test.fn("UTC", 1994, 6, 30, 24, 0, 0.0)
+		errEN(t, -4, err, tname, "hour err")
+		_, _, err = test.fn("UTC", 1994, 6, 29, 23, 59, 60.5)
+		errEN(t, 2, err, tname, "sec err")
+		_, _, err = test.fn("TAI", 1994, 6, 31, 12, 0, 0.0)
+		errEN(t, -3, err, tname, "day err")
 	}
 }
 
@@ -47,7 +54,7 @@ func BenchmarkDtf2d(b *testing.B) {
 			c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoDtf2d},
-		{"go", GoDtf2d},
+		{"go", Dtf2d},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoEceq06 Transformation from ecliptic coordinates (mean equinox and
+//  ecliptic of date) to ICRS RA,Dec, using the IAU 2006 precession
+//  model.
+func CgoEceq06(date1, date2, dl, db float64) (dr, dd float64) {
+	var cDr, cDd C.double
+	C.iauEceq06(C.double(date1), C.double(date2), C.double(dl), C.double(db), &cDr, &cDd)
+	return float64(cDr), float64(cDd)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoEceq06 Transformation from ecliptic coordinates (mean equinox and
+//  Eceq06 Transformation from ecliptic coordinates (mean equinox and
 //  ecliptic of date) to ICRS RA,Dec, using the IAU 2006 precession
 //  model.
 //
@@ -65,38 +62,29 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEceq06 Transformation from ecliptic coordinates (mean equinox and
-//  ecliptic of date) to ICRS RA,Dec, using the IAU 2006 precession
-//  model.
-func CgoEceq06(date1, date2, dl, db float64) (dr, dd float64) {
-	var cDr, cDd C.double
-	C.iauEceq06(C.double(date1), C.double(date2), C.double(dl), C.double(db), &cDr, &cDd)
-	return float64(cDr), float64(cDd)
-}
-
-//  GoEceq06 Transformation from ecliptic coordinates (mean equinox and
+//  Eceq06 Transformation from ecliptic coordinates (mean equinox and
 //  ecliptic of date) to ICRS RA,Dec, using the IAU 2006 precession
 //  model.
-func GoEceq06(date1, date2, dl, db float64) (dr, dd float64) {
+func Eceq06(date1, date2, dl, db float64) (dr, dd float64) {
 
 	var rm [3][3]float64
 	var v1, v2 [3]float64
 	var a, b float64
 
 	// Spherical to Cartesian.
-	v1 = GoS2c(dl, db)
+	v1 = S2c(dl, db)
 
 	// Rotation matrix, ICRS equatorial to ecliptic.
-	rm = GoEcm06(date1, date2)
+	rm = Ecm06(date1, date2)
 
 	// The transformation from ecliptic to ICRS.
-	v2 = GoTrxp(rm, v1)
+	v2 = Trxp(rm, v1)
 
 	// Cartesian to spherical.
-	a, b = GoC2s(v2)
+	a, b = C2s(v2)
 
 	// Express in conventional ranges.
-	dr = GoAnp(a)
-	dd = GoAnpm(b)
+	dr = Anp(a)
+	dd = Anpm(b)
 	return
 }
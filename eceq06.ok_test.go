@@ -27,7 +27,7 @@ func TestEceq06(t *testing.T) {
 		fn  func(a1, a2, a3, a4 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoEceq06},
-		{"go", GoEceq06},
+		{"go", Eceq06},
 	}
 
 	for _, test := range tests {
@@ -53,7 +53,7 @@ func BenchmarkEceq06(b *testing.B) {
 		fn  func(a1, a2, a3, a4 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoEceq06},
-		{"go", GoEceq06},
+		{"go", Eceq06},
 	}
 
 	for _, test := range tests {
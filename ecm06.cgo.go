@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoEcm06 ICRS equatorial to ecliptic rotation matrix, IAU 2006.
+func CgoEcm06(date1, date2 float64) (rm [3][3]float64) {
+	var cRm [3][3]C.double
+	C.iauEcm06(C.double(date1), C.double(date2), &cRm[0])
+	return v3tC2Go(cRm)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoEcm06 ICRS equatorial to ecliptic rotation matrix, IAU 2006.
+//  Ecm06 ICRS equatorial to ecliptic rotation matrix, IAU 2006.
 //
 //  - - - - - -
 //   E c m 0 6
@@ -71,29 +68,22 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEcm06 ICRS equatorial to ecliptic rotation matrix, IAU 2006.
-func CgoEcm06(date1, date2 float64) (rm [3][3]float64) {
-	var cRm [3][3]C.double
-	C.iauEcm06(C.double(date1), C.double(date2), &cRm[0])
-	return v3tC2Go(cRm)
-}
-
-//  GoEcm06 ICRS equatorial to ecliptic rotation matrix, IAU 2006.
-func GoEcm06(date1, date2 float64) (rm [3][3]float64) {
+//  Ecm06 ICRS equatorial to ecliptic rotation matrix, IAU 2006.
+func Ecm06(date1, date2 float64) (rm [3][3]float64) {
 	var ob float64
 	var bp, e [3][3]float64
 
 	// Obliquity, IAU 2006.
-	ob = GoObl06(date1, date2)
+	ob = Obl06(date1, date2)
 
 	// Precession-bias matrix, IAU 2006.
-	bp = GoPmat06(date1, date2)
+	bp = Pmat06(date1, date2)
 
 	// Equatorial of date to ecliptic matrix.
-	e = GoIr()
-	e = GoRx(ob, e)
+	e = Ir()
+	e = Rx(ob, e)
 
 	// ICRS to ecliptic coordinates rotation matrix, IAU 2006.
-	rm = GoRxr(e, bp)
+	rm = Rxr(e, bp)
 	return
 }
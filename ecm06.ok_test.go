@@ -26,7 +26,7 @@ func TestEcm06(t *testing.T) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoEcm06},
-		{"go", GoEcm06},
+		{"go", Ecm06},
 	}
 
 	for _, test := range tests {
@@ -65,7 +65,7 @@ func BenchmarkEcm06(b *testing.B) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoEcm06},
-		{"go", GoEcm06},
+		{"go", Ecm06},
 	}
 
 	for _, test := range tests {
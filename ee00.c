@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 double iauEe00(double date1, double date2, double epsa, double dpsi)
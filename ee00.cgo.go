@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoEe00 The equation of the equinoxes, compatible with IAU 2000
+//  resolutions, given the nutation in longitude and the mean obliquity.
+func CgoEe00(date1, date2, epsa, dpsi float64) float64 {
+	var cF C.double
+	cF = C.iauEe00(C.double(date1), C.double(date2), C.double(epsa),
+		C.double(dpsi))
+	return float64(cF)
+}
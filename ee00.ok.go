@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoEe00 The equation of the equinoxes, compatible with IAU 2000
+//  Ee00 The equation of the equinoxes, compatible with IAU 2000
 //  resolutions, given the nutation in longitude and the mean obliquity.
 //
 //  - - - - -
@@ -73,20 +71,11 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEe00 The equation of the equinoxes, compatible with IAU 2000
+//  Ee00 The equation of the equinoxes, compatible with IAU 2000
 //  resolutions, given the nutation in longitude and the mean obliquity.
-func CgoEe00(date1, date2, epsa, dpsi float64) float64 {
-	var cF C.double
-	cF = C.iauEe00(C.double(date1), C.double(date2), C.double(epsa),
-		C.double(dpsi))
-	return float64(cF)
-}
-
-//  GoEe00 The equation of the equinoxes, compatible with IAU 2000
-//  resolutions, given the nutation in longitude and the mean obliquity.
-func GoEe00(date1, date2, epsa, dpsi float64) (ee float64) {
+func Ee00(date1, date2, epsa, dpsi float64) (ee float64) {
 
 	// Equation of the equinoxes.
-	ee = dpsi*math.Cos(epsa) + GoEect00(date1, date2)
+	ee = dpsi*math.Cos(epsa) + Eect00(date1, date2)
 	return
 }
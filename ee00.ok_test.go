@@ -25,7 +25,7 @@ func TestEe00(t *testing.T) {
 		fn  func(a1, a2, a3, a4 float64) float64
 	}{
 		{"cgo", CgoEe00},
-		{"go", GoEe00},
+		{"go", Ee00},
 	}
 
 	for _, test := range tests {
@@ -47,7 +47,7 @@ func BenchmarkEe00(b *testing.B) {
 		fn  func(a1, a2, a3, a4 float64) float64
 	}{
 		{"cgo", CgoEe00},
-		{"go", GoEe00},
+		{"go", Ee00},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoEe00a Equation of the equinoxes, compatible with IAU 2000
+//  resolutions.
+func CgoEe00a(date1, date2 float64) float64 {
+	var cF C.double
+	cF = C.iauEe00a(C.double(date1), C.double(date2))
+	return float64(cF)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoEe00a Equation of the equinoxes, compatible with IAU 2000
+//  Ee00a Equation of the equinoxes, compatible with IAU 2000
 //  resolutions.
 //
 //  - - - - - -
@@ -71,30 +68,22 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEe00a Equation of the equinoxes, compatible with IAU 2000
-//  resolutions.
-func CgoEe00a(date1, date2 float64) float64 {
-	var cF C.double
-	cF = C.iauEe00a(C.double(date1), C.double(date2))
-	return float64(cF)
-}
-
-//  GoEe00a Equation of the equinoxes, compatible with IAU 2000
+//  Ee00a Equation of the equinoxes, compatible with IAU 2000
 //  resolutions.
-func GoEe00a(date1, date2 float64) float64 {
+func Ee00a(date1, date2 float64) float64 {
 	var depspr, epsa, dpsi, ee float64
 
 	// IAU 2000 precession-rate adjustments.
-	_, depspr = GoPr00(date1, date2)
+	_, depspr = Pr00(date1, date2)
 
 	// Mean obliquity, consistent with IAU 2000 precession-nutation.
-	epsa = GoObl80(date1, date2) + depspr
+	epsa = Obl80(date1, date2) + depspr
 
 	// Nutation in longitude.
-	dpsi, _ = GoNut00a(date1, date2)
+	dpsi, _ = Nut00a(date1, date2)
 
 	// Equation of the equinoxes.
-	ee = GoEe00(date1, date2, epsa, dpsi)
+	ee = Ee00(date1, date2, epsa, dpsi)
 
 	return ee
 }
@@ -22,7 +22,7 @@ func TestEe00a(t *testing.T) {
 		fn  func(a1,a2 float64) float64
 	}{
 		{"cgo", CgoEe00a},
-		{"go", GoEe00a},
+		{"go", Ee00a},
 	}
 
 	for _, test := range tests {
@@ -39,7 +39,7 @@ func BenchmarkEe00a(b *testing.B) {
 		fn  func(a1,a2 float64) float64
 	}{
 		{"cgo", CgoEe00a},
-		{"go", GoEe00a},
+		{"go", Ee00a},
 	}
 
 	for _, test := range tests {
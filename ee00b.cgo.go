@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoEe00b Equation of the equinoxes, compatible with IAU 2000
+//  resolutions but using the truncated nutation model IAU 2000B.
+func CgoEe00b(date1, date2 float64) float64 {
+	var cF C.double
+	cF = C.iauEe00b(C.double(date1), C.double(date2))
+	return float64(cF)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoEe00b Equation of the equinoxes, compatible with IAU 2000
+//  Ee00b Equation of the equinoxes, compatible with IAU 2000
 //  resolutions but using the truncated nutation model IAU 2000B.
 //
 //  - - - - - -
@@ -76,31 +73,23 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEe00b Equation of the equinoxes, compatible with IAU 2000
-//  resolutions but using the truncated nutation model IAU 2000B.
-func CgoEe00b(date1, date2 float64) float64 {
-	var cF C.double
-	cF = C.iauEe00b(C.double(date1), C.double(date2))
-	return float64(cF)
-}
-
-//  GoEe00b Equation of the equinoxes, compatible with IAU 2000
+//  Ee00b Equation of the equinoxes, compatible with IAU 2000
 //  resolutions but using the truncated nutation model IAU 2000B.
-func GoEe00b(date1, date2 float64) float64 {
+func Ee00b(date1, date2 float64) float64 {
 
 	var depspr, epsa, dpsi, ee float64
 
 	// IAU 2000 precession-rate adjustments.
-	_, depspr = GoPr00(date1, date2)
+	_, depspr = Pr00(date1, date2)
 
 	// Mean obliquity, consistent with IAU 2000 precession-nutation.
-	epsa = GoObl80(date1, date2) + depspr
+	epsa = Obl80(date1, date2) + depspr
 
 	// Nutation in longitude.
-	dpsi, _ = GoNut00b(date1, date2)
+	dpsi, _ = Nut00b(date1, date2)
 
 	// Equation of the equinoxes.
-	ee = GoEe00(date1, date2, epsa, dpsi)
+	ee = Ee00(date1, date2, epsa, dpsi)
 
 	return ee
 }
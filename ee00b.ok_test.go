@@ -22,7 +22,7 @@ func TestEe00b(t *testing.T) {
 		fn  func(a1,a2 float64) float64	
 	}{
 		{"cgo", CgoEe00b},
-		{"go", GoEe00b},
+		{"go", Ee00b},
 	}
 
 	for _, test := range tests {
@@ -39,7 +39,7 @@ func BenchmarkEe00b(b *testing.B) {
 		fn  func(a1,a2 float64) float64	
 	}{
 		{"cgo", CgoEe00b},
-		{"go", GoEe00b},
+		{"go", Ee00b},
 	}
 
 	for _, test := range tests {
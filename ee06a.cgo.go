@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoEe06a Equation of the equinoxes, compatible with IAU 2000
+//  resolutions and IAU 2006/2000A precession-nutation.
+func CgoEe06a(date1, date2 float64) float64 {
+	var cF C.double
+	cF = C.iauEe06a(C.double(date1), C.double(date2))
+	return float64(cF)
+}
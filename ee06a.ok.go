@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoEe06a Equation of the equinoxes, compatible with IAU 2000
+//  Ee06a Equation of the equinoxes, compatible with IAU 2000
 //  resolutions and IAU 2006/2000A precession-nutation.
 //
 //  - - - - - -
@@ -62,26 +59,18 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEe06a Equation of the equinoxes, compatible with IAU 2000
-//  resolutions and IAU 2006/2000A precession-nutation.
-func CgoEe06a(date1, date2 float64) float64 {
-	var cF C.double
-	cF = C.iauEe06a(C.double(date1), C.double(date2))
-	return float64(cF)
-}
-
-//  GoEe06a Equation of the equinoxes, compatible with IAU 2000
+//  Ee06a Equation of the equinoxes, compatible with IAU 2000
 //  resolutions and IAU 2006/2000A precession-nutation.
-func GoEe06a(date1, date2 float64) (ee float64) {
+func Ee06a(date1, date2 float64) (ee float64) {
 
 	var gst06a, gmst06 float64
 
 	// Apparent and mean sidereal times.
-	gst06a = GoGst06a(0.0, 0.0, date1, date2)
-	gmst06 = GoGmst06(0.0, 0.0, date1, date2)
+	gst06a = Gst06a(0.0, 0.0, date1, date2)
+	gmst06 = Gmst06(0.0, 0.0, date1, date2)
 
 	// Equation of the equinoxes.
-	ee = GoAnpm(gst06a - gmst06)
+	ee = Anpm(gst06a - gmst06)
 
 	return
 }
@@ -13,7 +13,7 @@ import "testing"
 //
 //  This revision:  2013 August 7
 //
-func TestingEe06a(t *testing.T) {
+func TestEe06a(t *testing.T) {
 	const fname = "Ee06a"
 	var ee float64
 
@@ -22,7 +22,7 @@ func TestingEe06a(t *testing.T) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoEe06a},
-		{"go", GoEe06a},
+		{"go", Ee06a},
 	}
 
 	for _, test := range tests {
@@ -40,7 +40,7 @@ func BenchmarkEe06a(b *testing.B) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoEe06a},
-		{"go", GoEe06a},
+		{"go", Ee06a},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoEect00 Equation of the equinoxes complementary terms, consistent
+//  with IAU 2000 resolutions.
+func CgoEect00(date1, date2 float64) float64 {
+	var cF C.double
+	cF = C.iauEect00(C.double(date1), C.double(date2))
+	return float64(cF)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoEect00 Equation of the equinoxes complementary terms, consistent
+//  Eect00 Equation of the equinoxes complementary terms, consistent
 //  with IAU 2000 resolutions.
 //
 //  - - - - - - -
@@ -104,17 +102,9 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEect00 Equation of the equinoxes complementary terms, consistent
+//  Eect00 Equation of the equinoxes complementary terms, consistent
 //  with IAU 2000 resolutions.
-func CgoEect00(date1, date2 float64) float64 {
-	var cF C.double
-	cF = C.iauEect00(C.double(date1), C.double(date2))
-	return float64(cF)
-}
-
-//  GoEect00 Equation of the equinoxes complementary terms, consistent
-//  with IAU 2000 resolutions.
-func GoEect00(date1, date2 float64) float64 {
+func Eect00(date1, date2 float64) float64 {
 	// Time since J2000.0, in Julian centuries
 	var t float64
 
@@ -199,28 +189,28 @@ func GoEect00(date1, date2 float64) float64 {
 	// Fundamental Arguments (from IERS Conventions 2003)
 
 	// Mean anomaly of the Moon.
-	fa[0] = GoFal03(t)
+	fa[0] = Fal03(t)
 
 	// Mean anomaly of the Sun.
-	fa[1] = GoFalp03(t)
+	fa[1] = Falp03(t)
 
 	// Mean longitude of the Moon minus that of the ascending node.
-	fa[2] = GoFaf03(t)
+	fa[2] = Faf03(t)
 
 	// Mean elongation of the Moon from the Sun.
-	fa[3] = GoFad03(t)
+	fa[3] = Fad03(t)
 
 	// Mean longitude of the ascending node of the Moon.
-	fa[4] = GoFaom03(t)
+	fa[4] = Faom03(t)
 
 	// Mean longitude of Venus.
-	fa[5] = GoFave03(t)
+	fa[5] = Fave03(t)
 
 	// Mean longitude of Earth.
-	fa[6] = GoFae03(t)
+	fa[6] = Fae03(t)
 
 	// General precession in longitude.
-	fa[7] = GoFapa03(t)
+	fa[7] = Fapa03(t)
 
 	// Evaluate the EE complementary terms.
 	s0 = 0.0
@@ -22,7 +22,7 @@ func TestEect00(t *testing.T) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoEect00},
-		{"go", GoEect00},
+		{"go", Eect00},
 	}
 
 	for _, test := range tests {
@@ -40,7 +40,7 @@ func BenchmarkEect00(b *testing.B) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoEect00},
-		{"go", GoEect00},
+		{"go", Eect00},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,25 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import (
+	"github.com/8i8/sofa/en"
+)
+
+//  CgoEform Earth reference ellipsoids.
+func CgoEform(n int) (a, f float64, err en.ErrNum) {
+	var cA, cF C.double
+	var cStatus C.int
+	cStatus = C.iauEform(C.int(n), &cA, &cF)
+	switch int(cStatus) {
+	case 0:
+	case -1:
+		err = errEform.Set(-1)
+	default:
+		err = errEform.Set(0)
+	}
+	return float64(cA), float64(cF), err
+}
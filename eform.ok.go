@@ -1,14 +1,12 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "github.com/8i8/sofa/en"
 // import "errors"
 
 // var errEformitE1 = errors.New("illegal identifier (Note 3)")
 var errEform = en.New(1, "Eform", []string{"illegal identifier (Note 3)"})
 
-//  CgoEform Earth reference ellipsoids.
+//  Eform Earth reference ellipsoids.
 //
 //  - - - - - -
 //   E f o r m
@@ -72,23 +70,8 @@ var errEform = en.New(1, "Eform", []string{"illegal identifier (Note 3)"})
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEform Earth reference ellipsoids.
-func CgoEform(n int) (a, f float64, err en.ErrNum) {
-	var cA, cF C.double
-	var cStatus C.int
-	cStatus = C.iauEform(C.int(n), &cA, &cF)
-	switch int(cStatus) {
-	case 0:
-	case -1:
-		err = errEform.Set(-1)
-	default:
-		err = errEform.Set(0)
-	}
-	return float64(cA), float64(cF), err
-}
-
-//  GoEform Earth reference ellipsoids.
-func GoEform(n int) (a, f float64, err en.ErrNum) {
+//  Eform Earth reference ellipsoids.
+func Eform(n int) (a, f float64, err en.ErrNum) {
 
 	// Look up a and f for the specified reference ellipsoid.
 	switch n {
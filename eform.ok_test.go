@@ -21,7 +21,7 @@ func TestEform(t *testing.T) {
 		fn  func(int) (float64, float64, en.ErrNum)
 	}{
 		{"cgo", CgoEform},
-		{"go", GoEform},
+		{"go", Eform},
 	}
 
 	for _, test := range tests {
@@ -56,7 +56,7 @@ func BenchmarkEform(b *testing.B) {
 		fn  func(int) (float64, float64, en.ErrNum)
 	}{
 		{"cgo", CgoEform},
-		{"go", GoEform},
+		{"go", Eform},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
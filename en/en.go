@@ -2,15 +2,23 @@ package en
 
 import (
 	"bytes"
-	"log"
-	"os"
+	"errors"
+	"strconv"
 )
 
-// ErrNum is an error type for dealing with enumerated errors.
+// ErrRange is matched, by errors.Is, by an ErrNum whose value or offset
+// is outside of its messages.
+var ErrRange = errors.New("error value out of range")
+
+// ErrNum is an error type for dealing with enumerated errors.  Code
+// returns the SOFA status value, negative for an error and positive
+// for a warning.  Is makes ErrNum work with errors.Is, matching an
+// ErrNum of the same messages and value, whatever its name.
 type ErrNum interface {
 	Error() string
 	Set(int) ErrNum
-	Is() int
+	Code() int
+	Is(error) bool
 	Add(ErrNum, int) ErrNum
 	Wrap(ErrNum) ErrNum
 	Name() string
@@ -23,9 +31,9 @@ type errnum struct {
 	msg    []string
 }
 
-// Set sets the error value that is to be output to the user.
+// Set sets the error value that is to be output to the user.  A value
+// outside of the messages is kept, and reported as such by Error.
 func (e errnum) Set(n int) ErrNum {
-	e.check(n)
 	e.n = n
 	return e
 }
@@ -33,13 +41,11 @@ func (e errnum) Set(n int) ErrNum {
 // Add additions v with the current error value.
 func (e errnum) Add(err ErrNum, v int) ErrNum {
 	if err == nil {
-		e = e.Set(v).(errnum)
-		e.limit()
-		return e
+		e.n = v
+		return e.limit()
 	}
-	e.n += (err.Is() + v)
-	e.limit()
-	return e
+	e.n += (err.Code() + v)
+	return e.limit()
 }
 
 // GetNum returns the current set error value.
@@ -47,11 +53,26 @@ func (e errnum) GetNum() int {
 	return e.n
 }
 
-// Is returns the errors value.
-func (e errnum) Is() int {
+// Code returns the errors value.
+func (e errnum) Code() int {
 	return e.n
 }
 
+// Is reports whether target is an ErrNum of the same messages and
+// value, or ErrRange when the value is out of range.
+func (e errnum) Is(target error) bool {
+	if target == ErrRange {
+		s := e.status()
+		return s == 2 || s == -2
+	}
+	t, ok := target.(errnum)
+	if !ok || t.n != e.n || t.offset != e.offset ||
+		len(t.msg) != len(e.msg) {
+		return false
+	}
+	return len(e.msg) == 0 || &t.msg[0] == &e.msg[0]
+}
+
 // Wrap mantains an errrors value and adds the previous errors name to
 // the current name, replacing the mesage with that if the current
 // error.
@@ -75,6 +96,8 @@ func (e errnum) Error() string {
 	switch e.status() {
 	case 2:
 		buf.WriteString(" err.Error(): value of 'n' too high: ")
+		buf.WriteString(strconv.Itoa(e.n))
+		return buf.String()
 	case 1:
 		buf.WriteString(" warning: ")
 	case 0:
@@ -83,6 +106,8 @@ func (e errnum) Error() string {
 		buf.WriteString(" error: ")
 	case -2:
 		buf.WriteString(" err.Error(): value of 'n' too low: ")
+		buf.WriteString(strconv.Itoa(e.n))
+		return buf.String()
 	}
 	buf.WriteString(e.msg[e.n+e.offset])
 	return buf.String()
@@ -97,12 +122,12 @@ func (e errnum) status() int {
 	case e.n > len(e.msg)-e.offset-1:
 		// e.n is greater than the highest output index.
 		return 2
-	case e.n > 0:
-		// e.n is posative.
-		return 1
 	case e.n < -e.offset:
 		// e.n is lower than the least index.
 		return -2
+	case e.n > 0:
+		// e.n is posative.
+		return 1
 	case e.n < 0:
 		// e.n is negative.
 		return -1
@@ -113,39 +138,44 @@ func (e errnum) status() int {
 }
 
 // New returns a new ErrNum, setting output messages, value and the
-// offset from 0 for dealing with negative value errors.
+// offset from 0 for dealing with negative value errors.  An offset
+// outside of the messages leaves every value out of range.
 func New(o int, name string, msg []string) ErrNum {
-	if o > len(msg) {
-		log.SetFlags(log.Lshortfile)
-		log.Output(3, "invalid offset")
-		os.Exit(1)
+	if o < 0 || o > len(msg) {
+		o = len(msg)
+		msg = nil
 	}
 	return errnum{offset: o, name: name, msg: msg}
 }
 
-// limit checks that the value of e.n is not out side of the output
-// boundaries, if it is then the value is set to the limit that has 
-// been passed.
-func (e errnum) limit() {
+// limit returns e with its value moved to the nearest that is within
+// the output boundaries.
+func (e errnum) limit() errnum {
 	if e.n >= len(e.msg)-e.offset {
-		e.n = len(e.msg)-e.offset - 1
+		e.n = len(e.msg) - e.offset - 1
 	}
 	if e.n < -e.offset {
 		e.n = -e.offset
 	}
+	return e
 }
 
-// check verifies that the current error value is within the output
-// boundaries, if it is not then a fatal error is triggered.
-func (e errnum) check(v int) {
-	if v >= len(e.msg)-e.offset {
-		log.SetFlags(log.Lshortfile)
-		log.Output(3, "value 'Set(n)' to high")
-		os.Exit(1)
+// Code returns the SOFA status value of the first ErrNum in the chain
+// of err, 0 if err is nil and -1 if err has no ErrNum in it.
+func Code(err error) int {
+	if err == nil {
+		return 0
 	}
-	if v < -e.offset {
-		log.SetFlags(log.Lshortfile)
-		log.Output(3, "value 'Set(n)' to low")
-		os.Exit(1)
+	var e ErrNum
+	if errors.As(err, &e) {
+		return e.Code()
 	}
+	return -1
+}
+
+// IsWarning reports whether err is a warning, an ErrNum with a positive
+// value, rather than an error.  A nil error is not a warning, and
+// neither is a value out of range, which matches ErrRange.
+func IsWarning(err error) bool {
+	return Code(err) > 0 && !errors.Is(err, ErrRange)
 }
@@ -1,6 +1,10 @@
 package en
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
 
 func TestErrNum(t *testing.T) {
 	const fname = "ErrNum"
@@ -34,3 +38,75 @@ func TestErrNum(t *testing.T) {
 	}
 }
 
+func TestErrNumIs(t *testing.T) {
+	const fname = "ErrNum Is"
+	msg := []string{"err -1", "err 0", "err 1"}
+	var terr = New(1, "test", msg)
+	var other = New(1, "other", []string{"err -1", "err 0", "err 1"})
+
+	err := fmt.Errorf("wrapped: %w", terr.Set(-1))
+	if !errors.Is(err, terr.Set(-1)) {
+		t.Errorf("%s: want match", fname)
+	}
+	if !errors.Is(err, New(1, "outer", msg).Set(-1).Wrap(terr)) {
+		t.Errorf("%s: want match whatever the name", fname)
+	}
+	if errors.Is(err, terr.Set(1)) {
+		t.Errorf("%s: want no match of value", fname)
+	}
+	if errors.Is(err, other.Set(-1)) {
+		t.Errorf("%s: want no match of messages", fname)
+	}
+
+	var e ErrNum
+	if !errors.As(err, &e) || e.Code() != -1 {
+		t.Errorf("%s: want As with code -1", fname)
+	}
+	if Code(err) != -1 || Code(nil) != 0 || Code(errors.New("x")) != -1 {
+		t.Errorf("%s: bad Code", fname)
+	}
+	if IsWarning(err) || !IsWarning(terr.Set(1)) || IsWarning(nil) {
+		t.Errorf("%s: bad IsWarning", fname)
+	}
+}
+
+func TestErrNumRange(t *testing.T) {
+	const fname = "ErrNum range"
+	var terr = New(1, "test", []string{"err -1", "err 0", "err 1"})
+
+	err := terr.Set(2)
+	want := "test err.Error(): value of 'n' too high: 2"
+	if err.Error() != want {
+		t.Errorf("%s: want %q got %q", fname, want, err.Error())
+	}
+	if !errors.Is(err, ErrRange) {
+		t.Errorf("%s: want ErrRange", fname)
+	}
+	err = terr.Set(-2)
+	want = "test err.Error(): value of 'n' too low: -2"
+	if err.Error() != want {
+		t.Errorf("%s: want %q got %q", fname, want, err.Error())
+	}
+	if errors.Is(terr.Set(1), ErrRange) {
+		t.Errorf("%s: want no ErrRange", fname)
+	}
+
+	// A value out of range is an error, even if positive.
+	msg := []string{"err -1", "err 0", "err 1"}
+	if IsWarning(New(1, "test", msg).Set(len(msg))) {
+		t.Errorf("%s: want no warning for a value out of range", fname)
+	}
+	if !IsWarning(terr.Set(1)) {
+		t.Errorf("%s: want a warning", fname)
+	}
+
+	// Add keeps to the range.
+	if c := terr.Add(terr.Set(1), 1).Code(); c != 1 {
+		t.Errorf("%s: want 1 got %d", fname, c)
+	}
+
+	// As does a bad offset.
+	if !errors.Is(New(4, "bad", []string{"err 0"}).Set(0), ErrRange) {
+		t.Errorf("%s: want ErrRange for offset", fname)
+	}
+}
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoEo06a Equation of the origins, IAU 2006 precession and IAU 2000A
+//  nutation.
+func CgoEo06a(date1, date2 float64) (eo float64) {
+	var cF C.double
+	cF = C.iauEo06a(C.double(date1), C.double(date2))
+	return float64(cF)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoEo06a Equation of the origins, IAU 2006 precession and IAU 2000A
+//  Eo06a Equation of the origins, IAU 2006 precession and IAU 2000A
 //  nutation.
 //
 //  - - - - - -
@@ -67,30 +64,22 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEo06a Equation of the origins, IAU 2006 precession and IAU 2000A
-//  nutation.
-func CgoEo06a(date1, date2 float64) (eo float64) {
-	var cF C.double
-	cF = C.iauEo06a(C.double(date1), C.double(date2))
-	return float64(cF)
-}
-
-//  GoEo06a Equation of the origins, IAU 2006 precession and IAU 2000A
+//  Eo06a Equation of the origins, IAU 2006 precession and IAU 2000A
 //  nutation.
-func GoEo06a(date1, date2 float64) (eo float64) {
+func Eo06a(date1, date2 float64) (eo float64) {
 	var r [3][3]float64
 	var x, y, s float64
 
 	// Classical nutation x precession x bias matrix.
-	r = GoPnm06a(date1, date2)
+	r = Pnm06a(date1, date2)
 
 	// Extract CIP coordinates.
-	x, y = GoBpn2xy(r)
+	x, y = Bpn2xy(r)
 
 	// The CIO locator, s.
-	s = GoS06(date1, date2, x, y)
+	s = S06(date1, date2, x, y)
 
 	// Solve for the EO.
-	eo = GoEors(r, s)
+	eo = Eors(r, s)
 	return
 }
@@ -22,7 +22,7 @@ func TestEo06a(t *testing.T) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoEo06a},
-		{"go", GoEo06a},
+		{"go", Eo06a},
 	}
 
 	for _, test := range tests {
@@ -40,7 +40,7 @@ func BenchmarkEo06a(b *testing.B) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoEo06a},
-		{"go", GoEo06a},
+		{"go", Eo06a},
 	}
 
 	for _, test := range tests {
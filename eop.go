@@ -0,0 +1,432 @@
+package sofa
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	errEOPEmpty  = errors.New("EOP table contains no usable entries")
+	errEOPFormat = errors.New("unrecognised EOP record")
+	errEOPOrder  = errors.New("EOP records are not in date order")
+	errEOPRange  = errors.New("date outside the EOP table")
+)
+
+// PoleOffsets identifies the kind of celestial pole offsets that an EOP
+// table provides.
+type PoleOffsets int
+
+const (
+	// OffsetsDXDY are dX,dY with respect to the IAU 2006/2000A CIP.
+	OffsetsDXDY PoleOffsets = iota
+
+	// OffsetsDpsiDeps are dpsi,deps with respect to IAU 1976/1980.
+	OffsetsDpsiDeps
+)
+
+// EOPFlag marks the quality of the values in an EOP record.
+type EOPFlag int
+
+const (
+	// EOPPredictedPM is set when the polar motion is predicted.
+	EOPPredictedPM EOPFlag = 1 << iota
+
+	// EOPPredictedUT1 is set when UT1-UTC is predicted.
+	EOPPredictedUT1
+
+	// EOPPredictedOffsets is set when the pole offsets are predicted.
+	EOPPredictedOffsets
+
+	// EOPNoOffsets is set when the source gives no pole offsets, in
+	// which case they are zero.
+	EOPNoOffsets
+)
+
+// EOPPredicted is the set of flags that mark predicted values.
+const EOPPredicted = EOPPredictedPM | EOPPredictedUT1 | EOPPredictedOffsets
+
+// EOP holds the Earth orientation parameters for one instant.
+type EOP struct {
+	MJD   float64 // UTC (MJD)
+	DUT1  float64 // UT1-UTC (s)
+	LOD   float64 // excess length of day (s)
+	Xp    float64 // polar motion x (radians)
+	Yp    float64 // polar motion y (radians)
+	DX    float64 // pole offset dX or dpsi (radians)
+	DY    float64 // pole offset dY or deps (radians)
+	Flags EOPFlag // predicted and missing values
+
+	// Offsets is the kind of pole offsets in DX, DY.  It is set by
+	// EOPTable.EOP from the table, and ignored in its records.
+	Offsets PoleOffsets
+}
+
+// Predicted reports whether any of the values are predictions rather
+// than final or rapid service values.
+func (e EOP) Predicted() bool {
+	return e.Flags&EOPPredicted != 0
+}
+
+// EOPSource is a source of Earth orientation parameters at a UTC
+// given as a 2-part quasi Julian Date, as used by Utctai.
+type EOPSource interface {
+	EOP(utc1, utc2 float64) (EOP, error)
+}
+
+// EOPTable is a daily series of Earth orientation parameters, as read
+// from an IERS product, from which values at any UTC are interpolated.
+type EOPTable struct {
+	Records []EOP       // tabulated values at 0h UTC, in date order
+	Offsets PoleOffsets // kind of pole offsets in DX, DY
+	Leap    LeapSeconds // TAI-UTC, nil for the process wide table
+}
+
+// EOP returns the Earth orientation parameters at the UTC utc1+utc2,
+// interpolated from the table with a four point Lagrangian over the
+// nearest records.  UT1-UTC is interpolated as UT1-TAI so that the one
+// second steps at leap seconds do not disturb it.  The flags are the
+// union of those of the records used.
+func (t *EOPTable) EOP(utc1, utc2 float64) (e EOP, err error) {
+	var n, i0 int
+	var mjd, dut float64
+	var x, ut [4]float64
+
+	n = len(t.Records)
+	if n == 0 {
+		return e, errEOPEmpty
+	}
+	mjd = (utc1 - DJM0) + utc2
+	if mjd < t.Records[0].MJD || mjd > t.Records[n-1].MJD {
+		return e, errEOPRange
+	}
+
+	ls := t.Leap
+	if ls == nil {
+		ls = CurrentLeapSeconds()
+	}
+
+	// First of the four records centred on the date.
+	i0 = sort.Search(n, func(i int) bool {
+		return t.Records[i].MJD > mjd
+	}) - 2
+	if i0 > n-4 {
+		i0 = n - 4
+	}
+	if i0 < 0 {
+		i0 = 0
+	}
+	m := 4
+	if n < m {
+		m = n
+	}
+
+	for i := 0; i < m; i++ {
+		r := t.Records[i0+i]
+		x[i] = r.MJD
+		dat, err := eopDat(ls, r.MJD, 0.0)
+		if err != nil {
+			return e, err
+		}
+		ut[i] = r.DUT1 - dat
+		e.Flags |= r.Flags
+	}
+
+	// Interpolate each quantity.
+	w := lagrange(mjd, x[:m])
+	for i := 0; i < m; i++ {
+		r := t.Records[i0+i]
+		dut += w[i] * ut[i]
+		e.LOD += w[i] * r.LOD
+		e.Xp += w[i] * r.Xp
+		e.Yp += w[i] * r.Yp
+		e.DX += w[i] * r.DX
+		e.DY += w[i] * r.DY
+	}
+
+	// Back from UT1-TAI to UT1-UTC.
+	dat, err := eopDat(ls, mjd, 0.0)
+	if err != nil {
+		return e, err
+	}
+	e.MJD = mjd
+	e.DUT1 = dut + dat
+	e.Offsets = t.Offsets
+	return e, nil
+}
+
+// eopDat returns TAI-UTC for the day containing the given MJD, fd
+// being the fraction used for pre-1972 drift.
+func eopDat(ls LeapSeconds, mjd, fd float64) (float64, error) {
+	iy, im, id, f, err := Jd2cal(DJM0, mjd)
+	if err != nil {
+		return 0, err
+	}
+	dat, err := ls.Dat(iy, im, id, f+fd)
+	if err != nil && err.Code() < 0 {
+		return 0, err
+	}
+	return dat, nil
+}
+
+// lagrange returns the Lagrangian interpolation weights at x for the
+// abscissae xs.
+func lagrange(x float64, xs []float64) (w [4]float64) {
+	for i := range xs {
+		w[i] = 1.0
+		for j := range xs {
+			if i != j {
+				w[i] *= (x - xs[j]) / (xs[i] - xs[j])
+			}
+		}
+	}
+	return
+}
+
+// LoadEOPFile opens and reads an IERS file with parse, one of the
+// ParseXxx functions of this package.
+func LoadEOPFile(name string, parse func(io.Reader) (*EOPTable, error)) (
+	*EOPTable, error) {
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	t, err := parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return t, nil
+}
+
+// ParseFinals2000A reads the IERS finals2000A.all, finals2000A.data or
+// finals2000A.daily fixed width format, whose pole offsets are dX,dY
+// with respect to IAU 2000A.  Bulletin B values are used where given,
+// otherwise those of Bulletin A, with its prediction flags.
+func ParseFinals2000A(r io.Reader) (*EOPTable, error) {
+	return parseFinals(r, OffsetsDXDY)
+}
+
+// ParseFinals reads the IERS finals.all, finals.data or finals.daily
+// fixed width format, whose pole offsets are dpsi,deps with respect to
+// IAU 1976/1980.
+func ParseFinals(r io.Reader) (*EOPTable, error) {
+	return parseFinals(r, OffsetsDpsiDeps)
+}
+
+// parseFinals reads the finals format, see readme.finals2000A.
+func parseFinals(r io.Reader, k PoleOffsets) (*EOPTable, error) {
+	t := &EOPTable{Offsets: k}
+	s := bufio.NewScanner(r)
+	for ln := 1; s.Scan(); ln++ {
+		line := s.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		var e EOP
+		var ok bool
+		if e.MJD, ok = column(line, 8, 15); !ok {
+			return nil, fmt.Errorf("line %d: %w", ln, errEOPFormat)
+		}
+
+		// Polar motion and UT1-UTC, the table ends where the
+		// predictions do.
+		xp, ok1 := column(line, 19, 27)
+		yp, ok2 := column(line, 38, 46)
+		dut, ok3 := column(line, 59, 68)
+		if !(ok1 && ok2 && ok3) {
+			break
+		}
+		if columnByte(line, 17) == 'P' {
+			e.Flags |= EOPPredictedPM
+		}
+		if columnByte(line, 58) == 'P' {
+			e.Flags |= EOPPredictedUT1
+		}
+		if lod, ok := column(line, 80, 86); ok {
+			e.LOD = lod * 1e-3
+		}
+		dx, ok1 := column(line, 98, 106)
+		dy, ok2 := column(line, 117, 125)
+		if ok1 && ok2 {
+			if columnByte(line, 96) == 'P' {
+				e.Flags |= EOPPredictedOffsets
+			}
+		} else {
+			dx, dy = 0, 0
+			e.Flags |= EOPNoOffsets
+		}
+
+		// Bulletin B values where available.
+		if bx, ok := column(line, 135, 144); ok {
+			by, ok1 := column(line, 145, 154)
+			bu, ok2 := column(line, 155, 165)
+			if ok1 && ok2 {
+				xp, yp, dut = bx, by, bu
+				e.Flags &^= EOPPredictedPM | EOPPredictedUT1
+			}
+		}
+		if bx, ok := column(line, 166, 175); ok {
+			if by, ok := column(line, 176, 185); ok {
+				dx, dy = bx, by
+				e.Flags &^= EOPPredictedOffsets | EOPNoOffsets
+			}
+		}
+
+		e.Xp = xp * DAS2R
+		e.Yp = yp * DAS2R
+		e.DUT1 = dut
+		e.DX = dx * DMAS2R
+		e.DY = dy * DMAS2R
+		t.Records = append(t.Records, e)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return t.finish()
+}
+
+// ParseC04 reads the IERS EOP 14 C04 series, in which all values are
+// final and the pole offsets are dX,dY in arcseconds.
+func ParseC04(r io.Reader) (*EOPTable, error) {
+	t := &EOPTable{Offsets: OffsetsDXDY}
+	s := bufio.NewScanner(r)
+	for ln := 1; s.Scan(); ln++ {
+		f := strings.Fields(s.Text())
+
+		// Skip the header, the data lines start with the date.
+		if len(f) < 10 || len(f[0]) != 4 {
+			continue
+		}
+		if _, err := strconv.Atoi(f[0]); err != nil {
+			continue
+		}
+		v, err := floats(f[3:10])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", ln, errEOPFormat)
+		}
+		t.Records = append(t.Records, EOP{
+			MJD:  v[0],
+			Xp:   v[1] * DAS2R,
+			Yp:   v[2] * DAS2R,
+			DUT1: v[3],
+			LOD:  v[4],
+			DX:   v[5] * DAS2R,
+			DY:   v[6] * DAS2R,
+		})
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return t.finish()
+}
+
+// ParseBulletinB reads section 1 of IERS Bulletin B, the daily final
+// values of x, y, UT1-UTC, dX and dY, in mas and ms.  Values in a
+// preliminary extension are flagged as predicted.
+func ParseBulletinB(r io.Reader) (*EOPTable, error) {
+	var in, prelim bool
+	t := &EOPTable{Offsets: OffsetsDXDY}
+	s := bufio.NewScanner(r)
+	for ln := 1; s.Scan(); ln++ {
+		line := strings.TrimSpace(s.Text())
+		up := strings.ToUpper(line)
+
+		// Section headings are of the form "1 - DAILY FINAL ...".
+		if len(line) > 4 && line[0] >= '0' && line[0] <= '9' &&
+			strings.HasPrefix(strings.TrimLeft(line[1:], " "), "- ") {
+			in = line[0] == '1'
+			continue
+		}
+		if !in {
+			continue
+		}
+		if strings.Contains(up, "PRELIMINARY") {
+			prelim = true
+			continue
+		}
+		f := strings.Fields(line)
+		if len(f) < 9 || len(f[0]) != 4 {
+			continue
+		}
+		if _, err := strconv.Atoi(f[0]); err != nil {
+			continue
+		}
+		v, err := floats(f[3:9])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", ln, errEOPFormat)
+		}
+		e := EOP{
+			MJD:  v[0],
+			Xp:   v[1] * DMAS2R,
+			Yp:   v[2] * DMAS2R,
+			DUT1: v[3] * 1e-3,
+			DX:   v[4] * DMAS2R,
+			DY:   v[5] * DMAS2R,
+		}
+		if prelim {
+			e.Flags = EOPPredicted
+		}
+		t.Records = append(t.Records, e)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return t.finish()
+}
+
+// finish checks that a freshly parsed table is usable.
+func (t *EOPTable) finish() (*EOPTable, error) {
+	if len(t.Records) == 0 {
+		return nil, errEOPEmpty
+	}
+	for i := 1; i < len(t.Records); i++ {
+		if t.Records[i].MJD <= t.Records[i-1].MJD {
+			return nil, errEOPOrder
+		}
+	}
+	return t, nil
+}
+
+// column returns the number in the 1-based inclusive columns a to b of
+// a fixed width line, ok is false if they are blank or absent.
+func column(line string, a, b int) (v float64, ok bool) {
+	if len(line) < a {
+		return
+	}
+	if len(line) < b {
+		b = len(line)
+	}
+	f := strings.TrimSpace(line[a-1 : b])
+	if f == "" {
+		return
+	}
+	v, err := strconv.ParseFloat(f, 64)
+	return v, err == nil
+}
+
+// columnByte returns the character in the 1-based column c of a line.
+func columnByte(line string, c int) byte {
+	if len(line) < c {
+		return ' '
+	}
+	return line[c-1]
+}
+
+// floats parses a list of numbers.
+func floats(f []string) ([]float64, error) {
+	v := make([]float64, len(f))
+	for i := range f {
+		var err error
+		if v[i], err = strconv.ParseFloat(f[i], 64); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,130 @@
+package sofa
+
+import (
+	"strings"
+	"testing"
+)
+
+// eopFinals spans the leap second at the end of 2016, with Bulletin B
+// values for the first five days and predictions for the last two.
+const eopFinals = `161228 57750.00 I  0.026100 0.000030  0.278000 0.000030  I-0.4055900 0.0000100  1.1000 0.0100  I     0.060    0.050    -0.050    0.050  0.026000  0.278000 -0.4056000     0.060    -0.050
+161229 57751.00 I  0.027100 0.000030  0.278500 0.000030  I-0.4066900 0.0000100  1.1000 0.0100  I     0.070    0.050    -0.050    0.050  0.027000  0.278500 -0.4067000     0.070    -0.050
+161230 57752.00 I  0.028100 0.000030  0.279000 0.000030  I-0.4077900 0.0000100  1.1000 0.0100  I     0.080    0.050    -0.050    0.050  0.028000  0.279000 -0.4078000     0.080    -0.050
+161231 57753.00 I  0.029100 0.000030  0.279500 0.000030  I-0.4088900 0.0000100  1.1000 0.0100  I     0.090    0.050    -0.050    0.050  0.029000  0.279500 -0.4089000     0.090    -0.050
+17 1 1 57754.00 I  0.030100 0.000030  0.280000 0.000030  I 0.5900100 0.0000100  1.1000 0.0100  I     0.100    0.050    -0.050    0.050  0.030000  0.280000  0.5900000     0.100    -0.050
+17 1 2 57755.00 I  0.031000 0.000030  0.280500 0.000030  I 0.5889000 0.0000100  1.1000 0.0100  I     0.110    0.050    -0.050    0.050
+17 1 3 57756.00 P  0.032000 0.000030  0.281000 0.000030  P 0.5878000 0.0000100  1.1000 0.0100  P     0.120    0.050    -0.050    0.050
+17 1 4 57757.00 P  0.033000 0.000030  0.281500 0.000030  P 0.5867000 0.0000100  1.1000 0.0100  P     0.130    0.050    -0.050    0.050
+17 1 9 57762.00
+`
+
+const eopC04 = `                          EARTH ORIENTATION PARAMETERS (IERS EOP 14 C04)
+
+      Date      MJD      x          y        UT1-UTC       LOD         dX        dY        x Err     y Err   UT1-UTC Err  LOD Err     dX Err       dY Err
+                         "          "           s           s          "         "           "          "          s         s            "           "
+     (0h UTC)
+
+2016  12  30  57752   0.028000   0.279000  -0.4078000   0.0011000   0.000080  -0.000050   0.000030   0.000030  0.0000100  0.0000100    0.000050    0.000050
+2016  12  31  57753   0.029000   0.279500  -0.4089000   0.0011000   0.000090  -0.000050   0.000030   0.000030  0.0000100  0.0000100    0.000050    0.000050
+2017   1   1  57754   0.030000   0.280000   0.5900000   0.0011000   0.000100  -0.000050   0.000030   0.000030  0.0000100  0.0000100    0.000050    0.000050
+2017   1   2  57755   0.031000   0.280500   0.5889000   0.0011000   0.000110  -0.000050   0.000030   0.000030  0.0000100  0.0000100    0.000050    0.000050
+`
+
+const eopBulletinB = `BULLETIN B 348
+
+ 1 - DAILY FINAL VALUES OF  x, y, UT1-UTC, dX, dY
+     Angles are in milliseconds of arc, times in milliseconds of time.
+
+       DATE      MJD        x       y      UT1-UTC      dX     dY      x err  y err   UT1 err  dX err  dY err
+      (0 h UTC)            mas     mas       ms         mas    mas     mas    mas      ms      mas    mas
+
+ 2016  12  30  57752     28.000  279.000  -407.8000    0.080 -0.050   0.030  0.030  0.0100   0.050  0.050
+ 2016  12  31  57753     29.000  279.500  -408.9000    0.090 -0.050   0.030  0.030  0.0100   0.050  0.050
+ 2017   1   1  57754     30.000  280.000   590.0000    0.100 -0.050   0.030  0.030  0.0100   0.050  0.050
+     PRELIMINARY EXTENSION
+ 2017   1   2  57755     31.000  280.500   588.9000    0.110 -0.050   0.030  0.030  0.0100   0.050  0.050
+
+ 2 - SMOOTHED VALUES OF x, y, UT1-UTC, UT1-UT1R, D, dX, dY
+ 2016  12  30  57752     28.000  279.000  -407.8000    0.080 -0.050
+`
+
+func TestEOPTable(t *testing.T) {
+	const fname = "EOPTable"
+	tests := []struct {
+		ref   string
+		pred  bool
+		parse func(string) (*EOPTable, error)
+	}{
+		{"finals2000A", false, func(s string) (*EOPTable, error) {
+			return ParseFinals2000A(strings.NewReader(s))
+		}},
+		{"c04", false, func(s string) (*EOPTable, error) {
+			return ParseC04(strings.NewReader(s))
+		}},
+		{"bulletinB", true, func(s string) (*EOPTable, error) {
+			return ParseBulletinB(strings.NewReader(s))
+		}},
+	}
+	data := map[string]string{
+		"finals2000A": eopFinals,
+		"c04":         eopC04,
+		"bulletinB":   eopBulletinB,
+	}
+
+	for _, test := range tests {
+		tname := fname + " " + test.ref
+
+		tbl, err := test.parse(data[test.ref])
+		if err != nil {
+			t.Fatalf("%s: %v", tname, err)
+		}
+
+		// Across the leap second UT1-TAI is smooth.
+		e, err := tbl.EOP(DJM0, 57753.5)
+		errT(t, nil, err, tname, "err")
+		vvd(t, e.DUT1, -0.40945, 1e-12, tname, "dut1")
+		vvd(t, e.Xp, 0.0295*DAS2R, 1e-15, tname, "xp")
+		vvd(t, e.Yp, 0.27975*DAS2R, 1e-15, tname, "yp")
+		vvd(t, e.DX, 0.095*DMAS2R, 1e-15, tname, "dx")
+		vvd(t, e.DY, -0.05*DMAS2R, 1e-15, tname, "dy")
+		if e.Predicted() != test.pred {
+			t.Errorf("%s: want predicted %v got %v", tname,
+				test.pred, e.Predicted())
+		}
+
+		e, err = tbl.EOP(DJM0, 57754.25)
+		errT(t, nil, err, tname, "err")
+		vvd(t, e.DUT1, 0.589725, 1e-12, tname, "dut1 after")
+
+		_, err = tbl.EOP(DJM0, 57751.0)
+		if tbl.Records[0].MJD > 57751.0 && err != errEOPRange {
+			t.Errorf("%s: want %v got %v", tname, errEOPRange, err)
+		}
+	}
+}
+
+func TestEOPFinals(t *testing.T) {
+	const fname = "EOPFinals"
+
+	tbl, err := ParseFinals2000A(strings.NewReader(eopFinals))
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	viv(t, len(tbl.Records), 8, fname, "records")
+
+	// Bulletin B is preferred to Bulletin A.
+	vvd(t, tbl.Records[0].Xp, 0.026*DAS2R, 1e-15, fname, "bulletin B")
+	vvd(t, tbl.Records[5].Xp, 0.031*DAS2R, 1e-15, fname, "bulletin A")
+	vvd(t, tbl.Records[0].LOD, 1.1e-3, 1e-15, fname, "lod")
+
+	// Predictions are flagged.
+	e, err := tbl.EOP(DJM0, 57754.5)
+	errT(t, nil, err, fname, "err")
+	if !e.Predicted() {
+		t.Errorf("%s: want predicted flag", fname)
+	}
+	vvd(t, e.DUT1, 0.58945, 1e-12, fname, "dut1")
+
+	_, err = tbl.EOP(DJM0, 57758.0)
+	errT(t, errEOPRange, err, fname, "range")
+}
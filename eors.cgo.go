@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoEors Equation of the origins, given the classical NPB matrix and
+//  the quantity s.
+func CgoEors(rnpb [3][3]float64, s float64) (eo float64) {
+	cRnpb := v3tGo2C(rnpb)
+	cF := C.iauEors(&cRnpb[0], C.double(s))
+	return float64(cF)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoEors Equation of the origins, given the classical NPB matrix and
+//  Eors Equation of the origins, given the classical NPB matrix and
 //  the quantity s.
 //
 //  - - - - -
@@ -46,17 +44,9 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEors Equation of the origins, given the classical NPB matrix and
+//  Eors Equation of the origins, given the classical NPB matrix and
 //  the quantity s.
-func CgoEors(rnpb [3][3]float64, s float64) (eo float64) {
-	cRnpb := v3tGo2C(rnpb)
-	cF := C.iauEors(&cRnpb[0], C.double(s))
-	return float64(cF)
-}
-
-// GoEors Equation of the origins, given the classical NPB matrix and the
-// quantity s.
-func GoEors(rnpb [3][3]float64, s float64) (eo float64) {
+func Eors(rnpb [3][3]float64, s float64) (eo float64) {
 	var x, ax, xs, ys, zs, p, q float64
 
 	// Evaluate Wallace & Capitaine (2006) expression (16).
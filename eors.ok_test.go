@@ -37,7 +37,7 @@ func TestEors(t *testing.T) {
 		fn  func([3][3]float64, float64) float64
 	}{
 		{"cgo", CgoEors},
-		{"go", GoEors},
+		{"go", Eors},
 	}
 
 	for _, test := range tests {
@@ -70,7 +70,7 @@ func BenchmarkEors(b *testing.B) {
 		fn  func([3][3]float64, float64) float64
 	}{
 		{"cgo", CgoEors},
-		{"go", GoEors},
+		{"go", Eors},
 	}
 
 	for _, test := range tests {
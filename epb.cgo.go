@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoEpb Julian Date to Besselian Epoch.
+func CgoEpb(dj1, dj2 float64) (be float64) {
+	var cF C.double
+	cF = C.iauEpb(C.double(dj1), C.double(dj2))
+	return float64(cF)
+}
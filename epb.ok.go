@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoEpb Julian Date to Besselian Epoch.
+//  Epb Julian Date to Besselian Epoch.
 //
 //  - - - -
 //   E p b
@@ -38,15 +35,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEpb Julian Date to Besselian Epoch.
-func CgoEpb(dj1, dj2 float64) (be float64) {
-	var cF C.double
-	cF = C.iauEpb(C.double(dj1), C.double(dj2))
-	return float64(cF)
-}
-
-//  GoEpb Julian Date to Besselian Epoch.
-func GoEpb(dj1, dj2 float64) (be float64) {
+//  Epb Julian Date to Besselian Epoch.
+func Epb(dj1, dj2 float64) (be float64) {
 
 	// J2000.0-B1900.0 (2415019.81352) in days.
 	const D1900 = 36524.68648
@@ -22,7 +22,7 @@ func TestEpb(t *testing.T) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoEpb},
-		{"go", GoEpb},
+		{"go", Epb},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -39,7 +39,7 @@ func BenchmarkEpb(b *testing.B) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoEpb},
-		{"go", GoEpb},
+		{"go", Epb},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoEpb2jd Besselian Epoch to Julian Date.
+func CgoEpb2jd(epb float64) (djm0, djm float64) {
+	var cDjm0, cDjm C.double
+	C.iauEpb2jd(C.double(epb), &cDjm0, &cDjm)
+	return float64(cDjm0), float64(cDjm)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoEpb2jd Besselian Epoch to Julian Date.
+//  Epb2jd Besselian Epoch to Julian Date.
 //
 //  - - - - - - -
 //   E p b 2 j d
@@ -38,15 +35,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEpb2jd Besselian Epoch to Julian Date.
-func CgoEpb2jd(epb float64) (djm0, djm float64) {
-	var cDjm0, cDjm C.double
-	C.iauEpb2jd(C.double(epb), &cDjm0, &cDjm)
-	return float64(cDjm0), float64(cDjm)
-}
-
-//  GoEpb2jd Besselian Epoch to Julian Date.
-func GoEpb2jd(epb float64) (djm0, djm float64) {
+//  Epb2jd Besselian Epoch to Julian Date.
+func Epb2jd(epb float64) (djm0, djm float64) {
 
 	djm0 = DJM0
 	djm = 15019.81352 + (epb-1900.0)*DTY
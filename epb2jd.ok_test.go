@@ -24,7 +24,7 @@ func TestEpb2jd(t *testing.T) {
 		fn  func(float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoEpb2jd},
-		{"go", GoEpb2jd},
+		{"go", Epb2jd},
 	}
 
 	for _, test := range tests {
@@ -47,7 +47,7 @@ func BenchmarkEpb2jd(b *testing.B) {
 		fn  func(float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoEpb2jd},
-		{"go", GoEpb2jd},
+		{"go", Epb2jd},
 	}
 
 	for _, test := range tests {
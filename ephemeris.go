@@ -0,0 +1,185 @@
+package sofa
+
+import "fmt"
+
+// NAIF integer codes of solar system bodies, as used by JPL ephemerides
+// and by Ephemeris.
+const (
+	NaifSSB     = 0 // solar system barycentre
+	NaifMercury = 199
+	NaifVenus   = 299
+	NaifEMB     = 3 // Earth-Moon barycentre
+	NaifMars    = 4 // barycentre, for Mars and its moons
+	NaifJupiter = 5 // barycentre
+	NaifSaturn  = 6 // barycentre
+	NaifUranus  = 7 // barycentre
+	NaifNeptune = 8 // barycentre
+	NaifSun     = 10
+	NaifMoon    = 301
+	NaifEarth   = 399
+)
+
+// Ephemeris supplies the positions and velocities of the Earth and of
+// other bodies, in au and au/day with respect to the BCRS, at a TDB
+// 2-part Julian Date.
+//
+// An error for which IsWarning holds, such as the date warning of
+// Epv00, leaves the result usable.  *spk.File satisfies Ephemeris.
+type Ephemeris interface {
+	// Epv returns the heliocentric and barycentric position and
+	// velocity of the Earth, as Epv00 does.
+	Epv(date1, date2 float64) (pvh, pvb [2][3]float64, err error)
+
+	// Body returns the barycentric position and velocity of the
+	// body with the NAIF code body.
+	Body(body int, date1, date2 float64) (pv [2][3]float64, err error)
+}
+
+// EphemerisBodyError is returned by an Ephemeris that has no data for
+// a body.
+type EphemerisBodyError struct {
+	Body int
+}
+
+func (e *EphemerisBodyError) Error() string {
+	return fmt.Sprintf("ephemeris has no body %d", e.Body)
+}
+
+// SOFAEphemeris is the Ephemeris of the SOFA models: Epv00 for the
+// Earth and the Sun and Plan94 for the planets.
+type SOFAEphemeris struct{}
+
+// DefaultEphemeris is used by the functions that take an Ephemeris
+// when given nil.
+var DefaultEphemeris Ephemeris = SOFAEphemeris{}
+
+func ephemeris(eph Ephemeris) Ephemeris {
+	if eph == nil {
+		return DefaultEphemeris
+	}
+	return eph
+}
+
+// Epv returns the Earth from Epv00.
+func (SOFAEphemeris) Epv(date1, date2 float64) (pvh, pvb [2][3]float64,
+	err error) {
+	return Epv00(date1, date2)
+}
+
+// plan94Bodies are the Plan94 planet numbers of the NAIF codes.
+var plan94Bodies = map[int]int{
+	1: 1, NaifMercury: 1, 2: 2, NaifVenus: 2, NaifEMB: 3, NaifMars: 4,
+	499: 4, NaifJupiter: 5, 599: 5, NaifSaturn: 6, 699: 6,
+	NaifUranus: 7, 799: 7, NaifNeptune: 8, 899: 8,
+}
+
+// Body returns the Sun and the Earth from Epv00, the Moon from Epv00
+// and Moon98 and the planets from Plan94.  The planets are barycentric
+// through the Sun of Epv00, and are the same whether the code of the
+// planet or of its barycentre is given.
+func (SOFAEphemeris) Body(body int, date1, date2 float64) (
+	pv [2][3]float64, err error) {
+	if body == NaifSSB {
+		return pv, nil
+	}
+	np, planet := plan94Bodies[body]
+	if !planet && body != NaifSun && body != NaifEarth &&
+		body != NaifMoon {
+		return pv, &EphemerisBodyError{body}
+	}
+	pvh, pvb, err := Epv00(date1, date2)
+	switch body {
+	case NaifEarth:
+		return pvb, err
+	case NaifMoon:
+		return Pvppv(pvb, Moon98(date1, date2)), err
+	}
+	sun := Pvmpv(pvb, pvh)
+	if body == NaifSun {
+		return sun, err
+	}
+	hpv, perr := Plan94(date1, date2, np)
+	if perr != nil {
+		if perr.Code() < 0 {
+			return pv, perr
+		}
+		err = perr
+	}
+	return Pvppv(hpv, sun), err
+}
+
+// ephemerisErr returns err unless it is a warning, which is kept in
+// warn to be returned along with the result.
+func ephemerisErr(err error, warn *error) error {
+	if err != nil && IsWarning(err) {
+		*warn = err
+		return nil
+	}
+	return err
+}
+
+// Apcg13Eph is Apcg13 with the Earth from eph, or from DefaultEphemeris
+// if eph is nil.
+func Apcg13Eph(eph Ephemeris, date1, date2 float64, astrom ASTROM) (
+	ASTROM, error) {
+	pvh, pvb, err := ephemeris(eph).Epv(date1, date2)
+	var warn error
+	if err = ephemerisErr(err, &warn); err != nil {
+		return astrom, fmt.Errorf("Apcg13: %w", err)
+	}
+	return Apcg(date1, date2, pvb, pvh[0], astrom), warn
+}
+
+// Apci13Eph is Apci13 with the Earth from eph, or from DefaultEphemeris
+// if eph is nil.
+func Apci13Eph(eph Ephemeris, date1, date2 float64, astrom ASTROM) (
+	ASTROM, float64, error) {
+	return Apci13Model(nil, eph, date1, date2, astrom)
+}
+
+// Apcs13Eph is Apcs13 with the Earth from eph, or from DefaultEphemeris
+// if eph is nil.
+func Apcs13Eph(eph Ephemeris, date1, date2 float64, pv [2][3]float64,
+	astrom ASTROM) (ASTROM, error) {
+	ehpv, ebpv, err := ephemeris(eph).Epv(date1, date2)
+	var warn error
+	if err = ephemerisErr(err, &warn); err != nil {
+		return astrom, fmt.Errorf("Apcs13: %w", err)
+	}
+	return Apcs(date1, date2, pv, ebpv, ehpv[0], astrom), warn
+}
+
+// Apco13Eph is Apco13 with the Earth from eph, or from DefaultEphemeris
+// if eph is nil.  The warnings of Apco13 and of eph are returned as
+// err.
+func Apco13Eph(eph Ephemeris, utc1, utc2, dut1, elong, phi, hm, xp, yp,
+	phpa, tc, rh, wl float64, astr ASTROM) (astrom ASTROM, eo float64,
+	err error) {
+	return Apco13Model(nil, eph, utc1, utc2, dut1, elong, phi, hm, xp,
+		yp, phpa, tc, rh, wl, astr)
+}
+
+// LdbodiesEph is Ldbodies with the bodies from eph, or from
+// DefaultEphemeris if eph is nil.  Saturn and Jupiter are taken as
+// their barycentres.
+func LdbodiesEph(eph Ephemeris, date1, date2 float64) ([]LDBODY,
+	error) {
+	eph = ephemeris(eph)
+	var warn error
+	var b []LDBODY
+	for _, body := range []struct {
+		id     int
+		bm, dl float64
+	}{
+		{NaifSaturn, LdSaturnBm, LdSaturnDl},
+		{NaifJupiter, LdJupiterBm, LdJupiterDl},
+		{NaifSun, LdSunBm, LdSunDl},
+	} {
+		pv, err := eph.Body(body.id, date1, date2)
+		if err = ephemerisErr(err, &warn); err != nil {
+			return nil, fmt.Errorf("Ldbodies: %w", err)
+		}
+		b = append(b, NewLDBODY(body.bm, body.dl, pv))
+	}
+	return b, warn
+}
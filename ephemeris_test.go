@@ -0,0 +1,152 @@
+package sofa
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/8i8/sofa/spk"
+)
+
+var _ Ephemeris = (*spk.File)(nil)
+
+// testEphemeris moves the Earth of Epv00 by a fixed offset, or fails.
+type testEphemeris struct {
+	db  [3]float64
+	err error
+}
+
+func (e testEphemeris) Epv(date1, date2 float64) (pvh, pvb [2][3]float64,
+	err error) {
+	if e.err != nil {
+		return pvh, pvb, e.err
+	}
+	pvh, pvb, err = Epv00(date1, date2)
+	for j := 0; j < 3; j++ {
+		pvb[0][j] += e.db[j]
+		pvh[0][j] += e.db[j]
+	}
+	return
+}
+
+func (e testEphemeris) Body(body int, date1, date2 float64) (
+	pv [2][3]float64, err error) {
+	if e.err != nil {
+		return pv, e.err
+	}
+	return SOFAEphemeris{}.Body(body, date1, date2)
+}
+
+func TestEphemerisBuilders(t *testing.T) {
+	const fname = "Ephemeris builders"
+	var astr ASTROM
+	date1, date2 := 2456165.5, 0.401182685
+	pv := [2][3]float64{{-1836024.09, 1056607.72, -5998795.26},
+		{-77.0361767, -133.310856, 0.0971855934}}
+
+	a := Apcg13(date1, date2, astr)
+	b, err := Apcg13Eph(nil, date1, date2, astr)
+	errT(t, nil, err, fname, "Apcg13Eph err")
+	if a != b {
+		t.Errorf("%s: Apcg13Eph differs from Apcg13", fname)
+	}
+
+	a, eo := Apci13(date1, date2, astr)
+	b, eob, err := Apci13Eph(SOFAEphemeris{}, date1, date2, astr)
+	errT(t, nil, err, fname, "Apci13Eph err")
+	if a != b || eo != eob {
+		t.Errorf("%s: Apci13Eph differs from Apci13", fname)
+	}
+
+	a = Apcs13(date1, date2, pv, astr)
+	b, err = Apcs13Eph(nil, date1, date2, pv, astr)
+	errT(t, nil, err, fname, "Apcs13Eph err")
+	if a != b {
+		t.Errorf("%s: Apcs13Eph differs from Apcs13", fname)
+	}
+
+	utc1, utc2 := 2456384.5, 0.969254051
+	a, eo, _ = Apco13(utc1, utc2, 0.1550675, -0.527800806, -1.2345856,
+		2738.0, 2.47230737e-7, 1.82640464e-6, 731.0, 12.8, 0.59, 0.55,
+		astr)
+	b, eob, err = Apco13Eph(nil, utc1, utc2, 0.1550675, -0.527800806,
+		-1.2345856, 2738.0, 2.47230737e-7, 1.82640464e-6, 731.0, 12.8,
+		0.59, 0.55, astr)
+	errT(t, nil, err, fname, "Apco13Eph err")
+	if a != b || eo != eob {
+		t.Errorf("%s: Apco13Eph differs from Apco13", fname)
+	}
+}
+
+func TestEphemerisCustom(t *testing.T) {
+	const fname = "Ephemeris custom"
+	var astr ASTROM
+	date1, date2 := 2456165.5, 0.401182685
+
+	eph := testEphemeris{db: [3]float64{1e-3, -2e-3, 3e-3}}
+	a, _ := Apci13(date1, date2, astr)
+	b, _, err := Apci13Eph(eph, date1, date2, astr)
+	errT(t, nil, err, fname, "err")
+	for j := 0; j < 3; j++ {
+		vvd(t, b.Eb[j], a.Eb[j]+eph.db[j], 1e-15, fname, "eb")
+	}
+
+	fail := testEphemeris{err: errors.New("no data")}
+	if _, _, err = Apci13Eph(fail, date1, date2, astr); !errors.Is(err,
+		fail.err) {
+		t.Errorf("%s: want %v got %v", fname, fail.err, err)
+	}
+	if _, _, err = Apco13Eph(fail, 2456384.5, 0.969254051, 0.1550675,
+		-0.527800806, -1.2345856, 2738.0, 0, 0, 731.0, 12.8, 0.59,
+		0.55, astr); !errors.Is(err, fail.err) {
+		t.Errorf("%s: want %v got %v", fname, fail.err, err)
+	}
+	if _, err = LdbodiesEph(fail, date1, date2); !errors.Is(err,
+		fail.err) {
+		t.Errorf("%s: want %v got %v", fname, fail.err, err)
+	}
+
+	// A warning leaves the result.
+	_, _, err = Apci13Eph(nil, 2300000.5, 0.0, astr)
+	errT(t, errEpv00Warn, err, fname, "warning")
+}
+
+func TestSOFAEphemeris(t *testing.T) {
+	const fname = "SOFAEphemeris"
+	date1, date2 := 2456165.5, 0.401182685
+
+	b, _ := Ldbodies(date1, date2)
+	c, err := LdbodiesEph(nil, date1, date2)
+	errT(t, nil, err, fname, "err")
+	viv(t, len(c), len(b), fname, "n")
+	for i := range b {
+		if b[i] != c[i] {
+			t.Errorf("%s: body %d differs from Ldbodies", fname, i)
+		}
+	}
+	var astrom ASTROM
+	astrom, _ = Apci13(date1, date2, astrom)
+	ldbodiesAtciqn(t, fname, astrom, c)
+
+	var eph SOFAEphemeris
+	m, _ := eph.Body(NaifMars, date1, date2)
+	m4, _ := eph.Body(499, date1, date2)
+	if m != m4 {
+		t.Errorf("%s: Mars differs from its barycentre", fname)
+	}
+
+	e, _ := eph.Body(NaifEarth, date1, date2)
+	mo, err := eph.Body(NaifMoon, date1, date2)
+	errT(t, nil, err, fname, "Moon err")
+	gm := Moon98(date1, date2)
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			vvd(t, mo[i][j]-e[i][j], gm[i][j], 1e-15, fname, "Moon")
+		}
+	}
+
+	_, err = eph.Body(NaifMoon+1, date1, date2)
+	var be *EphemerisBodyError
+	if !errors.As(err, &be) || be.Body != NaifMoon+1 {
+		t.Errorf("%s: want an EphemerisBodyError got %v", fname, err)
+	}
+}
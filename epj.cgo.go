@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoEpj Julian Date to Julian Epoch.
+func CgoEpj(dj1, dj2 float64) (epj float64) {
+	var cEpj C.double
+	cEpj = C.iauEpj(C.double(dj1), C.double(dj2))
+	return float64(cEpj)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoEpj Julian Date to Julian Epoch.
+//  Epj Julian Date to Julian Epoch.
 //
 //  - - - -
 //   E p j
@@ -38,15 +35,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEpj Julian Date to Julian Epoch.
-func CgoEpj(dj1, dj2 float64) (epj float64) {
-	var cEpj C.double
-	cEpj = C.iauEpj(C.double(dj1), C.double(dj2))
-	return float64(cEpj)
-}
-
-//  GoEpj Julian Date to Julian Epoch.
-func GoEpj(dj1, dj2 float64) (epj float64) {
+//  Epj Julian Date to Julian Epoch.
+func Epj(dj1, dj2 float64) (epj float64) {
 
 	epj = 2000.0 + ((dj1-DJ00)+dj2)/DJY
 	return
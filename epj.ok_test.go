@@ -22,7 +22,7 @@ func TestEpj(t *testing.T) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoEpj},
-		{"go", GoEpj},
+		{"go", Epj},
 	}
 
 	for _, test := range tests {
@@ -40,7 +40,7 @@ func BenchmarkEpj(b *testing.B) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoEpj},
-		{"go", GoEpj},
+		{"go", Epj},
 	}
 
 	for _, test := range tests {
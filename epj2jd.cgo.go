@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoEpj2jd Julian Epoch to Julian Date.
+func CgoEpj2jd(epj float64) (djm0, djm float64) {
+	var cDjm0, cDjm C.double
+	C.iauEpj2jd(C.double(epj), &cDjm0, &cDjm)
+	return float64(cDjm0), float64(cDjm)
+}
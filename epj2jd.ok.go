@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoEpj2jd Julian Epoch to Julian Date.
+//  Epj2jd Julian Epoch to Julian Date.
 //
 //  - - - - - - -
 //   E p j 2 j d
@@ -38,15 +35,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEpj2jd Julian Epoch to Julian Date.
-func CgoEpj2jd(epj float64) (djm0, djm float64) {
-	var cDjm0, cDjm C.double
-	C.iauEpj2jd(C.double(epj), &cDjm0, &cDjm)
-	return float64(cDjm0), float64(cDjm)
-}
-
-//  GoEpj2jd Julian Epoch to Julian Date.
-func GoEpj2jd(epj float64) (djm0, djm float64) {
+//  Epj2jd Julian Epoch to Julian Date.
+func Epj2jd(epj float64) (djm0, djm float64) {
 
 	djm0 = DJM0
 	djm = DJM00 + (epj-2000.0)*365.25
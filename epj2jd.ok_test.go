@@ -24,7 +24,7 @@ func TestEpj2jd(t *testing.T) {
 		fn  func(float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoEpj2jd},
-		{"go", GoEpj2jd},
+		{"go", Epj2jd},
 	}
 
 	for _, test := range tests {
@@ -47,7 +47,7 @@ func BenchmarkEpj2jd(b *testing.B) {
 		fn  func(float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoEpj2jd},
-		{"go", GoEpj2jd},
+		{"go", Epj2jd},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,34 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include <sofa.h>
+// #include <sofam.h>
+import "C"
+
+//  CgoEpv00 Earth position and velocity, heliocentric and barycentric,
+//  with respect to the Barycentric Celestial Reference System.
+func CgoEpv00(date1, date2 float64) (pvh, pvb [2][3]float64, err error) {
+
+	var cPvh, cPvb [2][3]C.double
+
+	//  Earth position and velocity, heliocentric and barycentric,
+	//  with respect to the Barycentric Celestial Reference System.
+	cI := C.iauEpv00(C.double(date1), C.double(date2), &cPvh[0], &cPvb[0])
+
+	// C into go types.
+	pvh = v3dC2Go(cPvh)
+	pvb = v3dC2Go(cPvb)
+
+	// Status
+	switch int(cI) {
+	case 0:
+	case 1:
+		err = errEpv00Warn
+	default:
+		err = errAdmin
+	}
+
+	return
+}
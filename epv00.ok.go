@@ -1,8 +1,5 @@
 package sofa
 
-// #include <sofa.h>
-// #include <sofam.h>
-import "C"
 import (
 	"errors"
 	"math"
@@ -15,7 +12,7 @@ var errEpv00Warn = errors.New("date outside the range 1900-2100 AD")
 // 	"date outside the range 1900-2100 AD",
 // })
 
-//  CgoEpv00 Earth position and velocity, heliocentric and barycentric,
+//  Epv00 Earth position and velocity, heliocentric and barycentric,
 //  with respect to the Barycentric Celestial Reference System.
 //
 //  - - - - - -
@@ -117,35 +114,9 @@ var errEpv00Warn = errors.New("date outside the range 1900-2100 AD")
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEpv00 Earth position and velocity, heliocentric and barycentric,
+//  Epv00 Earth position and velocity, heliocentric and barycentric,
 //  with respect to the Barycentric Celestial Reference System.
-func CgoEpv00(date1, date2 float64) (pvh, pvb [2][3]float64, err error) {
-
-	var cPvh, cPvb [2][3]C.double
-
-	//  Earth position and velocity, heliocentric and barycentric,
-	//  with respect to the Barycentric Celestial Reference System.
-	cI := C.iauEpv00(C.double(date1), C.double(date2), &cPvh[0], &cPvb[0])
-
-	// C into go types.
-	pvh = v3dC2Go(cPvh)
-	pvb = v3dC2Go(cPvb)
-
-	// Status
-	switch int(cI) {
-	case 0:
-	case 1:
-		err = errEpv00Warn
-	default:
-		err = errAdmin
-	}
-
-	return
-}
-
-//  GoEpv00 Earth position and velocity, heliocentric and barycentric,
-//  with respect to the Barycentric Celestial Reference System.
-func GoEpv00(date1, date2 float64) (pvh, pvb [2][3]float64, err error) {
+func Epv00(date1, date2 float64) (pvh, pvb [2][3]float64, err error) {
 
 	const (
 		am12 = 0.000000211284
@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoEqec06 Transformation from ICRS equatorial coordinates to
+//  ecliptic coordinates (mean equinox and ecliptic of date) using IAU
+//  2006 precession model.
+func CgoEqec06(date1, date2, dr, dd float64) (dl, db float64) {
+	var cDl, cDb C.double
+	C.iauEqec06(C.double(date1), C.double(date2), C.double(dr),
+		C.double(dd), &cDl, &cDb)
+	return float64(cDl), float64(cDb)
+}
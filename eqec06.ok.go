@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoEqec06 Transformation from ICRS equatorial coordinates to
+//  Eqec06 Transformation from ICRS equatorial coordinates to
 //  ecliptic coordinates (mean equinox and ecliptic of date) using IAU
 //  2006 precession model.
 //
@@ -65,38 +62,28 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEqec06 Transformation from ICRS equatorial coordinates to
-//  ecliptic coordinates (mean equinox and ecliptic of date) using IAU
-//  2006 precession model.
-func CgoEqec06(date1, date2, dr, dd float64) (dl, db float64) {
-	var cDl, cDb C.double
-	C.iauEqec06(C.double(date1), C.double(date2), C.double(dr),
-		C.double(dd), &cDl, &cDb)
-	return float64(cDl), float64(cDb)
-}
-
-//  GoEqec06 Transformation from ICRS equatorial coordinates to
+//  Eqec06 Transformation from ICRS equatorial coordinates to
 //  ecliptic coordinates (mean equinox and ecliptic of date) using IAU
 //  2006 precession model.
-func GoEqec06(date1, date2, dr, dd float64) (dl, db float64) {
+func Eqec06(date1, date2, dr, dd float64) (dl, db float64) {
 	var rm [3][3]float64
 	var v1, v2 [3]float64
 	var a, b float64
 
 	// Spherical to Cartesian.
-	v1 = GoS2c(dr, dd)
+	v1 = S2c(dr, dd)
 
 	// Rotation matrix, ICRS equatorial to ecliptic.
-	rm = GoEcm06(date1, date2)
+	rm = Ecm06(date1, date2)
 
 	// The transformation from ICRS to ecliptic.
-	v2 = GoRxp(rm, v1)
+	v2 = Rxp(rm, v1)
 
 	// Cartesian to spherical.
-	a, b = GoC2s(v2)
+	a, b = C2s(v2)
 
 	// Express in conventional ranges.
-	dl = GoAnp(a)
-	db = GoAnpm(b)
+	dl = Anp(a)
+	db = Anpm(b)
 	return
 }
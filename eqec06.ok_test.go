@@ -27,7 +27,7 @@ func TestEqec06(t *testing.T) {
 		fn  func(a1, a2, a3, a4 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoEqec06},
-		{"go", GoEqec06},
+		{"go", Eqec06},
 	}
 
 	for _, test := range tests {
@@ -52,7 +52,7 @@ func BenchmarkEqec06(b *testing.B) {
 		fn  func(a1, a2, a3, a4 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoEqec06},
-		{"go", GoEqec06},
+		{"go", Eqec06},
 	}
 
 	for _, test := range tests {
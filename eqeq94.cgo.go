@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoEqeq94 Equation of the equinoxes, IAU 1994 model.
+// double iauEqeq94(double date1, double date2)
+func CgoEqeq94(date1, date2 float64) (ee float64) {
+	var cEe C.double
+	cEe = C.iauEqeq94(C.double(date1), C.double(date2))
+	return float64(cEe)
+}
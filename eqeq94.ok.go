@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoEqeq94 Equation of the equinoxes, IAU 1994 model.
+//  Eqeq94 Equation of the equinoxes, IAU 1994 model.
 //
 //  - - - - - - -
 //   E q e q 9 4
@@ -64,17 +62,9 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEqeq94 Equation of the equinoxes, IAU 1994 model.
+//  Eqeq94 Equation of the equinoxes, IAU 1994 model.
 // double iauEqeq94(double date1, double date2)
-func CgoEqeq94(date1, date2 float64) (ee float64) {
-	var cEe C.double
-	cEe = C.iauEqeq94(C.double(date1), C.double(date2))
-	return float64(cEe)
-}
-
-//  GoEqeq94 Equation of the equinoxes, IAU 1994 model.
-// double iauEqeq94(double date1, double date2)
-func GoEqeq94(date1, date2 float64) (ee float64) {
+func Eqeq94(date1, date2 float64) (ee float64) {
 	var t, om, dpsi, eps0  float64
 
 	// Interval between fundamental epoch J2000.0 and given date (JC).
@@ -82,13 +72,13 @@ func GoEqeq94(date1, date2 float64) (ee float64) {
 
 	// Longitude of the mean ascending node of the lunar orbit on the
 	// ecliptic, measured from the mean equinox of date.
-	om = GoAnpm((450160.280+(-482890.539+
+	om = Anpm((450160.280+(-482890.539+
 		(7.455+0.008*t)*t)*t)*DAS2R +
 		math.Mod(-5.0*t, 1.0)*D2PI)
 
 	// Nutation components and mean obliquity.
-	dpsi, _ = GoNut80(date1, date2)
-	eps0 = GoObl80(date1, date2)
+	dpsi, _ = Nut80(date1, date2)
+	eps0 = Obl80(date1, date2)
 
 	// Equation of the equinoxes.
 	ee = dpsi*math.Cos(eps0) + DAS2R*(0.00264*math.Sin(om)+
@@ -22,7 +22,7 @@ func TestEqeq94(t *testing.T) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoEqeq94},
-		{"go", GoEqeq94},
+		{"go", Eqeq94},
 	}
 
 	for _, test := range tests {
@@ -40,7 +40,7 @@ func BenchmarkEqeq94(b *testing.B) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoEqeq94},
-		{"go", GoEqeq94},
+		{"go", Eqeq94},
 	}
 
 	for _, test := range tests {
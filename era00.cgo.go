@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoEra00 Earth rotation angle (IAU 2000 model).
+func CgoEra00(dj1, dj2 float64) float64 {
+	var cR C.double
+	cR = C.iauEra00(C.double(dj1), C.double(dj2))
+	return float64(cR)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoEra00 Earth rotation angle (IAU 2000 model).
+//  Era00 Earth rotation angle (IAU 2000 model).
 //
 //  - - - - - -
 //   E r a 0 0
@@ -67,15 +65,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoEra00 Earth rotation angle (IAU 2000 model).
-func CgoEra00(dj1, dj2 float64) float64 {
-	var cR C.double
-	cR = C.iauEra00(C.double(dj1), C.double(dj2))
-	return float64(cR)
-}
-
-// GoEra00 Earth rotation angle (IAU 2000 model).
-func GoEra00(dj1, dj2 float64) float64 {
+//  Era00 Earth rotation angle (IAU 2000 model).
+func Era00(dj1, dj2 float64) float64 {
 	var d1, d2, t, f, theta float64
 
 	// Days since fundamental epoch.
@@ -92,7 +83,7 @@ func GoEra00(dj1, dj2 float64) float64 {
 	f = math.Mod(d1, 1.0) + math.Mod(d2, 1.0)
 
 	// Earth rotation angle at this UT1.
-	theta = GoAnp(D2PI * (f + 0.7790572732640 +
+	theta = Anp(D2PI * (f + 0.7790572732640 +
 		0.00273781191135448*t))
 	return theta
 }
@@ -20,7 +20,7 @@ func TestEra00(t *testing.T) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoEra00},
-		{"go", GoEra00},
+		{"go", Era00},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -35,7 +35,7 @@ func BenchmarkEra00(b *testing.B) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoEra00},
-		{"go", GoEra00},
+		{"go", Era00},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFad03 Mean elongation of the Moon from the Sun (IERS Conventions
+//  2003).
+func CgoFad03(t float64) float64 {
+	var cF C.double
+	cF = C.iauFad03(C.double(t))
+	return float64(cF)
+}
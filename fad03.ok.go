@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoFad03 Mean elongation of the Moon from the Sun (IERS Conventions
+//  Fad03 Mean elongation of the Moon from the Sun (IERS Conventions
 //  2003).
 //
 //  - - - - - -
@@ -44,17 +42,9 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFad03 Mean elongation of the Moon from the Sun (IERS Conventions
+//  Fad03 Mean elongation of the Moon from the Sun (IERS Conventions
 //  2003).
-func CgoFad03(t float64) float64 {
-	var cF C.double
-	cF = C.iauFad03(C.double(t))
-	return float64(cF)
-}
-
-// GoFad03 Fundamental argument, IERS Conventions (2003): mean elongation
-// of the Moon from the Sun.
-func GoFad03(t float64) float64 {
+func Fad03(t float64) float64 {
 	// Mean elongation of the Moon from the Sun (IERS Conventions 2003).
 	return math.Mod(1072260.703692+
 		t*(1602961601.2090+
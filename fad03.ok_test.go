@@ -21,7 +21,7 @@ func TestFad03(t *testing.T) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFad03},
-		{"go", GoFad03},
+		{"go", Fad03},
 	}
 
 	for _, test := range tests {
@@ -37,7 +37,7 @@ func BenchmarkFad03(b *testing.B) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFad03},
-		{"go", GoFad03},
+		{"go", Fad03},
 	}
 
 	for _, test := range tests {
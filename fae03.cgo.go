@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFae03 Mean longitude of Earth (IERS Conventions 2003).
+func CgoFae03(t float64) float64 {
+	var cF C.double
+	cF = C.iauFae03(C.double(t))
+	return float64(cF)
+}
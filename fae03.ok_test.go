@@ -21,7 +21,7 @@ func TestFae03(t *testing.T) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFae03},
-		{"go", GoFae03},
+		{"go", Fae03},
 	}
 
 	for _, test := range tests {
@@ -37,7 +37,7 @@ func BenchmarkFae03(b *testing.B) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFae03},
-		{"go", GoFae03},
+		{"go", Fae03},
 	}
 
 	for _, test := range tests {
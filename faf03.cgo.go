@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFaf03 is the SOFA C version of Faf03.
+func CgoFaf03(t float64) float64 {
+	var cF C.double
+	cF = C.iauFaf03(C.double(t))
+	return float64(cF)
+}
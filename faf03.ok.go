@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoFaf03 Mean longitude of the Moon minus that of the ascending node
+//  Faf03 Mean longitude of the Moon minus that of the ascending node
 //  (IERS Conventions 2003).
 //
 //  - - - - - -
@@ -46,15 +44,7 @@ import "math"
 //
 //  Mean longitude of the Moon minus that of the ascending node (IERS
 //  Conventions 2003).
-func CgoFaf03(t float64) float64 {
-	var cF C.double
-	cF = C.iauFaf03(C.double(t))
-	return float64(cF)
-}
-
-//  GoFaf03 mean longitude of the Moon minus mean longitude of the
-//  ascending node.  Fundamental argument, IERS Conventions (2003)
-func GoFaf03(t float64) float64 {
+func Faf03(t float64) float64 {
 	// Mean longitude of the Moon minus that of the ascending node
 	// (IERS Conventions 2003).
 	return math.Mod(335779.526232+
@@ -21,7 +21,7 @@ func TestFaf03(t *testing.T) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFaf03},
-		{"go", GoFaf03},
+		{"go", Faf03},
 	}
 
 	for _, test := range tests {
@@ -37,7 +37,7 @@ func BenchmarkFaf03(b *testing.B) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFaf03},
-		{"go", GoFaf03},
+		{"go", Faf03},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFaju03 Mean longitude of Jupiter (IERS Conventions 2003).
+func CgoFaju03(t float64) float64 {
+	var cF C.double
+	cF = C.iauFaju03(C.double(t))
+	return float64(cF)
+}
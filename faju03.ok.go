@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoFaju03 Mean longitude of Jupiter (IERS Conventions 2003).
+//  Faju03 Mean longitude of Jupiter (IERS Conventions 2003).
 //
 //  - - - - - - -
 //   F a j u 0 3
@@ -46,16 +44,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFaju03 Mean longitude of Jupiter (IERS Conventions 2003).
-func CgoFaju03(t float64) float64 {
-	var cF C.double
-	cF = C.iauFaju03(C.double(t))
-	return float64(cF)
-}
-
-//  CgoFaju03 mean longitude of Jupiter.
-//  Fundamental argument, IERS Conventions (2003):
-func GoFaju03(t float64) float64 {
+//  Faju03 Mean longitude of Jupiter (IERS Conventions 2003).
+func Faju03(t float64) float64 {
 	// Mean longitude of Jupiter (IERS Conventions 2003).
 	return math.Mod(0.599546497+52.9690962641*t, D2PI)
 }
@@ -20,7 +20,7 @@ func TestFaju03(t *testing.T) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFaju03},
-		{"go", GoFaju03},
+		{"go", Faju03},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -35,7 +35,7 @@ func BenchmarkFaju03(b *testing.B) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFaju03},
-		{"go", GoFaju03},
+		{"go", Faju03},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
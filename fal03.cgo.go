@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFal03 Mean anomaly of the Moon (IERS Conventions 2003).
+func CgoFal03(t float64) float64 {
+	var cF C.double
+	cF = C.iauFal03(C.double(t))
+	return float64(cF)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoFal03 Mean anomaly of the Moon (IERS Conventions 2003).
+//  Fal03 Mean anomaly of the Moon (IERS Conventions 2003).
 //
 //  - - - - - -
 //   F a l 0 3
@@ -43,16 +41,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFal03 Mean anomaly of the Moon (IERS Conventions 2003).
-func CgoFal03(t float64) float64 {
-	var cF C.double
-	cF = C.iauFal03(C.double(t))
-	return float64(cF)
-}
-
-//  CgoFal03 mean anomaly of the Moon.
-//  Fundamental argument, IERS Conventions (2003):
-func GoFal03(t float64) float64 {
+//  Fal03 Mean anomaly of the Moon (IERS Conventions 2003).
+func Fal03(t float64) float64 {
 	// Mean anomaly of the Moon (IERS Conventions 2003).
 	return math.Mod(485868.249036+
 		t*(1717915923.2178+
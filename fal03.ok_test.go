@@ -20,7 +20,7 @@ func TestFal03(t *testing.T) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFal03},
-		{"go", GoFal03},
+		{"go", Fal03},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -35,7 +35,7 @@ func BenchmarkFal03(b *testing.B) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFal03},
-		{"go", GoFal03},
+		{"go", Fal03},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFalp03 Mean anomaly of the Sun (IERS Conventions 2003).
+func CgoFalp03(t float64) float64 {
+	var cF C.double
+	cF = C.iauFalp03(C.double(t))
+	return float64(cF)
+}
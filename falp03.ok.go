@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoFalp03 Mean anomaly of the Sun (IERS Conventions 2003).
+//  Falp03 Mean anomaly of the Sun (IERS Conventions 2003).
 //
 //  - - - - - - -
 //   F a l p 0 3
@@ -46,15 +44,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFalp03 Mean anomaly of the Sun (IERS Conventions 2003).
-func CgoFalp03(t float64) float64 {
-	var cF C.double
-	cF = C.iauFalp03(C.double(t))
-	return float64(cF)
-}
-
-// GoFalp03 Mean anomaly of the Sun (IERS Conventions 2003).
-func GoFalp03(t float64) float64 {
+//  Falp03 Mean anomaly of the Sun (IERS Conventions 2003).
+func Falp03(t float64) float64 {
 	return math.Mod(1287104.793048+
 		t*(129596581.0481+
 			t*(-0.5532+
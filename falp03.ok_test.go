@@ -20,7 +20,7 @@ func TestFalp03(t *testing.T) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFalp03},
-		{"go", GoFalp03},
+		{"go", Falp03},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -35,7 +35,7 @@ func BenchmarkFalp03(b *testing.B) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFalp03},
-		{"go", GoFalp03},
+		{"go", Falp03},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFama03 Mean longitude of Mars (IERS Conventions 2003).
+func CgoFama03(t float64) float64 {
+	var cF C.double
+	cF = C.iauFama03(C.double(t))
+	return float64(cF)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoFama03 Mean longitude of Mars (IERS Conventions 2003).
+//  Fama03 Mean longitude of Mars (IERS Conventions 2003).
 //
 //  - - - - - - -
 //   F a m a 0 3
@@ -46,14 +44,7 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFama03 Mean longitude of Mars (IERS Conventions 2003).
-func CgoFama03(t float64) float64 {
-	var cF C.double
-	cF = C.iauFama03(C.double(t))
-	return float64(cF)
-}
-
-// GoFama03 Mean longitude of Mars (IERS Conventions 2003).
-func GoFama03(t float64) float64 {
+//  Fama03 Mean longitude of Mars (IERS Conventions 2003).
+func Fama03(t float64) float64 {
 	return math.Mod(6.203480913+334.0612426700*t, D2PI)
 }
@@ -20,7 +20,7 @@ func TestFama03(t *testing.T) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFama03},
-		{"go", GoFama03},
+		{"go", Fama03},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -35,7 +35,7 @@ func BenchmarkFama03(b *testing.B) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFama03},
-		{"go", GoFama03},
+		{"go", Fama03},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
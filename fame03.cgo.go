@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFame03 Mean longitude of Mercury (IERS Conventions 2003).
+func CgoFame03(t float64) float64 {
+	var cF C.double
+	cF = C.iauFame03(C.double(t))
+	return float64(cF)
+}
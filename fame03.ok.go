@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoFame03 Mean longitude of Mercury (IERS Conventions 2003).
+//  Fame03 Mean longitude of Mercury (IERS Conventions 2003).
 //
 //  - - - - - - -
 //   F a m e 0 3
@@ -49,15 +47,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFame03 Mean longitude of Mercury (IERS Conventions 2003).
-func CgoFame03(t float64) float64 {
-	var cF C.double
-	cF = C.iauFame03(C.double(t))
-	return float64(cF)
-}
-
-// GoFame03 Mean longitude of Mercury (IERS Conventions 2003).
-func GoFame03(t float64) float64 {
+//  Fame03 Mean longitude of Mercury (IERS Conventions 2003).
+func Fame03(t float64) float64 {
 	// Mean longitude of Mercury (IERS Conventions 2003).
 	return math.Mod(4.402608842+2608.7903141574*t, D2PI)
 }
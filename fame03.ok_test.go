@@ -20,7 +20,7 @@ func TestFame03(t *testing.T) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFame03},
-		{"go", GoFame03},
+		{"go", Fame03},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -35,7 +35,7 @@ func BenchmarkFame03(b *testing.B) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFame03},
-		{"go", GoFame03},
+		{"go", Fame03},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
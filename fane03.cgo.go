@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFane03 Mean longitude of Neptune (IERS Conventions 2003).
+func CgoFane03(t float64) float64 {
+	var cF C.double
+	cF = C.iauFane03(C.double(t))
+	return float64(cF)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoFane03 Mean longitude of Neptune (IERS Conventions 2003).
+//  Fane03 Mean longitude of Neptune (IERS Conventions 2003).
 //
 //  - - - - - - -
 //   F a n e 0 3
@@ -46,14 +44,7 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFane03 Mean longitude of Neptune (IERS Conventions 2003).
-func CgoFane03(t float64) float64 {
-	var cF C.double
-	cF = C.iauFane03(C.double(t))
-	return float64(cF)
-}
-
-// GoFane03 Mean longitude of Neptune (IERS Conventions 2003).
-func GoFane03(t float64) float64 {
+//  Fane03 Mean longitude of Neptune (IERS Conventions 2003).
+func Fane03(t float64) float64 {
 	return math.Mod(5.311886287+3.8133035638*t, D2PI)
 }
@@ -20,7 +20,7 @@ func TestFane03(t *testing.T) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFane03},
-		{"go", GoFane03},
+		{"go", Fane03},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -35,7 +35,7 @@ func BenchmarkFane03(b *testing.B) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFane03},
-		{"go", GoFane03},
+		{"go", Fane03},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
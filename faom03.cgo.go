@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFaom03 Mean longitude of the Moon's ascending node (IERS
+//  Conventions 2003).
+func CgoFaom03(t float64) float64 {
+	var cF C.double
+	cF = C.iauFaom03(C.double(t))
+	return float64(cF)
+}
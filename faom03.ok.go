@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoFaom03 Mean longitude of the Moon's ascending node (IERS
+//  Faom03 Mean longitude of the Moon's ascending node (IERS
 //  Conventions 2003).
 //
 //  - - - - - - -
@@ -47,17 +45,9 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFaom03 Mean longitude of the Moon's ascending node (IERS
+//  Faom03 Mean longitude of the Moon's ascending node (IERS
 //  Conventions 2003).
-func CgoFaom03(t float64) float64 {
-	var cF C.double
-	cF = C.iauFaom03(C.double(t))
-	return float64(cF)
-}
-
-// GoFaom03 Mean longitude of the Moon's ascending node (IERS Conventions
-// 2003).
-func GoFaom03(t float64) float64 {
+func Faom03(t float64) float64 {
 	return math.Mod(450160.398036+
 		t*(-6962890.5431+
 			t*(7.4722+
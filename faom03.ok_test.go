@@ -20,7 +20,7 @@ func TestFaom03(t *testing.T) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFaom03},
-		{"go", GoFaom03},
+		{"go", Faom03},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -35,7 +35,7 @@ func BenchmarkFaom03(b *testing.B) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFaom03},
-		{"go", GoFaom03},
+		{"go", Faom03},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
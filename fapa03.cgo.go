@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFapa03 General accumulated precession in longitude.
+func CgoFapa03(t float64) float64 {
+	var cF C.double
+	cF = C.iauFapa03(C.double(t))
+	return float64(cF)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoFapa03 General accumulated precession in longitude.
+//  Fapa03 General accumulated precession in longitude.
 //
 //  - - - - - - -
 //   F a p a 0 3
@@ -49,14 +46,7 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFapa03 General accumulated precession in longitude.
-func CgoFapa03(t float64) float64 {
-	var cF C.double
-	cF = C.iauFapa03(C.double(t))
-	return float64(cF)
-}
-
-// GoFapa03 General accumulated precession in longitude.
-func GoFapa03(t float64) float64 {
+//  Fapa03 General accumulated precession in longitude.
+func Fapa03(t float64) float64 {
 	return (0.024381750 + 0.00000538691*t) * t
 }
@@ -20,7 +20,7 @@ func TestFapa03(t *testing.T) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFapa03},
-		{"go", GoFapa03},
+		{"go", Fapa03},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -35,7 +35,7 @@ func BenchmarkFapa03(b *testing.B) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFapa03},
-		{"go", GoFapa03},
+		{"go", Fapa03},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFasa03 Mean longitude of Saturn (IERS Conventions 2003).
+func CgoFasa03(t float64) float64 {
+	var cF C.double
+	cF = C.iauFasa03(C.double(t))
+	return float64(cF)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoFasa03 Mean longitude of Saturn (IERS Conventions 2003).
+//  Fasa03 Mean longitude of Saturn (IERS Conventions 2003).
 //
 //  - - - - - - -
 //   F a s a 0 3
@@ -49,14 +47,7 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFasa03 Mean longitude of Saturn (IERS Conventions 2003).
-func CgoFasa03(t float64) float64 {
-	var cF C.double
-	cF = C.iauFasa03(C.double(t))
-	return float64(cF)
-}
-
-// GoFasa03 Mean longitude of Saturn (IERS Conventions 2003).
-func GoFasa03(t float64) float64 {
+//  Fasa03 Mean longitude of Saturn (IERS Conventions 2003).
+func Fasa03(t float64) float64 {
 	return math.Mod(0.874016757+21.3299104960*t, D2PI)
 }
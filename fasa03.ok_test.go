@@ -20,7 +20,7 @@ func TestFasa03(t *testing.T) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFasa03},
-		{"go", GoFasa03},
+		{"go", Fasa03},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -35,7 +35,7 @@ func BenchmarkFasa03(b *testing.B) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFasa03},
-		{"go", GoFasa03},
+		{"go", Fasa03},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
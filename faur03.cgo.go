@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFaur03 Mean longitude of Uranus (IERS Conventions 2003).
+func CgoFaur03(t float64) float64 {
+	var cF C.double
+	cF = C.iauFaur03(C.double(t))
+	return float64(cF)
+}
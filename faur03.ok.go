@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoFaur03 Mean longitude of Uranus (IERS Conventions 2003).
+//  Faur03 Mean longitude of Uranus (IERS Conventions 2003).
 //
 //  - - - - - - -
 //   F a u r 0 3
@@ -46,14 +44,7 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFaur03 Mean longitude of Uranus (IERS Conventions 2003).
-func CgoFaur03(t float64) float64 {
-	var cF C.double
-	cF = C.iauFaur03(C.double(t))
-	return float64(cF)
-}
-
-// GoFaur03 Mean longitude of Uranus (IERS Conventions 2003).
-func GoFaur03(t float64) float64 {
+//  Faur03 Mean longitude of Uranus (IERS Conventions 2003).
+func Faur03(t float64) float64 {
 	return math.Mod(5.481293872+7.4781598567*t, D2PI)
 }
@@ -20,7 +20,7 @@ func TestFaur03(t *testing.T) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFaur03},
-		{"go", GoFaur03},
+		{"go", Faur03},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -35,7 +35,7 @@ func BenchmarkFaur03(b *testing.B) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFaur03},
-		{"go", GoFaur03},
+		{"go", Faur03},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
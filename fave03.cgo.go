@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFave03 Mean longitude of Venus (IERS Conventions 2003).
+func CgoFave03(t float64) float64 {
+	var cF C.double
+	cF = C.iauFave03(C.double(t))
+	return float64(cF)
+}
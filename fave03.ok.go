@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoFave03 Mean longitude of Venus (IERS Conventions 2003).
+//  Fave03 Mean longitude of Venus (IERS Conventions 2003).
 //
 //  - - - - - - -
 //   F a v e 0 3
@@ -49,14 +47,7 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFave03 Mean longitude of Venus (IERS Conventions 2003).
-func CgoFave03(t float64) float64 {
-	var cF C.double
-	cF = C.iauFave03(C.double(t))
-	return float64(cF)
-}
-
-// GoFave03 Mean longitude of Venus (IERS Conventions 2003).
-func GoFave03(t float64) float64 {
+//  Fave03 Mean longitude of Venus (IERS Conventions 2003).
+func Fave03(t float64) float64 {
 	return math.Mod(3.176146697+1021.3285546211*t, D2PI)
 }
@@ -20,7 +20,7 @@ func TestFave03(t *testing.T) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFave03},
-		{"go", GoFave03},
+		{"go", Fave03},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -35,7 +35,7 @@ func BenchmarkFave03(b *testing.B) {
 		fn  func(float64) float64
 	}{
 		{"cgo", CgoFave03},
-		{"go", GoFave03},
+		{"go", Fave03},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -0,0 +1,18 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+//  #include "sofa.h"
+import "C"
+
+//  CgoFk425 Convert B1950.0 FK4 star catalog data to J2000.0 FK5.
+func CgoFk425(r1950, d1950, dr1950, dd1950, p1950, v1950 float64) (
+	r2000, d2000, dr2000, dd2000, p2000, v2000 float64) {
+	var cR2000, cD2000, cDr2000, cDd2000, cP2000, cV2000 C.double
+	C.iauFk425(C.double(r1950), C.double(d1950), C.double(dr1950),
+		C.double(dd1950), C.double(p1950), C.double(v1950),
+		&cR2000, &cD2000, &cDr2000, &cDd2000, &cP2000, &cV2000)
+	return float64(cR2000), float64(cD2000), float64(cDr2000),
+		float64(cDd2000), float64(cP2000), float64(cV2000)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-//  #include "sofa.h"
-import "C"
-
-//  CgoFk425 Convert B1950.0 FK4 star catalog data to J2000.0 FK5.
+//  Fk425 Convert B1950.0 FK4 star catalog data to J2000.0 FK5.
 //
 //  - - - - - -
 //   F k 4 2 5
@@ -117,19 +114,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFk425 Convert B1950.0 FK4 star catalog data to J2000.0 FK5.
-func CgoFk425(r1950, d1950, dr1950, dd1950, p1950, v1950 float64) (
-	r2000, d2000, dr2000, dd2000, p2000, v2000 float64) {
-	var cR2000, cD2000, cDr2000, cDd2000, cP2000, cV2000 C.double
-	C.iauFk425(C.double(r1950), C.double(d1950), C.double(dr1950),
-		C.double(dd1950), C.double(p1950), C.double(v1950),
-		&cR2000, &cD2000, &cDr2000, &cDd2000, &cP2000, &cV2000)
-	return float64(cR2000), float64(cD2000), float64(cDr2000),
-		float64(cDd2000), float64(cP2000), float64(cV2000)
-}
-
-//  GoFk425 Convert B1950.0 FK4 star catalog data to J2000.0 FK5.
-func GoFk425(r1950, d1950, dr1950, dd1950, p1950, v1950 float64) (
+//  Fk425 Convert B1950.0 FK4 star catalog data to J2000.0 FK5.
+func Fk425(r1950, d1950, dr1950, dd1950, p1950, v1950 float64) (
 	r2000, d2000, dr2000, dd2000, p2000, v2000 float64) {
 	// Radians double p1950, double v1950, per year to arcsec per
 	// century
@@ -198,13 +184,13 @@ func GoFk425(r1950, d1950, dr1950, dd1950, p1950, v1950 float64) (
 	// Express as a pv-vector.
 	pxvf = px * VF
 	w = rv * pxvf
-	r0 = GoS2pv(r, d, 1.0, ur, ud, w)
+	r0 = S2pv(r, d, 1.0, ur, ud, w)
 
 	// Allow for E-terms (cf. Seidelmann 3.591-2).
-	pv1 = GoPvmpv(r0, a)
-	pv2[0] = GoSxp(GoPdp(r0[0], a[0]), r0[0])
-	pv2[1] = GoSxp(GoPdp(r0[0], a[1]), r0[0])
-	pv1 = GoPvppv(pv1, pv2)
+	pv1 = Pvmpv(r0, a)
+	pv2[0] = Sxp(Pdp(r0[0], a[0]), r0[0])
+	pv2[1] = Sxp(Pdp(r0[0], a[1]), r0[0])
+	pv1 = Pvppv(pv1, pv2)
 
 	// Convert pv-vector to Fricke system (cf. Seidelmann 3.591-3).
 	for i = 0; i < 2; i++ {
@@ -220,14 +206,14 @@ func GoFk425(r1950, d1950, dr1950, dd1950, p1950, v1950 float64) (
 	}
 
 	// Revert to catalog form.
-	r, d, w, ur, ud, rd = GoPv2s(pv2)
+	r, d, w, ur, ud, rd = Pv2s(pv2)
 	if px > TINY {
 		rv = rd / pxvf
 		px = px / w
 	}
 
 	// Return the results.
-	r2000 = GoAnp(r)
+	r2000 = Anp(r)
 	d2000 = d
 	dr2000 = ur / PMF
 	dd2000 = ud / PMF
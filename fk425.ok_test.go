@@ -31,7 +31,7 @@ func TestFk425(t *testing.T) {
 			c1, c2, c3, c4, c5, c6 float64)
 	}{
 		{"cgo", CgoFk425},
-		{"go", GoFk425},
+		{"go", Fk425},
 	}
 
 	for _, test := range tests {
@@ -70,7 +70,7 @@ func BenchmarkFk425(b *testing.B) {
 			c1, c2, c3, c4, c5, c6 float64)
 	}{
 		{"cgo", CgoFk425},
-		{"go", GoFk425},
+		{"go", Fk425},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFk45z Convert a B1950.0 FK4 star position to J2000.0 FK5,
+//  assuming zero proper motion in the FK5 system.
+func CgoFk45z(r1950, d1950, bepoch float64) (r2000, d2000 float64) {
+	var cR2000, cD2000 C.double
+	C.iauFk45z(C.double(r1950), C.double(d1950), C.double(bepoch),
+		&cR2000, &cD2000)
+	return float64(cR2000), float64(cD2000)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoFk45z Convert a B1950.0 FK4 star position to J2000.0 FK5,
+//  Fk45z Convert a B1950.0 FK4 star position to J2000.0 FK5,
 //  assuming zero proper motion in the FK5 system.
 //
 //  - - - - - -
@@ -89,18 +86,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFk45z Convert a B1950.0 FK4 star position to J2000.0 FK5,
-//  assuming zero proper motion in the FK5 system.
-func CgoFk45z(r1950, d1950, bepoch float64) (r2000, d2000 float64) {
-	var cR2000, cD2000 C.double
-	C.iauFk45z(C.double(r1950), C.double(d1950), C.double(bepoch),
-		&cR2000, &cD2000)
-	return float64(cR2000), float64(cD2000)
-}
-
-//  GoFk45z Convert a B1950.0 FK4 star position to J2000.0 FK5,
+//  Fk45z Convert a B1950.0 FK4 star position to J2000.0 FK5,
 //  assuming zero proper motion in the FK5 system.
-func GoFk45z(r1950, d1950, bepoch float64) (r2000, d2000 float64) {
+func Fk45z(r1950, d1950, bepoch float64) (r2000, d2000 float64) {
 
 	// Radians per year to arcsec per century
 	const PMF = 100.0 * DR2AS
@@ -132,15 +120,15 @@ func GoFk45z(r1950, d1950, bepoch float64) (r2000, d2000 float64) {
 	//- - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
 
 	// Spherical coordinates to p-vector.
-	r0 = GoS2c(r1950, d1950)
+	r0 = S2c(r1950, d1950)
 
 	// Adjust p-vector A to give zero proper motion in FK5.
 	w = (bepoch - 1950) / PMF
-	p = GoPpsp(a, w, ad)
+	p = Ppsp(a, w, ad)
 
 	// Remove E-terms.
-	p = GoPpsp(p, -GoPdp(r0, p), r0)
-	p = GoPmp(r0, p)
+	p = Ppsp(p, -Pdp(r0, p), r0)
+	p = Pmp(r0, p)
 
 	// Convert to Fricke system pv-vector (cf. Seidelmann 3.591-3).
 	for i = 0; i < 2; i++ {
@@ -154,13 +142,13 @@ func GoFk45z(r1950, d1950, bepoch float64) (r2000, d2000 float64) {
 	}
 
 	// Allow for fictitious proper motion.
-	djm0, djm = GoEpb2jd(bepoch)
-	w = (GoEpj(djm0, djm) - 2000.0) / PMF
-	pv = GoPvu(w, pv)
+	djm0, djm = Epb2jd(bepoch)
+	w = (Epj(djm0, djm) - 2000.0) / PMF
+	pv = Pvu(w, pv)
 
 	// Revert to spherical coordinates.
-	w, d2000 = GoC2s(pv[0])
-	r2000 = GoAnp(w)
+	w, d2000 = C2s(pv[0])
+	r2000 = Anp(w)
 
 	// Finished.
 	return
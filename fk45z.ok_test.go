@@ -26,7 +26,7 @@ func TestFk45z(t *testing.T) {
 		fn  func(a1, a2, a3 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoFk45z},
-		{"go", GoFk45z},
+		{"go", Fk45z},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -52,7 +52,7 @@ func BenchmarkFk45z(b *testing.B) {
 		fn  func(a1, a2, a3 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoFk45z},
-		{"go", GoFk45z},
+		{"go", Fk45z},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -0,0 +1,18 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFk524 Convert J2000.0 FK5 star catalog data to B1950.0 FK4.
+func CgoFk524(r2000, d2000, dr2000, dd2000, p2000, v2000 float64) (
+	r1950, d1950, dr1950, dd1950, p1950, v1950 float64) {
+	var cR1950, cD1950, cDr1950, cDd1950, cP1950, cV1950 C.double
+	C.iauFk524(C.double(r2000), C.double(d2000), C.double(dr2000),
+		C.double(dd2000), C.double(p2000), C.double(v2000),
+		&cR1950, &cD1950, &cDr1950, &cDd1950, &cP1950, &cV1950)
+	return float64(cR1950), float64(cD1950), float64(cDr1950),
+		float64(cDd1950), float64(cP1950), float64(cV1950)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoFk524 Convert J2000.0 FK5 star catalog data to B1950.0 FK4.
+//  Fk524 Convert J2000.0 FK5 star catalog data to B1950.0 FK4.
 //
 //  - - - - - -
 //   F k 5 2 4
@@ -111,19 +108,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFk524 Convert J2000.0 FK5 star catalog data to B1950.0 FK4.
-func CgoFk524(r2000, d2000, dr2000, dd2000, p2000, v2000 float64) (
-	r1950, d1950, dr1950, dd1950, p1950, v1950 float64) {
-	var cR1950, cD1950, cDr1950, cDd1950, cP1950, cV1950 C.double
-	C.iauFk524(C.double(r2000), C.double(d2000), C.double(dr2000),
-		C.double(dd2000), C.double(p2000), C.double(v2000),
-		&cR1950, &cD1950, &cDr1950, &cDd1950, &cP1950, &cV1950)
-	return float64(cR1950), float64(cD1950), float64(cDr1950),
-		float64(cDd1950), float64(cP1950), float64(cV1950)
-}
-
-//  GoFk524 Convert J2000.0 FK5 star catalog data to B1950.0 FK4.
-func GoFk524(r2000, d2000, dr2000, dd2000, p2000, v2000 float64) (
+//  Fk524 Convert J2000.0 FK5 star catalog data to B1950.0 FK4.
+func Fk524(r2000, d2000, dr2000, dd2000, p2000, v2000 float64) (
 	r1950, d1950, dr1950, dd1950, p1950, v1950 float64) {
 	// Radians per year to arcsec per century
 	const PMF = 100.0 * DR2AS
@@ -188,7 +174,7 @@ func GoFk524(r2000, d2000, dr2000, dd2000, p2000, v2000 float64) (
 	// Express as a pv-vector.
 	pxvf = px * VF
 	w = rv * pxvf
-	r0 = GoS2pv(r, d, 1.0, ur, ud, w)
+	r0 = S2pv(r, d, 1.0, ur, ud, w)
 
 	// Convert pv-vector to Bessel-Newcomb system (cf. Seidelmann
 	// 3.592-1).
@@ -208,36 +194,36 @@ func GoFk524(r2000, d2000, dr2000, dd2000, p2000, v2000 float64) (
 	// iteration).
 
 	// Direction.
-	w = GoPm(r1[0])
-	p1 = GoSxp(GoPdp(r1[0], a[0]), r1[0])
-	p2 = GoSxp(w, a[0])
-	p1 = GoPmp(p2, p1)
-	p1 = GoPpp(r1[0], p1)
+	w = Pm(r1[0])
+	p1 = Sxp(Pdp(r1[0], a[0]), r1[0])
+	p2 = Sxp(w, a[0])
+	p1 = Pmp(p2, p1)
+	p1 = Ppp(r1[0], p1)
 
 	// Recompute length.
-	w = GoPm(p1)
+	w = Pm(p1)
 
 	// Direction.
-	p1 = GoSxp(GoPdp(r1[0], a[0]), r1[0])
-	p2 = GoSxp(w, a[0])
-	p1 = GoPmp(p2, p1)
-	pv[0] = GoPpp(r1[0], p1)
+	p1 = Sxp(Pdp(r1[0], a[0]), r1[0])
+	p2 = Sxp(w, a[0])
+	p1 = Pmp(p2, p1)
+	pv[0] = Ppp(r1[0], p1)
 
 	// Derivative.
-	p1 = GoSxp(GoPdp(r1[0], a[1]), pv[0])
-	p2 = GoSxp(w, a[1])
-	p1 = GoPmp(p2, p1)
-	pv[1] = GoPpp(r1[1], p1)
+	p1 = Sxp(Pdp(r1[0], a[1]), pv[0])
+	p2 = Sxp(w, a[1])
+	p1 = Pmp(p2, p1)
+	pv[1] = Ppp(r1[1], p1)
 
 	// Revert to catalog form.
-	r, d, w, ur, ud, rd = GoPv2s(pv)
+	r, d, w, ur, ud, rd = Pv2s(pv)
 	if px > TINY {
 		rv = rd / pxvf
 		px = px / w
 	}
 
 	// Return the results.
-	r1950 = GoAnp(r)
+	r1950 = Anp(r)
 	d1950 = d
 	dr1950 = ur / PMF
 	dd1950 = ud / PMF
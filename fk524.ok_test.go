@@ -31,7 +31,7 @@ func TestFk524(t *testing.T) {
 			c1, c2, c3, c4, c5, c6 float64)
 	}{
 		{"cgo", CgoFk524},
-		{"go", GoFk524},
+		{"go", Fk524},
 	}
 
 	for _, test := range tests {
@@ -71,7 +71,7 @@ func BenchmarkFk524(b *testing.B) {
 			c1, c2, c3, c4, c5, c6 float64)
 	}{
 		{"cgo", CgoFk524},
-		{"go", GoFk524},
+		{"go", Fk524},
 	}
 
 	for _, test := range tests {
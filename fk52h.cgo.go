@@ -0,0 +1,19 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFk52h Transform FK5 (J2000.0) star data into the Hipparcos
+//  system.
+func CgoFk52h(r5, d5, dr5, dd5, px5, rv5 float64) (
+	rh, dh, drh, ddh, pxh, rvh float64) {
+	var cRh, cDh, cDrh, cDdh, cPxh, cRvh C.double
+	C.iauFk52h(C.double(r5), C.double(d5), C.double(dr5),
+		C.double(dd5), C.double(px5), C.double(rv5),
+		&cRh, &cDh, &cDrh, &cDdh, &cPxh, &cRvh)
+	return float64(cRh), float64(cDh), float64(cDrh),
+		float64(cDdh), float64(cPxh), float64(cRvh)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoFk52h Transform FK5 (J2000.0) star data into the Hipparcos
+//  Fk52h Transform FK5 (J2000.0) star data into the Hipparcos
 //  system.
 //
 //  - - - - - -
@@ -63,21 +60,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFk52h Transform FK5 (J2000.0) star data into the Hipparcos
-//  system.
-func CgoFk52h(r5, d5, dr5, dd5, px5, rv5 float64) (
-	rh, dh, drh, ddh, pxh, rvh float64) {
-	var cRh, cDh, cDrh, cDdh, cPxh, cRvh C.double
-	C.iauFk52h(C.double(r5), C.double(d5), C.double(dr5),
-		C.double(dd5), C.double(px5), C.double(rv5),
-		&cRh, &cDh, &cDrh, &cDdh, &cPxh, &cRvh)
-	return float64(cRh), float64(cDh), float64(cDrh),
-		float64(cDdh), float64(cPxh), float64(cRvh)
-}
-
-//  GoFk52h Transform FK5 (J2000.0) star data into the Hipparcos
+//  Fk52h Transform FK5 (J2000.0) star data into the Hipparcos
 //  system.
-func GoFk52h(r5, d5, dr5, dd5, px5, rv5 float64) (
+func Fk52h(r5, d5, dr5, dd5, px5, rv5 float64) (
 	rh, dh, drh, ddh, pxh, rvh float64) {
 
 	var i int
@@ -86,10 +71,10 @@ func GoFk52h(r5, d5, dr5, dd5, px5, rv5 float64) (
 	var s5h, wxp, vv [3]float64
 
 	// FK5 barycentric position/velocity pv-vector (normalized).
-	pv5, _ = GoStarpv(r5, d5, dr5, dd5, px5, rv5)
+	pv5, _ = Starpv(r5, d5, dr5, dd5, px5, rv5)
 
 	// FK5 to Hipparcos orientation matrix and spin vector.
-	r5h, s5h = GoFk5hip()
+	r5h, s5h = Fk5hip()
 
 	// Make spin units per day instead of per year.
 	for i = 0; i < 3; i++ {
@@ -97,19 +82,19 @@ func GoFk52h(r5, d5, dr5, dd5, px5, rv5 float64) (
 	}
 
 	// Orient the FK5 position into the Hipparcos system.
-	pvh[0] = GoRxp(r5h, pv5[0])
+	pvh[0] = Rxp(r5h, pv5[0])
 
 	// Apply spin to the position giving an extra space motion
 	// component.
-	wxp = GoPxp(pv5[0], s5h)
+	wxp = Pxp(pv5[0], s5h)
 
 	// Add this component to the FK5 space motion.
-	vv = GoPpp(wxp, pv5[1])
+	vv = Ppp(wxp, pv5[1])
 
 	// Orient the FK5 space motion into the Hipparcos system.
-	pvh[1] = GoRxp(r5h, vv)
+	pvh[1] = Rxp(r5h, vv)
 
 	// Hipparcos pv-vector to spherical.
-	rh, dh, drh, ddh, pxh, rvh, _ = GoPvstar(pvh)
+	rh, dh, drh, ddh, pxh, rvh, _ = Pvstar(pvh)
 	return
 }
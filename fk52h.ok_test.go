@@ -31,7 +31,7 @@ func TestFk52h(t *testing.T) {
 			c1, c2, c3, c4, c5, c6 float64)
 	}{
 		{"cgo", CgoFk52h},
-		{"go", GoFk52h},
+		{"go", Fk52h},
 	}
 
 	for _, test := range tests {
@@ -67,7 +67,7 @@ func BenchmarkFk52h(b *testing.B) {
 			c1, c2, c3, c4, c5, c6 float64)
 	}{
 		{"cgo", CgoFk52h},
-		{"go", GoFk52h},
+		{"go", Fk52h},
 	}
 
 	for _, test := range tests {
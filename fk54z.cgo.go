@@ -0,0 +1,18 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFk54z Convert a J2000.0 FK5 star position to B1950.0 FK4, assuming zero
+//  proper motion in FK5 and parallax.
+func CgoFk54z(r2000, d2000, bepoch float64) (
+	r1950, d1950, dr1950, dd1950 float64) {
+	var cR1950, cD1950, cDr1950, cDd1950 C.double
+	C.iauFk54z(C.double(r2000), C.double(d2000), C.double(bepoch),
+		&cR1950, &cD1950, &cDr1950, &cDd1950)
+	return float64(cR1950), float64(cD1950), float64(cDr1950),
+		float64(cDd1950)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoFk54z Convert a J2000.0 FK5 star position to B1950.0 FK4, assuming zero
+//  Fk54z Convert a J2000.0 FK5 star position to B1950.0 FK4, assuming zero
 //  proper motion in FK5 and parallax.
 //
 //  - - - - - -
@@ -62,30 +60,19 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFk54z Convert a J2000.0 FK5 star position to B1950.0 FK4, assuming zero
+//  Fk54z Convert a J2000.0 FK5 star position to B1950.0 FK4, assuming zero
 //  proper motion in FK5 and parallax.
-func CgoFk54z(r2000, d2000, bepoch float64) (
-	r1950, d1950, dr1950, dd1950 float64) {
-	var cR1950, cD1950, cDr1950, cDd1950 C.double
-	C.iauFk54z(C.double(r2000), C.double(d2000), C.double(bepoch),
-		&cR1950, &cD1950, &cDr1950, &cDd1950)
-	return float64(cR1950), float64(cD1950), float64(cDr1950),
-		float64(cDd1950)
-}
-
-//  GoFk54z Convert a J2000.0 FK5 star position to B1950.0 FK4, assuming zero
-//  proper motion in FK5 and parallax.
-func GoFk54z(r2000, d2000, bepoch float64) (
+func Fk54z(r2000, d2000, bepoch float64) (
 	r1950, d1950, dr1950, dd1950 float64) {
 	var r, d, pr, pd, w float64
 	var p, v [3]float64
 	var i int
 
 	// FK5 equinox J2000.0 to FK4 equinox B1950.0.
-	r, d, pr, pd, _, _ = GoFk524(r2000, d2000, 0.0, 0.0, 0.0, 0.0)
+	r, d, pr, pd, _, _ = Fk524(r2000, d2000, 0.0, 0.0, 0.0, 0.0)
 
 	// Spherical to Cartesian.
-	p = GoS2c(r, d)
+	p = S2c(r, d)
 
 	// Fictitious proper motion (radians per year).
 	v[0] = -pr*p[1] - pd*math.Cos(r)*math.Sin(d)
@@ -99,8 +86,8 @@ func GoFk54z(r2000, d2000, bepoch float64) (
 	}
 
 	// Cartesian to spherical.
-	w, d1950 = GoC2s(p)
-	r1950 = GoAnp(w)
+	w, d1950 = C2s(p)
+	r1950 = Anp(w)
 
 	// Fictitious proper motion.
 	dr1950 = pr
@@ -26,7 +26,7 @@ func TestFk54z(t *testing.T) {
 		fn  func(a1, a2, a3 float64) (c1, c2, c3, c4 float64)
 	}{
 		{"cgo", CgoFk54z},
-		{"go", GoFk54z},
+		{"go", Fk54z},
 	}
 
 	for _, test := range tests {
@@ -57,7 +57,7 @@ func BenchmarkFk54z(b *testing.B) {
 		fn  func(a1, a2, a3 float64) (c1, c2, c3, c4 float64)
 	}{
 		{"cgo", CgoFk54z},
-		{"go", GoFk54z},
+		{"go", Fk54z},
 	}
 
 	for _, test := range tests {
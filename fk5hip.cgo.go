@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFk5hip FK5 to Hipparcos rotation and spin.
+func CgoFk5hip() (r5h [3][3]float64, s5h [3]float64) {
+	var cRh [3][3]C.double
+	var cS5h [3]C.double
+	C.iauFk5hip(&cRh[0], &cS5h[0])
+	return v3tC2Go(cRh), v3sC2Go(cS5h)
+}
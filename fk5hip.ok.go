@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoFk5hip FK5 to Hipparcos rotation and spin.
+//  Fk5hip FK5 to Hipparcos rotation and spin.
 //
 //  - - - - - - -
 //   F k 5 h i p
@@ -48,16 +45,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFk5hip FK5 to Hipparcos rotation and spin.
-func CgoFk5hip() (r5h [3][3]float64, s5h [3]float64) {
-	var cRh [3][3]C.double
-	var cS5h [3]C.double
-	C.iauFk5hip(&cRh[0], &cS5h[0])
-	return v3tC2Go(cRh), v3sC2Go(cS5h)
-}
-
-//  GoFk5hip FK5 to Hipparcos rotation and spin.
-func GoFk5hip() (r5h [3][3]float64, s5h [3]float64) {
+//  Fk5hip FK5 to Hipparcos rotation and spin.
+func Fk5hip() (r5h [3][3]float64, s5h [3]float64) {
 	var v [3]float64
 
 	// FK5 wrt Hipparcos orientation and spin (radians, radians/year)
@@ -77,7 +66,7 @@ func GoFk5hip() (r5h [3][3]float64, s5h [3]float64) {
 	v[2] = epz
 
 	// Re-express as an r-matrix.
-	r5h = GoRv2m(v)
+	r5h = Rv2m(v)
 
 	// Hipparcos wrt FK5 spin expressed as an r-vector.
 	s5h[0] = omx
@@ -23,7 +23,7 @@ func TestFk5hip(t *testing.T) {
 		fn  func()([3][3]float64, [3]float64) 
 	}{
 		{"cgo", CgoFk5hip},
-		{"go", GoFk5hip},
+		{"go", Fk5hip},
 	}
 
 	for _, test := range tests {
@@ -65,7 +65,7 @@ func BenchmarkFk5hip(b *testing.B) {
 		fn  func()([3][3]float64, [3]float64) 
 	}{
 		{"cgo", CgoFk5hip},
-		{"go", GoFk5hip},
+		{"go", Fk5hip},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFk5hz Transform an FK5 (J2000.0) star position into the system of the
+//  Hipparcos catalogue, assuming zero Hipparcos proper motion.
+func CgoFk5hz(r5, d5, date1, date2 float64) (rh, dh float64) {
+	var cRh, cDh C.double
+	C.iauFk5hz(C.double(r5), C.double(d5), C.double(date1),
+		C.double(date2), &cRh, &cDh)
+	return float64(cRh), float64(cDh)
+}
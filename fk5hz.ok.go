@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoFk5hz Transform an FK5 (J2000.0) star position into the system of the
+//  Fk5hz Transform an FK5 (J2000.0) star position into the system of the
 //  Hipparcos catalogue, assuming zero Hipparcos proper motion.
 //
 //  - - - - - - - - -
@@ -80,18 +77,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFk5hz Transform an FK5 (J2000.0) star position into the system of the
-//  Hipparcos catalogue, assuming zero Hipparcos proper motion.
-func CgoFk5hz(r5, d5, date1, date2 float64) (rh, dh float64) {
-	var cRh, cDh C.double
-	C.iauFk5hz(C.double(r5), C.double(d5), C.double(date1),
-		C.double(date2), &cRh, &cDh)
-	return float64(cRh), float64(cDh)
-}
-
-//  GoFk5hz Transform an FK5 (J2000.0) star position into the system of the
+//  Fk5hz Transform an FK5 (J2000.0) star position into the system of the
 //  Hipparcos catalogue, assuming zero Hipparcos proper motion.
-func GoFk5hz(r5, d5, date1, date2 float64) (rh, dh float64) {
+func Fk5hz(r5, d5, date1, date2 float64) (rh, dh float64) {
 
 	var t, w float64
 	var p5e, s5h, vst, p5, ph[3]float64
@@ -101,25 +89,25 @@ func GoFk5hz(r5, d5, date1, date2 float64) (rh, dh float64) {
 	t = - ((date1 - DJ00) + date2) / DJY;
 
 	// FK5 barycentric position vector. 
-	p5e = GoS2c(r5, d5);
+	p5e = S2c(r5, d5);
 
 	// FK5 to Hipparcos orientation matrix and spin vector. 
-	r5h, s5h = GoFk5hip();
+	r5h, s5h = Fk5hip();
 
 	// Accumulated Hipparcos wrt FK5 spin over that interval. 
-	vst = GoSxp(t, s5h);
+	vst = Sxp(t, s5h);
 
 	// Express the accumulated spin as a rotation matrix. 
-	rst =GoRv2m(vst);
+	rst =Rv2m(vst);
 
 	// Derotate the vector's FK5 axes back to date. 
-	p5 = GoTrxp(rst, p5e);
+	p5 = Trxp(rst, p5e);
 
 	// Rotate the vector into the Hipparcos system. 
-	ph = GoRxp(r5h, p5);
+	ph = Rxp(r5h, p5);
 
 	// Hipparcos vector to spherical. 
-	w, dh = GoC2s(ph);
-	rh = GoAnp(w);
+	w, dh = C2s(ph);
+	rh = Anp(w);
 	return
 }
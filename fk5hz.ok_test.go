@@ -25,7 +25,7 @@ func TestFk5hz(t *testing.T) {
 		fn  func(a1, a2, a3, a4 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoFk5hz},
-		{"go", GoFk5hz},
+		{"go", Fk5hz},
 	}
 
 	for _, test := range tests {
@@ -49,7 +49,7 @@ func BenchmarkFk5hz(b *testing.B) {
 		fn  func(a1, a2, a3, a4 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoFk5hz},
-		{"go", GoFk5hz},
+		{"go", Fk5hz},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFw2m Form rotation matrix given the Fukushima-Williams angles.
+func CgoFw2m(gamb, phib, psi, eps float64) (r [3][3]float64) {
+	var cR [3][3]C.double
+	C.iauFw2m(C.double(gamb), C.double(phib),
+		C.double(psi), C.double(eps), &cR[0])
+	return v3tC2Go(cR)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoFw2m Form rotation matrix given the Fukushima-Williams angles.
+//  Fw2m Form rotation matrix given the Fukushima-Williams angles.
 //
 //  - - - - -
 //   F w 2 m
@@ -82,21 +79,13 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFw2m Form rotation matrix given the Fukushima-Williams angles.
-func CgoFw2m(gamb, phib, psi, eps float64) (r [3][3]float64) {
-	var cR [3][3]C.double
-	C.iauFw2m(C.double(gamb), C.double(phib),
-		C.double(psi), C.double(eps), &cR[0])
-	return v3tC2Go(cR)
-}
-
-// GoFw2m Form rotation matrix given the Fukushima-Williams angles.
-func GoFw2m(gamb, phib, psi, eps float64) (r [3][3]float64) {
+//  Fw2m Form rotation matrix given the Fukushima-Williams angles.
+func Fw2m(gamb, phib, psi, eps float64) (r [3][3]float64) {
 	// Construct the matrix.
-	r = GoIr()
-	r = GoRz(gamb, r)
-	r = GoRx(phib, r)
-	r = GoRz(-psi, r)
-	r = GoRx(-eps, r)
+	r = Ir()
+	r = Rz(gamb, r)
+	r = Rx(phib, r)
+	r = Rz(-psi, r)
+	r = Rx(-eps, r)
 	return
 }
@@ -27,7 +27,7 @@ func TestFw2m(t *testing.T) {
 		fn  func(a, b, c, d float64) [3][3]float64
 	}{
 		{"cgo", CgoFw2m},
-		{"go", GoFw2m},
+		{"go", Fw2m},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -70,7 +70,7 @@ func BenchmarkFw2m(b *testing.B) {
 		fn  func(a, b, c, d float64) [3][3]float64
 	}{
 		{"cgo", CgoFw2m},
-		{"go", GoFw2m},
+		{"go", Fw2m},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
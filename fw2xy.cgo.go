@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoFw2xy CIP X,Y given Fukushima-Williams bias-precession-nutation
+//  angles.
+func CgoFw2xy(gamb, phib, psi, eps float64) (x, y float64) {
+	var cX, cY C.double
+	C.iauFw2xy(C.double(gamb), C.double(phib), C.double(psi),
+		C.double(eps), &cX, &cY)
+	return float64(cX), float64(cY)
+}
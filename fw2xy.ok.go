@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoFw2xy CIP X,Y given Fukushima-Williams bias-precession-nutation angles.
+//  Fw2xy CIP X,Y given Fukushima-Williams bias-precession-nutation angles.
 //
 //  - - - - - -
 //   F w 2 x y
@@ -61,25 +58,16 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoFw2xy CIP X,Y given Fukushima-Williams bias-precession-nutation
-//  angles.
-func CgoFw2xy(gamb, phib, psi, eps float64) (x, y float64) {
-	var cX, cY C.double
-	C.iauFw2xy(C.double(gamb), C.double(phib), C.double(psi),
-		C.double(eps), &cX, &cY)
-	return float64(cX), float64(cY)
-}
-
-//  GoFw2xy CIP X,Y given Fukushima-Williams bias-precession-nutation
+//  Fw2xy CIP X,Y given Fukushima-Williams bias-precession-nutation
 //  angles.
-func GoFw2xy(gamb, phib, psi, eps float64) (x, y float64) {
+func Fw2xy(gamb, phib, psi, eps float64) (x, y float64) {
 
 	var r [3][3]float64
 
 	// Form NxPxB matrix.
-	r = GoFw2m(gamb, phib, psi, eps)
+	r = Fw2m(gamb, phib, psi, eps)
 
 	// Extract CIP X,Y.
-	x, y = GoBpn2xy(r)
+	x, y = Bpn2xy(r)
 	return
 }
@@ -27,7 +27,7 @@ func TestFw2xy(t *testing.T) {
 		fn  func(a1, a2, a3, a4 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoFw2xy},
-		{"go", GoFw2xy},
+		{"go", Fw2xy},
 	}
 
 	for _, test := range tests {
@@ -53,7 +53,7 @@ func BenchmarkFw2xy(b *testing.B) {
 		fn  func(a1, a2, a3, a4 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoFw2xy},
-		{"go", GoFw2xy},
+		{"go", Fw2xy},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauG2icrs ( double dl, double db, double *dr, double *dd )
@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoG2icrs Transformation from Galactic Coordinates to ICRS.
+func CgoG2icrs(dl, db float64) (dr, dd float64) {
+	var cDr, cDd C.double
+	C.iauG2icrs(C.double(dl), C.double(db), &cDr, &cDd)
+	return float64(cDr), float64(cDd)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoG2icrs Transformation from Galactic Coordinates to ICRS.
+//  G2icrs Transformation from Galactic Coordinates to ICRS.
 //
 //  - - - - - - -
 //   G 2 i c r s
@@ -73,15 +70,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoG2icrs Transformation from Galactic Coordinates to ICRS.
-func CgoG2icrs(dl, db float64) (dr, dd float64) {
-	var cDr, cDd C.double
-	C.iauG2icrs(C.double(dl), C.double(db), &cDr, &cDd)
-	return float64(cDr), float64(cDd)
-}
-
-//  Go2icrs Transformation from Galactic Coordinates to ICRS.
-func GoG2icrs(dl, db float64) (dr, dd float64) {
+//  G2icrs Transformation from Galactic Coordinates to ICRS.
+func G2icrs(dl, db float64) (dr, dd float64) {
 
 	var v1, v2 [3]float64
 
@@ -108,16 +98,16 @@ func GoG2icrs(dl, db float64) (dr, dd float64) {
 			+0.455983776175066922272100478348}}
 
 	// Spherical to Cartesian.
-	v1 = GoS2c(dl, db)
+	v1 = S2c(dl, db)
 
 	// Galactic to ICRS.
-	v2 = GoTrxp(r, v1)
+	v2 = Trxp(r, v1)
 
 	// Cartesian to spherical.
-	dr, dd = GoC2s(v2)
+	dr, dd = C2s(v2)
 
 	// Express in conventional ranges.
-	dr = GoAnp(dr)
-	dd = GoAnpm(dd)
+	dr = Anp(dr)
+	dd = Anpm(dd)
 	return
 }
@@ -25,7 +25,7 @@ func TestG2icrs(t *testing.T) {
 		fn  func(a1, a2 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoG2icrs},
-		{"go", GoG2icrs},
+		{"go", G2icrs},
 	}
 
 	for _, test := range tests {
@@ -49,7 +49,7 @@ func BenchmarkG2icrs(b *testing.B) {
 		fn  func(a1, a2 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoG2icrs},
-		{"go", GoG2icrs},
+		{"go", G2icrs},
 	}
 
 	for _, test := range tests {
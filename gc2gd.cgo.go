@@ -0,0 +1,23 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import (
+	"github.com/8i8/sofa/en"
+)
+
+//  CgoGc2gd Transform geocentric coordinates to geodetic using the
+//  specified reference ellipsoid.
+func CgoGc2gd(n int, xyz [3]float64) (
+	elong, phi, height float64, err en.ErrNum) {
+	var cElong, cPhi, cHeight C.double
+	cXyz := v3sGo2C(xyz)
+	cI := C.iauGc2gd(C.int(n), &cXyz[0], &cElong, &cPhi, &cHeight)
+	if n := int(cI); n != 0 {
+		err = errGc2gd.Set(n)
+	}
+	return float64(cElong), float64(cPhi), float64(cHeight), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "github.com/8i8/sofa/en"
 
 //            int         status:  0 = OK
@@ -13,7 +11,7 @@ var errGc2gd = en.New(2, "Gc2gd", []string{
 	"",
 })
 
-//  CgoGc2gd Transform geocentric coordinates to geodetic using the
+//  Gc2gd Transform geocentric coordinates to geodetic using the
 //  specified reference ellipsoid.
 //
 //  - - - - - -
@@ -72,40 +70,27 @@ var errGc2gd = en.New(2, "Gc2gd", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoGc2gd Transform geocentric coordinates to geodetic using the
+//  Gc2gd Transform geocentric coordinates to geodetic using the
 //  specified reference ellipsoid.
-func CgoGc2gd(n int, xyz [3]float64) (
-	elong, phi, height float64, err en.ErrNum) {
-	var cElong, cPhi, cHeight C.double
-	cXyz := v3sGo2C(xyz)
-	cI := C.iauGc2gd(C.int(n), &cXyz[0], &cElong, &cPhi, &cHeight)
-	if n := int(cI); n != 0 {
-		err = errGc2gd.Set(n)
-	}
-	return float64(cElong), float64(cPhi), float64(cHeight), err
-}
-
-//  GoGc2gd Transform geocentric coordinates to geodetic using the
-//  specified reference ellipsoid.
-func GoGc2gd(n int, xyz [3]float64) (
+func Gc2gd(n int, xyz [3]float64) (
 	elong, phi, height float64, err en.ErrNum) {
 
 	var a, f float64
 
 	// Obtain reference ellipsoid parameters.
-	a, f, err = GoEform(n)
+	a, f, err = Eform(n)
 
 	// If OK, transform x,y,z to longitude, geodetic latitude, height.
 	if err == nil {
-		elong, phi, height, err = GoGc2gde(a, f, xyz)
-		if err != nil && err.Is() < 0 {
+		elong, phi, height, err = Gc2gde(a, f, xyz)
+		if err != nil && err.Code() < 0 {
 			err = errGc2gd.Wrap(err)
 			err = errGc2gd.Add(err, -2)
 		}
 	}
 
 	// Deal with any errors.
-	if err != nil && err.Is() < 0 {
+	if err != nil && err.Code() < 0 {
 		elong = -1e9
 		phi = -1e9
 		height = -1e9
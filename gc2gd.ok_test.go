@@ -29,7 +29,7 @@ func TestGc2gd(t *testing.T) {
 			c1, c2, c3 float64, c4 en.ErrNum)
 	}{
 		{"cgo", CgoGc2gd},
-		{"go", GoGc2gd},
+		{"go", Gc2gd},
 	}
 
 	for _, test := range tests {
@@ -75,7 +75,7 @@ func BenchmarkGc2gd(b *testing.B) {
 			c1, c2, c3 float64, c4 en.ErrNum)
 	}{
 		{"cgo", CgoGc2gd},
-		{"go", GoGc2gd},
+		{"go", Gc2gd},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,23 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoGc2gde Transform geocentric coordinates to geodetic for a
+//  reference ellipsoid of specified form.
+func CgoGc2gde(a, f float64, xyz [3]float64) (
+	elong, phi, height float64, err en.ErrNum) {
+
+	var cElong, cPhi, cHeight C.double
+	cXyz := v3sGo2C(xyz)
+	cI := C.iauGc2gde(C.double(a), C.double(f), &cXyz[0], &cElong, &cPhi,
+		&cHeight)
+	if n := int(cI); n != 0 {
+		err = errGc2gde.Set(n)
+	}
+	return float64(cElong), float64(cPhi), float64(cHeight), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -14,7 +12,7 @@ var errGc2gde = en.New(2, "Gc2gde", []string{
 	"",
 })
 
-//  CgoGc2gde Transform geocentric coordinates to geodetic for a
+//  Gc2gde Transform geocentric coordinates to geodetic for a
 //  reference ellipsoid of specified form.
 //
 //  - - - - - - -
@@ -78,24 +76,9 @@ var errGc2gde = en.New(2, "Gc2gde", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoGc2gde Transform geocentric coordinates to geodetic for a
+//  Gc2gde Transform geocentric coordinates to geodetic for a
 //  reference ellipsoid of specified form.
-func CgoGc2gde(a, f float64, xyz [3]float64) (
-	elong, phi, height float64, err en.ErrNum) {
-
-	var cElong, cPhi, cHeight C.double
-	cXyz := v3sGo2C(xyz)
-	cI := C.iauGc2gde(C.double(a), C.double(f), &cXyz[0], &cElong, &cPhi,
-		&cHeight)
-	if n := int(cI); n != 0 {
-		err = errGc2gde.Set(n)
-	}
-	return float64(cElong), float64(cPhi), float64(cHeight), err
-}
-
-//  GoGc2gde Transform geocentric coordinates to geodetic for a
-//  reference ellipsoid of specified form.
-func GoGc2gde(a, f float64, xyz [3]float64) (
+func Gc2gde(a, f float64, xyz [3]float64) (
 	elong, phi, height float64, err en.ErrNum) {
 
 	var aeps2, e2, e4t, ec2, ec, b, x, y, z, p2, absz, p, s0, pn,
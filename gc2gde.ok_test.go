@@ -30,7 +30,7 @@ func TestGc2gde(t *testing.T) {
 			c1, c2, c3 float64, c4 en.ErrNum)
 	}{
 		{"cgo", CgoGc2gde},
-		{"go", GoGc2gde},
+		{"go", Gc2gde},
 	}
 
 	for _, test := range tests {
@@ -55,7 +55,7 @@ func BenchmarkGc2gde(b *testing.B) {
 			c1, c2, c3 float64, c4 en.ErrNum)
 	}{
 		{"cgo", CgoGc2gde},
-		{"go", GoGc2gde},
+		{"go", Gc2gde},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,27 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoGd2gc Transform geodetic coordinates to geocentric using the
+//  specified reference ellipsoid.
+func CgoGd2gc(n int, elong, phi,
+	height float64) (xyz [3]float64, err error) {
+
+	var cXyz [3]C.double
+	cI := C.iauGd2gc(C.int(n), C.double(elong), C.double(phi),
+		C.double(height), &cXyz[0])
+	switch int(cI) {
+	case 0:
+	case -1:
+		err = errGd2gcE1
+	case -2:
+		err = errGd2gcE2
+	default:
+		err = errAdmin
+	}
+	return v3sC2Go(cXyz), err
+}
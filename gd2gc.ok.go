@@ -1,13 +1,11 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "errors"
 
 var errGd2gcE1 = errors.New("illegal identifier (gd2c documentation note 3)")
 var errGd2gcE2 = errors.New("illegal case (gd2c documentation note 3)")
 
-//  CgoGd2gc Transform geodetic coordinates to geocentric using the
+//  Gd2gc Transform geodetic coordinates to geocentric using the
 //  specified reference ellipsoid.
 //
 //  - - - - - -
@@ -69,40 +67,22 @@ var errGd2gcE2 = errors.New("illegal case (gd2c documentation note 3)")
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoGd2gc Transform geodetic coordinates to geocentric using the
+//  Gd2gc Transform geodetic coordinates to geocentric using the
 //  specified reference ellipsoid.
-func CgoGd2gc(n int, elong, phi,
-	height float64) (xyz [3]float64, err error) {
-
-	var cXyz [3]C.double
-	cI := C.iauGd2gc(C.int(n), C.double(elong), C.double(phi),
-		C.double(height), &cXyz[0])
-	switch int(cI) {
-	case 0:
-	case -1:
-		err = errGd2gcE1
-	case -2:
-		err = errGd2gcE2
-	default:
-		err = errAdmin
-	}
-	return v3sC2Go(cXyz), err
-}
-
-func GoGd2gc(n int, elong, phi,
+func Gd2gc(n int, elong, phi,
 	height float64) (xyz [3]float64, err error) {
 
 	var a, f float64
 
 	// Obtain reference ellipsoid parameters.
-	a, f, err = GoEform(n)
+	a, f, err = Eform(n)
 	if err != nil {
 		err = errGd2gcE1
 	}
 
 	// If OK, transform longitude, geodetic latitude, height to x,y,z.
 	if err == nil {
-		xyz, err = GoGd2gce(a, f, elong, phi, height)
+		xyz, err = Gd2gce(a, f, elong, phi, height)
 		if err != nil {
 			err = errGd2gcE2
 		}
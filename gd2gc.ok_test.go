@@ -28,7 +28,7 @@ func TestGd2gc(t *testing.T) {
 		fn  func(a1 int, a2, a3, a4 float64) ([3]float64, error)
 	}{
 		{"cgo", CgoGd2gc},
-		{"go", GoGd2gc},
+		{"go", Gd2gc},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -73,7 +73,7 @@ func BenchmarkGd2gc(b *testing.B) {
 		fn  func(a1 int, a2, a3, a4 float64) ([3]float64, error)
 	}{
 		{"cgo", CgoGd2gc},
-		{"go", GoGd2gc},
+		{"go", Gd2gc},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
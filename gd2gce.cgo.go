@@ -0,0 +1,23 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoGd2gce Transform geodetic coordinates to geocentric for a
+//  reference ellipsoid of specified form.
+func CgoGd2gce(a, f, elong, phi, height float64) (
+	xyz [3]float64, err error) {
+	var cXyz [3]C.double
+	var cI C.int
+	cI = C.iauGd2gce(C.double(a), C.double(f), C.double(elong),
+		C.double(phi), C.double(height), &cXyz[0])
+	switch int(cI) {
+	case 0:
+	case -1:
+		err = errGd2gceE1
+	}
+	return v3sC2Go(cXyz), err
+}
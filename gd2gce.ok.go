@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"errors"
 	"math"
@@ -9,7 +7,7 @@ import (
 
 var errGd2gceE1 = errors.New("illegal case (Note 4)")
 
-//  CgoGd2gce Transform geodetic coordinates to geocentric for a
+//  Gd2gce Transform geodetic coordinates to geocentric for a
 //  reference ellipsoid of specified form.
 //
 //  - - - - - - -
@@ -72,24 +70,9 @@ var errGd2gceE1 = errors.New("illegal case (Note 4)")
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoGd2gce Transform geodetic coordinates to geocentric for a
+//  Gd2gce Transform geodetic coordinates to geocentric for a
 //  reference ellipsoid of specified form.
-func CgoGd2gce(a, f, elong, phi, height float64) (
-	xyz [3]float64, err error) {
-	var cXyz [3]C.double
-	var cI C.int
-	cI = C.iauGd2gce(C.double(a), C.double(f), C.double(elong),
-		C.double(phi), C.double(height), &cXyz[0])
-	switch int(cI) {
-	case 0:
-	case -1:
-		err = errGd2gceE1
-	}
-	return v3sC2Go(cXyz), err
-}
-
-// GoGd2gce Transform geodetic coordinates to geocentric for a
-func GoGd2gce(a, f, elong, phi, height float64) (
+func Gd2gce(a, f, elong, phi, height float64) (
 	xyz [3]float64, err error) {
 
 	//  reference ellipsoid of specified form.
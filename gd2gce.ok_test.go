@@ -26,7 +26,7 @@ func TestGd2gce(t *testing.T) {
 		fn  func(a1, a2, a3, a4, a5 float64) ([3]float64, error)
 	}{
 		{"cgo", CgoGd2gce},
-		{"go", GoGd2gce},
+		{"go", Gd2gce},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -52,7 +52,7 @@ func BenchmarkGd2gce(b *testing.B) {
 		fn  func(a1, a2, a3, a4, a5 float64) ([3]float64, error)
 	}{
 		{"cgo", CgoGd2gce},
-		{"go", GoGd2gce},
+		{"go", Gd2gce},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
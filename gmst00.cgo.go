@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoGmst00 Greenwich mean sidereal time (model consistent with IAU
+//  2000 resolutions).
+func CgoGmst00(uta, utb, tta, ttb float64) float64 {
+	var cF C.double
+	cF = C.iauGmst00(C.double(uta), C.double(utb), C.double(tta),
+		C.double(ttb))
+	return float64(cF)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoGmst00 Greenwich mean sidereal time (model consistent with IAU
+//  Gmst00 Greenwich mean sidereal time (model consistent with IAU
 //  2000 resolutions).
 //
 //  - - - - - - -
@@ -80,25 +77,16 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoGmst00 Greenwich mean sidereal time (model consistent with IAU
-//  2000 resolutions).
-func CgoGmst00(uta, utb, tta, ttb float64) float64 {
-	var cF C.double
-	cF = C.iauGmst00(C.double(uta), C.double(utb), C.double(tta),
-		C.double(ttb))
-	return float64(cF)
-}
-
-//  GoGmst00 Greenwich mean sidereal time (model consistent with IAU
+//  Gmst00 Greenwich mean sidereal time (model consistent with IAU
 //  2000 resolutions).
-func GoGmst00(uta, utb, tta, ttb float64) float64 {
+func Gmst00(uta, utb, tta, ttb float64) float64 {
 	var t, gmst float64
 
 	// TT Julian centuries since J2000.0.
 	t = ((tta - DJ00) + ttb) / DJC
 
 	// Greenwich Mean Sidereal Time, IAU 2000.
-	gmst = GoAnp(GoEra00(uta, utb) +
+	gmst = Anp(Era00(uta, utb) +
 		(0.014506+(4612.15739966+
 			(1.39667721+(-0.00009344+
 				(0.00001882)*t)*t)*t)*t)*DAS2R)
@@ -22,7 +22,7 @@ func TestGmst00(t *testing.T) {
 		fn  func(a1, a2, a3, a4 float64) float64
 	}{
 		{"cgo", CgoGmst00},
-		{"go", GoGmst00},
+		{"go", Gmst00},
 	}
 
 	for _, test := range tests {
@@ -39,7 +39,7 @@ func BenchmarkGmst00(b *testing.B) {
 		fn  func(a1, a2, a3, a4 float64) float64
 	}{
 		{"cgo", CgoGmst00},
-		{"go", GoGmst00},
+		{"go", Gmst00},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoGmst06 Greenwich mean sidereal time (consistent with IAU 2006
+//  precession).
+func CgoGmst06(uta, utb, tta, ttb float64) float64 {
+	var cF C.double
+	cF = C.iauGmst06(C.double(uta), C.double(utb), C.double(tta),
+		C.double(ttb))
+	return float64(cF)
+}
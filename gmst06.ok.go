@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoGmst06 Greenwich mean sidereal time (consistent with IAU 2006
+//  Gmst06 Greenwich mean sidereal time (consistent with IAU 2006
 //  precession).
 //
 //  - - - - - - -
@@ -71,18 +68,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoGmst06 Greenwich mean sidereal time (consistent with IAU 2006
-//  precession).
-func CgoGmst06(uta, utb, tta, ttb float64) float64 {
-	var cF C.double
-	cF = C.iauGmst06(C.double(uta), C.double(utb), C.double(tta),
-		C.double(ttb))
-	return float64(cF)
-}
-
-//  GoGmst06 Greenwich mean sidereal time (consistent with IAU 2006
+//  Gmst06 Greenwich mean sidereal time (consistent with IAU 2006
 //  precession).
-func GoGmst06(uta, utb, tta, ttb float64) float64 {
+func Gmst06(uta, utb, tta, ttb float64) float64 {
 
 	var t, gmst float64
 
@@ -90,7 +78,7 @@ func GoGmst06(uta, utb, tta, ttb float64) float64 {
 	t = ((tta - DJ00) + ttb) / DJC
 
 	// Greenwich mean sidereal time, IAU 2006. 
-	gmst = GoAnp(GoEra00(uta, utb) +
+	gmst = Anp(Era00(uta, utb) +
 		(0.014506+(4612.156534+
 			(1.3915817+(-0.00000044+
 				(-0.000029956+(-0.0000000368)*
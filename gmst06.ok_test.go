@@ -22,7 +22,7 @@ func TestGmst06(t *testing.T) {
 		fn  func(a1, a2, a3, a4 float64) float64
 	}{
 		{"cgo", CgoGmst06},
-		{"go", GoGmst06},
+		{"go", Gmst06},
 	}
 
 	for _, test := range tests {
@@ -40,7 +40,7 @@ func BenchmarkGmst06(b *testing.B) {
 		fn  func(a1, a2, a3, a4 float64) float64
 	}{
 		{"cgo", CgoGmst06},
-		{"go", GoGmst06},
+		{"go", Gmst06},
 	}
 
 	for _, test := range tests {
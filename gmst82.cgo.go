@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoGmst82 Universal Time to Greenwich mean sidereal time (IAU 1982
+//  model).
+func CgoGmst82(dj1, dj2 float64) (gmst float64) {
+	var cF C.double
+	cF = C.iauGmst82(C.double(dj1), C.double(dj2))
+	return float64(cF)
+}
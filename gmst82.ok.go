@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoGmst82 Universal Time to Greenwich mean sidereal time (IAU 1982
+//  Gmst82 Universal Time to Greenwich mean sidereal time (IAU 1982
 //  model).
 //
 //  - - - - - - -
@@ -74,17 +72,9 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoGmst82 Universal Time to Greenwich mean sidereal time (IAU 1982
+//  Gmst82 Universal Time to Greenwich mean sidereal time (IAU 1982
 //  model).
-func CgoGmst82(dj1, dj2 float64) (gmst float64) {
-	var cF C.double
-	cF = C.iauGmst82(C.double(dj1), C.double(dj2))
-	return float64(cF)
-}
-
-//  GoGmst82 Universal Time to Greenwich mean sidereal time (IAU 1982
-//  model).
-func GoGmst82(dj1, dj2 float64) (gmst float64) {
+func Gmst82(dj1, dj2 float64) (gmst float64) {
 
 	// Coefficients of IAU 1982 GMST-UT1 model
 	var A = 24110.54841 - DAYSEC/2.0
@@ -111,6 +101,6 @@ func GoGmst82(dj1, dj2 float64) (gmst float64) {
 	f = DAYSEC * (math.Mod(d1, 1.0) + math.Mod(d2, 1.0))
 
 	// GMST at this UT1.
-	gmst = GoAnp(DS2R * ((A + (B+(C+D*t)*t)*t) + f))
+	gmst = Anp(DS2R * ((A + (B+(C+D*t)*t)*t) + f))
 	return
 }
@@ -22,7 +22,7 @@ func TestGmst82(t *testing.T) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoGmst82},
-		{"go", GoGmst82},
+		{"go", Gmst82},
 	}
 
 	for _, test := range tests {
@@ -39,7 +39,7 @@ func BenchmarkGmst82(b *testing.B) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoGmst82},
-		{"go", GoGmst82},
+		{"go", Gmst82},
 	}
 
 	for _, test := range tests {
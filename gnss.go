@@ -0,0 +1,234 @@
+package sofa
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var errGNSSWeek = errors.New("time scale has no week numbering")
+
+// Offsets of the satellite navigation time scales (s).
+const (
+	// TAIMGPS is TAI minus GPS Time, which is also TAI minus Galileo
+	// System Time.
+	TAIMGPS = 19.0
+
+	// TAIMBDT is TAI minus BeiDou Time.
+	TAIMBDT = 33.0
+
+	// GLOMUTC is GLONASS Time minus UTC.
+	GLOMUTC = 10800.0
+)
+
+// Widths (bits) of the broadcast week numbers.
+const (
+	GPSWeekBits     = 10 // GPS LNAV
+	GPSCNAVWeekBits = 13 // GPS CNAV
+	GSTWeekBits     = 12 // Galileo
+	BDTWeekBits     = 13 // BeiDou
+)
+
+// gnssEpochs are the starts of week 0, as Julian Dates in each scale.
+var gnssEpochs = map[Scale]float64{
+	ScaleGPS: 2444244.5, // 1980 January 6
+	ScaleGST: 2451412.5, // 1999 August 22, GPS week 1024
+	ScaleBDT: 2453736.5, // 2006 January 1
+}
+
+// shift adds sec seconds to the 2-part Julian Date d1+d2, safeguarding
+// precision as Taitt does.
+func shift(d1, d2, sec float64) (float64, float64) {
+	if math.Abs(d1) > math.Abs(d2) {
+		return d1, d2 + sec/DAYSEC
+	}
+	return d1 + sec/DAYSEC, d2
+}
+
+// Taigps Time scale transformation:  International Atomic Time, TAI,
+// to GPS Time.
+func Taigps(tai1, tai2 float64) (gps1, gps2 float64, err error) {
+	gps1, gps2 = shift(tai1, tai2, -TAIMGPS)
+	return
+}
+
+// Gpstai Time scale transformation:  GPS Time to International
+// Atomic Time, TAI.
+func Gpstai(gps1, gps2 float64) (tai1, tai2 float64, err error) {
+	tai1, tai2 = shift(gps1, gps2, TAIMGPS)
+	return
+}
+
+// Taigst Time scale transformation:  International Atomic Time, TAI,
+// to Galileo System Time, GST.  GST is steered to GPS Time.
+func Taigst(tai1, tai2 float64) (gst1, gst2 float64, err error) {
+	gst1, gst2 = shift(tai1, tai2, -TAIMGPS)
+	return
+}
+
+// Gsttai Time scale transformation:  Galileo System Time, GST, to
+// International Atomic Time, TAI.
+func Gsttai(gst1, gst2 float64) (tai1, tai2 float64, err error) {
+	tai1, tai2 = shift(gst1, gst2, TAIMGPS)
+	return
+}
+
+// Taibdt Time scale transformation:  International Atomic Time, TAI,
+// to BeiDou Time, BDT.
+func Taibdt(tai1, tai2 float64) (bdt1, bdt2 float64, err error) {
+	bdt1, bdt2 = shift(tai1, tai2, -TAIMBDT)
+	return
+}
+
+// Bdttai Time scale transformation:  BeiDou Time, BDT, to
+// International Atomic Time, TAI.
+func Bdttai(bdt1, bdt2 float64) (tai1, tai2 float64, err error) {
+	tai1, tai2 = shift(bdt1, bdt2, TAIMBDT)
+	return
+}
+
+// Utcglo Time scale transformation:  Coordinated Universal Time, UTC,
+// to GLONASS Time, UTC(SU)+3h.  Both are quasi Julian Dates:  GLONASS
+// Time has the leap seconds of UTC, at 02:59:60 Moscow time, and its
+// day that holds one is 86401 s long, as is the UTC day before.
+func Utcglo(utc1, utc2 float64) (glo1, glo2 float64, err error) {
+	return UtcgloWith(CurrentLeapSeconds(), utc1, utc2)
+}
+
+// UtcgloWith is Utcglo with Delta(AT) taken from ls.
+func UtcgloWith(ls LeapSeconds, utc1, utc2 float64) (
+	glo1, glo2 float64, err error) {
+	day, fd, dl0, dl1, err := gloDay(ls, utc1, utc2)
+	if err != nil && !IsWarning(err) {
+		return utc1, utc2, err
+	}
+
+	// SI seconds into the UTC day, before or after 21h, when the
+	// Moscow day begins.
+	sec := fd * (DAYSEC + dl1)
+	if sec < DAYSEC-GLOMUTC {
+		return day, (sec + GLOMUTC + dl0) / (DAYSEC + dl0), err
+	}
+	return day + 1.0, (sec - (DAYSEC - GLOMUTC)) / (DAYSEC + dl1), err
+}
+
+// Gloutc Time scale transformation:  GLONASS Time, UTC(SU)+3h, to
+// Coordinated Universal Time, UTC.
+func Gloutc(glo1, glo2 float64) (utc1, utc2 float64, err error) {
+	return GloutcWith(CurrentLeapSeconds(), glo1, glo2)
+}
+
+// GloutcWith is Gloutc with Delta(AT) taken from ls.
+func GloutcWith(ls LeapSeconds, glo1, glo2 float64) (
+	utc1, utc2 float64, err error) {
+	day, fd, dl0, dl1, err := gloDay(ls, glo1, glo2)
+	if err != nil && !IsWarning(err) {
+		return glo1, glo2, err
+	}
+
+	// SI seconds into the Moscow day, before or after the end of the
+	// UTC day, leap second included.
+	sec := fd * (DAYSEC + dl0)
+	if sec < GLOMUTC+dl0 {
+		return day - 1.0, (sec + DAYSEC - GLOMUTC) / (DAYSEC + dl0), err
+	}
+	return day, (sec - GLOMUTC - dl0) / (DAYSEC + dl1), err
+}
+
+// gloDay returns the Julian Date of 0h of the day of the quasi Julian
+// Date d1+d2, its fraction of the day, and the leap seconds at the
+// ends of the UTC days before and of that date, found as D2dtf finds
+// them.
+func gloDay(ls LeapSeconds, d1, d2 float64) (day, fd, dl0, dl1 float64,
+	err error) {
+	iy, im, id, fd, errn := Jd2cal(d1, d2)
+	if errn != nil {
+		return 0, 0, 0, 0, errn
+	}
+	dj, w, _ := Cal2jd(iy, im, id)
+	day = dj + w
+	var warn error
+	for i, dl := range []*float64{&dl0, &dl1} {
+		var dat [3]float64
+		for k, t := range [3][2]float64{{-1, 0}, {-1, 0.5}, {0, 0}} {
+			y, m, d, _, errn := Jd2cal(day+float64(i)+t[0], 0)
+			if errn == nil {
+				dat[k], errn = ls.Dat(y, m, d, t[1])
+			}
+			if errn != nil && errn.Code() < 0 {
+				return 0, 0, 0, 0, errn
+			}
+			if errn != nil && warn == nil {
+				warn = errn
+			}
+		}
+		*dl = dat[2] - (2.0*dat[1] - dat[0])
+	}
+	return day, fd, dl0, dl1, warn
+}
+
+// GNSSWeek returns the full week number and the seconds of week of t
+// in the GPS, Galileo or BeiDou scale s, converting t to s with
+// DefaultTimeConverter.  Week 0 begins at the epoch of the system.
+func (t Time) GNSSWeek(s Scale) (week int, sow float64, err error) {
+	e, ok := gnssEpochs[s]
+	if !ok {
+		return 0, 0, fmt.Errorf("%v: %w", s, errGNSSWeek)
+	}
+	r, err := t.To(s)
+	if err != nil && !IsWarning(err) {
+		return 0, 0, err
+	}
+
+	// Days from the epoch, keeping the fraction of the day separate
+	// to preserve precision.
+	w1 := r.JD1 - e
+	week = int(math.Floor((w1 + r.JD2) / 7.0))
+	sow = ((w1 - 7.0*float64(week)) + r.JD2) * DAYSEC
+	if sow < 0.0 {
+		week--
+		sow += 7.0 * DAYSEC
+	} else if sow >= 7.0*DAYSEC {
+		week++
+		sow -= 7.0 * DAYSEC
+	}
+	return week, sow, err
+}
+
+// GNSSWeekTime returns the Time, in the GPS, Galileo or BeiDou scale s,
+// of the full week number week and the seconds of week sow.
+func GNSSWeekTime(s Scale, week int, sow float64) (Time, error) {
+	e, ok := gnssEpochs[s]
+	if !ok {
+		return Time{}, fmt.Errorf("%v: %w", s, errGNSSWeek)
+	}
+	return Time{JD1: e + 7.0*float64(week), JD2: sow / DAYSEC, Scale: s},
+		nil
+}
+
+// UnrollWeek returns the full week number, in the scale s, of a
+// broadcast week number wn that is the full week modulo 2^bits.  Of the
+// candidates, the one nearest to the time near is chosen, which
+// resolves the rollovers provided near is within half a cycle, for
+// example 9.8 years for the GPS 10 bit week.
+func UnrollWeek(s Scale, wn, bits int, near Time) (int, error) {
+	ref, _, err := near.GNSSWeek(s)
+	if err != nil && !IsWarning(err) {
+		return 0, err
+	}
+	n := 1 << uint(bits)
+	wn %= n
+	if wn < 0 {
+		wn += n
+	}
+	k := int(math.Floor(float64(ref-wn)/float64(n) + 0.5))
+	return wn + k*n, nil
+}
+
+// GPSRollover returns the start of the GPS week that follows the n'th
+// rollover of the 10 bit week number.  The first and second were at
+// 1999 August 22 and 2019 April 7.
+func GPSRollover(n int) Time {
+	t, _ := GNSSWeekTime(ScaleGPS, n<<GPSWeekBits, 0.0)
+	return t
+}
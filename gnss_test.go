@@ -0,0 +1,153 @@
+package sofa
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGNSSWeek(t *testing.T) {
+	const fname = "GNSSWeek"
+
+	// The second GPS rollover, when GPS-UTC was 18s.
+	utc, _ := ParseISO8601("2019-04-06T23:59:42", ScaleUTC)
+	tests := []struct {
+		scale Scale
+		week  int
+		sow   float64
+	}{
+		{ScaleGPS, 2048, 0.0},
+		{ScaleGST, 1024, 0.0},
+		{ScaleBDT, 691, 6.0*DAYSEC + 86400.0 - 14.0},
+	}
+
+	for _, test := range tests {
+		tname := fname + " " + test.scale.String()
+
+		week, sow, err := utc.GNSSWeek(test.scale)
+		errT(t, nil, err, tname, "err")
+		viv(t, week, test.week, tname, "week")
+		vvd(t, sow, test.sow, 1e-6, tname, "sow")
+
+		r, err := GNSSWeekTime(test.scale, week, sow)
+		errT(t, nil, err, tname, "err")
+		u, _ := r.To(ScaleUTC)
+		vvd(t, (u.JD1-utc.JD1)+u.JD2, utc.JD2, 1e-12, tname, "back")
+	}
+
+	_, _, err := utc.GNSSWeek(ScaleTT)
+	errT(t, errGNSSWeek, errors.Unwrap(err), fname, "scale")
+}
+
+func TestGNSSScales(t *testing.T) {
+	const fname = "GNSS scales"
+
+	tai := NewTime(ScaleTAI, 2457754.5, 0.25)
+	tests := []struct {
+		scale Scale
+		dt    float64
+	}{
+		{ScaleGPS, -19.0},
+		{ScaleGST, -19.0},
+		{ScaleBDT, -33.0},
+	}
+
+	for _, test := range tests {
+		tname := fname + " " + test.scale.String()
+		r, err := tai.To(test.scale)
+		errT(t, nil, err, tname, "err")
+		vvd(t, ((r.JD1-tai.JD1)+(r.JD2-tai.JD2))*DAYSEC, test.dt,
+			1e-6, tname, "offset")
+	}
+
+}
+
+func TestGLONASS(t *testing.T) {
+	const fname = "GLONASS"
+
+	// GLONASS has the UTC leap seconds, at 02:59:60 Moscow time.
+	tests := []struct {
+		scale    Scale
+		from, to string
+	}{
+		{ScaleUTC, "2016-12-31T12:00:00.000", "2016-12-31T15:00:00.000"},
+		{ScaleUTC, "2016-12-31T20:59:59.000", "2016-12-31T23:59:59.000"},
+		{ScaleUTC, "2016-12-31T23:59:59.500", "2017-01-01T02:59:59.500"},
+		{ScaleUTC, "2016-12-31T23:59:60.500", "2017-01-01T02:59:60.500"},
+		{ScaleUTC, "2017-01-01T00:00:00.000", "2017-01-01T03:00:00.000"},
+		{ScaleUTC, "2017-01-01T12:00:00.000", "2017-01-01T15:00:00.000"},
+		{ScaleUTC, "2015-06-30T12:00:00.000", "2015-06-30T15:00:00.000"},
+		{ScaleUTC, "2015-06-30T23:59:60.500", "2015-07-01T02:59:60.500"},
+		{ScaleUTC, "2015-07-01T06:00:00.000", "2015-07-01T09:00:00.000"},
+		{ScaleTAI, "2017-01-01T06:00:00.000", "2017-01-01T08:59:23.000"},
+	}
+	for _, test := range tests {
+		tname := fname + " " + test.from
+		a, err := ParseISO8601(test.from, test.scale)
+		errT(t, nil, err, tname, "parse")
+		g, err := a.To(ScaleGLONASS)
+		errT(t, nil, err, tname, "to")
+		s, _ := g.ISO8601(3)
+		if s != test.to {
+			t.Errorf("%s: want %s got %s", tname, test.to, s)
+		}
+
+		// And back, from the fields.
+		g, err = ParseISO8601(test.to, ScaleGLONASS)
+		errT(t, nil, err, tname, "parse glonass")
+		b, err := g.To(test.scale)
+		errT(t, nil, err, tname, "back")
+		s, _ = b.ISO8601(3)
+		if s != test.from {
+			t.Errorf("%s: want %s got %s back", tname, test.from, s)
+		}
+	}
+
+	// The start of the Moscow day of the leap second, and no leap
+	// second at the end of it.
+	glo, err := ParseISO8601("2017-01-01T03:00:00", ScaleGLONASS)
+	errT(t, nil, err, fname, "glonass")
+	u, _ := glo.To(ScaleUTC)
+	vvd(t, u.JD1+u.JD2, 2457754.5, 1e-9, fname, "glonass")
+	// Half the UTC day of the leap second is 12:00:00.5.
+	g1, g2, _ := Utcglo(2457753.5, 0.5)
+	vvd(t, g1+g2, 2457753.5+54000.5/DAYSEC, 1e-12, fname, "utcglo")
+	_, err = ParseISO8601("2017-01-01T23:59:60", ScaleGLONASS)
+	errT(t, errISOLeap, errors.Unwrap(err), fname, "no leap")
+}
+
+func TestUnrollWeek(t *testing.T) {
+	const fname = "UnrollWeek"
+
+	near, _ := ParseISO8601("2021-06-01T00:00:00", ScaleUTC)
+	tests := []struct {
+		ref   string
+		scale Scale
+		wn    int
+		bits  int
+		want  int
+	}{
+		{"gps", ScaleGPS, 2160 % 1024, GPSWeekBits, 2160},
+		{"gps rollover", ScaleGPS, 1020, GPSWeekBits, 2044},
+		{"cnav", ScaleGPS, 2160, GPSCNAVWeekBits, 2160},
+		{"galileo", ScaleGST, 1136, GSTWeekBits, 1136},
+		{"beidou", ScaleBDT, 804, BDTWeekBits, 804},
+	}
+
+	for _, test := range tests {
+		tname := fname + " " + test.ref
+		w, err := UnrollWeek(test.scale, test.wn, test.bits, near)
+		errT(t, nil, err, tname, "err")
+		viv(t, w, test.want, tname, "week")
+	}
+
+	r := GPSRollover(1)
+	s, _ := r.ISO8601(0)
+	if s != "1999-08-22T00:00:00" {
+		t.Errorf("%s: first rollover %s", fname, s)
+	}
+	r = GPSRollover(2)
+	s, _ = r.ISO8601(0)
+	if s != "2019-04-07T00:00:00" {
+		t.Errorf("%s: second rollover %s", fname, s)
+	}
+}
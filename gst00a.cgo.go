@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoGst00a Greenwich apparent sidereal time (consistent with IAU
+//  2000 resolutions).
+func CgoGst00a(uta, utb, tta, ttb float64) (gst float64) {
+	var cF C.double
+	cF = C.iauGst00a(C.double(uta), C.double(utb), C.double(tta),
+		C.double(ttb))
+	return float64(cF)
+}
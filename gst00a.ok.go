@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoGst00a Greenwich apparent sidereal time (consistent with IAU
+//  Gst00a Greenwich apparent sidereal time (consistent with IAU
 //  2000 resolutions).
 //
 //  - - - - - - -
@@ -81,22 +78,13 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoGst00a Greenwich apparent sidereal time (consistent with IAU
-//  2000 resolutions).
-func CgoGst00a(uta, utb, tta, ttb float64) (gst float64) {
-	var cF C.double
-	cF = C.iauGst00a(C.double(uta), C.double(utb), C.double(tta),
-		C.double(ttb))
-	return float64(cF)
-}
-
-//  GoGst00a Greenwich apparent sidereal time (consistent with IAU
+//  Gst00a Greenwich apparent sidereal time (consistent with IAU
 //  2000 resolutions).
-func GoGst00a(uta, utb, tta, ttb float64) (gst float64) {
+func Gst00a(uta, utb, tta, ttb float64) (gst float64) {
 	var gmst00, ee00a float64
 
-	gmst00 = GoGmst00(uta, utb, tta, ttb)
-	ee00a = GoEe00a(tta, ttb)
-	gst = GoAnp(gmst00 + ee00a)
+	gmst00 = Gmst00(uta, utb, tta, ttb)
+	ee00a = Ee00a(tta, ttb)
+	gst = Anp(gmst00 + ee00a)
 	return
 }
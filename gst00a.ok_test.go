@@ -22,7 +22,7 @@ func TestGst00a(t *testing.T) {
 		fn  func(a1,a2,a3,a4 float64) float64
 	}{
 		{"cgo", CgoGst00a},
-		{"go", GoGst00a},
+		{"go", Gst00a},
 	}
 
 	for _, test := range tests {
@@ -39,7 +39,7 @@ func BenchmarkGst00a(b *testing.B) {
 		fn  func(a1,a2,a3,a4 float64) float64
 	}{
 		{"cgo", CgoGst00a},
-		{"go", GoGst00a},
+		{"go", Gst00a},
 	}
 
 	for _, test := range tests {
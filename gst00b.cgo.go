@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoGst00b Greenwich apparent sidereal time (consistent with IAU 2000
+//  resolutions but using the truncated nutation model IAU 2000B).
+func CgoGst00b(uta, utb float64) (gst float64) {
+	var cF C.double
+	cF = C.iauGst00b(C.double(uta), C.double(utb))
+	return float64(cF)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoGst00b Greenwich apparent sidereal time (consistent with IAU 2000
+//  Gst00b Greenwich apparent sidereal time (consistent with IAU 2000
 //  resolutions but using the truncated nutation model IAU 2000B).
 //
 //  - - - - - - -
@@ -89,21 +86,13 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoGst00b Greenwich apparent sidereal time (consistent with IAU 2000
-//  resolutions but using the truncated nutation model IAU 2000B).
-func CgoGst00b(uta, utb float64) (gst float64) {
-	var cF C.double
-	cF = C.iauGst00b(C.double(uta), C.double(utb))
-	return float64(cF)
-}
-
-//  GoGst00b Greenwich apparent sidereal time (consistent with IAU 2000
+//  Gst00b Greenwich apparent sidereal time (consistent with IAU 2000
 //  resolutions but using the truncated nutation model IAU 2000B).
-func GoGst00b(uta, utb float64) (gst float64) {
+func Gst00b(uta, utb float64) (gst float64) {
 	var gmst00, ee00b float64
 
-	gmst00 = GoGmst00(uta, utb, uta, utb)
-	ee00b = GoEe00b(uta, utb)
-	gst = GoAnp(gmst00 + ee00b)
+	gmst00 = Gmst00(uta, utb, uta, utb)
+	ee00b = Ee00b(uta, utb)
+	gst = Anp(gmst00 + ee00b)
 	return
 }
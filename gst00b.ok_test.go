@@ -22,7 +22,7 @@ func TestGst00b(t *testing.T) {
 		fn  func(a1,a2 float64) float64
 	}{
 		{"cgo", CgoGst00b},
-		{"go", GoGst00b},
+		{"go", Gst00b},
 	}
 
 	for _, test := range tests {
@@ -39,7 +39,7 @@ func BenchmarkGst00b(b *testing.B) {
 		fn  func(a1,a2 float64) float64
 	}{
 		{"cgo", CgoGst00b},
-		{"go", GoGst00b},
+		{"go", Gst00b},
 	}
 
 	for _, test := range tests {
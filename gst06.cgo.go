@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoGst06 Greenwich apparent sidereal time, IAU 2006, given the NPB
+//  matrix.
+func CgoGst06(uta, utb, tta, ttb float64, rnpb [3][3]float64) float64 {
+	var cF C.double
+	cRnpb := v3tGo2C(rnpb)
+	cF = C.iauGst06(C.double(uta), C.double(utb), C.double(tta),
+		C.double(ttb), &cRnpb[0])
+	return float64(cF)
+}
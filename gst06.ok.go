@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoGst06 Greenwich apparent sidereal time, IAU 2006, given the NPB
+//  Gst06 Greenwich apparent sidereal time, IAU 2006, given the NPB
 //  matrix.
 //
 //  - - - - - -
@@ -76,31 +73,21 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoGst06 Greenwich apparent sidereal time, IAU 2006, given the NPB
-//  matrix.
-func CgoGst06(uta, utb, tta, ttb float64, rnpb [3][3]float64) float64 {
-	var cF C.double
-	cRnpb := v3tGo2C(rnpb)
-	cF = C.iauGst06(C.double(uta), C.double(utb), C.double(tta),
-		C.double(ttb), &cRnpb[0])
-	return float64(cF)
-}
-
-//  GoGst06 Greenwich apparent sidereal time, IAU 2006, given the NPB
+//  Gst06 Greenwich apparent sidereal time, IAU 2006, given the NPB
 //  matrix.
-func GoGst06(uta, utb, tta, ttb float64, rnpb [3][3]float64) float64 {
+func Gst06(uta, utb, tta, ttb float64, rnpb [3][3]float64) float64 {
 	var x, y, s, era, eors, gst float64
 
 	// Extract CIP coordinates.
-	x, y = GoBpn2xy(rnpb)
+	x, y = Bpn2xy(rnpb)
 
 	// The CIO locator, s.
-	s = GoS06(tta, ttb, x, y)
+	s = S06(tta, ttb, x, y)
 
 	// Greenwich apparent sidereal time.
-	era = GoEra00(uta, utb)
-	eors = GoEors(rnpb, s)
-	gst = GoAnp(era - eors)
+	era = Era00(uta, utb)
+	eors = Eors(rnpb, s)
+	gst = Anp(era - eors)
 
 	return gst
 }
@@ -36,7 +36,7 @@ func TestGst06(t *testing.T) {
 			a5 [3][3]float64) float64
 	}{
 		{"cgo", CgoGst06},
-		{"go", GoGst06},
+		{"go", Gst06},
 	}
 
 	for _, test := range tests {
@@ -70,7 +70,7 @@ func BenchmarkGst06(b *testing.B) {
 			a5 [3][3]float64) float64
 	}{
 		{"cgo", CgoGst06},
-		{"go", GoGst06},
+		{"go", Gst06},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoGst06a is the SOFA C version of Gst06a.
+func CgoGst06a(uta, utb, tta, ttb float64) float64 {
+	var cF C.double
+	cF = C.iauGst06a(C.double(uta), C.double(utb), C.double(tta),
+		C.double(ttb))
+	return float64(cF)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoCst06a Greenwich apparent sidereal time (consistent with IAU 2000
+//  Gst06a Greenwich apparent sidereal time (consistent with IAU 2000
 //  and 2006 resolutions).
 //
 //  - - - - - - -
@@ -72,27 +69,18 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoCst06a Greenwich apparent sidereal time (consistent with IAU 2000
-//  and 2006 resolutions).
-func CgoGst06a(uta, utb, tta, ttb float64) float64 {
-	var cF C.double
-	cF = C.iauGst06a(C.double(uta), C.double(utb), C.double(tta),
-		C.double(ttb))
-	return float64(cF)
-}
-
-//  GoCst06a Greenwich apparent sidereal time (consistent with IAU 2000
+//  Gst06a Greenwich apparent sidereal time (consistent with IAU 2000
 //  and 2006 resolutions).
-func GoGst06a(uta, utb, tta, ttb float64) float64 {
+func Gst06a(uta, utb, tta, ttb float64) float64 {
 
 	var rnpb [3][3]float64
 	var gst float64
 
 	// Classical nutation x precession x bias matrix, IAU 2000A.
-	rnpb = GoPnm06a(tta, ttb)
+	rnpb = Pnm06a(tta, ttb)
 
 	// Greenwich apparent sidereal time.
-	gst = GoGst06(uta, utb, tta, ttb, rnpb)
+	gst = Gst06(uta, utb, tta, ttb, rnpb)
 
 	return gst
 }
@@ -22,7 +22,7 @@ func TestGst06a(t *testing.T) {
 		fn  func(a1, a2, a3, a4 float64) float64
 	}{
 		{"cgo", CgoGst06a},
-		{"go", GoGst06a},
+		{"go", Gst06a},
 	}
 
 	for _, test := range tests {
@@ -40,7 +40,7 @@ func BenchmarkGst06a(b *testing.B) {
 		fn  func(a1, a2, a3, a4 float64) float64
 	}{
 		{"cgo", CgoGst06a},
-		{"go", GoGst06a},
+		{"go", Gst06a},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoGst94 Greenwich apparent sidereal time (consistent with IAU
+//  1982/94 resolutions).
+func CgoGst94(uta, utb float64) (gst float64) {
+	var cGst C.double
+	cGst = C.iauGst94(C.double(uta), C.double(utb))
+	return float64(cGst)
+}
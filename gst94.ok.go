@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoGst94 Greenwich apparent sidereal time (consistent with IAU
+//  Gst94 Greenwich apparent sidereal time (consistent with IAU
 //  1982/94 resolutions).
 //
 //  - - - - - -
@@ -74,21 +71,13 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoGst94 Greenwich apparent sidereal time (consistent with IAU
-//  1982/94 resolutions).
-func CgoGst94(uta, utb float64) (gst float64) {
-	var cGst C.double
-	cGst = C.iauGst94(C.double(uta), C.double(utb))
-	return float64(cGst)
-}
-
-//  GoGst94 Greenwich apparent sidereal time (consistent with IAU
+//  Gst94 Greenwich apparent sidereal time (consistent with IAU
 //  1982/94 resolutions).
-func GoGst94(uta, utb float64) (gst float64) {
+func Gst94(uta, utb float64) (gst float64) {
    var gmst82, eqeq94 float64
 
-   gmst82 = GoGmst82(uta, utb);
-   eqeq94 = GoEqeq94(uta, utb);
-   gst = GoAnp(gmst82  + eqeq94);
+   gmst82 = Gmst82(uta, utb);
+   eqeq94 = Eqeq94(uta, utb);
+   gst = Anp(gmst82  + eqeq94);
    return 
 }
@@ -22,7 +22,7 @@ func TestGst94(t *testing.T) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoGst94},
-		{"go", GoGst94},
+		{"go", Gst94},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -38,7 +38,7 @@ func BenchmarkGst94(b *testing.B) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoGst94},
-		{"go", GoGst94},
+		{"go", Gst94},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
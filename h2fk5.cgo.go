@@ -0,0 +1,19 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoH2fk5 Transform Hipparcos star data into the FK5 (J2000.0)
+//  system.
+func CgoH2fk5(rh, dh, drh, ddh, pxh, rvh float64) (
+	r5, d5, dr5, dd5, px5, rv5 float64) {
+	var cR5, cD5, cDr5, cDd5, cPx5, cRv5 C.double
+	C.iauH2fk5(C.double(rh), C.double(dh), C.double(drh),
+		C.double(ddh), C.double(pxh), C.double(rvh),
+		&cR5, &cD5, &cDr5, &cDd5, &cPx5, &cRv5)
+	return float64(cR5), float64(cD5), float64(cDr5), float64(cDd5),
+		float64(cPx5), float64(cRv5)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoH2fk5 Transform Hipparcos star data into the FK5 (J2000.0)
+//  H2fk5 Transform Hipparcos star data into the FK5 (J2000.0)
 //  system.
 //
 //  - - - - - -
@@ -65,21 +62,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoH2fk5 Transform Hipparcos star data into the FK5 (J2000.0)
-//  system.
-func CgoH2fk5(rh, dh, drh, ddh, pxh, rvh float64) (
-	r5, d5, dr5, dd5, px5, rv5 float64) {
-	var cR5, cD5, cDr5, cDd5, cPx5, cRv5 C.double
-	C.iauH2fk5(C.double(rh), C.double(dh), C.double(drh),
-		C.double(ddh), C.double(pxh), C.double(rvh),
-		&cR5, &cD5, &cDr5, &cDd5, &cPx5, &cRv5)
-	return float64(cR5), float64(cD5), float64(cDr5), float64(cDd5),
-		float64(cPx5), float64(cRv5)
-}
-
-//  GoH2fk5 Transform Hipparcos star data into the FK5 (J2000.0)
+//  H2fk5 Transform Hipparcos star data into the FK5 (J2000.0)
 //  system.
-func GoH2fk5(rh, dh, drh, ddh, pxh, rvh float64) (
+func H2fk5(rh, dh, drh, ddh, pxh, rvh float64) (
 	r5, d5, dr5, dd5, px5, rv5 float64) {
 
 	var i int
@@ -90,10 +75,10 @@ func GoH2fk5(rh, dh, drh, ddh, pxh, rvh float64) (
 
 	// Hipparcos barycentric position/velocity pv-vector
 	// (normalized).
-	pvh, _ = GoStarpv(rh, dh, drh, ddh, pxh, rvh)
+	pvh, _ = Starpv(rh, dh, drh, ddh, pxh, rvh)
 
 	// FK5 to Hipparcos orientation matrix and spin vector.
-	r5h, s5h = GoFk5hip()
+	r5h, s5h = Fk5hip()
 
 	// Make spin units per day instead of per year.
 	for i = 0; i < 3; i++ {
@@ -101,22 +86,22 @@ func GoH2fk5(rh, dh, drh, ddh, pxh, rvh float64) (
 	}
 
 	// Orient the spin into the Hipparcos system.
-	sh = GoRxp(r5h, s5h)
+	sh = Rxp(r5h, s5h)
 
 	// De-orient the Hipparcos position into the FK5 system.
-	pv5[0] = GoTrxp(r5h, pvh[0])
+	pv5[0] = Trxp(r5h, pvh[0])
 
 	// Apply spin to the position giving an extra space motion
 	// component.
-	wxp = GoPxp(pvh[0], sh)
+	wxp = Pxp(pvh[0], sh)
 
 	// Subtract this component from the Hipparcos space motion.
-	vv = GoPmp(pvh[1], wxp)
+	vv = Pmp(pvh[1], wxp)
 
 	// De-orient the Hipparcos space motion into the FK5 system.
-	pv5[1] = GoTrxp(r5h, vv)
+	pv5[1] = Trxp(r5h, vv)
 
 	// FK5 pv-vector to spherical.
-	r5, d5, dr5, dd5, px5, rv5, _ = GoPvstar(pv5)
+	r5, d5, dr5, dd5, px5, rv5, _ = Pvstar(pv5)
 	return
 }
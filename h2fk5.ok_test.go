@@ -31,7 +31,7 @@ func TestH2fk5(t *testing.T) {
 			c1, c2, c3, c4, c5, c6 float64)
 	}{
 		{"cgo", CgoH2fk5},
-		{"go", GoH2fk5},
+		{"go", H2fk5},
 	}
 
 	for _, test := range tests {
@@ -71,7 +71,7 @@ func BenchmarkH2fk5(b *testing.B) {
 			c1, c2, c3, c4, c5, c6 float64)
 	}{
 		{"cgo", CgoH2fk5},
-		{"go", GoH2fk5},
+		{"go", H2fk5},
 	}
 
 	for _, test := range tests {
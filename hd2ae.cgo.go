@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoHd2ae Equatorial to horizon coordinates:  transform hour angle
+//  and declination to azimuth and altitude.
+func CgoHd2ae(ha, dec, phi float64) (az, el float64) {
+	var cAz, cEl C.double
+	C.iauHd2ae(C.double(ha), C.double(dec), C.double(phi),
+		&cAz, &cEl)
+	return float64(cAz), float64(cEl)
+}
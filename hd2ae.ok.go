@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoHd2ae Equatorial to horizon coordinates:  transform hour angle
+//  Hd2ae Equatorial to horizon coordinates:  transform hour angle
 //  and declination to azimuth and altitude.
 //
 //  - - - - - -
@@ -68,18 +66,9 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoHd2ae Equatorial to horizon coordinates:  transform hour angle
+//  Hd2ae Equatorial to horizon coordinates:  transform hour angle
 //  and declination to azimuth and altitude.
-func CgoHd2ae(ha, dec, phi float64) (az, el float64) {
-	var cAz, cEl C.double
-	C.iauHd2ae(C.double(ha), C.double(dec), C.double(phi),
-		&cAz, &cEl)
-	return float64(cAz), float64(cEl)
-}
-
-//  GoHd2ae Equatorial to horizon coordinates:  transform hour angle
-//  and declination to azimuth and altitude.
-func GoHd2ae(ha, dec, phi float64) (az, el float64) {
+func Hd2ae(ha, dec, phi float64) (az, el float64) {
 
 	var sh, ch, sd, cd, sp, cp, x, y, z, r, a float64
 
@@ -26,7 +26,7 @@ func TestHd2ae(t *testing.T) {
 		fn  func(a1, a2, a3 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoHd2ae},
-		{"go", GoHd2ae},
+		{"go", Hd2ae},
 	}
 
 	for _, test := range tests {
@@ -51,7 +51,7 @@ func BenchmarkHd2ae(b *testing.B) {
 		fn  func(a1, a2, a3 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoHd2ae},
-		{"go", GoHd2ae},
+		{"go", Hd2ae},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoHd2pa Parallactic angle for a given hour angle and declination.
+func CgoHd2pa(ha, dec, phi float64) (pa float64) {
+	var cPa C.double
+	cPa = C.iauHd2pa(C.double(ha), C.double(dec), C.double(phi))
+	return float64(cPa)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoHd2pa Parallactic angle for a given hour angle and declination.
+//  Hd2pa Parallactic angle for a given hour angle and declination.
 //
 //  - - - - - -
 //   H d 2 p a
@@ -57,15 +55,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoHd2pa Parallactic angle for a given hour angle and declination.
-func CgoHd2pa(ha, dec, phi float64) (pa float64) {
-	var cPa C.double
-	cPa = C.iauHd2pa(C.double(ha), C.double(dec), C.double(phi))
-	return float64(cPa)
-}
-
-//  GoHd2pa Parallactic angle for a given hour angle and declination.
-func GoHd2pa(ha, dec, phi float64) (pa float64) {
+//  Hd2pa Parallactic angle for a given hour angle and declination.
+func Hd2pa(ha, dec, phi float64) (pa float64) {
 	var cp, cqsz, sqsz float64
 
 	cp = math.Cos(phi)
@@ -26,7 +26,7 @@ func TestHd2pa(t *testing.T) {
 		fn  func(a1, a2, a3 float64) float64
 	}{
 		{"cgo", CgoHd2pa},
-		{"go", GoHd2pa},
+		{"go", Hd2pa},
 	}
 
 	for _, test := range tests {
@@ -50,7 +50,7 @@ func BenchmarkHd2pa(b *testing.B) {
 		fn  func(a1, a2, a3 float64) float64
 	}{
 		{"cgo", CgoHd2pa},
-		{"go", GoHd2pa},
+		{"go", Hd2pa},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoHfk5z Transform a Hipparcos star position into FK5 J2000.0,
+//  assuming zero Hipparcos proper motion.
+func CgoHfk5z(rh, dh, date1, date2 float64) (
+	r5, d5, dr5, dd5 float64) {
+	var cR5, cD5, cDr5, cDd5 C.double
+	C.iauHfk5z(C.double(rh), C.double(dh), C.double(date1),
+		C.double(date2), &cR5, &cD5, &cDr5, &cDd5)
+	return float64(cR5), float64(cD5), float64(cDr5), float64(cDd5)
+}
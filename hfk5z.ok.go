@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoHfk5z Transform a Hipparcos star position into FK5 J2000.0,
+//  Hfk5z Transform a Hipparcos star position into FK5 J2000.0,
 //  assuming zero Hipparcos proper motion.
 //
 //  - - - - - -
@@ -85,19 +82,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoHfk5z Transform a Hipparcos star position into FK5 J2000.0,
-//  assuming zero Hipparcos proper motion.
-func CgoHfk5z(rh, dh, date1, date2 float64) (
-	r5, d5, dr5, dd5 float64) {
-	var cR5, cD5, cDr5, cDd5 C.double
-	C.iauHfk5z(C.double(rh), C.double(dh), C.double(date1),
-		C.double(date2), &cR5, &cD5, &cDr5, &cDd5)
-	return float64(cR5), float64(cD5), float64(cDr5), float64(cDd5)
-}
-
-//  GoHfk5z Transform a Hipparcos star position into FK5 J2000.0,
+//  Hfk5z Transform a Hipparcos star position into FK5 J2000.0,
 //  assuming zero Hipparcos proper motion.
-func GoHfk5z(rh, dh, date1, date2 float64) (
+func Hfk5z(rh, dh, date1, date2 float64) (
 	r5, d5, dr5, dd5 float64) {
 
 	var t, w float64
@@ -110,35 +97,35 @@ func GoHfk5z(rh, dh, date1, date2 float64) (
 	t = ((date1 - DJ00) + date2) / DJY
 
 	// Hipparcos barycentric position vector (normalized).
-	ph = GoS2c(rh, dh)
+	ph = S2c(rh, dh)
 
 	// FK5 to Hipparcos orientation matrix and spin vector.
-	r5h, s5h = GoFk5hip()
+	r5h, s5h = Fk5hip()
 
 	// Rotate the spin into the Hipparcos system.
-	sh = GoRxp(r5h, s5h)
+	sh = Rxp(r5h, s5h)
 
 	// Accumulated Hipparcos wrt FK5 spin over that interval.
-	vst = GoSxp(t, s5h)
+	vst = Sxp(t, s5h)
 
 	// Express the accumulated spin as a rotation matrix.
-	rst = GoRv2m(vst)
+	rst = Rv2m(vst)
 
 	// Rotation matrix:  accumulated spin, then FK5 to Hipparcos.
-	r5ht = GoRxr(r5h, rst)
+	r5ht = Rxr(r5h, rst)
 
 	// De-orient & de-spin the Hipparcos position into FK5 J2000.0.
-	pv5e[0] = GoTrxp(r5ht, ph)
+	pv5e[0] = Trxp(r5ht, ph)
 
 	// Apply spin to the position giving a space motion.
-	vv = GoPxp(sh, ph)
+	vv = Pxp(sh, ph)
 
 	// De-orient & de-spin the Hipparcos space motion into FK5
 	// J2000.0.
-	pv5e[1] = GoTrxp(r5ht, vv)
+	pv5e[1] = Trxp(r5ht, vv)
 
 	// FK5 position/velocity pv-vector to spherical.
-	w, d5, _, dr5, dd5, _ = GoPv2s(pv5e)
-	r5 = GoAnp(w)
+	w, d5, _, dr5, dd5, _ = Pv2s(pv5e)
+	r5 = Anp(w)
 	return
 }
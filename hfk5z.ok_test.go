@@ -26,7 +26,7 @@ func TestHfk5z(t *testing.T) {
 			c1, c2, c3, c4 float64)
 	}{
 		{"cgo", CgoHfk5z},
-		{"go", GoHfk5z},
+		{"go", Hfk5z},
 	}
 
 	for _, test := range tests {
@@ -57,7 +57,7 @@ func BenchmarkHfk5z(b *testing.B) {
 			c1, c2, c3, c4 float64)
 	}{
 		{"cgo", CgoHfk5z},
-		{"go", GoHfk5z},
+		{"go", Hfk5z},
 	}
 
 	for _, test := range tests {
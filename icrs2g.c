@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauIcrs2g ( double dr, double dd, double *dl, double *db )
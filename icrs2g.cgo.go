@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoIcrs2g Transformation from ICRS to Galactic Coordinates.
+// void iauIcrs2g ( double dr, double dd, double *dl, double *db )
+func CgoIcrs2g(dr, dd float64) (dl, db float64) {
+	var cDl, cDb C.double
+	C.iauIcrs2g(C.double(dr), C.double(dd),
+		&cDl, &cDb)
+	return float64(cDl), float64(cDb)
+}
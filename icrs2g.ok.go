@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoIcrs2g Transformation from ICRS to Galactic Coordinates.
+//  Icrs2g Transformation from ICRS to Galactic Coordinates.
 //
 //  - - - - - - -
 //   I c r s 2 g
@@ -73,18 +70,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoIcrs2g Transformation from ICRS to Galactic Coordinates.
-// void iauIcrs2g ( double dr, double dd, double *dl, double *db )
-func CgoIcrs2g(dr, dd float64) (dl, db float64) {
-	var cDl, cDb C.double
-	C.iauIcrs2g(C.double(dr), C.double(dd),
-		&cDl, &cDb)
-	return float64(cDl), float64(cDb)
-}
-
-//  GoIcrs2g Transformation from ICRS to Galactic Coordinates.
+//  Icrs2g Transformation from ICRS to Galactic Coordinates.
 // void iauIcrs2g ( double dr, double dd, double *dl, double *db )
-func GoIcrs2g(dr, dd float64) (dl, db float64) {
+func Icrs2g(dr, dd float64) (dl, db float64) {
 	var v1, v2 [3]float64
 
 	//
@@ -110,16 +98,16 @@ func GoIcrs2g(dr, dd float64) (dl, db float64) {
 			+0.455983776175066922272100478348}}
 
 	// Spherical to Cartesian.
-	v1 = GoS2c(dr, dd)
+	v1 = S2c(dr, dd)
 
 	// ICRS to Galactic.
-	v2 = GoRxp(r, v1)
+	v2 = Rxp(r, v1)
 
 	// Cartesian to spherical.
-	dl, db = GoC2s(v2)
+	dl, db = C2s(v2)
 
 	// Express in conventional ranges.
-	dl = GoAnp(dl)
-	db = GoAnpm(db)
+	dl = Anp(dl)
+	db = Anpm(db)
 	return
 }
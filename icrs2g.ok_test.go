@@ -25,7 +25,7 @@ func TestIcrs2g(t *testing.T) {
 		fn  func(a1, a2 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoIcrs2g},
-		{"go", GoIcrs2g},
+		{"go", Icrs2g},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -48,7 +48,7 @@ func BenchmarkIcrs2g(b *testing.B) {
 		fn  func(a1, a2 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoIcrs2g},
-		{"go", GoIcrs2g},
+		{"go", Icrs2g},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -0,0 +1,226 @@
+// Gendiff writes diffpairs.cgo_test.go, the table of Cgo and Go function
+// pairs that the differential tests of package sofa run.  Each argument
+// is given an input domain from its name and type, and each result
+// that is an angle is marked so that its differences can be reported
+// in arcseconds.
+//
+// Run it with go generate in the package directory.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// floatDomains gives the domain of a float64 argument by its name.
+// Arguments not listed are drawn from "any".
+var floatDomains = map[string]string{
+	// Julian Dates, as the first and second parts.
+	"date1": "jd", "date01": "jd", "date11": "jd", "dj1": "jd",
+	"tt1": "jd", "ut11": "jd", "utc1": "jd", "tai1": "jd", "tcb1": "jd",
+	"tcg1": "jd", "tdb1": "jd", "tta": "jd", "uta": "jd", "d1": "jd",
+	"ep1a": "jd", "ep2a": "jd",
+	"date2": "fd", "date02": "fd", "date12": "fd", "dj2": "fd",
+	"tt2": "fd", "ut12": "fd", "utc2": "fd", "tai2": "fd", "tcb2": "fd",
+	"tcg2": "fd", "tdb2": "fd", "ttb": "fd", "utb": "fd", "d2": "fd",
+	"ep1b": "fd", "ep2b": "fd", "fd": "fd",
+
+	// Epochs and time arguments.
+	"epj": "epoch", "epb": "epoch", "bepoch": "epoch",
+	"t": "centuries", "days": "days", "dt": "days",
+	"dut1": "dut1", "dta": "dut1",
+
+	// Longitudes and right ascensions.
+	"rc": "ra", "ra": "ra", "ra1": "ra", "r1950": "ra", "r2000": "ra",
+	"r5": "ra", "dr": "ra", "al": "ra", "az": "ra", "ha": "ra",
+	"theta": "ra", "elong": "ra", "a0": "ra", "ri": "ra", "ob1": "ra",
+	"dl": "ra", "rd": "ra", "el": "ra", "era": "ra", "gst": "ra",
+	"a": "ra", "bl": "ra",
+
+	// Latitudes and declinations.
+	"dc": "dec", "dec": "dec", "dec1": "dec", "d1950": "dec",
+	"d2000": "dec", "d5": "dec", "di": "dec", "phi": "dec", "b0": "dec",
+	"db": "dec", "dd": "dec", "ob2": "dec", "b": "dec", "bp": "dec",
+	"ap": "dec",
+
+	// Star data.
+	"pr": "pm", "pd": "pm", "pmr": "pm", "pmd": "pm", "pmr1": "pm",
+	"pmd1": "pm", "dr1950": "pm", "dd1950": "pm", "dr2000": "pm",
+	"dd2000": "pm", "dr5": "pm", "dd5": "pm", "dra": "pm", "ddh": "pm",
+	"drh": "pm",
+	"px":  "px", "px1": "px", "px5": "px", "p1950": "px", "p2000": "px",
+	"pxh": "px",
+	"rv":  "rv", "rv1": "rv", "rv5": "rv", "v1950": "rv", "v2000": "rv",
+	"rvh": "rv",
+
+	// Site and atmosphere.
+	"hm": "height", "height": "height",
+	"xp": "polar", "yp": "polar", "sp": "polar",
+	"phpa": "pressure", "tc": "temperature", "rh": "humidity",
+	"wl": "wavelength", "refa": "refraction", "refb": "refraction",
+
+	// Small angles and CIP coordinates.
+	"dpsi": "small", "deps": "small", "dpsibi": "small",
+	"depsbi": "small", "x": "small", "y": "small", "s": "small",
+	"xi": "small", "eta": "small", "dlim": "small", "eo": "small",
+
+	// Precession and nutation angles.
+	"gamb": "obliquity", "phib": "obliquity", "psib": "obliquity",
+	"psi": "obliquity", "eps": "obliquity", "epsa": "obliquity",
+
+	// Seconds of time and arc.
+	"sec": "seconds", "asec": "seconds",
+}
+
+// floatOverrides gives domains that depend on the function as well as
+// the argument name.
+var floatOverrides = map[string]string{
+	"Ttut1.dt": "deltat", "Ut1tt.dt": "deltat",
+	"Sxp.s": "any", "Sxpv.s": "any", "Ppsp.s": "any",
+	"Tpors.a": "ra", "Tpors.b": "dec", "Tpsts.a0": "ra",
+	"Tpxes.a": "ra", "Tpxes.b": "dec",
+}
+
+// intDomains gives the domain of an int argument by its name.
+var intDomains = map[string]string{
+	"ndp": "ndp", "iy": "year", "im": "month", "id": "day",
+	"ihr": "hour", "ihour": "hour", "imn": "minute", "imin": "minute",
+	"iamin": "minute", "ideg": "degrees", "np": "planet",
+	"n": "ellipsoid",
+}
+
+// angleResults are the names of results that are angles.
+var angleResults = map[string]bool{
+	"ra": true, "dec": true, "ri": true, "di": true, "rc": true,
+	"dc": true, "aob": true, "zob": true, "hob": true, "dob": true,
+	"rob": true, "eo": true, "theta": true, "elong": true, "phi": true,
+	"rad": true, "a": true, "b": true, "xi": true, "eta": true,
+	"dl": true, "db": true, "dr": true, "dd": true, "az": true,
+	"el": true, "ha": true, "dec1": true, "ra1": true, "gst": true,
+	"era": true, "ee": true, "dpsi": true, "deps": true, "epsa": true,
+	"x": true, "y": true, "s": true, "sp": true, "cio": true,
+	"r1950": true, "d1950": true, "r2000": true, "d2000": true,
+	"r5": true, "d5": true, "dh": true, "rh": true, "aoi": true,
+	"gamb": true, "phib": true, "psib": true, "psi": true, "eps": true,
+}
+
+type pair struct {
+	name   string
+	in     []string
+	angles []bool
+}
+
+func argDomain(fn, name string, t ast.Expr, hasBodies bool) string {
+	switch types.ExprString(t) {
+	case "[3]float64":
+		return "vector"
+	case "[2][3]float64":
+		return "pv"
+	case "[3][3]float64":
+		return "matrix"
+	case "ASTROM":
+		return "astrom"
+	case "[]LDBODY":
+		return "bodies"
+	case "byte":
+		return "sign"
+	case "string":
+		if name == "scale" {
+			return "scale"
+		}
+		return "obstype"
+	case "int":
+		if name == "n" && hasBodies {
+			return "nbodies"
+		}
+		if d, ok := intDomains[name]; ok {
+			return d
+		}
+		return "int"
+	}
+	if d, ok := floatOverrides[fn+"."+name]; ok {
+		return d
+	}
+	if d, ok := floatDomains[name]; ok {
+		return d
+	}
+	return "any"
+}
+
+func main() {
+	files, err := filepath.Glob("*.cgo.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fset := token.NewFileSet()
+	var pairs []pair
+	for _, fn := range files {
+		f, err := parser.ParseFile(fset, fn, nil, 0)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, d := range f.Decls {
+			fd, ok := d.(*ast.FuncDecl)
+			if !ok || fd.Recv != nil ||
+				!strings.HasPrefix(fd.Name.Name, "Cgo") {
+				continue
+			}
+			p := pair{name: fd.Name.Name[3:]}
+			hasBodies := false
+			for _, a := range fd.Type.Params.List {
+				if types.ExprString(a.Type) == "[]LDBODY" {
+					hasBodies = true
+				}
+			}
+			for _, a := range fd.Type.Params.List {
+				for _, n := range a.Names {
+					p.in = append(p.in, argDomain(p.name,
+						n.Name, a.Type, hasBodies))
+				}
+			}
+			if fd.Type.Results != nil {
+				for _, r := range fd.Type.Results.List {
+					if len(r.Names) == 0 {
+						p.angles = append(p.angles, false)
+					}
+					for _, n := range r.Names {
+						p.angles = append(p.angles,
+							angleResults[n.Name])
+					}
+				}
+			}
+			pairs = append(pairs, p)
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].name < pairs[j].name
+	})
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gendiff; DO NOT EDIT.\n\n")
+	b.WriteString("//go:build cgo\n// +build cgo\n\npackage sofa\n\n")
+	b.WriteString("var diffPairs = []diffPair{\n")
+	for _, p := range pairs {
+		fmt.Fprintf(&b, "\t{%q, Cgo%s, %s,\n\t\t%#v,\n\t\t%#v},\n",
+			p.name, p.name, p.name, p.in, p.angles)
+	}
+	b.WriteString("}\n")
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile("diffpairs.cgo_test.go", src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoIr Initialize an r-matrix to the identity matrix.
+func CgoIr() (idm [3][3]float64) {
+	var cIdm [3][3]C.double
+	C.iauIr(&cIdm[0])
+	return v3tC2Go(cIdm)
+}
@@ -20,7 +20,7 @@ func TestIr(t *testing.T) {
 		fn  func() [3][3]float64
 	}{
 		{"cgo", CgoIr},
-		{"go", GoIr},
+		{"go", Ir},
 	}
 	for _, test := range tests {
 		r := test.fn()
@@ -45,7 +45,7 @@ func BenchmarkIr(b *testing.B) {
 		fn  func() [3][3]float64
 	}{
 		{"cgo", CgoIr},
-		{"go", GoIr},
+		{"go", Ir},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
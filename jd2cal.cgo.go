@@ -0,0 +1,23 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoJd2cal Julian Date to Gregorian year, month, day, and fraction of
+//  a day.
+func CgoJd2cal(dj1, dj2 float64) (
+	iy, im, id int, fd float64, err en.ErrNum) {
+
+	var cIy, cIm, cId C.int
+	var cFd C.double
+	cI := C.iauJd2cal(C.double(dj1), C.double(dj2), 
+	&cIy, &cIm, &cId, &cFd)
+	if n := int(cI); n != 0 {
+		err = errJd2cal.Set(n)
+	}
+	return int(cIy), int(cIm), int(cId), float64(cFd), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"errors"
 	"math"
@@ -12,7 +10,7 @@ var errJd2calE1 = errors.New("unacceptable date (Note 1)")
 
 var errJd2cal = en.New(1, "jd2cal", []string{"unacceptable date (Note 1)"})
 
-//  CgoJd2cal Julian Date to Gregorian year, month, day, and fraction of
+//  Jd2cal Julian Date to Gregorian year, month, day, and fraction of
 //  a day.
 //
 //  - - - - - - -
@@ -78,24 +76,9 @@ var errJd2cal = en.New(1, "jd2cal", []string{"unacceptable date (Note 1)"})
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoJd2cal Julian Date to Gregorian year, month, day, and fraction of
+//  Jd2cal Julian Date to Gregorian year, month, day, and fraction of
 //  a day.
-func CgoJd2cal(dj1, dj2 float64) (
-	iy, im, id int, fd float64, err en.ErrNum) {
-
-	var cIy, cIm, cId C.int
-	var cFd C.double
-	cI := C.iauJd2cal(C.double(dj1), C.double(dj2), 
-	&cIy, &cIm, &cId, &cFd)
-	if n := int(cI); n != 0 {
-		err = errJd2cal.Set(n)
-	}
-	return int(cIy), int(cIm), int(cId), float64(cFd), err
-}
-
-// GoJd2cal Julian Date to Gregorian year, month, day, and fraction of a
-// day.
-func GoJd2cal(dj1, dj2 float64) (
+func Jd2cal(dj1, dj2 float64) (
 	iy, im, id int, fd float64, err en.ErrNum) {
 
 	// Minimum and maximum allowed JD
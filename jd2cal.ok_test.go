@@ -26,7 +26,7 @@ func TestJd2cal(t *testing.T) {
 		fn  func(a1, a2 float64) (b1, b2, b3 int, b4 float64, b5 en.ErrNum)
 	}{
 		{"cgo", CgoJd2cal},
-		{"go", GoJd2cal},
+		{"go", Jd2cal},
 	}
 
 	for _, test := range tests {
@@ -50,7 +50,7 @@ func BenchmarkJd2cal(b *testing.B) {
 		fn  func(a1, a2 float64) (b1, b2, b3 int, b4 float64, b5 en.ErrNum)
 	}{
 		{"cgo", CgoJd2cal},
-		{"go", GoJd2cal},
+		{"go", Jd2cal},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauJdcalf(int ndp, double dj1, double dj2, int iymdf[4])
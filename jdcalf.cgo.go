@@ -0,0 +1,22 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoJdcalf Julian Date to Gregorian Calendar, expressed in a form
+//  convenient for formatting messages:  rounded to a specified
+//  precision.
+func CgoJdcalf(ndp int, dj1, dj2 float64) (
+	iymdf [4]int, err en.ErrNum) {
+	var cIymdf [4]C.int
+	cI := C.iauJdcalf(C.int(ndp), C.double(dj1), C.double(dj2),
+		&cIymdf[0])
+	if n := int(cI); n != 0 {
+		err = errJdcalf.Set(n)
+	}
+	return v4sIntC2Go(cIymdf), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -14,7 +12,7 @@ var errJdcalf = en.New(1, "Jdcalf", []string{
 	"NDP not 0-9 (interpreted as 0)",
 })
 
-//  CgoJdcalf Julian Date to Gregorian Calendar, expressed in a form
+//  Jdcalf Julian Date to Gregorian Calendar, expressed in a form
 //  convenient for formatting messages:  rounded to a specified
 //  precision.
 //
@@ -79,24 +77,10 @@ var errJdcalf = en.New(1, "Jdcalf", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoJdcalf Julian Date to Gregorian Calendar, expressed in a form
+//  Jdcalf Julian Date to Gregorian Calendar, expressed in a form
 //  convenient for formatting messages:  rounded to a specified
 //  precision.
-func CgoJdcalf(ndp int, dj1, dj2 float64) (
-	iymdf [4]int, err en.ErrNum) {
-	var cIymdf [4]C.int
-	cI := C.iauJdcalf(C.int(ndp), C.double(dj1), C.double(dj2),
-		&cIymdf[0])
-	if n := int(cI); n != 0 {
-		err = errJdcalf.Set(n)
-	}
-	return v4sIntC2Go(cIymdf), err
-}
-
-//  GoJdcalf Julian Date to Gregorian Calendar, expressed in a form
-//  convenient for formatting messages:  rounded to a specified
-//  precision.
-func GoJdcalf(ndp int, dj1, dj2 float64) (
+func Jdcalf(ndp int, dj1, dj2 float64) (
 	iymdf [4]int, err en.ErrNum) {
 	var err1 en.ErrNum
 	var denom, d1, d2, f1, f2, d, djd, f, rf float64
@@ -143,7 +127,7 @@ func GoJdcalf(ndp int, dj1, dj2 float64) (
 	djd += 0.5
 
 	// Convert to Gregorian calendar.
-	iymdf[0], iymdf[1], iymdf[2], f, err1 = GoJd2cal(djd, rf)
+	iymdf[0], iymdf[1], iymdf[2], f, err1 = Jd2cal(djd, rf)
 	if err1 == nil {
 		iymdf[3] = int(dnint(f * denom))
 	} else {
@@ -32,7 +32,7 @@ func TestJdcalf(t *testing.T) {
 			[4]int, en.ErrNum)
 	}{
 		{"cgo", CgoJdcalf},
-		{"go", GoJdcalf},
+		{"go", Jdcalf},
 	}
 
 	for _, test := range tests {
@@ -61,7 +61,7 @@ func BenchmarkJdcalf(b *testing.B) {
 			[4]int, en.ErrNum)
 	}{
 		{"cgo", CgoJdcalf},
-		{"go", GoJdcalf},
+		{"go", Jdcalf},
 	}
 
 	for _, test := range tests {
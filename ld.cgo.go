@@ -0,0 +1,18 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoLd Apply light deflection by a solar-system body, as part of
+//  transforming coordinate direction into natural direction.
+func CgoLd(bm float64, p, q, e [3]float64,
+	em, dlim float64) (p1 [3]float64) {
+	var cP1 [3]C.double
+	cP, cQ, cE := v3sGo2C(p), v3sGo2C(q), v3sGo2C(e)
+	C.iauLd(C.double(bm), &cP[0], &cQ[0], &cE[0],
+		C.double(em), C.double(dlim), &cP1[0])
+	return v3sC2Go(cP1)
+}
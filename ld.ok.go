@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoLd Apply light deflection by a solar-system body, as part of
+//  Ld Apply light deflection by a solar-system body, as part of
 //  transforming coordinate direction into natural direction.
 //
 //  - - -
@@ -79,20 +76,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoLd Apply light deflection by a solar-system body, as part of
-//  transforming coordinate direction into natural direction.
-func CgoLd(bm float64, p, q, e [3]float64,
-	em, dlim float64) (p1 [3]float64) {
-	var cP1 [3]C.double
-	cP, cQ, cE := v3sGo2C(p), v3sGo2C(q), v3sGo2C(e)
-	C.iauLd(C.double(bm), &cP[0], &cQ[0], &cE[0],
-		C.double(em), C.double(dlim), &cP1[0])
-	return v3sC2Go(cP1)
-}
-
-//  GoLd Apply light deflection by a solar-system body, as part of
+//  Ld Apply light deflection by a solar-system body, as part of
 //  transforming coordinate direction into natural direction.
-func GoLd(bm float64, p, q, e [3]float64,
+func Ld(bm float64, p, q, e [3]float64,
 	em, dlim float64) (p1 [3]float64) {
 	var i int
 	var qpe, eq, peq [3]float64
@@ -102,14 +88,14 @@ func GoLd(bm float64, p, q, e [3]float64,
 	for i = 0; i < 3; i++ {
 		qpe[i] = q[i] + e[i]
 	}
-	qdqpe = GoPdp(q, qpe)
+	qdqpe = Pdp(q, qpe)
 
 	// 2 x G x bm / ( em x c^2 x ( q . (q + e) ) ). 
 	w = bm * SRS / em / fmax(qdqpe, dlim)
 
 	// p x (e x q). 
-	eq = GoPxp(e, q)
-	peq = GoPxp(p, eq)
+	eq = Pxp(e, q)
+	peq = Pxp(p, eq)
 
 	// Apply the deflection. 
 	for i = 0; i < 3; i++ {
@@ -37,7 +37,7 @@ func TestLd(t *testing.T) {
 			a5, a6 float64) [3]float64
 	}{
 		{"cgo", CgoLd},
-		{"go", GoLd},
+		{"go", Ld},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -75,7 +75,7 @@ func BenchmarkLd(b *testing.B) {
 			a5, a6 float64) [3]float64
 	}{
 		{"cgo", CgoLd},
-		{"go", GoLd},
+		{"go", Ld},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
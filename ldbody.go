@@ -0,0 +1,84 @@
+package sofa
+
+// Masses (solar masses) and deflection limiters (radians^2/2) of the
+// bodies of Ldbodies, suitable for a terrestrial observer.
+const (
+	LdSunBm     = 1.0
+	LdSunDl     = 6e-6
+	LdJupiterBm = 0.00095435
+	LdJupiterDl = 3e-9
+	LdSaturnBm  = 0.00028574
+	LdSaturnDl  = 3e-10
+)
+
+// Masses (solar masses) and deflection limiters (radians^2/2) of the
+// Earth and the Moon.  The Earth is among the bodies of LdbodiesEarth,
+// for an observer away from the geocentre:  for a geocentric observer
+// the deflection is singular, and for a terrestrial one it is below a
+// milliarcsecond.  The Moon may be added with NewLDBODY and the
+// positions of an Ephemeris.
+const (
+	LdEarthBm = 3.00349e-6
+	LdEarthDl = 3e-10
+	LdMoonBm  = 3.69432e-8
+	LdMoonDl  = 3e-10
+)
+
+// NewLDBODY returns the light deflection parameters of a body of mass
+// bm (solar masses), with deflection limiter dl (radians^2/2) and
+// barycentric position and velocity pv (au, au/day).
+func NewLDBODY(bm, dl float64, pv [2][3]float64) LDBODY {
+	return LDBODY{Bm: bm, Dl: dl, Pv: pv}
+}
+
+// Ldbodies returns the light deflecting bodies Saturn, Jupiter and the
+// Sun, in the order in which light reaches a terrestrial observer, as
+// required by Atciqn, Aticqn and Ldn.  The Earth is left out, being
+// where a geocentric observer is;  see LdbodiesEarth.  date1+date2 is
+// TDB as a 2-part Julian Date.  The barycentric positions and
+// velocities are from Epv00 and Plan94.
+//
+// A warning is returned for dates outside 1900-2100 AD, where Epv00
+// loses accuracy, or outside 1000-3000 AD for Plan94.
+func Ldbodies(date1, date2 float64) (b []LDBODY, err error) {
+
+	// The Sun, from the Earth.
+	pvh, pvb, err := Epv00(date1, date2)
+	var sun [2][3]float64
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			sun[i][j] = pvb[i][j] - pvh[i][j]
+		}
+	}
+
+	// Saturn and Jupiter, heliocentric to barycentric.
+	planet := func(np int) [2][3]float64 {
+		pv, perr := Plan94(date1, date2, np)
+		if perr != nil {
+			err = perr
+		}
+		for i := 0; i < 2; i++ {
+			for j := 0; j < 3; j++ {
+				pv[i][j] += sun[i][j]
+			}
+		}
+		return pv
+	}
+
+	b = []LDBODY{
+		NewLDBODY(LdSaturnBm, LdSaturnDl, planet(6)),
+		NewLDBODY(LdJupiterBm, LdJupiterDl, planet(5)),
+		NewLDBODY(LdSunBm, LdSunDl, sun),
+	}
+	return b, err
+}
+
+// LdbodiesEarth is Ldbodies with the Earth after the Sun, for a
+// topocentric observer or one in space, whose barycentric position, as
+// in the eb of its ASTROM, is not the geocentre.  It is not for a
+// geocentric observer, for whom the deflection is singular.
+func LdbodiesEarth(date1, date2 float64) ([]LDBODY, error) {
+	b, err := Ldbodies(date1, date2)
+	_, pvb, _ := Epv00(date1, date2)
+	return append(b, NewLDBODY(LdEarthBm, LdEarthDl, pvb)), err
+}
@@ -0,0 +1,117 @@
+package sofa
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLdbodies(t *testing.T) {
+	const fname = "Ldbodies"
+	var astrom ASTROM
+
+	date1 := 2456165.5
+	date2 := 0.401182685
+	b, err := Ldbodies(date1, date2)
+	errT(t, nil, err, fname, "err")
+	viv(t, len(b), 3, fname, "n")
+
+	pvh, pvb, _ := Epv00(date1, date2)
+	pvs, _ := Plan94(date1, date2, 6)
+	pvj, _ := Plan94(date1, date2, 5)
+	want := []LDBODY{
+		NewLDBODY(0.00028574, 3e-10, pvs),
+		NewLDBODY(0.00095435, 3e-9, pvj),
+		NewLDBODY(1.0, 6e-6, [2][3]float64{}),
+	}
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			sun := pvb[i][j] - pvh[i][j]
+			want[0].Pv[i][j] += sun
+			want[1].Pv[i][j] += sun
+			want[2].Pv[i][j] = sun
+		}
+	}
+	for i, w := range want {
+		vvd(t, b[i].Bm, w.Bm, 0.0, fname, "bm")
+		vvd(t, b[i].Dl, w.Dl, 0.0, fname, "dl")
+		for j := 0; j < 3; j++ {
+			vvd(t, b[i].Pv[0][j], w.Pv[0][j], 1e-15, fname, "p")
+			vvd(t, b[i].Pv[1][j], w.Pv[1][j], 1e-15, fname, "v")
+		}
+	}
+
+	// The deflection is close to that of the Sun alone, and the C
+	// and Go functions agree on it, for a geocentric and a
+	// terrestrial observer.
+	astrom, _ = Apci13(date1, date2, astrom)
+	ldbodiesAtciqn(t, fname+" geocentric", astrom, b)
+	astrom, _, _ = Apco13(2456165.5, 0.401182685, 0.0, -0.527800806,
+		-1.2345856, 2738.0, 0.0, 0.0, 0.0, 0.0, 0.0, 0.0, astrom)
+	ldbodiesAtciqn(t, fname+" terrestrial", astrom, b)
+	astrom, _ = Apci13(date1, date2, astrom)
+
+	// The context can be read.
+	vvd(t, astrom.Eb[0], pvb[0][0], 1e-6, fname, "eb")
+
+	_, err = Ldbodies(2400000.5, 0.0)
+	if err == nil {
+		t.Errorf("%s: want a warning for 1858", fname)
+	}
+}
+
+func TestLdbodiesEarth(t *testing.T) {
+	const fname = "LdbodiesEarth"
+	var astrom ASTROM
+
+	date1 := 2456165.5
+	date2 := 0.401182685
+	b, err := LdbodiesEarth(date1, date2)
+	errT(t, nil, err, fname, "err")
+	viv(t, len(b), 4, fname, "n")
+	b3, _ := Ldbodies(date1, date2)
+	for i := range b3 {
+		if b[i] != b3[i] {
+			t.Errorf("%s: body %d differs from Ldbodies", fname, i)
+		}
+	}
+	_, pvb, _ := Epv00(date1, date2)
+	vvd(t, b[3].Bm, 3.00349e-6, 0.0, fname, "bm")
+	vvd(t, b[3].Dl, 3e-10, 0.0, fname, "dl")
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			vvd(t, b[3].Pv[i][j], pvb[i][j], 0.0, fname, "pv")
+		}
+	}
+
+	// For a terrestrial observer the Earth adds less than a
+	// milliarcsecond.
+	astrom, _, _ = Apco13(2456165.5, 0.401182685, 0.0, -0.527800806,
+		-1.2345856, 2738.0, 0.0, 0.0, 0.0, 0.0, 0.0, 0.0, astrom)
+	ldbodiesAtciqn(t, fname+" terrestrial", astrom, b)
+	r3, d3 := Atciqn(2.71, 0.174, 1e-5, 5e-6, 0.1, 55.0, astrom, 3, b3)
+	r4, d4 := Atciqn(2.71, 0.174, 1e-5, 5e-6, 0.1, 55.0, astrom, 4, b)
+	vvd(t, r4, r3, 5e-9, fname, "ri earth")
+	vvd(t, d4, d3, 5e-9, fname, "di earth")
+
+	_, err = LdbodiesEarth(2400000.5, 0.0)
+	if err == nil {
+		t.Errorf("%s: want a warning for 1858", fname)
+	}
+}
+
+// ldbodiesAtciqn checks the deflection by the bodies b of a star seen
+// with the parameters astrom.
+func ldbodiesAtciqn(t *testing.T, fname string, astrom ASTROM,
+	b []LDBODY) {
+	r0, d0 := Atciq(2.71, 0.174, 1e-5, 5e-6, 0.1, 55.0, astrom)
+	ri, di := Atciqn(2.71, 0.174, 1e-5, 5e-6, 0.1, 55.0, astrom, len(b), b)
+	if math.IsNaN(ri) || math.IsNaN(di) {
+		t.Fatalf("%s: NaN place", fname)
+	}
+	vvd(t, ri, r0, 1e-7, fname, "ri")
+	vvd(t, di, d0, 1e-7, fname, "di")
+	rc, dc := CgoAtciqn(2.71, 0.174, 1e-5, 5e-6, 0.1, 55.0, astrom,
+		len(b), b)
+	vvd(t, ri, rc, 1e-12, fname, "ri cgo")
+	vvd(t, di, dc, 1e-12, fname, "di cgo")
+}
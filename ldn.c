@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauLdn(int n, iauLDBODY b[], double ob[3], double sc[3],
@@ -0,0 +1,18 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoLdn For a star, apply light deflection by multiple solar-system
+//  bodies, as part of transforming coordinate direction into natural
+//  direction.
+func CgoLdn(n int, b []LDBODY, ob, sc [3]float64) (sn [3]float64) {
+	var cSn [3]C.double
+	cB := ldbodiesGo2C(n, b)
+	cAb, cSc := v3sGo2C(ob), v3sGo2C(sc)
+	C.iauLdn(C.int(n), cB, &cAb[0], &cSc[0], &cSn[0])
+	return v3sC2Go(cSn)
+}
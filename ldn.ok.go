@@ -1,9 +1,18 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
+// func CgoLdn2(n int, b []LDBODY, ob, sc [3]float64) (sn [3]float64) {
+// 	var cSn [3]C.double
+// 	cB := C.malloc(C.ulong(C.int(n) * C.sizeof_iauLDBODY))
+// 	cB2 := (*[1<<20]C.iauLDBODY)(cB)
+// 	for i := 0; i < n; i++ {
+// 		cB2[i] = ldbodyGo2C(b[i])
+// 	}
+// 	cAb, cSc := v3sGo2C(ob), v3sGo2C(sc)
+// 	C.iauLdn(C.int(n), &cB2[0], &cAb[0], &cSc[0], &cSn[0])
+// 	return v3sC2Go(cSn)
+// }
 
-//  CgoLdn For a star, apply light deflection by multiple solar-system
+//  Ldn For a star, apply light deflection by multiple solar-system
 //  bodies, as part of transforming coordinate direction into natural
 //  direction.
 //
@@ -90,36 +99,10 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoLdn For a star, apply light deflection by multiple solar-system
-//  bodies, as part of transforming coordinate direction into natural
-//  direction.
-func CgoLdn(n int, b []LDBODY, ob, sc [3]float64) (sn [3]float64) {
-	var cSn [3]C.double
-	cB := make([]C.iauLDBODY, n)
-	for i := 0; i < n; i++ {
-		cB[i] = ldbodyGo2C(b[i])
-	}
-	cAb, cSc := v3sGo2C(ob), v3sGo2C(sc)
-	C.iauLdn(C.int(n), &cB[0], &cAb[0], &cSc[0], &cSn[0])
-	return v3sC2Go(cSn)
-}
-
-// func CgoLdn2(n int, b []LDBODY, ob, sc [3]float64) (sn [3]float64) {
-// 	var cSn [3]C.double
-// 	cB := C.malloc(C.ulong(C.int(n) * C.sizeof_iauLDBODY))
-// 	cB2 := (*[1<<20]C.iauLDBODY)(cB)
-// 	for i := 0; i < n; i++ {
-// 		cB2[i] = ldbodyGo2C(b[i])
-// 	}
-// 	cAb, cSc := v3sGo2C(ob), v3sGo2C(sc)
-// 	C.iauLdn(C.int(n), &cB2[0], &cAb[0], &cSc[0], &cSn[0])
-// 	return v3sC2Go(cSn)
-// }
-
-//  GoLdn For a star, apply light deflection by multiple solar-system
+//  Ldn For a star, apply light deflection by multiple solar-system
 //  bodies, as part of transforming coordinate direction into natural
 //  direction.
-func GoLdn(n int, b []LDBODY, ob, sc [3]float64) (sn [3]float64) {
+func Ldn(n int, b []LDBODY, ob, sc [3]float64) (sn [3]float64) {
 
 	// Light time for 1 au (days)
 	const CR = AULT / DAYSEC
@@ -135,22 +118,22 @@ func GoLdn(n int, b []LDBODY, ob, sc [3]float64) (sn [3]float64) {
 	for i = 0; i < n; i++ {
 
 		// Body to observer vector at epoch of observation (au).
-		v = GoPmp(ob, b[i].pv[0])
+		v = Pmp(ob, b[i].Pv[0])
 
 		// Minus the time since the light passed the body (days).
-		dt = GoPdp(sn, v) * CR
+		dt = Pdp(sn, v) * CR
 
 		// Neutralize if the star is "behind" the observer.
 		dt = fmin(dt, 0.0)
 
 		// Backtrack the body to the time the light was passing the body.
-		ev = GoPpsp(v, -dt, b[i].pv[1])
+		ev = Ppsp(v, -dt, b[i].Pv[1])
 
 		// Body to observer vector as magnitude and direction.
-		em, e = GoPn(ev)
+		em, e = Pn(ev)
 
 		// Apply light deflection for this body.
-		sn = GoLd(b[i].bm, sn, sn, e, em, b[i].dl)
+		sn = Ld(b[i].Bm, sn, sn, e, em, b[i].Dl)
 
 		// Next body.
 	}
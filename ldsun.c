@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauLdsun(double p[3], double e[3], double em, double p1[3])
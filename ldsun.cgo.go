@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoLdsun Deflection of starlight by the Sun.
+func CgoLdsun(p, e [3]float64, em float64) (p1 [3]float64) {
+	var cP1 [3]C.double
+	cP, cE := v3sGo2C(p), v3sGo2C(e)
+	C.iauLdsun(&cP[0], &cE[0], C.double(em), &cP1[0])
+	return v3sC2Go(cP1)
+}
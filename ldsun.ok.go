@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoLdsun Deflection of starlight by the Sun.
+//  Ldsun Deflection of starlight by the Sun.
 //
 //  - - - - - -
 //   L d s u n
@@ -45,16 +42,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoLdsun Deflection of starlight by the Sun.
-func CgoLdsun(p, e [3]float64, em float64) (p1 [3]float64) {
-	var cP1 [3]C.double
-	cP, cE := v3sGo2C(p), v3sGo2C(e)
-	C.iauLdsun(&cP[0], &cE[0], C.double(em), &cP1[0])
-	return v3sC2Go(cP1)
-}
-
-//  GoLdsun Deflection of starlight by the Sun.
-func GoLdsun(p, e [3]float64, em float64) (p1 [3]float64) {
+//  Ldsun Deflection of starlight by the Sun.
+func Ldsun(p, e [3]float64, em float64) (p1 [3]float64) {
 
 	var em2, dlim float64
 
@@ -71,7 +60,7 @@ func GoLdsun(p, e [3]float64, em float64) (p1 [3]float64) {
 	}
 
 	// Apply the deflection.
-	p1 = GoLd(1.0, p, p, e, em, dlim)
+	p1 = Ld(1.0, p, p, e, em, dlim)
 
 	return
 }
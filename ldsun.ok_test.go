@@ -31,7 +31,7 @@ func TestLdsun(t *testing.T) {
 		fn  func(a1, a2 [3]float64, a3 float64) [3]float64
 	}{
 		{"cgo", CgoLdsun},
-		{"go", GoLdsun},
+		{"go", Ldsun},
 	}
 
 	for _, test := range tests {
@@ -64,7 +64,7 @@ func BenchmarkLdsun(b *testing.B) {
 		fn  func(a1, a2 [3]float64, a3 float64) [3]float64
 	}{
 		{"cgo", CgoLdsun},
-		{"go", GoLdsun},
+		{"go", Ldsun},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,484 @@
+package sofa
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/8i8/sofa/en"
+)
+
+var (
+	errLeapEmpty  = errors.New("leap second table contains no entries")
+	errLeapFormat = errors.New("unrecognised leap second file format")
+	errLeapOrder  = errors.New("leap second entries are not in date order")
+)
+
+// LeapSeconds is a source of Delta(AT) = TAI-UTC.  Implementations
+// follow the Dat conventions for arguments, result and status.
+type LeapSeconds interface {
+	Dat(iy, im, id int, fd float64) (deltat float64, err en.ErrNum)
+}
+
+// LeapSecond is one change in Delta(AT), taking effect at 0h UTC on
+// the first day of the given month.
+type LeapSecond struct {
+	Year   int     // UTC year of the change
+	Month  int     // UTC month of the change
+	Delat  float64 // TAI-UTC from this date on (s)
+	RefMJD float64 // pre-1972 drift reference date (MJD)
+	Drift  float64 // pre-1972 drift rate (s/day), zero once leap seconds began
+}
+
+// LeapTable is a Delta(AT) history, either the one compiled into the
+// package or one loaded from an IERS, USNO or NIST/IANA file.
+type LeapTable struct {
+	Changes []LeapSecond // the changes in date order
+	Release int          // release year, used when Expires is zero
+	Updated float64      // MJD of the last update, zero if unknown
+	Expires float64      // MJD after which the table is stale, zero if unknown
+}
+
+// builtinLeap is the table that Dat used before leap second files
+// could be loaded; it matches the SOFA iauDat release 2020-07-21.
+var builtinLeap = &LeapTable{
+	Changes: []LeapSecond{
+		{1960, 1, 1.4178180, 37300.0, 0.0012960},
+		{1961, 1, 1.4228180, 37300.0, 0.0012960},
+		{1961, 8, 1.3728180, 37300.0, 0.0012960},
+		{1962, 1, 1.8458580, 37665.0, 0.0011232},
+		{1963, 11, 1.9458580, 37665.0, 0.0011232},
+		{1964, 1, 3.2401300, 38761.0, 0.0012960},
+		{1964, 4, 3.3401300, 38761.0, 0.0012960},
+		{1964, 9, 3.4401300, 38761.0, 0.0012960},
+		{1965, 1, 3.5401300, 38761.0, 0.0012960},
+		{1965, 3, 3.6401300, 38761.0, 0.0012960},
+		{1965, 7, 3.7401300, 38761.0, 0.0012960},
+		{1965, 9, 3.8401300, 38761.0, 0.0012960},
+		{1966, 1, 4.3131700, 39126.0, 0.0025920},
+		{1968, 2, 4.2131700, 39126.0, 0.0025920},
+		{1972, 1, 10.0, 0, 0},
+		{1972, 7, 11.0, 0, 0},
+		{1973, 1, 12.0, 0, 0},
+		{1974, 1, 13.0, 0, 0},
+		{1975, 1, 14.0, 0, 0},
+		{1976, 1, 15.0, 0, 0},
+		{1977, 1, 16.0, 0, 0},
+		{1978, 1, 17.0, 0, 0},
+		{1979, 1, 18.0, 0, 0},
+		{1980, 1, 19.0, 0, 0},
+		{1981, 7, 20.0, 0, 0},
+		{1982, 7, 21.0, 0, 0},
+		{1983, 7, 22.0, 0, 0},
+		{1985, 7, 23.0, 0, 0},
+		{1988, 1, 24.0, 0, 0},
+		{1990, 1, 25.0, 0, 0},
+		{1991, 1, 26.0, 0, 0},
+		{1992, 7, 27.0, 0, 0},
+		{1993, 7, 28.0, 0, 0},
+		{1994, 7, 29.0, 0, 0},
+		{1996, 1, 30.0, 0, 0},
+		{1997, 7, 31.0, 0, 0},
+		{1999, 1, 32.0, 0, 0},
+		{2006, 1, 33.0, 0, 0},
+		{2009, 1, 34.0, 0, 0},
+		{2012, 7, 35.0, 0, 0},
+		{2015, 7, 36.0, 0, 0},
+		{2017, 1, 37.0, 0, 0},
+	},
+	Release: 2020,
+}
+
+// leap holds the process wide Delta(AT) source used by Dat.
+var leap = struct {
+	sync.RWMutex
+	ls LeapSeconds
+}{ls: builtinLeap}
+
+// BuiltinLeapSeconds returns a copy of the Delta(AT) table compiled
+// into the package, which the caller may change freely.
+func BuiltinLeapSeconds() *LeapTable {
+	t := *builtinLeap
+	t.Changes = append([]LeapSecond(nil), builtinLeap.Changes...)
+	return &t
+}
+
+// SetLeapSeconds installs ls as the process wide source of Delta(AT)
+// used by Dat and the functions that call it, returning the source
+// that it replaces.  A nil ls restores the built-in table.
+func SetLeapSeconds(ls LeapSeconds) (prev LeapSeconds) {
+	if ls == nil {
+		ls = builtinLeap
+	}
+	leap.Lock()
+	prev, leap.ls = leap.ls, ls
+	leap.Unlock()
+	return
+}
+
+// CurrentLeapSeconds returns the process wide source of Delta(AT).
+func CurrentLeapSeconds() LeapSeconds {
+	leap.RLock()
+	defer leap.RUnlock()
+	return leap.ls
+}
+
+// Dat For a given UTC date, calculate Delta(AT) = TAI-UTC from the
+// table; the arguments, result and status are those of Dat.  When
+// the table has an expiry date, dates after it are dubious, otherwise
+// years five or more after the release year are.
+func (t *LeapTable) Dat(iy, im, id int, fd float64) (
+	deltat float64, err en.ErrNum) {
+
+	var i, m int
+	var da, djm float64
+
+	// If invalid fraction of a day, set error status and give up.
+	if fd < 0.0 || fd > 1.0 {
+		err = errDat.Set(-4)
+		return
+	}
+
+	// Convert the date into an MJD.
+	_, djm, err = Cal2jd(iy, im, id)
+
+	// If invalid year, month, or day, give up.
+	if err != nil {
+		if err.Code() < 0 {
+			err = errDat.Set(err.Code()).Wrap(err)
+			return
+		}
+	}
+
+	// If pre-UTC year, set warning status and give up.
+	if len(t.Changes) == 0 || iy < t.Changes[0].Year {
+		err = errDat.Set(1)
+		return
+	}
+
+	// If suspiciously late date, set warning status but proceed.
+	if t.Expires > 0 {
+		if djm+fd > t.Expires {
+			err = errDat.Set(1)
+		}
+	} else if iy > t.Release+5 {
+		err = errDat.Set(1)
+	}
+
+	// Combine year and month to form a date-ordered integer...
+	m = 12*iy + im
+
+	// ...and use it to find the preceding table entry.
+	for i = len(t.Changes) - 1; i >= 0; i-- {
+		if m >= (12*t.Changes[i].Year + t.Changes[i].Month) {
+			break
+		}
+	}
+
+	// Prevent underflow warnings.
+	if i < 0 {
+		err = errDat.Set(-5)
+		return
+	}
+
+	// Get the Delta(AT), adjusting for drift if pre-1972.
+	da = t.Changes[i].Delat
+	if t.Changes[i].Drift != 0 {
+		da += (djm + fd - t.Changes[i].RefMJD) * t.Changes[i].Drift
+	}
+	deltat = da
+
+	return
+}
+
+// LoadLeapSecondsFile reads a leap second file, see LoadLeapSeconds.
+func LoadLeapSecondsFile(name string) (*LeapTable, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	t, err := LoadLeapSeconds(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return t, nil
+}
+
+// LoadLeapSeconds reads a leap second table in any of the IERS
+// Leap_Second.dat, USNO tai-utc.dat or NIST/IANA leap-seconds.list
+// formats, detecting which from the content.  The pre-1972 part of the
+// built-in table is used for dates earlier than the file covers.
+func LoadLeapSeconds(r io.Reader) (*LeapTable, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case bytes.Contains(b, []byte("=JD")):
+		return ParseUSNOLeapSeconds(bytes.NewReader(b))
+	case bytes.Contains(b, []byte("#@")) || bytes.Contains(b, []byte("#$")):
+		return ParseNISTLeapSeconds(bytes.NewReader(b))
+	case bytes.Contains(b, []byte("TAI-UTC")):
+		return ParseIERSLeapSeconds(bytes.NewReader(b))
+	}
+	return nil, errLeapFormat
+}
+
+// months maps the month names used in leap second files to numbers.
+var months = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+// monthNum returns the number of a month given by name, in full or by
+// its first three letters.
+func monthNum(s string) (int, bool) {
+	if len(s) < 3 {
+		return 0, false
+	}
+	n, ok := months[strings.ToUpper(s[:3])]
+	return n, ok
+}
+
+// ieExpires matches the expiry comment of the IERS Leap_Second.dat.
+var ieExpires = regexp.MustCompile(
+	`(?i)expires\s+on\s+(\d+)\s+([a-z]+)\s+(\d{4})`)
+
+// ParseIERSLeapSeconds reads the IERS Leap_Second.dat format, lines of
+// MJD, day, month, year and TAI-UTC, with the expiry date given in a
+// comment.
+func ParseIERSLeapSeconds(r io.Reader) (*LeapTable, error) {
+	t := &LeapTable{}
+	s := bufio.NewScanner(r)
+	for ln := 1; s.Scan(); ln++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" {
+			continue
+		}
+		if line[0] == '#' {
+			if m := ieExpires.FindStringSubmatch(line); m != nil {
+				mjd, err := leapDateMJD(m[3], m[2], m[1])
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", ln, err)
+				}
+				t.Expires = mjd
+			}
+			continue
+		}
+		f := strings.Fields(line)
+		if len(f) < 5 {
+			return nil, fmt.Errorf("line %d: %w", ln, errLeapFormat)
+		}
+		c, err := leapChange(f[3], f[2], f[1], f[4])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", ln, err)
+		}
+		t.Changes = append(t.Changes, c)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return t.finish()
+}
+
+// usLine matches a line of the USNO tai-utc.dat.
+var usLine = regexp.MustCompile(`^\s*(\d{4})\s+([A-Za-z]{3})\s+(\d+)\s+` +
+	`=JD\s+[\d.]+\s+TAI-UTC=\s*([\d.]+)\s*S\s*\+\s*\(MJD\s*-\s*` +
+	`([\d.]+)\s*\)\s*X\s*([\d.]+)\s*S`)
+
+// ParseUSNOLeapSeconds reads the USNO tai-utc.dat format, which also
+// gives the pre-1972 drift expressions.  The format has no update or
+// expiry date;  the year of loading is used as the release year.
+func ParseUSNOLeapSeconds(r io.Reader) (*LeapTable, error) {
+	t := &LeapTable{}
+	s := bufio.NewScanner(r)
+	for ln := 1; s.Scan(); ln++ {
+		line := s.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		m := usLine.FindStringSubmatch(line)
+		if m == nil {
+			return nil, fmt.Errorf("line %d: %w", ln, errLeapFormat)
+		}
+		c, err := leapChange(m[1], m[2], m[3], m[4])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", ln, err)
+		}
+		ref, err1 := strconv.ParseFloat(m[5], 64)
+		rate, err2 := strconv.ParseFloat(m[6], 64)
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("line %d: %w", ln, errLeapFormat)
+		}
+		if rate != 0 {
+			c.RefMJD = ref
+			c.Drift = rate
+		}
+		t.Changes = append(t.Changes, c)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return t.finish()
+}
+
+// ParseNISTLeapSeconds reads the NIST/IANA leap-seconds.list format, in
+// which dates are NTP seconds from 1900 January 1, with the last
+// update and expiry given on the "#$" and "#@" lines.
+func ParseNISTLeapSeconds(r io.Reader) (*LeapTable, error) {
+	t := &LeapTable{}
+	s := bufio.NewScanner(r)
+	for ln := 1; s.Scan(); ln++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" {
+			continue
+		}
+		var p *float64
+		switch {
+		case strings.HasPrefix(line, "#$"):
+			p = &t.Updated
+		case strings.HasPrefix(line, "#@"):
+			p = &t.Expires
+		case line[0] == '#':
+			continue
+		}
+		if p != nil {
+			f := strings.Fields(line[2:])
+			if len(f) == 0 {
+				return nil, fmt.Errorf("line %d: %w", ln, errLeapFormat)
+			}
+			ntp, err := strconv.ParseInt(f[0], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", ln, err)
+			}
+			*p = ntpMJD(ntp)
+			continue
+		}
+		f := strings.Fields(line)
+		if len(f) < 2 {
+			return nil, fmt.Errorf("line %d: %w", ln, errLeapFormat)
+		}
+		ntp, err := strconv.ParseInt(f[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", ln, err)
+		}
+		dat, err := strconv.ParseFloat(f[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", ln, err)
+		}
+		iy, im, id, _, e := Jd2cal(DJM0, ntpMJD(ntp))
+		if e != nil || id != 1 {
+			return nil, fmt.Errorf("line %d: %w", ln, errLeapFormat)
+		}
+		t.Changes = append(t.Changes, LeapSecond{Year: iy, Month: im,
+			Delat: dat})
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return t.finish()
+}
+
+// ntpMJD converts NTP seconds, from 1900 January 1.0, to an MJD.
+func ntpMJD(ntp int64) float64 {
+	const mjd1900 = 15020.0
+	return mjd1900 + float64(ntp)/DAYSEC
+}
+
+// leapDateMJD returns the MJD of a date given as year, month name or
+// number, and day strings.
+func leapDateMJD(year, month, day string) (float64, error) {
+	iy, im, id, err := leapDate(year, month, day)
+	if err != nil {
+		return 0, err
+	}
+	_, djm, e := Cal2jd(iy, im, id)
+	if e != nil {
+		return 0, e
+	}
+	return djm, nil
+}
+
+// leapDate parses a date given as year, month name or number, and day
+// strings.
+func leapDate(year, month, day string) (iy, im, id int, err error) {
+	if iy, err = strconv.Atoi(year); err != nil {
+		return
+	}
+	if id, err = strconv.Atoi(day); err != nil {
+		return
+	}
+	if n, e := strconv.Atoi(month); e == nil {
+		im = n
+	} else if n, ok := monthNum(month); ok {
+		im = n
+	} else {
+		err = errLeapFormat
+	}
+	return
+}
+
+// leapChange builds a LeapSecond from its date and TAI-UTC strings,
+// checking that it falls on the first of the month.
+func leapChange(year, month, day, delat string) (c LeapSecond, err error) {
+	var id int
+	if c.Year, c.Month, id, err = leapDate(year, month, day); err != nil {
+		return
+	}
+	if id != 1 || c.Month < 1 || c.Month > 12 {
+		err = errLeapFormat
+		return
+	}
+	c.Delat, err = strconv.ParseFloat(delat, 64)
+	return
+}
+
+// finish validates a freshly parsed table, completes it with the
+// earlier entries of the built-in table and sets the release year.
+func (t *LeapTable) finish() (*LeapTable, error) {
+	if len(t.Changes) == 0 {
+		return nil, errLeapEmpty
+	}
+	for i := 1; i < len(t.Changes); i++ {
+		a, b := t.Changes[i-1], t.Changes[i]
+		if 12*a.Year+a.Month >= 12*b.Year+b.Month {
+			return nil, errLeapOrder
+		}
+	}
+	first := 12*t.Changes[0].Year + t.Changes[0].Month
+	var early []LeapSecond
+	for _, c := range builtinLeap.Changes {
+		if 12*c.Year+c.Month >= first {
+			break
+		}
+		early = append(early, c)
+	}
+	t.Changes = append(early, t.Changes...)
+
+	// The release year is that of the last update of the file, or of
+	// the loading if the file gives none, and never earlier than the
+	// last change or the built-in table.
+	t.Release = time.Now().UTC().Year()
+	if t.Updated > 0 {
+		if iy, _, _, _, e := Jd2cal(DJM0, t.Updated); e == nil {
+			t.Release = iy
+		}
+	}
+	if y := t.Changes[len(t.Changes)-1].Year; y > t.Release {
+		t.Release = y
+	}
+	if t.Release < builtinLeap.Release {
+		t.Release = builtinLeap.Release
+	}
+	return t, nil
+}
@@ -0,0 +1,222 @@
+package sofa
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const leapIERS = `#  Value of TAI-UTC in second valid beetween the initial value until
+#  the epoch given on the next line. The last line reads that NO
+#  leap second was introduced since the corresponding date
+#  Updated through IERS Bulletin 66 issued in July 2023
+#
+#
+#  File expires on 28 June 2024
+#
+#
+#    MJD        Date        TAI-UTC (s)
+#           day month year
+#    ---    --------------   ------
+#
+    41317.0    1  1 1972       10
+    41499.0    1  7 1972       11
+    41683.0    1  1 1973       12
+    57204.0    1  7 2015       36
+    57754.0    1  1 2017       37
+`
+
+const leapUSNO = ` 1961 JAN  1 =JD 2437300.5  TAI-UTC=   1.4228180 S + (MJD - 37300.) X 0.001296 S
+ 1961 AUG  1 =JD 2437512.5  TAI-UTC=   1.3728180 S + (MJD - 37300.) X 0.001296 S
+ 1962 JAN  1 =JD 2437665.5  TAI-UTC=   1.8458580 S + (MJD - 37665.) X 0.0011232S
+ 1972 JAN  1 =JD 2441317.5  TAI-UTC=  10.0       S + (MJD - 41317.) X 0.0      S
+ 1972 JUL  1 =JD 2441499.5  TAI-UTC=  11.0       S + (MJD - 41317.) X 0.0      S
+ 2015 JUL  1 =JD 2457204.5  TAI-UTC=  36.0       S + (MJD - 41317.) X 0.0      S
+ 2017 JAN  1 =JD 2457754.5  TAI-UTC=  37.0       S + (MJD - 41317.) X 0.0      S
+`
+
+const leapNIST = `#	Updated through IERS Bulletin C65
+#$	 3676924800
+#@	 3928521600
+#
+2272060800	10	# 1 Jan 1972
+2287785600	11	# 1 Jul 1972
+2303683200	12	# 1 Jan 1973
+3644697600	36	# 1 Jul 2015
+3692217600	37	# 1 Jan 2017
+#h	16edd0f0 3666784f a356c682 97e3ff01 d4b2b9d9
+`
+
+// TestLeapTable checks the built-in leap second table against the C
+// iauDat.
+func TestLeapTable(t *testing.T) {
+	const fname = "LeapTable"
+	tbl := BuiltinLeapSeconds()
+	for iy := 1960; iy <= 2024; iy++ {
+		for im := 1; im <= 12; im++ {
+			want, werr := CgoDat(iy, im, 15, 0.25)
+			got, gerr := tbl.Dat(iy, im, 15, 0.25)
+			vvd(t, got, want, 0.0, fname, "builtin")
+			if (werr == nil) != (gerr == nil) {
+				t.Errorf("%s: %d-%d: want %v got %v",
+					fname, iy, im, werr, gerr)
+			}
+		}
+	}
+
+	// The table returned is a copy.
+	tbl.Changes[len(tbl.Changes)-1].Delat = 0.0
+	tbl.Changes = tbl.Changes[:1]
+	got, _ := BuiltinLeapSeconds().Dat(2020, 1, 1, 0.0)
+	vvd(t, got, 37.0, 0.0, fname, "copy")
+}
+
+func TestLoadLeapSeconds(t *testing.T) {
+	const fname = "LoadLeapSeconds"
+	tests := []struct {
+		ref  string
+		data string
+	}{
+		{"iers", leapIERS},
+		{"usno", leapUSNO},
+		{"nist", leapNIST},
+	}
+
+	for _, test := range tests {
+		tname := fname + " " + test.ref
+
+		tbl, err := LoadLeapSeconds(strings.NewReader(test.data))
+		if err != nil {
+			t.Fatalf("%s: %v", tname, err)
+		}
+
+		// Pre-1972 entries come from the file or the built-in table.
+		deltat, err := tbl.Dat(1961, 3, 1, 0.0)
+		vvd(t, deltat, 1.4228180+(37359.0-37300.0)*0.001296,
+			1e-12, tname, "d1")
+		errT(t, nil, err, tname, "j1")
+
+		deltat, err = tbl.Dat(1972, 8, 1, 0.0)
+		vvd(t, deltat, 11.0, 0.0, tname, "d2")
+		errT(t, nil, err, tname, "j2")
+
+		deltat, _ = tbl.Dat(2016, 12, 31, 0.0)
+		vvd(t, deltat, 36.0, 0.0, tname, "d3")
+
+		deltat, _ = tbl.Dat(2017, 1, 1, 0.0)
+		vvd(t, deltat, 37.0, 0.0, tname, "d4")
+	}
+}
+
+func TestLeapExpiry(t *testing.T) {
+	const fname = "LeapExpiry"
+	tests := []struct {
+		ref     string
+		data    string
+		expires float64
+	}{
+		{"iers", leapIERS, 60489.0},
+		{"nist", leapNIST, 60489.0},
+	}
+
+	for _, test := range tests {
+		tname := fname + " " + test.ref
+
+		tbl, err := LoadLeapSeconds(strings.NewReader(test.data))
+		if err != nil {
+			t.Fatalf("%s: %v", tname, err)
+		}
+		vvd(t, tbl.Expires, test.expires, 0.0, tname, "expires")
+
+		_, derr := tbl.Dat(2024, 6, 28, 0.0)
+		errT(t, nil, derr, tname, "before")
+
+		deltat, derr := tbl.Dat(2024, 6, 29, 0.0)
+		vvd(t, deltat, 37.0, 0.0, tname, "after")
+		errEN(t, 1, derr, tname, "after")
+	}
+}
+
+func TestLeapRelease(t *testing.T) {
+	const fname = "LeapRelease"
+
+	// tai-utc.dat ends in 2017 and has no date of its own:  it is taken
+	// as released when loaded, and never before the built-in table.
+	tbl, err := LoadLeapSeconds(strings.NewReader(leapUSNO))
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	if tbl.Release < time.Now().UTC().Year() ||
+		tbl.Release < builtinLeap.Release {
+		t.Errorf("%s: usno release %d", fname, tbl.Release)
+	}
+	deltat, derr := tbl.Dat(2024, 6, 1, 0.0)
+	vvd(t, deltat, 37.0, 0.0, fname, "usno 2024")
+	errT(t, nil, derr, fname, "usno 2024")
+
+	// leap-seconds.list gives its last update, here in 2016, which the
+	// built-in table outdates.
+	tbl, err = LoadLeapSeconds(strings.NewReader(leapNIST))
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	iy, _, _, _, _ := Jd2cal(DJM0, tbl.Updated)
+	viv(t, iy, 2016, fname, "nist updated")
+	viv(t, tbl.Release, builtinLeap.Release, fname, "nist")
+}
+
+func TestLoadLeapSecondsErrors(t *testing.T) {
+	const fname = "LoadLeapSeconds"
+	tests := []struct {
+		ref  string
+		data string
+	}{
+		{"empty", "# nothing here\n"},
+		{"day", "    41318.0    2  1 1972       10\n"},
+		{"order", "    41499.0    1  7 1972       11\n" +
+			"    41317.0    1  1 1972       10\n"},
+	}
+
+	for _, test := range tests {
+		_, err := ParseIERSLeapSeconds(strings.NewReader(test.data))
+		if err == nil {
+			t.Errorf("%s %s: want error got nil", fname, test.ref)
+		}
+	}
+}
+
+func TestSetLeapSeconds(t *testing.T) {
+	const fname = "SetLeapSeconds"
+
+	// A table with an extra leap second at the end of 2026 June.
+	tbl, err := LoadLeapSeconds(strings.NewReader(leapIERS +
+		"    61222.0    1  7 2026       38\n"))
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	tbl.Expires = 0
+
+	prev := SetLeapSeconds(tbl)
+	defer SetLeapSeconds(prev)
+
+	deltat, _ := Dat(2026, 7, 2, 0.0)
+	vvd(t, deltat, 38.0, 0.0, fname, "installed")
+
+	u1, u2, _ := Taiutc(DJM0, 61223.0)
+	vvd(t, (u1-DJM0)+u2, 61223.0-38.0/DAYSEC, 1e-12, fname, "taiutc")
+
+	_, _, err = Dtf2d("UTC", 2026, 6, 30, 23, 59, 60.5)
+	errT(t, nil, err, fname, "dtf2d")
+
+	// Per call use does not depend on the installed table.
+	deltat, _ = builtinLeap.Dat(2026, 7, 2, 0.0)
+	vvd(t, deltat, 37.0, 0.0, fname, "builtin")
+	a1, a2, _ := UtctaiWith(builtinLeap, DJM0, 61223.0)
+	vvd(t, (a1-DJM0)+a2, 61223.0+37.0/DAYSEC, 1e-12, fname,
+		"utctai with")
+
+	SetLeapSeconds(nil)
+	if CurrentLeapSeconds() != LeapSeconds(builtinLeap) {
+		t.Errorf("%s: nil did not restore the built-in table", fname)
+	}
+}
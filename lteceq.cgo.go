@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoLteceq Transformation from ecliptic coordinates (mean equinox and
+//  ecliptic of date) to ICRS RA,Dec, using a long-term precession
+//  model.
+func CgoLteceq(epj, dl, db float64) (dr, dd float64) {
+	var cDr, cDd C.double
+	C.iauLteceq(C.double(epj), C.double(dl), C.double(db),
+		&cDr, &cDd)
+	return float64(cDr), float64(cDd)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoLteceq Transformation from ecliptic coordinates (mean equinox and
+//  Lteceq Transformation from ecliptic coordinates (mean equinox and
 //  ecliptic of date) to ICRS RA,Dec, using a long-term precession
 //  model.
 //
@@ -63,38 +60,28 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoLteceq Transformation from ecliptic coordinates (mean equinox and
-//  ecliptic of date) to ICRS RA,Dec, using a long-term precession
-//  model.
-func CgoLteceq(epj, dl, db float64) (dr, dd float64) {
-	var cDr, cDd C.double
-	C.iauLteceq(C.double(epj), C.double(dl), C.double(db),
-		&cDr, &cDd)
-	return float64(cDr), float64(cDd)
-}
-
-//  GoLteceq Transformation from ecliptic coordinates (mean equinox and
+//  Lteceq Transformation from ecliptic coordinates (mean equinox and
 //  ecliptic of date) to ICRS RA,Dec, using a long-term precession
 //  model.
-func GoLteceq(epj, dl, db float64) (dr, dd float64) {
+func Lteceq(epj, dl, db float64) (dr, dd float64) {
 	var rm [3][3]float64
 	var v1, v2 [3]float64
 	var a, b float64
 
 	// Spherical to Cartesian.
-	v1 = GoS2c(dl, db)
+	v1 = S2c(dl, db)
 
 	// Rotation matrix, ICRS equatorial to ecliptic.
-	rm = GoLtecm(epj)
+	rm = Ltecm(epj)
 
 	// The transformation from ecliptic to ICRS.
-	v2 = GoTrxp(rm, v1)
+	v2 = Trxp(rm, v1)
 
 	// Cartesian to spherical.
-	a, b = GoC2s(v2)
+	a, b = C2s(v2)
 
 	// Express in conventional ranges.
-	dr = GoAnp(a)
-	dd = GoAnpm(b)
+	dr = Anp(a)
+	dd = Anpm(b)
 	return
 }
@@ -26,7 +26,7 @@ func TestLteceq(t *testing.T) {
 		fn  func(a1, a2, a3 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoLteceq},
-		{"go", GoLteceq},
+		{"go", Lteceq},
 	}
 
 	for _, test := range tests {
@@ -51,7 +51,7 @@ func BenchmarkLteceq(b *testing.B) {
 		fn  func(a1, a2, a3 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoLteceq},
-		{"go", GoLteceq},
+		{"go", Lteceq},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoLtecm ICRS equatorial to ecliptic rotation matrix, long-term.
+func CgoLtecm(epj float64) (rm [3][3]float64) {
+	var cRm [3][3]C.double
+	C.iauLtecm(C.double(epj), &cRm[0])
+	return v3tC2Go(cRm)
+}
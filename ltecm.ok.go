@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoLtecm ICRS equatorial to ecliptic rotation matrix, long-term.
+//  Ltecm ICRS equatorial to ecliptic rotation matrix, long-term.
 //
 //  - - - - - -
 //   L t e c m
@@ -68,15 +65,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoLtecm ICRS equatorial to ecliptic rotation matrix, long-term.
-func CgoLtecm(epj float64) (rm [3][3]float64) {
-	var cRm [3][3]C.double
-	C.iauLtecm(C.double(epj), &cRm[0])
-	return v3tC2Go(cRm)
-}
-
-//  GoLtecm ICRS equatorial to ecliptic rotation matrix, long-term.
-func GoLtecm(epj float64) (rm [3][3]float64) {
+//  Ltecm ICRS equatorial to ecliptic rotation matrix, long-term.
+func Ltecm(epj float64) (rm [3][3]float64) {
 
 	// Frame bias (IERS Conventions 2010, Eqs. 5.21 and 5.33)
 	const dx = -0.016617 * DAS2R
@@ -86,17 +76,17 @@ func GoLtecm(epj float64) (rm [3][3]float64) {
 	var p, z, w, x, y [3]float64
 
 	// Equator pole.
-	p = GoLtpequ(epj)
+	p = Ltpequ(epj)
 
 	// Ecliptic pole (bottom row of equatorial to ecliptic matrix).
-	z = GoLtpecl(epj)
+	z = Ltpecl(epj)
 
 	// Equinox (top row of matrix).
-	w = GoPxp(p, z)
-	_, x = GoPn(w)
+	w = Pxp(p, z)
+	_, x = Pn(w)
 
 	// Middle row of matrix.
-	y = GoPxp(z, x)
+	y = Pxp(z, x)
 
 	// Combine with frame bias.
 	rm[0][0] = x[0] - x[1]*dr + x[2]*dx
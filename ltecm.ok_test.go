@@ -25,7 +25,7 @@ func TestLtecm(t *testing.T) {
 		fn  func(float64) [3][3]float64
 	}{
 		{"cgo", CgoLtecm},
-		{"go", GoLtecm},
+		{"go", Ltecm},
 	}
 
 	for _, test := range tests {
@@ -64,7 +64,7 @@ func BenchmarkLtecm(b *testing.B) {
 		fn  func(float64) [3][3]float64
 	}{
 		{"cgo", CgoLtecm},
-		{"go", GoLtecm},
+		{"go", Ltecm},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauLteqec(double epj, double dr, double dd, double *dl, double *db)
@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoLteqec Transformation from ICRS equatorial coordinates to
+//  ecliptic coordinates (mean equinox and ecliptic of date) using a
+//  long-term precession model.
+func CgoLteqec(epj, dr, dd float64) (dl, db float64) {
+	var cDl, cDb C.double
+	C.iauLteqec(C.double(epj), C.double(dr), C.double(dd),
+		&cDl, &cDb)
+	return float64(cDl), float64(cDb)
+}
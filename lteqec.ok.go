@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoLteqec Transformation from ICRS equatorial coordinates to
+//  Lteqec Transformation from ICRS equatorial coordinates to
 //  ecliptic coordinates (mean equinox and ecliptic of date) using a
 //  long-term precession model.
 //
@@ -63,39 +60,29 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoLteqec Transformation from ICRS equatorial coordinates to
-//  ecliptic coordinates (mean equinox and ecliptic of date) using a
-//  long-term precession model.
-func CgoLteqec(epj, dr, dd float64) (dl, db float64) {
-	var cDl, cDb C.double
-	C.iauLteqec(C.double(epj), C.double(dr), C.double(dd),
-		&cDl, &cDb)
-	return float64(cDl), float64(cDb)
-}
-
-//  GoLteqec Transformation from ICRS equatorial coordinates to
+//  Lteqec Transformation from ICRS equatorial coordinates to
 //  ecliptic coordinates (mean equinox and ecliptic of date) using a
 //  long-term precession model.
-func GoLteqec(epj, dr, dd float64) (dl, db float64) {
+func Lteqec(epj, dr, dd float64) (dl, db float64) {
 	var rm [3][3]float64
 	var v1 [3]float64
 	var v2 [3]float64
 	var a, b float64
 
 	// Spherical to Cartesian. 
-	v1 = GoS2c(dr, dd)
+	v1 = S2c(dr, dd)
 
 	// Rotation matrix, ICRS equatorial to ecliptic. 
-	rm = GoLtecm(epj)
+	rm = Ltecm(epj)
 
 	// The transformation from ICRS to ecliptic. 
-	v2 = GoRxp(rm, v1)
+	v2 = Rxp(rm, v1)
 
 	// Cartesian to spherical. 
-	a, b = GoC2s(v2)
+	a, b = C2s(v2)
 
 	// Express in conventional ranges. 
-	dl = GoAnp(a)
-	db = GoAnpm(b)
+	dl = Anp(a)
+	db = Anpm(b)
 	return
 }
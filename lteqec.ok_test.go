@@ -26,7 +26,7 @@ func TestLteqec(t *testing.T) {
 		fn  func(a1, a2, a3 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoLteqec},
-		{"go", GoLteqec},
+		{"go", Lteqec},
 	}
 
 	for _, test := range tests {
@@ -51,7 +51,7 @@ func BenchmarkLteqec(b *testing.B) {
 		fn  func(a1, a2, a3 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoLteqec},
-		{"go", GoLteqec},
+		{"go", Lteqec},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoLtp Long-term precession matrix.
+func CgoLtp(epj float64) (rp [3][3]float64) {
+	var cRp [3][3]C.double
+	C.iauLtp(C.double(epj), &cRp[0])
+	return v3tC2Go(cRp)
+}
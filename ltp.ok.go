@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoLtp Long-term precession matrix.
+//  Ltp Long-term precession matrix.
 //
 //  - - - -
 //   L t p
@@ -59,30 +56,23 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoLtp Long-term precession matrix.
-func CgoLtp(epj float64) (rp [3][3]float64) {
-	var cRp [3][3]C.double
-	C.iauLtp(C.double(epj), &cRp[0])
-	return v3tC2Go(cRp)
-}
-
-//  GoLtp Long-term precession matrix.
-func GoLtp(epj float64) (rp [3][3]float64) {
+//  Ltp Long-term precession matrix.
+func Ltp(epj float64) (rp [3][3]float64) {
 	var i int
 	var peqr, pecl, v, eqx [3]float64
 
 	// Equator pole (bottom row of matrix).
-	peqr = GoLtpequ(epj)
+	peqr = Ltpequ(epj)
 
 	// Ecliptic pole.
-	pecl = GoLtpecl(epj)
+	pecl = Ltpecl(epj)
 
 	// Equinox (top row of matrix).
-	v = GoPxp(peqr, pecl)
-	_, eqx = GoPn(v)
+	v = Pxp(peqr, pecl)
+	_, eqx = Pn(v)
 
 	// Middle row of matrix.
-	v = GoPxp(peqr, eqx)
+	v = Pxp(peqr, eqx)
 
 	// Assemble the matrix.
 	for i = 0; i < 3; i++ {
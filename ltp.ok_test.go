@@ -25,7 +25,7 @@ func TestLtp(t *testing.T) {
 		fn  func(float64) [3][3]float64
 	}{
 		{"cgo", CgoLtp},
-		{"go", GoLtp},
+		{"go", Ltp},
 	}
 
 	for _, test := range tests {
@@ -64,7 +64,7 @@ func BenchmarkLtp(b *testing.B) {
 		fn  func(float64) [3][3]float64
 	}{
 		{"cgo", CgoLtp},
-		{"go", GoLtp},
+		{"go", Ltp},
 	}
 
 	for _, test := range tests {
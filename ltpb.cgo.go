@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoLtpb Long-term precession matrix, including ICRS frame bias.
+// void iauLtpb(double epj, double rpb[3][3])
+func CgoLtpb(epj float64) (rpb [3][3]float64) {
+	var cRpb [3][3]C.double
+	C.iauLtpb(C.double(epj), &cRpb[0])
+	return v3tC2Go(cRpb)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoLtpb Long-term precession matrix, including ICRS frame bias.
+//  Ltpb Long-term precession matrix, including ICRS frame bias.
 //
 //  - - - - -
 //   L t p b
@@ -57,17 +54,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoLtpb Long-term precession matrix, including ICRS frame bias.
-// void iauLtpb(double epj, double rpb[3][3])
-func CgoLtpb(epj float64) (rpb [3][3]float64) {
-	var cRpb [3][3]C.double
-	C.iauLtpb(C.double(epj), &cRpb[0])
-	return v3tC2Go(cRpb)
-}
-
-//  GoLtpb Long-term precession matrix, including ICRS frame bias.
+//  Ltpb Long-term precession matrix, including ICRS frame bias.
 // void iauLtpb(double epj, double rpb[3][3])
-func GoLtpb(epj float64) (rpb [3][3]float64) {
+func Ltpb(epj float64) (rpb [3][3]float64) {
 
 	// Frame bias (IERS Conventions 2010, Eqs. 5.21 and 5.33)
 	const dx = -0.016617 * DAS2R
@@ -78,7 +67,7 @@ func GoLtpb(epj float64) (rpb [3][3]float64) {
 	var rp [3][3]float64
 
 	// Precession matrix.
-	rp = GoLtp(epj)
+	rp = Ltp(epj)
 
 	// Apply the bias.
 	for i = 0; i < 3; i++ {
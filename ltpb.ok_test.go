@@ -25,7 +25,7 @@ func TestLtpb(t *testing.T) {
 		fn  func(float64) [3][3]float64
 	}{
 		{"cgo", CgoLtpb},
-		{"go", GoLtpb},
+		{"go", Ltpb},
 	}
 
 	for _, test := range tests {
@@ -63,7 +63,7 @@ func BenchmarkLtpb(b *testing.B) {
 		fn  func(float64) [3][3]float64
 	}{
 		{"cgo", CgoLtpb},
-		{"go", GoLtpb},
+		{"go", Ltpb},
 	}
 
 	for _, test := range tests {
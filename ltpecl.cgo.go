@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoLtpecl Long-term precession of the ecliptic.
+func CgoLtpecl(epj float64) (vec [3]float64) {
+	var cVec [3]C.double
+	C.iauLtpecl(C.double(epj), &cVec[0])
+	return v3sC2Go(cVec)
+}
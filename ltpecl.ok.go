@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoLtpecl Long-term precession of the ecliptic.
+//  Ltpecl Long-term precession of the ecliptic.
 //
 //  - - - - - - -
 //   L t p e c l
@@ -49,15 +47,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoLtpecl Long-term precession of the ecliptic.
-func CgoLtpecl(epj float64) (vec [3]float64) {
-	var cVec [3]C.double
-	C.iauLtpecl(C.double(epj), &cVec[0])
-	return v3sC2Go(cVec)
-}
-
-//  GoLtpecl Long-term precession of the ecliptic.
-func GoLtpecl(epj float64) (vec [3]float64) {
+//  Ltpecl Long-term precession of the ecliptic.
+func Ltpecl(epj float64) (vec [3]float64) {
 
 	// Obliquity at J2000.0 (radians).
 	const eps0 = 84381.406 * DAS2R
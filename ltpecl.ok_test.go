@@ -25,7 +25,7 @@ func TestLtpecl(t *testing.T) {
 		fn  func(float64) [3]float64
 	}{
 		{"cgo", CgoLtpecl},
-		{"go", GoLtpecl},
+		{"go", Ltpecl},
 	}
 
 	for _, test := range tests {
@@ -52,7 +52,7 @@ func BenchmarkLtpecl(b *testing.B) {
 		fn  func(float64) [3]float64
 	}{
 		{"cgo", CgoLtpecl},
-		{"go", GoLtpecl},
+		{"go", Ltpecl},
 	}
 
 	for _, test := range tests {
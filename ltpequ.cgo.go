@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoLtpequ Long-term precession of the equator.
+func CgoLtpequ(epj float64) (veq [3]float64) {
+	var cVeq [3]C.double
+	C.iauLtpequ(C.double(epj), &cVeq[0])
+	return v3sC2Go(cVeq)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoLtpequ Long-term precession of the equator.
+//  Ltpequ Long-term precession of the equator.
 //
 //  - - - - - - -
 //   L t p e q u
@@ -49,15 +47,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoLtpequ Long-term precession of the equator.
-func CgoLtpequ(epj float64) (veq [3]float64) {
-	var cVeq [3]C.double
-	C.iauLtpequ(C.double(epj), &cVeq[0])
-	return v3sC2Go(cVeq)
-}
-
-//  GoLtpequ Long-term precession of the equator.
-func GoLtpequ(epj float64) (veq [3]float64) {
+//  Ltpequ Long-term precession of the equator.
+func Ltpequ(epj float64) (veq [3]float64) {
 	// Polynomial coefficients
 	const NPOL = 4
 	var xypol = [2][NPOL]float64{
@@ -25,7 +25,7 @@ func TestLtpequ(t *testing.T) {
 		fn  func(float64) [3]float64
 	}{
 		{"cgo", CgoLtpequ},
-		{"go", GoLtpequ},
+		{"go", Ltpequ},
 	}
 
 	for _, test := range tests {
@@ -52,7 +52,7 @@ func BenchmarkLtpequ(b *testing.B) {
 		fn  func(float64) [3]float64
 	}{
 		{"cgo", CgoLtpequ},
-		{"go", GoLtpequ},
+		{"go", Ltpequ},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoMoon98 Approximate geocentric position and velocity of the Moon.
+//  void iauMoon98 ( double date1, double date2, double pv[2][3] )
+func CgoMoon98(date1, date2 float64) (pv [2][3]float64) {
+	var cPv [2][3]C.double
+	C.iauMoon98(C.double(date1), C.double(date2), &cPv[0])
+	return v3dC2Go(cPv)
+}
@@ -0,0 +1,487 @@
+package sofa
+
+import "math"
+
+// moon98LR are the coefficients of the Moon longitude and distance
+// series (Meeus table 47.A): the multiples of D, M, M' and F in the
+// argument, the coefficient of the longitude sine (deg) and of the
+// distance cosine (m).
+var moon98LR = []struct {
+	nd, nem, nemp, nf int
+	coefl, coefr      float64
+}{
+	{0, 0, 1, 0, 6.288774, -20905355.0},
+	{2, 0, -1, 0, 1.274027, -3699111.0},
+	{2, 0, 0, 0, 0.658314, -2955968.0},
+	{0, 0, 2, 0, 0.213618, -569925.0},
+	{0, 1, 0, 0, -0.185116, 48888.0},
+	{0, 0, 0, 2, -0.114332, -3149.0},
+	{2, 0, -2, 0, 0.058793, 246158.0},
+	{2, -1, -1, 0, 0.057066, -152138.0},
+	{2, 0, 1, 0, 0.053322, -170733.0},
+	{2, -1, 0, 0, 0.045758, -204586.0},
+	{0, 1, -1, 0, -0.040923, -129620.0},
+	{1, 0, 0, 0, -0.034720, 108743.0},
+	{0, 1, 1, 0, -0.030383, 104755.0},
+	{2, 0, 0, -2, 0.015327, 10321.0},
+	{0, 0, 1, 2, -0.012528, 0.0},
+	{0, 0, 1, -2, 0.010980, 79661.0},
+	{4, 0, -1, 0, 0.010675, -34782.0},
+	{0, 0, 3, 0, 0.010034, -23210.0},
+	{4, 0, -2, 0, 0.008548, -21636.0},
+	{2, 1, -1, 0, -0.007888, 24208.0},
+	{2, 1, 0, 0, -0.006766, 30824.0},
+	{1, 0, -1, 0, -0.005163, -8379.0},
+	{1, 1, 0, 0, 0.004987, -16675.0},
+	{2, -1, 1, 0, 0.004036, -12831.0},
+	{2, 0, 2, 0, 0.003994, -10445.0},
+	{4, 0, 0, 0, 0.003861, -11650.0},
+	{2, 0, -3, 0, 0.003665, 14403.0},
+	{0, 1, -2, 0, -0.002689, -7003.0},
+	{2, 0, -1, 2, -0.002602, 0.0},
+	{2, -1, -2, 0, 0.002390, 10056.0},
+	{1, 0, 1, 0, -0.002348, 6322.0},
+	{2, -2, 0, 0, 0.002236, -9884.0},
+	{0, 1, 2, 0, -0.002120, 5751.0},
+	{0, 2, 0, 0, -0.002069, 0.0},
+	{2, -2, -1, 0, 0.002048, -4950.0},
+	{2, 0, 1, -2, -0.001773, 4130.0},
+	{2, 0, 0, 2, -0.001595, 0.0},
+	{4, -1, -1, 0, 0.001215, -3958.0},
+	{0, 0, 2, 2, -0.001110, 0.0},
+	{3, 0, -1, 0, -0.000892, 3258.0},
+	{2, 1, 1, 0, -0.000810, 2616.0},
+	{4, -1, -2, 0, 0.000759, -1897.0},
+	{0, 2, -1, 0, -0.000713, -2117.0},
+	{2, 2, -1, 0, -0.000700, 2354.0},
+	{2, 1, -2, 0, 0.000691, 0.0},
+	{2, -1, 0, -2, 0.000596, 0.0},
+	{4, 0, 1, 0, 0.000549, -1423.0},
+	{0, 0, 4, 0, 0.000537, -1117.0},
+	{4, -1, 0, 0, 0.000520, -1571.0},
+	{1, 0, -2, 0, -0.000487, -1739.0},
+	{2, 1, 0, -2, -0.000399, 0.0},
+	{0, 0, 2, -2, -0.000381, -4421.0},
+	{1, 1, 1, 0, 0.000351, 0.0},
+	{3, 0, -2, 0, -0.000340, 0.0},
+	{4, 0, -3, 0, 0.000330, 0.0},
+	{2, -1, 2, 0, 0.000327, 0.0},
+	{0, 2, 1, 0, -0.000323, 1165.0},
+	{1, 1, -1, 0, 0.000299, 0.0},
+	{2, 0, 3, 0, 0.000294, 0.0},
+	{2, 0, -1, -2, 0.000000, 8752.0},
+}
+
+// moon98B are the coefficients of the Moon latitude series (Meeus
+// table 47.B): the multiples of D, M, M' and F in the argument and the
+// coefficient of the latitude sine (deg).
+var moon98B = []struct {
+	nd, nem, nemp, nf int
+	coefb             float64
+}{
+	{0, 0, 0, 1, 5.128122},
+	{0, 0, 1, 1, 0.280602},
+	{0, 0, 1, -1, 0.277693},
+	{2, 0, 0, -1, 0.173237},
+	{2, 0, -1, 1, 0.055413},
+	{2, 0, -1, -1, 0.046271},
+	{2, 0, 0, 1, 0.032573},
+	{0, 0, 2, 1, 0.017198},
+	{2, 0, 1, -1, 0.009266},
+	{0, 0, 2, -1, 0.008822},
+	{2, -1, 0, -1, 0.008216},
+	{2, 0, -2, -1, 0.004324},
+	{2, 0, 1, 1, 0.004200},
+	{2, 1, 0, -1, -0.003359},
+	{2, -1, -1, 1, 0.002463},
+	{2, -1, 0, 1, 0.002211},
+	{2, -1, -1, -1, 0.002065},
+	{0, 1, -1, -1, -0.001870},
+	{4, 0, -1, -1, 0.001828},
+	{0, 1, 0, 1, -0.001794},
+	{0, 0, 0, 3, -0.001749},
+	{0, 1, -1, 1, -0.001565},
+	{1, 0, 0, 1, -0.001491},
+	{0, 1, 1, 1, -0.001475},
+	{0, 1, 1, -1, -0.001410},
+	{0, 1, 0, -1, -0.001344},
+	{1, 0, 0, -1, -0.001335},
+	{0, 0, 3, 1, 0.001107},
+	{4, 0, 0, -1, 0.001021},
+	{4, 0, -1, 1, 0.000833},
+	{0, 0, 1, -3, 0.000777},
+	{4, 0, -2, 1, 0.000671},
+	{2, 0, 0, -3, 0.000607},
+	{2, 0, 2, -1, 0.000596},
+	{2, -1, 1, -1, 0.000491},
+	{2, 0, -2, 1, -0.000451},
+	{0, 0, 3, -1, 0.000439},
+	{2, 0, 2, 1, 0.000422},
+	{2, 0, -3, -1, 0.000421},
+	{2, 1, -1, 1, -0.000366},
+	{2, 1, 0, 1, -0.000351},
+	{4, 0, 0, 1, 0.000331},
+	{2, -1, 1, 1, 0.000315},
+	{2, -2, 0, -1, 0.000302},
+	{0, 0, 1, 3, -0.000283},
+	{2, 1, 1, -1, -0.000229},
+	{1, 1, 0, -1, 0.000223},
+	{1, 1, 0, 1, 0.000223},
+	{0, 1, -2, -1, -0.000220},
+	{2, 1, -1, -1, -0.000220},
+	{1, 0, 1, 1, -0.000185},
+	{2, -1, -2, -1, 0.000181},
+	{0, 1, 2, 1, -0.000177},
+	{4, 0, -2, -1, 0.000176},
+	{4, -1, -1, -1, 0.000166},
+	{1, 0, 1, -1, -0.000164},
+	{4, 0, 1, -1, 0.000132},
+	{1, 0, -1, -1, -0.000119},
+	{4, -1, 0, -1, 0.000115},
+	{2, -2, 0, 1, 0.000107},
+}
+
+//  Moon98 This function is part of the International Astronomical
+//  Union's SOFA (Standards Of Fundamental Astronomy) software
+//  collection.
+//
+//  - - - - - - - -
+//   M o o n 9 8
+//  - - - - - - - -
+//
+//  Status:  support function.
+//
+//  Approximate geocentric position and velocity of the Moon.
+//
+//  Given:
+//     date1  double         TT date part A (Notes 1,4)
+//     date2  double         TT date part B (Notes 1,4)
+//
+//  Returned:
+//     pv     double[2][3]   Moon p,v, GCRS (au, au/d, Note 5)
+//
+//  Notes:
+//
+//  1) The TT date date1+date2 is a Julian Date, apportioned in any
+//     convenient way between the two arguments.  For example,
+//     JD(TT)=2450123.7 could be expressed in any of these ways, among
+//     others:
+//
+//            date1          date2
+//
+//         2450123.7           0.0       (JD method)
+//         2451545.0       -1421.3       (J2000 method)
+//         2400000.5       50123.2       (MJD method)
+//         2450123.5           0.2       (date & time method)
+//
+//     The JD method is the most natural and convenient to use in cases
+//     where the loss of several decimal digits of resolution is
+//     acceptable.  The J2000 method is best matched to the way the
+//     argument is handled internally and will deliver the optimum
+//     resolution.  The MJD method and the date & time methods are both
+//     good compromises between resolution and convenience.  The limited
+//     accuracy of the present algorithm is such that any of the methods
+//     is satisfactory.
+//
+//  2) This function is a full implementation of the algorithm
+//     published by Meeus (see reference) except that the light-time
+//     correction to the Moon's mean longitude has been omitted.
+//
+//  3) Comparisons with ELP/MPP02 over the interval 1950-2100 gave RMS
+//     errors of 2.9 arcsec in geocentric direction, 6.1 km in position
+//     and 36 mm/s in velocity.  The worst case errors were 18.3 arcsec
+//     in geocentric direction, 31.7 km in position and 172 mm/s in
+//     velocity.
+//
+//  4) The original algorithm is expressed in terms of "dynamical time",
+//     which can either be TDB or TT without any significant change in
+//     accuracy.  UT cannot be used without incurring significant errors
+//     (30 arcsec in the present era) due to the Moon's 0.5 arcsec/sec
+//     movement.
+//
+//  5) The result is with respect to the GCRS (the same as J2000.0 mean
+//     equator and equinox to within 23 mas).
+//
+//  6) Velocity is obtained by a complete analytical differentiation
+//     of the Meeus model.
+//
+//  7) The Meeus algorithm generates position and velocity in mean
+//     ecliptic coordinates of date, which the present function then
+//     rotates into GCRS.  Because the ecliptic system is precessing,
+//     there is a coupling between this spin (about 1.4 degrees per
+//     century) and the Moon position that causes a small velocity
+//     discrepancy.  This effect is at the level of 2 mm/s.
+//
+//  Reference:
+//
+//     Meeus, J., Astronomical Algorithms, 2nd edition, Willmann-Bell,
+//     1998, p337.
+//
+//  Called:
+//     iauS2pv      spherical coordinates to pv-vector
+//     iauPfw06     bias-precession F-W angles, IAU 2006
+//     iauIr        initialize r-matrix to identity
+//     iauRz        rotate around Z-axis
+//     iauRx        rotate around X-axis
+//     iauRxpv      product of r-matrix and pv-vector
+//
+//  This revision:  2021 April 12
+//
+//  SOFA release 2021-05-12
+//
+//  Copyright (C) 2021 IAU SOFA Board.  See notes at end.
+//
+//  Moon98 This function is part of the International Astronomical
+//  Union's SOFA (Standards Of Fundamental Astronomy) software
+//  collection.
+func Moon98(date1, date2 float64) (pv [2][3]float64) {
+
+	// Moon's mean longitude (wrt mean equinox and ecliptic of date).
+	const (
+		elp0 = 218.31665436 // Simon et al. (1994).
+		elp1 = 481267.88123421
+		elp2 = -0.0015786
+		elp3 = 1.0 / 538841.0
+		elp4 = -1.0 / 65194000.0
+	)
+
+	// Moon's mean elongation.
+	const (
+		d0 = 297.8501921
+		d1 = 445267.1114034
+		d2 = -0.0018819
+		d3 = 1.0 / 545868.0
+		d4 = 1.0 / 113065000.0
+	)
+
+	// Sun's mean anomaly.
+	const (
+		em0 = 357.5291092
+		em1 = 35999.0502909
+		em2 = -0.0001536
+		em3 = 1.0 / 24490000.0
+		em4 = 0.0
+	)
+
+	// Moon's mean anomaly.
+	const (
+		emp0 = 134.9633964
+		emp1 = 477198.8675055
+		emp2 = 0.0087414
+		emp3 = 1.0 / 69699.0
+		emp4 = -1.0 / 14712000.0
+	)
+
+	// Mean distance of the Moon from its ascending node.
+	const (
+		f0 = 93.2720950
+		f1 = 483202.0175233
+		f2 = -0.0036539
+		f3 = 1.0 / 3526000.0
+		f4 = 1.0 / 863310000.0
+	)
+
+	// Meeus A_1, due to Venus (deg).
+	const (
+		a10 = 119.75
+		a11 = 131.849
+	)
+
+	// Meeus A_2, due to Jupiter (deg).
+	const (
+		a20 = 53.09
+		a21 = 479264.290
+	)
+
+	// Meeus A_3, due to sidereal motion of the Moon in longitude (deg).
+	const (
+		a30 = 313.45
+		a31 = 481266.484
+	)
+
+	// Coefficients for Meeus "additive terms" (deg).
+	const (
+		al1 = 0.003958
+		al2 = 0.001962
+		al3 = 0.000318
+	)
+	const (
+		ab1 = -0.002235
+		ab2 = 0.000382
+		ab3 = 0.000175
+		ab4 = 0.000175
+		ab5 = 0.000127
+		ab6 = -0.000115
+	)
+
+	// Fixed term in distance (m).
+	const r0 = 385000560.0
+
+	// Coefficients for (dimensionless) E factor.
+	const (
+		e1 = -0.002516
+		e2 = -0.0000074
+	)
+
+	var t, elp, delp, d, dd, em, dem, emp, demp, f, df, a1, da1, a2,
+		da2, a3, da3, e, de, esq, desq, elpmf, delpmf, vel, vdel, vr,
+		vdr, a1mf, da1mf, a1pf, da1pf, dlpmp, slpmp, vb, vdb, v, dv,
+		emn, empn, dn, fn, en, den, arg, darg, farg, coeff, el, del, r,
+		dr, b, db, gamb, phib, psib float64
+	var rm [3][3]float64
+
+	// Centuries since J2000.
+	t = ((date1 - DJ00) + date2) / DJC
+
+	// Fundamental arguments (radians, and radians per Julian century).
+
+	// Moon's mean longitude.
+	elp = DD2R * math.Mod(elp0+
+		(elp1+(elp2+(elp3+elp4*t)*t)*t)*t, 360.0)
+	delp = DD2R * (elp1 +
+		(elp2*2.0+(elp3*3.0+elp4*4.0*t)*t)*t)
+
+	// Moon's mean elongation.
+	d = DD2R * math.Mod(d0+(d1+(d2+(d3+d4*t)*t)*t)*t, 360.0)
+	dd = DD2R * (d1 + (d2*2.0+(d3*3.0+d4*4.0*t)*t)*t)
+
+	// Sun's mean anomaly.
+	em = DD2R * math.Mod(em0+(em1+(em2+(em3+em4*t)*t)*t)*t, 360.0)
+	dem = DD2R * (em1 + (em2*2.0+(em3*3.0+em4*4.0*t)*t)*t)
+
+	// Moon's mean anomaly.
+	emp = DD2R * math.Mod(emp0+
+		(emp1+(emp2+(emp3+emp4*t)*t)*t)*t, 360.0)
+	demp = DD2R * (emp1 +
+		(emp2*2.0+(emp3*3.0+emp4*4.0*t)*t)*t)
+
+	// Mean distance of the Moon from its ascending node.
+	f = DD2R * math.Mod(f0+(f1+(f2+(f3+f4*t)*t)*t)*t, 360.0)
+	df = DD2R * (f1 + (f2*2.0+(f3*3.0+f4*4.0*t)*t)*t)
+
+	// Meeus further arguments.
+	a1 = DD2R * (a10 + a11*t)
+	da1 = DD2R * a11
+	a2 = DD2R * (a20 + a21*t)
+	da2 = DD2R * a21
+	a3 = DD2R * (a30 + a31*t)
+	da3 = DD2R * a31
+
+	// E-factor, and square.
+	e = 1.0 + (e1+e2*t)*t
+	de = e1 + 2.0*e2*t
+	esq = e * e
+	desq = 2.0 * e * de
+
+	// Use the Meeus additive terms (deg) to start off the summations.
+	elpmf = elp - f
+	delpmf = delp - df
+	vel = al1*math.Sin(a1) + al2*math.Sin(elpmf) + al3*math.Sin(a2)
+	vdel = al1*math.Cos(a1)*da1 + al2*math.Cos(elpmf)*delpmf +
+		al3*math.Cos(a2)*da2
+
+	vr = 0.0
+	vdr = 0.0
+
+	a1mf = a1 - f
+	da1mf = da1 - df
+	a1pf = a1 + f
+	da1pf = da1 + df
+	dlpmp = elp - emp
+	slpmp = elp + emp
+	vb = ab1*math.Sin(elp) + ab2*math.Sin(a3) + ab3*math.Sin(a1mf) +
+		ab4*math.Sin(a1pf) + ab5*math.Sin(dlpmp) + ab6*math.Sin(slpmp)
+	vdb = ab1*math.Cos(elp)*delp + ab2*math.Cos(a3)*da3 +
+		ab3*math.Cos(a1mf)*da1mf + ab4*math.Cos(a1pf)*da1pf +
+		ab5*math.Cos(dlpmp)*(delp-demp) +
+		ab6*math.Cos(slpmp)*(delp+demp)
+
+	// Series expansions.
+
+	// Longitude and distance plus derivatives.
+	for n := len(moon98LR) - 1; n >= 0; n-- {
+		dn = float64(moon98LR[n].nd)
+		i := moon98LR[n].nem
+		emn = float64(i)
+		empn = float64(moon98LR[n].nemp)
+		fn = float64(moon98LR[n].nf)
+		switch i {
+		case 1, -1:
+			en = e
+			den = de
+		case 2, -2:
+			en = esq
+			den = desq
+		default:
+			en = 1.0
+			den = 0.0
+		}
+		arg = dn*d + emn*em + empn*emp + fn*f
+		darg = dn*dd + emn*dem + empn*demp + fn*df
+		farg = math.Sin(arg)
+		v = farg * en
+		dv = math.Cos(arg)*darg*en + farg*den
+		coeff = moon98LR[n].coefl
+		vel += coeff * v
+		vdel += coeff * dv
+		farg = math.Cos(arg)
+		v = farg * en
+		dv = -math.Sin(arg)*darg*en + farg*den
+		coeff = moon98LR[n].coefr
+		vr += coeff * v
+		vdr += coeff * dv
+	}
+	el = elp + DD2R*vel
+	del = (delp + DD2R*vdel) / DJC
+	r = (vr + r0) / DAU
+	dr = vdr / DAU / DJC
+
+	// Latitude plus derivative.
+	for n := len(moon98B) - 1; n >= 0; n-- {
+		dn = float64(moon98B[n].nd)
+		i := moon98B[n].nem
+		emn = float64(i)
+		empn = float64(moon98B[n].nemp)
+		fn = float64(moon98B[n].nf)
+		switch i {
+		case 1, -1:
+			en = e
+			den = de
+		case 2, -2:
+			en = esq
+			den = desq
+		default:
+			en = 1.0
+			den = 0.0
+		}
+		arg = dn*d + emn*em + empn*emp + fn*f
+		darg = dn*dd + emn*dem + empn*demp + fn*df
+		farg = math.Sin(arg)
+		v = farg * en
+		dv = math.Cos(arg)*darg*en + farg*den
+		coeff = moon98B[n].coefb
+		vb += coeff * v
+		vdb += coeff * dv
+	}
+	b = vb * DD2R
+	db = vdb * DD2R / DJC
+
+	// Transformation into final form.
+
+	// Longitude, latitude to x, y, z (au).
+	pv = S2pv(el, b, r, del, db, dr)
+
+	// IAU 2006 Fukushima-Williams bias+precession angles.
+	gamb, phib, psib, _ = Pfw06(date1, date2)
+
+	// Mean ecliptic coordinates to GCRS rotation matrix.
+	rm = Ir()
+	rm = Rz(psib, rm)
+	rm = Rx(-phib, rm)
+	rm = Rz(-gamb, rm)
+
+	// Rotate the Moon position and velocity into GCRS (Note 7).
+	pv = Rxpv(rm, pv)
+
+	return
+}
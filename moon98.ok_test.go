@@ -0,0 +1,64 @@
+package sofa
+
+import "testing"
+
+//
+//  - - - - - - - - - - -
+//   T e s t M o o n 9 8
+//  - - - - - - - - - - -
+//
+//  Test Moon98 function.
+//
+//  Called:  Moon98, vvd, viv
+//
+//  This revision:  2021 May 11
+//
+func TestMoon98(t *testing.T) {
+	const fname = "Moon98"
+	var pv [2][3]float64
+
+	tests := []struct {
+		ref string
+		fn  func(a1, a2 float64) [2][3]float64
+	}{
+		{"cgo", CgoMoon98},
+		{"go", Moon98},
+	}
+
+	for _, test := range tests {
+		tname := fname + " " + test.ref
+		pv = test.fn(2400000.5, 43999.9)
+
+		vvd(t, pv[0][0], -0.2601295959971044180e-2, 1e-11,
+			tname, "x 4")
+		vvd(t, pv[0][1], 0.6139750944302742189e-3, 1e-11,
+			tname, "y 4")
+		vvd(t, pv[0][2], 0.2640794528229828909e-3, 1e-11,
+			tname, "z 4")
+
+		vvd(t, pv[1][0], -0.1244321506649895021e-3, 1e-11,
+			tname, "xd 4")
+		vvd(t, pv[1][1], -0.5219076942678119398e-3, 1e-11,
+			tname, "yd 4")
+		vvd(t, pv[1][2], -0.1716132214378462047e-3, 1e-11,
+			tname, "zd 4")
+	}
+}
+
+func BenchmarkMoon98(b *testing.B) {
+	tests := []struct {
+		ref string
+		fn  func(a1, a2 float64) [2][3]float64
+	}{
+		{"cgo", CgoMoon98},
+		{"go", Moon98},
+	}
+
+	for _, test := range tests {
+		b.Run(test.ref, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				test.fn(2400000.5, 43999.9)
+			}
+		})
+	}
+}
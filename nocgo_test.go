@@ -0,0 +1,250 @@
+//go:build !cgo
+// +build !cgo
+
+package sofa
+
+// Without cgo there is no SOFA C library to test against, so the Cgo
+// entries of the tests run the Go functions a second time.
+var (
+	CgoA2af   = A2af
+	CgoA2tf   = A2tf
+	CgoAb     = Ab
+	CgoAe2hd  = Ae2hd
+	CgoAf2a   = Af2a
+	CgoAnp    = Anp
+	CgoAnpm   = Anpm
+	CgoApcg   = Apcg
+	CgoApcg13 = Apcg13
+	CgoApci   = Apci
+	CgoApci13 = Apci13
+	CgoApco   = Apco
+	CgoApco13 = Apco13
+	CgoApcs   = Apcs
+	CgoApcs13 = Apcs13
+	CgoAper   = Aper
+	CgoAper13 = Aper13
+	CgoApio   = Apio
+	CgoApio13 = Apio13
+	CgoAtcc13 = Atcc13
+	CgoAtccq  = Atccq
+	CgoAtci13 = Atci13
+	CgoAtciq  = Atciq
+	CgoAtciqn = Atciqn
+	CgoAtciqz = Atciqz
+	CgoAtco13 = Atco13
+	CgoAtic13 = Atic13
+	CgoAticq  = Aticq
+	CgoAticqn = Aticqn
+	CgoAtio13 = Atio13
+	CgoAtioq  = Atioq
+	CgoAtoc13 = Atoc13
+	CgoAtoi13 = Atoi13
+	CgoAtoiq  = Atoiq
+	CgoBi00   = Bi00
+	CgoBp00   = Bp00
+	CgoBp06   = Bp06
+	CgoBpn2xy = Bpn2xy
+	CgoC2i00a = C2i00a
+	CgoC2i00b = C2i00b
+	CgoC2i06a = C2i06a
+	CgoC2ibpn = C2ibpn
+	CgoC2ixy  = C2ixy
+	CgoC2ixys = C2ixys
+	CgoC2s    = C2s
+	CgoC2t00a = C2t00a
+	CgoC2t00b = C2t00b
+	CgoC2t06a = C2t06a
+	CgoC2tcio = C2tcio
+	CgoC2teqx = C2teqx
+	CgoC2tpe  = C2tpe
+	CgoC2txy  = C2txy
+	CgoCal2jd = Cal2jd
+	CgoD2dtf  = D2dtf
+	CgoD2tf   = D2tf
+	CgoDat    = Dat
+	CgoDtdb   = Dtdb
+	CgoDtf2d  = Dtf2d
+	CgoEceq06 = Eceq06
+	CgoEcm06  = Ecm06
+	CgoEe00   = Ee00
+	CgoEe00a  = Ee00a
+	CgoEe00b  = Ee00b
+	CgoEe06a  = Ee06a
+	CgoEect00 = Eect00
+	CgoEform  = Eform
+	CgoEo06a  = Eo06a
+	CgoEors   = Eors
+	CgoEpb    = Epb
+	CgoEpb2jd = Epb2jd
+	CgoEpj    = Epj
+	CgoEpj2jd = Epj2jd
+	CgoEpv00  = Epv00
+	CgoEqec06 = Eqec06
+	CgoEqeq94 = Eqeq94
+	CgoEra00  = Era00
+	CgoFad03  = Fad03
+	CgoFae03  = Fae03
+	CgoFaf03  = Faf03
+	CgoFaju03 = Faju03
+	CgoFal03  = Fal03
+	CgoFalp03 = Falp03
+	CgoFama03 = Fama03
+	CgoFame03 = Fame03
+	CgoFane03 = Fane03
+	CgoFaom03 = Faom03
+	CgoFapa03 = Fapa03
+	CgoFasa03 = Fasa03
+	CgoFaur03 = Faur03
+	CgoFave03 = Fave03
+	CgoFk425  = Fk425
+	CgoFk45z  = Fk45z
+	CgoFk524  = Fk524
+	CgoFk52h  = Fk52h
+	CgoFk54z  = Fk54z
+	CgoFk5hip = Fk5hip
+	CgoFk5hz  = Fk5hz
+	CgoFw2m   = Fw2m
+	CgoFw2xy  = Fw2xy
+	CgoG2icrs = G2icrs
+	CgoGc2gd  = Gc2gd
+	CgoGc2gde = Gc2gde
+	CgoGd2gc  = Gd2gc
+	CgoGd2gce = Gd2gce
+	CgoGmst00 = Gmst00
+	CgoGmst06 = Gmst06
+	CgoGmst82 = Gmst82
+	CgoGst00a = Gst00a
+	CgoGst00b = Gst00b
+	CgoGst06  = Gst06
+	CgoGst06a = Gst06a
+	CgoGst94  = Gst94
+	CgoH2fk5  = H2fk5
+	CgoHd2ae  = Hd2ae
+	CgoHd2pa  = Hd2pa
+	CgoHfk5z  = Hfk5z
+	CgoIcrs2g = Icrs2g
+	CgoIr     = Ir
+	CgoJd2cal = Jd2cal
+	CgoJdcalf = Jdcalf
+	CgoLd     = Ld
+	CgoLdn    = Ldn
+	CgoLdsun  = Ldsun
+	CgoLteceq = Lteceq
+	CgoLtecm  = Ltecm
+	CgoLteqec = Lteqec
+	CgoLtp    = Ltp
+	CgoLtpb   = Ltpb
+	CgoLtpecl = Ltpecl
+	CgoLtpequ = Ltpequ
+	CgoMoon98 = Moon98
+	CgoNum00a = Num00a
+	CgoNum00b = Num00b
+	CgoNum06a = Num06a
+	CgoNumat  = Numat
+	CgoNut00a = Nut00a
+	CgoNut00b = Nut00b
+	CgoNut06a = Nut06a
+	CgoNut80  = Nut80
+	CgoNutm80 = Nutm80
+	CgoObl06  = Obl06
+	CgoObl80  = Obl80
+	CgoP06e   = P06e
+	CgoP2pv   = P2pv
+	CgoP2s    = P2s
+	CgoPap    = Pap
+	CgoPas    = Pas
+	CgoPb06   = Pb06
+	CgoPdp    = Pdp
+	CgoPfw06  = Pfw06
+	CgoPlan94 = Plan94
+	CgoPm     = Pm
+	CgoPmat00 = Pmat00
+	CgoPmat06 = Pmat06
+	CgoPmat76 = Pmat76
+	CgoPmp    = Pmp
+	CgoPmpx   = Pmpx
+	CgoPmsafe = Pmsafe
+	CgoPn     = Pn
+	CgoPn00   = Pn00
+	CgoPn00a  = Pn00a
+	CgoPn00b  = Pn00b
+	CgoPn06   = Pn06
+	CgoPn06a  = Pn06a
+	CgoPnm00a = Pnm00a
+	CgoPnm00b = Pnm00b
+	CgoPnm06a = Pnm06a
+	CgoPnm80  = Pnm80
+	CgoPom00  = Pom00
+	CgoPpp    = Ppp
+	CgoPpsp   = Ppsp
+	CgoPr00   = Pr00
+	CgoPrec76 = Prec76
+	CgoPv2p   = Pv2p
+	CgoPv2s   = Pv2s
+	CgoPvdpv  = Pvdpv
+	CgoPvm    = Pvm
+	CgoPvmpv  = Pvmpv
+	CgoPvppv  = Pvppv
+	CgoPvstar = Pvstar
+	CgoPvtob  = Pvtob
+	CgoPvu    = Pvu
+	CgoPvup   = Pvup
+	CgoPvxpv  = Pvxpv
+	CgoPxp    = Pxp
+	CgoRefco  = Refco
+	CgoRm2v   = Rm2v
+	CgoRv2m   = Rv2m
+	CgoRx     = Rx
+	CgoRxp    = Rxp
+	CgoRxpv   = Rxpv
+	CgoRxr    = Rxr
+	CgoRy     = Ry
+	CgoRz     = Rz
+	CgoS00    = S00
+	CgoS00a   = S00a
+	CgoS00b   = S00b
+	CgoS06    = S06
+	CgoS06a   = S06a
+	CgoS2c    = S2c
+	CgoS2p    = S2p
+	CgoS2pv   = S2pv
+	CgoS2xpv  = S2xpv
+	CgoSepp   = Sepp
+	CgoSeps   = Seps
+	CgoSp00   = Sp00
+	CgoStarpm = Starpm
+	CgoStarpv = Starpv
+	CgoSxp    = Sxp
+	CgoSxpv   = Sxpv
+	CgoTaitt  = Taitt
+	CgoTaiut1 = Taiut1
+	CgoTaiutc = Taiutc
+	CgoTcbtdb = Tcbtdb
+	CgoTcgtt  = Tcgtt
+	CgoTdbtcb = Tdbtcb
+	CgoTdbtt  = Tdbtt
+	CgoTf2a   = Tf2a
+	CgoTf2d   = Tf2d
+	CgoTpors  = Tpors
+	CgoTporv  = Tporv
+	CgoTpsts  = Tpsts
+	CgoTpstv  = Tpstv
+	CgoTpxes  = Tpxes
+	CgoTpxev  = Tpxev
+	CgoTr     = Tr
+	CgoTrxp   = Trxp
+	CgoTrxpv  = Trxpv
+	CgoTttai  = Tttai
+	CgoTttcg  = Tttcg
+	CgoTttdb  = Tttdb
+	CgoTtut1  = Ttut1
+	CgoUt1tai = Ut1tai
+	CgoUt1tt  = Ut1tt
+	CgoUt1utc = Ut1utc
+	CgoUtctai = Utctai
+	CgoUtcut1 = Utcut1
+	CgoXy06   = Xy06
+	CgoXys00a = Xys00a
+	CgoXys00b = Xys00b
+	CgoXys06a = Xys06a
+)
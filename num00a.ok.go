@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoNum00a Form the matrix of nutation for a given date, IAU 2000A
+//  Num00a Form the matrix of nutation for a given date, IAU 2000A
 //  model.
 //
 //  - - - - - - -
@@ -65,19 +62,11 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoNum00a Form the matrix of nutation for a given date, IAU 2000A
-//  model.
-func CgoNum00a(date1, date2 float64) (rmatn [3][3]float64) {
-	var cRmatn [3][3]C.double
-	C.iauNum00a(C.double(date1), C.double(date2), &cRmatn[0])
-	return v3tC2Go(cRmatn)
-}
-
-//  GoNum00a Form the matrix of nutation for a given date, IAU 2000A
+//  Num00a Form the matrix of nutation for a given date, IAU 2000A
 //  model.
-func GoNum00a(date1, date2 float64) (rmatn [3][3]float64) {
+func Num00a(date1, date2 float64) (rmatn [3][3]float64) {
 
 	// Obtain the required matrix (discarding other results).
-	_, _, _, _, _, _, rmatn, _ = GoPn00a(date1, date2)
+	_, _, _, _, _, _, rmatn, _ = Pn00a(date1, date2)
 	return
 }
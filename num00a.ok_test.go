@@ -22,7 +22,7 @@ func TestNum00a(t *testing.T) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoNum00a},
-		{"go", GoNum00a},
+		{"go", Num00a},
 	}
 
 	for _, test := range tests {
@@ -59,7 +59,7 @@ func BenchmarkNum00a(b *testing.B) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoNum00a},
-		{"go", GoNum00a},
+		{"go", Num00a},
 	}
 
 	for _, test := range tests {
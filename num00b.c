@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauNum00b(double date1, double date2, double rmatn[3][3])
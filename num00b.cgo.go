@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoNum00b Form the matrix of nutation for a given date, IAU 2000B
+//  model.
+func CgoNum00b(date1, date2 float64) (rmatn [3][3]float64) {
+	var cRmatn [3][3]C.double
+	C.iauNum00b(C.double(date1), C.double(date2), &cRmatn[0])
+	return v3tC2Go(cRmatn)
+}
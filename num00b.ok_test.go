@@ -22,7 +22,7 @@ func TestNum00b(t *testing.T) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoNum00b},
-		{"go", GoNum00b},
+		{"go", Num00b},
 	}
 
 	for _, test := range tests {
@@ -59,7 +59,7 @@ func BenchmarkNum00b(b *testing.B) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoNum00b},
-		{"go", GoNum00b},
+		{"go", Num00b},
 	}
 
 	for _, test := range tests {
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoNum06a Form the matrix of nutation for a given date, IAU
+//  Num06a Form the matrix of nutation for a given date, IAU
 //  2006/2000A model.
 //
 //  - - - - - - -
@@ -64,27 +61,19 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoNum06a Form the matrix of nutation for a given date, IAU
-//  2006/2000A model.
-func CgoNum06a(date1, date2 float64) (rmatn [3][3]float64) {
-	var cRmatn [3][3]C.double
-	C.iauNum06a(C.double(date1), C.double(date2), &cRmatn[0])
-	return v3tC2Go(cRmatn)
-}
-
-//  GoNum06a Form the matrix of nutation for a given date, IAU
+//  Num06a Form the matrix of nutation for a given date, IAU
 //  2006/2000A model.
-func GoNum06a(date1, date2 float64) (rmatn [3][3]float64) {
+func Num06a(date1, date2 float64) (rmatn [3][3]float64) {
 
 	var eps, dp, de float64
 
 	// Mean obliquity.
-	eps = GoObl06(date1, date2)
+	eps = Obl06(date1, date2)
 
 	// Nutation components.
-	dp, de = GoNut06a(date1, date2)
+	dp, de = Nut06a(date1, date2)
 
 	// Nutation matrix.
-	rmatn = GoNumat(eps, dp, de)
+	rmatn = Numat(eps, dp, de)
 	return
 }
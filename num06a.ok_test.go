@@ -22,7 +22,7 @@ func TestNum06a(t *testing.T) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoNum06a},
-		{"go", GoNum06a},
+		{"go", Num06a},
 	}
 
 	for _, test := range tests {
@@ -59,7 +59,7 @@ func BenchmarkNum06a(b *testing.B) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoNum06a},
-		{"go", GoNum06a},
+		{"go", Num06a},
 	}
 
 	for _, test := range tests {
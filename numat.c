@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauNumat(double epsa, double dpsi, double deps, double rmatn[3][3])
@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoNumat Form the matrix of nutation.
+func CgoNumat(epsa, dpsi, deps float64) (rmatn [3][3]float64) {
+	var cRmatn [3][3]C.double
+	C.iauNumat(C.double(epsa), C.double(dpsi), C.double(deps), &cRmatn[0])
+	return v3tC2Go(cRmatn)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoNumat Form the matrix of nutation.
+//  Numat Form the matrix of nutation.
 //
 //  - - - - - -
 //   N u m a t
@@ -53,20 +50,13 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoNumat Form the matrix of nutation.
-func CgoNumat(epsa, dpsi, deps float64) (rmatn [3][3]float64) {
-	var cRmatn [3][3]C.double
-	C.iauNumat(C.double(epsa), C.double(dpsi), C.double(deps), &cRmatn[0])
-	return v3tC2Go(cRmatn)
-}
-
-//  GoNumat Form the matrix of nutation.
-func GoNumat(epsa, dpsi, deps float64) (rmatn [3][3]float64) {
+//  Numat Form the matrix of nutation.
+func Numat(epsa, dpsi, deps float64) (rmatn [3][3]float64) {
 
 	// Build the rotation matrix.
-	rmatn = GoIr()
-	rmatn = GoRx(epsa, rmatn)
-	rmatn = GoRz(-dpsi, rmatn)
-	rmatn = GoRx(-(epsa + deps), rmatn)
+	rmatn = Ir()
+	rmatn = Rx(epsa, rmatn)
+	rmatn = Rz(-dpsi, rmatn)
+	rmatn = Rx(-(epsa + deps), rmatn)
 	return
 }
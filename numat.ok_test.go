@@ -27,7 +27,7 @@ func TestNumat(t *testing.T) {
 		fn  func(a1, a2, a3 float64) [3][3]float64
 	}{
 		{"cgo", CgoNumat},
-		{"go", GoNumat},
+		{"go", Numat},
 	}
 
 	for _, test := range tests {
@@ -69,7 +69,7 @@ func BenchmarkNumat(b *testing.B) {
 		fn  func(a1, a2, a3 float64) [3][3]float64
 	}{
 		{"cgo", CgoNumat},
-		{"go", GoNumat},
+		{"go", Numat},
 	}
 
 	for _, test := range tests {
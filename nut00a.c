@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauNut00a(double date1, double date2, double *dpsi, double *deps)
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoNut00a Nutation, IAU 2000A model (MHB2000 luni-solar and
+//  planetary nutation with free core nutation omitted).
+func CgoNut00a(date1, date2 float64) (dpsi, deps float64) {
+	var cDpsi, cDeps C.double
+	C.iauNut00a(C.double(date1), C.double(date2), &cDpsi, &cDeps)
+	return float64(cDpsi), float64(cDeps)
+}
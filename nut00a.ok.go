@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoNut00a Nutation, IAU 2000A model (MHB2000 luni-solar and
+//  Nut00a Nutation, IAU 2000A model (MHB2000 luni-solar and
 //  planetary nutation with free core nutation omitted).
 //
 //  - - - - - - -
@@ -164,17 +162,9 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoNut00a Nutation, IAU 2000A model (MHB2000 luni-solar and
+//  Nut00a Nutation, IAU 2000A model (MHB2000 luni-solar and
 //  planetary nutation with free core nutation omitted).
-func CgoNut00a(date1, date2 float64) (dpsi, deps float64) {
-	var cDpsi, cDeps C.double
-	C.iauNut00a(C.double(date1), C.double(date2), &cDpsi, &cDeps)
-	return float64(cDpsi), float64(cDeps)
-}
-
-//  GoNut00a Nutation, IAU 2000A model (MHB2000 luni-solar and planetary
-//  nutation with free core nutation omitted).
-func GoNut00a(date1, date2 float64) (dpsi, deps float64) {
+func Nut00a(date1, date2 float64) (dpsi, deps float64) {
 	var i int
 	var t, el, elp, f, d, om, arg, dp, de, sarg, carg,
 		al, af, ad, aom, alme, alve, alea, alma,
@@ -1887,7 +1877,7 @@ func GoNut00a(date1, date2 float64) (dpsi, deps float64) {
 	// Fundamental (Delaunay) arguments
 
 	// Mean anomaly of the Moon (IERS 2003).
-	el = GoFal03(t)
+	el = Fal03(t)
 
 	// Mean anomaly of the Sun (MHB2000).
 	elp = math.Mod(1287104.79305+t*(129596581.0481+
@@ -1896,7 +1886,7 @@ func GoNut00a(date1, date2 float64) (dpsi, deps float64) {
 
 	// Mean longitude of the Moon minus that of the ascending node
 	// (IERS 2003.
-	f = GoFaf03(t)
+	f = Faf03(t)
 
 	// Mean elongation of the Moon from the Sun (MHB2000).
 	d = math.Mod(1072260.70369+t*(1602961601.2090+
@@ -1904,7 +1894,7 @@ func GoNut00a(date1, date2 float64) (dpsi, deps float64) {
 			t*(-0.00003169)))), TURNAS) * DAS2R
 
 	// Mean longitude of the ascending node of the Moon (IERS 2003).
-	om = GoFaom03(t)
+	om = Faom03(t)
 
 	// Initialize the nutation values.
 	dp = 0.0
@@ -1956,16 +1946,16 @@ func GoNut00a(date1, date2 float64) (dpsi, deps float64) {
 	aom = math.Mod(2.18243920-33.757045*t, D2PI)
 
 	// General accumulated precession in longitude (IERS 2003).
-	apa = GoFapa03(t)
+	apa = Fapa03(t)
 
 	// Planetary longitudes, Mercury through Uranus (IERS 2003).
-	alme = GoFame03(t)
-	alve = GoFave03(t)
-	alea = GoFae03(t)
-	alma = GoFama03(t)
-	alju = GoFaju03(t)
-	alsa = GoFasa03(t)
-	alur = GoFaur03(t)
+	alme = Fame03(t)
+	alve = Fave03(t)
+	alea = Fae03(t)
+	alma = Fama03(t)
+	alju = Faju03(t)
+	alsa = Fasa03(t)
+	alur = Faur03(t)
 
 	// Neptune longitude (MHB2000).
 	alne = math.Mod(5.321159000+3.8127774000*t, D2PI)
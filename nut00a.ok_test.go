@@ -20,7 +20,7 @@ func TestNut00a(t *testing.T) {
 		fn  func(a1, b1 float64) (c1, d1 float64)
 	}{
 		{"cgo", CgoNut00a},
-		{"go", GoNut00a},
+		{"go", Nut00a},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -39,7 +39,7 @@ func BenchmarkNut00a(b *testing.B) {
 		fn  func(a1, b1 float64) (c1, d1 float64)
 	}{
 		{"cgo", CgoNut00a},
-		{"go", GoNut00a},
+		{"go", Nut00a},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoNut00b Nutation, IAU 2000B model.
+//  Nut00b Nutation, IAU 2000B model.
 //
 //  - - - - - - -
 //   N u t 0 0 b
@@ -129,15 +127,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoNut00b Nutation, IAU 2000B model.
-func CgoNut00b(date1, date2 float64) (dpsi, deps float64) {
-	var cDpsi, cDeps C.double
-	C.iauNut00b(C.double(date1), C.double(date2), &cDpsi, &cDeps)
-	return float64(cDpsi), float64(cDeps)
-}
-
-//  GoNut00b Nutation, IAU 2000B model.
-func GoNut00b(date1, date2 float64) (dpsi, deps float64) {
+//  Nut00b Nutation, IAU 2000B model.
+func Nut00b(date1, date2 float64) (dpsi, deps float64) {
 	var t, el, elp, f, d, om, arg, dp, de, sarg, carg,
 		dpsils, depsls, dpsipl, depspl float64
 	var i int
@@ -22,7 +22,7 @@ func TestNut00b(t *testing.T) {
 		fn  func(a1, a2 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoNut00b},
-		{"go", GoNut00b},
+		{"go", Nut00b},
 	}
 
 	for _, test := range tests {
@@ -43,7 +43,7 @@ func BenchmarkNut00b(b *testing.B) {
 		fn  func(a1, a2 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoNut00b},
-		{"go", GoNut00b},
+		{"go", Nut00b},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoNut06a IAU 2000A nutation with adjustments to match the IAU 2006
+//  precession.
+func CgoNut06a(date1, date2 float64) (dpsi, deps float64) {
+	var cDpsi, cDeps C.double
+	C.iauNut06a(C.double(date1), C.double(date2), &cDpsi, &cDeps)
+	return float64(cDpsi), float64(cDeps)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoNut06a IAU 2000A nutation with adjustments to match the IAU 2006
+//  Nut06a IAU 2000A nutation with adjustments to match the IAU 2006
 //  precession.
 //
 //  - - - - - - -
@@ -84,17 +81,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoNut06a IAU 2000A nutation with adjustments to match the IAU 2006
-//  precession.
-func CgoNut06a(date1, date2 float64) (dpsi, deps float64) {
-	var cDpsi, cDeps C.double
-	C.iauNut06a(C.double(date1), C.double(date2), &cDpsi, &cDeps)
-	return float64(cDpsi), float64(cDeps)
-}
-
-//  GoNut06a IAU 2000A nutation with adjustments to match the IAU 2006
+//  Nut06a IAU 2000A nutation with adjustments to match the IAU 2006
 //  precession.
-func GoNut06a(date1, date2 float64) (dpsi, deps float64) {
+func Nut06a(date1, date2 float64) (dpsi, deps float64) {
 	var t, fj2, dp, de float64
 
 	// Interval between fundamental date J2000.0 and given date (JC).
@@ -104,7 +93,7 @@ func GoNut06a(date1, date2 float64) (dpsi, deps float64) {
 	fj2 = -2.7774e-6 * t
 
 	// Obtain IAU 2000A nutation.
-	dp, de = GoNut00a(date1, date2)
+	dp, de = Nut00a(date1, date2)
 
 	// Apply P03 adjustments (Wallace & Capitaine, 2006, Eqs.5).
 	dpsi = dp + dp*(0.4697e-6+fj2)
@@ -20,7 +20,7 @@ func TestNut06a(t *testing.T) {
 		fn  func(a1, a2 float64) (b1, b2 float64)
 	}{
 		{"cgo", CgoNut06a},
-		{"go", GoNut06a},
+		{"go", Nut06a},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -39,7 +39,7 @@ func BenchmarkNut06a(b *testing.B) {
 		fn  func(a1, a2 float64) (b1, b2 float64)
 	}{
 		{"cgo", CgoNut06a},
-		{"go", GoNut06a},
+		{"go", Nut06a},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
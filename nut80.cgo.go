@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoNut80 Nutation, IAU 1980 model.
+func CgoNut80(date1, date2 float64) (dpsi, deps float64) {
+	var cDpsi, cDeps C.double
+	C.iauNut80(C.double(date1), C.double(date2), &cDpsi, &cDeps)
+	return float64(cDpsi), float64(cDeps)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoNut80 Nutation, IAU 1980 model.
+//  Nut80 Nutation, IAU 1980 model.
 //
 //  - - - - - -
 //   N u t 8 0
@@ -61,15 +59,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoNut80 Nutation, IAU 1980 model.
-func CgoNut80(date1, date2 float64) (dpsi, deps float64) {
-	var cDpsi, cDeps C.double
-	C.iauNut80(C.double(date1), C.double(date2), &cDpsi, &cDeps)
-	return float64(cDpsi), float64(cDeps)
-}
-
-//  GoNut80 Nutation, IAU 1980 model.
-func GoNut80(date1, date2 float64) (dpsi, deps float64) {
+//  Nut80 Nutation, IAU 1980 model.
+func Nut80(date1, date2 float64) (dpsi, deps float64) {
 
 	var t, el, elp, f, d, om, dp, de, arg, s, c float64
 	var j int
@@ -232,28 +223,28 @@ func GoNut80(date1, date2 float64) (dpsi, deps float64) {
 	// ---------------------
 
 	// Mean longitude of Moon minus mean longitude of Moon's perigee.
-	el = GoAnpm(
+	el = Anpm(
 		(485866.733+(715922.633+(31.310+0.064*t)*t)*t)*
 			DAS2R + math.Mod(1325.0*t, 1.0)*D2PI)
 
 	// Mean longitude of Sun minus mean longitude of Sun's perigee.
-	elp = GoAnpm(
+	elp = Anpm(
 		(1287099.804+(1292581.224+(-0.577-0.012*t)*t)*t)*
 			DAS2R + math.Mod(99.0*t, 1.0)*D2PI)
 
 	// Mean longitude of Moon minus mean longitude of Moon's node.
-	f = GoAnpm(
+	f = Anpm(
 		(335778.877+(295263.137+(-13.257+0.011*t)*t)*t)*
 			DAS2R + math.Mod(1342.0*t, 1.0)*D2PI)
 
 	// Mean elongation of Moon from Sun.
-	d = GoAnpm(
+	d = Anpm(
 		(1072261.307+(1105601.328+(-6.891+0.019*t)*t)*t)*
 			DAS2R + math.Mod(1236.0*t, 1.0)*D2PI)
 
 	// Longitude of the mean ascending node of the lunar orbit on the
 	// ecliptic, measured from the mean equinox of date.
-	om = GoAnpm(
+	om = Anpm(
 		(450160.280+(-482890.539+(7.455+0.008*t)*t)*t)*
 			DAS2R + math.Mod(-5.0*t, 1.0)*D2PI)
 
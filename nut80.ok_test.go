@@ -22,7 +22,7 @@ func TestNut80(t *testing.T) {
 		fn  func(a1, a2 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoNut80},
-		{"go", GoNut80},
+		{"go", Nut80},
 	}
 
 	for _, test := range tests {
@@ -43,7 +43,7 @@ func BenchmarkNut80(b *testing.B) {
 		fn  func(a1, a2 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoNut80},
-		{"go", GoNut80},
+		{"go", Nut80},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoNutm80 Form the matrix of nutation for a given date, IAU 1980
+//  model.
+func CgoNutm80(date1, date2 float64) (rmatn [3][3]float64) {
+	var cRmatn [3][3]C.double
+	C.iauNutm80(C.double(date1), C.double(date2), &cRmatn[0])
+	return v3tC2Go(cRmatn)
+}
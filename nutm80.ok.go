@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoNutm80 Form the matrix of nutation for a given date, IAU 1980
+//  Nutm80 Form the matrix of nutation for a given date, IAU 1980
 //  model.
 //
 //  - - - - - - -
@@ -58,24 +55,16 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoNutm80 Form the matrix of nutation for a given date, IAU 1980
-//  model.
-func CgoNutm80(date1, date2 float64) (rmatn [3][3]float64) {
-	var cRmatn [3][3]C.double
-	C.iauNutm80(C.double(date1), C.double(date2), &cRmatn[0])
-	return v3tC2Go(cRmatn)
-}
-
-//  GoNutm80 Form the matrix of nutation for a given date, IAU 1980
+//  Nutm80 Form the matrix of nutation for a given date, IAU 1980
 //  model.
-func GoNutm80(date1, date2 float64) (rmatn [3][3]float64) {
+func Nutm80(date1, date2 float64) (rmatn [3][3]float64) {
 	var dpsi, deps, epsa float64
 
 	// Nutation components and mean obliquity.
-	dpsi, deps = GoNut80(date1, date2)
-	epsa = GoObl80(date1, date2)
+	dpsi, deps = Nut80(date1, date2)
+	epsa = Obl80(date1, date2)
 
 	// Build the rotation matrix.
-	rmatn = GoNumat(epsa, dpsi, deps)
+	rmatn = Numat(epsa, dpsi, deps)
 	return
 }
@@ -22,7 +22,7 @@ func TestNutm80(t *testing.T) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoNutm80},
-		{"go", GoNutm80},
+		{"go", Nutm80},
 	}
 
 	for _, test := range tests {
@@ -59,7 +59,7 @@ func BenchmarkNutm80(b *testing.B) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoNutm80},
-		{"go", GoNutm80},
+		{"go", Nutm80},
 	}
 
 	for _, test := range tests {
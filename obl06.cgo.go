@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoObl06 Mean obliquity of the ecliptic, IAU 2006 precession model.
+func CgoObl06(date1, date2 float64) (eps0 float64) {
+	var cF C.double
+	cF = C.iauObl06(C.double(date1), C.double(date2))
+	return float64(cF)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoObl06 Mean obliquity of the ecliptic, IAU 2006 precession model.
+//  Obl06 Mean obliquity of the ecliptic, IAU 2006 precession model.
 //
 //  - - - - - -
 //   O b l 0 6
@@ -54,15 +51,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoObl06 Mean obliquity of the ecliptic, IAU 2006 precession model.
-func CgoObl06(date1, date2 float64) (eps0 float64) {
-	var cF C.double
-	cF = C.iauObl06(C.double(date1), C.double(date2))
-	return float64(cF)
-}
-
-// GoObl06 Mean obliquity of the ecliptic, IAU 2006 precession model.
-func GoObl06(date1, date2 float64) (eps0 float64) {
+//  Obl06 Mean obliquity of the ecliptic, IAU 2006 precession model.
+func Obl06(date1, date2 float64) (eps0 float64) {
 	var t float64
 
 	// Interval between fundamental date J2000.0 and given date (JC).
@@ -20,7 +20,7 @@ func TestObl06(t *testing.T) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoObl06},
-		{"go", GoObl06},
+		{"go", Obl06},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -35,7 +35,7 @@ func BenchmarkObl06(b *testing.B) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoObl06},
-		{"go", GoObl06},
+		{"go", Obl06},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
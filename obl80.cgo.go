@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoObl80 Mean obliquity of the ecliptic, IAU 1980 model.
+func CgoObl80(date1, date2 float64) float64 {
+	var cF C.double
+	cF = C.iauObl80(C.double(date1), C.double(date2))
+	return float64(cF)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoObl80 Mean obliquity of the ecliptic, IAU 1980 model.
+//  Obl80 Mean obliquity of the ecliptic, IAU 1980 model.
 //
 //  - - - - - -
 //   O b l 8 0
@@ -56,15 +53,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoObl80 Mean obliquity of the ecliptic, IAU 1980 model.
-func CgoObl80(date1, date2 float64) float64 {
-	var cF C.double
-	cF = C.iauObl80(C.double(date1), C.double(date2))
-	return float64(cF)
-}
-
-//  GoObl80 Mean obliquity of the ecliptic, IAU 1980 model.
-func GoObl80(date1, date2 float64) float64 {
+//  Obl80 Mean obliquity of the ecliptic, IAU 1980 model.
+func Obl80(date1, date2 float64) float64 {
 	var t, eps0 float64
 
 	// Interval between fundamental epoch J2000.0 and given date (JC).
@@ -22,7 +22,7 @@ func TestObl80(t *testing.T) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoObl80},
-		{"go", GoObl80},
+		{"go", Obl80},
 	}
 
 	for _, test := range tests {
@@ -41,7 +41,7 @@ func BenchmarkObl80(b *testing.B) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoObl80},
-		{"go", GoObl80},
+		{"go", Obl80},
 	}
 
 	for _, test := range tests {
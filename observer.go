@@ -0,0 +1,202 @@
+package sofa
+
+import (
+	"sync"
+)
+
+// DefaultObserverTolerance is the interval (days) over which an
+// Observer keeps its astrometry parameters, refreshing only the Earth
+// rotation angle.  The direction of the diurnal aberration and
+// parallax, which is fixed when the parameters are built, then errs by
+// at most about 1 mas.
+const DefaultObserverTolerance = 60.0 / DAYSEC
+
+// Weather is the ambient conditions at a site, for the refraction.
+type Weather struct {
+	Pressure    float64 // hPa, zero for no refraction
+	Temperature float64 // deg C
+	Humidity    float64 // relative humidity (0-1)
+}
+
+// Observer is an observing site together with its weather, wavelength
+// and Earth orientation, including the celestial pole offsets, for the
+// transformation of many places between the ICRS and the observed
+// frame.  It builds the astrometry parameters of Apco13 only when the
+// date has moved further than its tolerance from that for which they
+// were built, and in between refreshes the Earth rotation angle with
+// Aper13.  It is safe for concurrent use.
+type Observer struct {
+	eph  Ephemeris
+	site Site
+	eop  EOPSource
+
+	mu      sync.RWMutex
+	model   PNModel
+	weather Weather
+	wl      float64
+	tol     float64
+	built   bool
+	c1, c2  float64 // UTC of the cached parameters
+	dut1    float64 // UT1-UTC at c1+c2
+	astrom  ASTROM
+	eo      float64
+	warn    error // warning of the build
+}
+
+// NewObserver returns an Observer for the site, with the solar system
+// from eph, or from DefaultEphemeris if eph is nil, and the Earth
+// orientation from eop, or zero if eop is nil.  The wavelength wl is
+// in micrometres, as for Apco13.  The tolerance is
+// DefaultObserverTolerance.
+func NewObserver(eph Ephemeris, site Site, w Weather, wl float64,
+	eop EOPSource) *Observer {
+	return &Observer{eph: eph, site: site, eop: eop, weather: w, wl: wl,
+		tol: DefaultObserverTolerance}
+}
+
+// SetTolerance sets the interval (days) within which the astrometry
+// parameters are reused.  A tolerance of zero builds them afresh for
+// every date.
+func (o *Observer) SetTolerance(days float64) {
+	o.mu.Lock()
+	o.tol = days
+	o.built = false
+	o.mu.Unlock()
+}
+
+// SetModel sets the precession-nutation model, IAU2006A if m is nil,
+// which takes effect at the next date.
+func (o *Observer) SetModel(m PNModel) {
+	o.mu.Lock()
+	o.model = m
+	o.built = false
+	o.mu.Unlock()
+}
+
+// SetWeather sets the ambient conditions, which take effect at once.
+func (o *Observer) SetWeather(w Weather) {
+	o.mu.Lock()
+	o.weather = w
+	o.refco()
+	o.mu.Unlock()
+}
+
+// SetWavelength sets the observing wavelength (micrometres), which
+// takes effect at once.
+func (o *Observer) SetWavelength(wl float64) {
+	o.mu.Lock()
+	o.wl = wl
+	o.refco()
+	o.mu.Unlock()
+}
+
+// refco recomputes the refraction constants of the cached parameters.
+// The caller holds the write lock.
+func (o *Observer) refco() {
+	o.astrom.Refa, o.astrom.Refb = Refco(o.weather.Pressure,
+		o.weather.Temperature, o.weather.Humidity, o.wl)
+}
+
+// Astrom returns the astrometry parameters of the observer at the UTC
+// utc1+utc2, for use with Atciq, Atioq and their kin, and the equation
+// of the origins (ERA-GST).  The warnings of Apco13 and of the
+// ephemeris are returned as err along with the parameters.
+func (o *Observer) Astrom(utc1, utc2 float64) (astrom ASTROM,
+	eo float64, err error) {
+	o.mu.RLock()
+	ok := o.fresh(utc1, utc2)
+	if ok {
+		astrom, eo, err = o.refresh(utc1, utc2)
+	}
+	o.mu.RUnlock()
+	if ok {
+		return
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if !o.fresh(utc1, utc2) {
+		if err = o.build(utc1, utc2); err != nil {
+			return
+		}
+	}
+	return o.refresh(utc1, utc2)
+}
+
+// fresh reports whether the cached parameters serve for utc1+utc2.
+// The caller holds the lock.
+func (o *Observer) fresh(utc1, utc2 float64) bool {
+	if !o.built {
+		return false
+	}
+	d := (utc1 - o.c1) + (utc2 - o.c2)
+	return d <= o.tol && d >= -o.tol
+}
+
+// build rebuilds the cached parameters for utc1+utc2.  The caller holds
+// the write lock.
+func (o *Observer) build(utc1, utc2 float64) error {
+	var e EOP
+	var dx, dy float64
+	var err error
+	if o.eop != nil {
+		if e, err = o.eop.EOP(utc1, utc2); err != nil {
+			return err
+		}
+		tt1, tt2 := utctt(utc1, utc2)
+		dx, dy = e.CIPOffsets(o.model, tt1, tt2)
+	}
+	w := o.weather
+	astrom, eo, err := Apco13Offsets(o.model, o.eph, utc1, utc2, e.DUT1,
+		o.site.Elong, o.site.Phi, o.site.Hm, e.Xp, e.Yp, dx, dy,
+		w.Pressure, w.Temperature, w.Humidity, o.wl, o.astrom)
+	if err != nil && !IsWarning(err) {
+		return err
+	}
+	o.astrom, o.eo, o.warn = astrom, eo, err
+	o.c1, o.c2, o.dut1 = utc1, utc2, e.DUT1
+	o.built = true
+	return nil
+}
+
+// refresh returns the cached parameters with the Earth rotation angle
+// of utc1+utc2.  The caller holds the lock.
+func (o *Observer) refresh(utc1, utc2 float64) (ASTROM, float64, error) {
+	if utc1 == o.c1 && utc2 == o.c2 {
+		return o.astrom, o.eo, o.warn
+	}
+	ut11, ut12, err := Utcut1(utc1, utc2, o.dut1)
+	if err != nil && err.Code() < 0 {
+		return o.astrom, o.eo, err
+	}
+	return Aper13(ut11, ut12, o.astrom), o.eo, o.warn
+}
+
+// ICRSToObserved transforms the ICRS place of a star to the observed
+// place at the UTC utc1+utc2, as Atco13.  The arguments and results
+// are those of Atco13;  the warnings of Astrom are returned as err
+// along with the place.
+func (o *Observer) ICRSToObserved(rc, dc, pr, pd, px, rv, utc1,
+	utc2 float64) (aob, zob, hob, dob, rob float64, err error) {
+	astrom, _, err := o.Astrom(utc1, utc2)
+	if err != nil && !IsWarning(err) {
+		return
+	}
+	ri, di := Atciq(rc, dc, pr, pd, px, rv, astrom)
+	aob, zob, hob, dob, rob = Atioq(ri, di, astrom)
+	return
+}
+
+// ObservedToICRS transforms an observed place, of the type t as for
+// Atoc13, at the UTC utc1+utc2 to the ICRS astrometric RA,Dec.  The
+// warnings of Astrom are returned as err along with the place.
+func (o *Observer) ObservedToICRS(t string, ob1, ob2, utc1,
+	utc2 float64) (rc, dc float64, err error) {
+	astrom, _, err := o.Astrom(utc1, utc2)
+	if err != nil && !IsWarning(err) {
+		return
+	}
+	ri, di := Atoiq(t, ob1, ob2, astrom)
+	rc, dc = Aticq(ri, di, astrom)
+	return
+}
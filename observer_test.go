@@ -0,0 +1,133 @@
+package sofa
+
+import (
+	"sync"
+	"testing"
+)
+
+// The site, weather and star of the Atco13 test.
+var (
+	obsSite    = Site{Elong: -0.527800806, Phi: -1.2345856, Hm: 2738.0}
+	obsWeather = Weather{Pressure: 731.0, Temperature: 12.8, Humidity: 0.59}
+)
+
+// obsEOP returns a table giving the EOP of the Atco13 test.
+func obsEOP() *EOPTable {
+	t := &EOPTable{Leap: BuiltinLeapSeconds()}
+	for mjd := 56382.0; mjd < 56387.0; mjd++ {
+		t.Records = append(t.Records, EOP{MJD: mjd, DUT1: 0.1550675,
+			Xp: 2.47230737e-7, Yp: 1.82640464e-6})
+	}
+	return t
+}
+
+func obsAtco13(utc1, utc2 float64) (aob, zob, hob, dob, rob float64) {
+	aob, zob, hob, dob, rob, _, _ = Atco13(2.71, 0.174, 1e-5, 5e-6, 0.1,
+		55.0, utc1, utc2, 0.1550675, obsSite.Elong, obsSite.Phi,
+		obsSite.Hm, 2.47230737e-7, 1.82640464e-6, 731.0, 12.8, 0.59,
+		0.55)
+	return
+}
+
+func TestObserver(t *testing.T) {
+	const fname = "Observer"
+	const utc1, utc2 = 2456384.5, 0.969254051
+	o := NewObserver(nil, obsSite, obsWeather, 0.55, obsEOP())
+
+	// At the date of the build, as Atco13;  30 s later, to 1 mas.
+	for _, dt := range []float64{0, 30.0 / DAYSEC, -30.0 / DAYSEC} {
+		aob, zob, hob, dob, rob, err := o.ICRSToObserved(2.71, 0.174,
+			1e-5, 5e-6, 0.1, 55.0, utc1, utc2+dt)
+		errT(t, nil, err, fname, "err")
+		a, z, h, d, r := obsAtco13(utc1, utc2+dt)
+		tol := 1e-12
+		if dt != 0 {
+			tol = 5e-9
+		}
+		vvd(t, aob, a, tol, fname, "aob")
+		vvd(t, zob, z, tol, fname, "zob")
+		vvd(t, hob, h, tol, fname, "hob")
+		vvd(t, dob, d, tol, fname, "dob")
+		vvd(t, rob, r, tol, fname, "rob")
+	}
+	if o.c2 != utc2 {
+		t.Errorf("%s: rebuilt within the tolerance", fname)
+	}
+
+	// Back to the ICRS, as Atoc13.
+	rc, dc, err := o.ObservedToICRS("R", 2.709956744659734087,
+		-0.01023470255688766, utc1, utc2+0.001)
+	errT(t, nil, err, fname, "ObservedToICRS err")
+	r, d, _ := Atoc13("R", 2.709956744659734087, -0.01023470255688766,
+		utc1, utc2+0.001, 0.1550675, obsSite.Elong, obsSite.Phi,
+		obsSite.Hm, 2.47230737e-7, 1.82640464e-6, 731.0, 12.8, 0.59,
+		0.55)
+	vvd(t, rc, r, 1e-12, fname, "rc")
+	vvd(t, dc, d, 1e-12, fname, "dc")
+	if o.c2 != utc2+0.001 {
+		t.Errorf("%s: not rebuilt beyond the tolerance", fname)
+	}
+
+	// With no tolerance every date is built afresh.
+	o.SetTolerance(0)
+	_, zob, _, _, _, _ := o.ICRSToObserved(2.71, 0.174, 1e-5, 5e-6, 0.1,
+		55.0, utc1, utc2+1e-5)
+	_, z, _, _, _ := obsAtco13(utc1, utc2+1e-5)
+	vvd(t, zob, z, 1e-12, fname, "no tolerance")
+
+	// The weather takes effect at once.
+	o.SetWeather(Weather{})
+	_, zob, _, _, _, _ = o.ICRSToObserved(2.71, 0.174, 1e-5, 5e-6, 0.1,
+		55.0, utc1, utc2+1e-5)
+	if zob <= z {
+		t.Errorf("%s: refraction with no atmosphere", fname)
+	}
+	astrom, eo, err := o.Astrom(utc1, utc2+1e-5)
+	errT(t, nil, err, fname, "Astrom err")
+	vvd(t, astrom.Refa, 0, 0, fname, "refa")
+	_, eo2, _ := Apco13(utc1, utc2+1e-5, 0.1550675, obsSite.Elong,
+		obsSite.Phi, obsSite.Hm, 0, 0, 0, 0, 0, 0, astrom)
+	vvd(t, eo, eo2, 1e-15, fname, "eo")
+
+	// Dates outside the EOP.
+	_, _, _, _, _, err = o.ICRSToObserved(2.71, 0.174, 0, 0, 0, 0,
+		2456400.5, 0)
+	errT(t, errEOPRange, err, fname, "EOP range")
+}
+
+func TestObserverConcurrent(t *testing.T) {
+	const fname = "Observer concurrent"
+	const utc1, utc2 = 2456384.5, 0.969254051
+	o := NewObserver(nil, obsSite, obsWeather, 0.55, obsEOP())
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				dt := float64(g*50+i) * 7.0 / DAYSEC
+				aob, zob, _, _, _, err := o.ICRSToObserved(2.71, 0.174,
+					1e-5, 5e-6, 0.1, 55.0, utc1, utc2+dt)
+				errT(t, nil, err, fname, "err")
+				a, z, _, _, _ := obsAtco13(utc1, utc2+dt)
+				vvd(t, aob, a, 5e-9, fname, "aob")
+				vvd(t, zob, z, 5e-9, fname, "zob")
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func BenchmarkObserver(b *testing.B) {
+	o := NewObserver(nil, obsSite, obsWeather, 0.55, nil)
+	for i := 0; i < b.N; i++ {
+		o.ICRSToObserved(2.71, 0.174, 1e-5, 5e-6, 0.1, 55.0, 2456384.5,
+			0.969254051+float64(i)*1e-8)
+	}
+}
+
+func BenchmarkObserverAtco13(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		obsAtco13(2456384.5, 0.969254051+float64(i)*1e-8)
+	}
+}
@@ -0,0 +1,225 @@
+package sofa
+
+import (
+	"fmt"
+	"math"
+)
+
+// The celestial pole offsets are the observed departures of the CIP
+// from a precession-nutation model, as published by the IERS:  dX,dY
+// in the CIP X,Y, with respect to IAU 2006/2000A, or dpsi,deps in the
+// nutation, with respect to IAU 1976/1980 for the equinox based route.
+// The functions here take them as dX,dY with respect to the model in
+// use;  Dpde2dxdy converts dpsi,deps to them, and EOP.CIPOffsets
+// derives them from the values of an EOP source.
+
+// dxdyTerms returns the coefficients that relate the offsets dX,dY to
+// dpsi,deps at the TT date date1+date2:  psia cos eps0 - chia, and sin
+// epsa, from the IAU 2006 precession.
+func dxdyTerms(date1, date2 float64) (a, se float64) {
+	eps0, psia, _, _, _, _, _, epsa, chia, _, _, _, _, _, _, _ :=
+		P06e(date1, date2)
+	return psia*math.Cos(eps0) - chia, math.Sin(epsa)
+}
+
+// Dpde2dxdy converts the nutation offsets dpsi,deps (radians) at the
+// TT date date1+date2 to the CIP offsets dX,dY (radians), by the
+// relations of the IERS Conventions (2010), section 5.5.4:
+//
+//	dX = dpsi sin epsa + (psia cos eps0 - chia) deps
+//	dY = deps - (psia cos eps0 - chia) dpsi sin epsa
+//
+// The conversion is geometric:  both sets of offsets refer to the same
+// model.
+func Dpde2dxdy(date1, date2, dpsi, deps float64) (dx, dy float64) {
+	a, se := dxdyTerms(date1, date2)
+	dx = dpsi*se + a*deps
+	dy = deps - a*dpsi*se
+	return
+}
+
+// Dxdy2dpde is the inverse of Dpde2dxdy.
+func Dxdy2dpde(date1, date2, dx, dy float64) (dpsi, deps float64) {
+	a, se := dxdyTerms(date1, date2)
+	d := 1.0 + a*a
+	dpsi = (dx - a*dy) / (se * d)
+	deps = (a*dx + dy) / d
+	return
+}
+
+// pnObliquity returns the mean obliquity of date of m at the TT date
+// date1+date2:  Obl80 for IAU1980, Obl80 with the precession-rate
+// correction of Pr00 for IAU2000A and IAU2000B, as Pn00 has it, and
+// Obl06 otherwise.
+func pnObliquity(m PNModel, date1, date2 float64) float64 {
+	switch m.(type) {
+	case IAU1980:
+		return Obl80(date1, date2)
+	case IAU2000A, IAU2000B:
+		_, depspr := Pr00(date1, date2)
+		return Obl80(date1, date2) + depspr
+	}
+	return Obl06(date1, date2)
+}
+
+// pnSp returns the TIO locator s' of m at the TT date date1+date2:
+// zero for IAU1980 and IAU2000B, whose C2t neglect it, as C2t00b does,
+// and Sp00 otherwise.
+func pnSp(m PNModel, date1, date2 float64) float64 {
+	switch m.(type) {
+	case IAU1980, IAU2000B:
+		return 0
+	}
+	return Sp00(date1, date2)
+}
+
+// cipOffsets applies the offsets dx,dy at the TT date date1+date2 to
+// the CIP x,y and the bias-precession-nutation matrix rnpb of m, of
+// which it is the pole.  The matrix is corrected by the equivalent
+// nutation offsets about the mean equator of m, for the equation of
+// the origins.
+func cipOffsets(m PNModel, date1, date2 float64, rnpb [3][3]float64, x,
+	y, dx, dy float64) ([3][3]float64, float64, float64) {
+	if dx == 0 && dy == 0 {
+		return rnpb, x, y
+	}
+	dp, de := Dxdy2dpde(date1, date2, dx, dy)
+	rnpb = Rxr(Numat(pnObliquity(m, date1, date2), dp, de), rnpb)
+	return rnpb, x + dx, y + dy
+}
+
+// CIPOffsets returns the celestial pole offsets of e as dX,dY with
+// respect to the model m, or IAU2006A if m is nil, at the TT date
+// date1+date2.  Unless m is the model to which the offsets refer,
+// IAU2006A for dX,dY and IAU1980 for dpsi,deps, they are taken through
+// that model to the observed pole, less the pole of m.  A source that
+// gives no offsets gives zero.
+func (e EOP) CIPOffsets(m PNModel, date1, date2 float64) (dx, dy float64) {
+	if e.Flags&EOPNoOffsets != 0 {
+		return 0, 0
+	}
+	m = pnModel(m)
+	_, m06 := m.(IAU2006A)
+	_, m80 := m.(IAU1980)
+	switch {
+	case e.Offsets == OffsetsDXDY && m06:
+		return e.DX, e.DY
+	case e.Offsets == OffsetsDpsiDeps && m80:
+		return Dpde2dxdy(date1, date2, e.DX, e.DY)
+	}
+
+	// The observed pole, from the model of the offsets.
+	var x, y float64
+	if e.Offsets == OffsetsDpsiDeps {
+		x, y = Bpn2xy(Rxr(Numat(Obl80(date1, date2), e.DX, e.DY),
+			Pnm80(date1, date2)))
+	} else {
+		x, y = Bpn2xy(Pnm06a(date1, date2))
+		x, y = x+e.DX, y+e.DY
+	}
+	xm, ym := Bpn2xy(m.Bpn(date1, date2))
+	return x - xm, y - ym
+}
+
+// Apci13Offsets is Apci13Model with the celestial pole offsets dx,dy
+// (radians) with respect to m.
+func Apci13Offsets(m PNModel, eph Ephemeris, date1, date2, dx,
+	dy float64, astrom ASTROM) (ASTROM, float64, error) {
+	ehpv, ebpv, err := ephemeris(eph).Epv(date1, date2)
+	var warn error
+	if err = ephemerisErr(err, &warn); err != nil {
+		return astrom, 0, fmt.Errorf("Apci13: %w", err)
+	}
+	astrom, eo := apci13(pnModel(m), date1, date2, dx, dy, ehpv, ebpv,
+		astrom)
+	return astrom, eo, warn
+}
+
+// Apco13Offsets is Apco13Model with the celestial pole offsets dx,dy
+// (radians) with respect to m.
+func Apco13Offsets(m PNModel, eph Ephemeris, utc1, utc2, dut1, elong,
+	phi, hm, xp, yp, dx, dy, phpa, tc, rh, wl float64, astr ASTROM) (
+	astrom ASTROM, eo float64, err error) {
+	astrom, eo, jerr, eerr := apco13(pnModel(m), ephemeris(eph), utc1,
+		utc2, dut1, elong, phi, hm, xp, yp, dx, dy, phpa, tc, rh, wl,
+		astr)
+	if jerr != nil && jerr.Code() < 0 {
+		return astrom, eo, jerr
+	}
+	var warn error
+	if eerr = ephemerisErr(eerr, &warn); eerr != nil {
+		return astr, 0, fmt.Errorf("Apco13: %w", eerr)
+	}
+	if jerr != nil {
+		return astrom, eo, jerr
+	}
+	return astrom, eo, warn
+}
+
+// Apco13EOP is Apco13Offsets with UT1-UTC, the polar motion and the
+// celestial pole offsets from eop, at the UTC utc1+utc2.
+func Apco13EOP(m PNModel, eph Ephemeris, eop EOPSource, utc1, utc2,
+	elong, phi, hm, phpa, tc, rh, wl float64, astr ASTROM) (
+	astrom ASTROM, eo float64, err error) {
+	e, err := eop.EOP(utc1, utc2)
+	if err != nil {
+		return astr, 0, err
+	}
+	tt1, tt2 := utctt(utc1, utc2)
+	dx, dy := e.CIPOffsets(m, tt1, tt2)
+	return Apco13Offsets(m, eph, utc1, utc2, e.DUT1, elong, phi, hm, e.Xp,
+		e.Yp, dx, dy, phpa, tc, rh, wl, astr)
+}
+
+// C2tOffsets is C2tModel with the celestial pole offsets dx,dy
+// (radians) with respect to m.  For IAU1980 they are applied as
+// dpsi,deps, in the equinox based route of IAU1980.C2t;  for the other
+// models they are added to the CIP X,Y of Bpn, in the CIO based route,
+// with the TIO locator s' of the model.
+func C2tOffsets(m PNModel, tta, ttb, uta, utb, xp, yp, dx,
+	dy float64) [3][3]float64 {
+	m = pnModel(m)
+	if dx == 0 && dy == 0 {
+		return m.C2t(tta, ttb, uta, utb, xp, yp)
+	}
+	if _, old := m.(IAU1980); old {
+		dp, de := Dxdy2dpde(tta, ttb, dx, dy)
+		epsa := Obl80(tta, ttb)
+		rbpn := Rxr(Numat(epsa, dp, de), Pnm80(tta, ttb))
+		gst := Anp(Gst94(uta, utb) + dp*math.Cos(epsa))
+		return C2teqx(rbpn, gst, Pom00(xp, yp, 0))
+	}
+	x, y := Bpn2xy(m.Bpn(tta, ttb))
+	x, y = x+dx, y+dy
+	s := m.S(tta, ttb, x, y)
+	return C2tcio(C2ixys(x, y, s), Era00(uta, utb),
+		Pom00(xp, yp, pnSp(m, tta, ttb)))
+}
+
+// C2tEOP is C2tOffsets with UT1, the polar motion and the celestial
+// pole offsets from eop, at the UTC utc1+utc2.
+func C2tEOP(m PNModel, eop EOPSource, utc1, utc2 float64) (
+	[3][3]float64, error) {
+	e, err := eop.EOP(utc1, utc2)
+	if err != nil {
+		return [3][3]float64{}, err
+	}
+	uta, utb, errn := Utcut1(utc1, utc2, e.DUT1)
+	if errn != nil && errn.Code() < 0 {
+		return [3][3]float64{}, errn
+	}
+	tta, ttb := utctt(utc1, utc2)
+	dx, dy := e.CIPOffsets(m, tta, ttb)
+	return C2tOffsets(m, tta, ttb, uta, utb, e.Xp, e.Yp, dx, dy), nil
+}
+
+// utctt returns the TT of the UTC utc1+utc2, or the UTC itself should
+// it be invalid, which is close enough for the pole offsets.
+func utctt(utc1, utc2 float64) (tt1, tt2 float64) {
+	tai1, tai2, err := Utctai(utc1, utc2)
+	if err != nil && err.Code() < 0 {
+		return utc1, utc2
+	}
+	tt1, tt2, _ = Taitt(tai1, tai2)
+	return
+}
@@ -0,0 +1,175 @@
+package sofa
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDpde2dxdy(t *testing.T) {
+	const fname = "Dpde2dxdy"
+	const date1, date2 = 2400000.5, 58849.0
+	const dpsi, deps = -0.25e-3 * DAS2R, 0.1e-3 * DAS2R
+
+	// At J2000.0 there is no precession.
+	dx, dy := Dpde2dxdy(DJ00, 0, dpsi, deps)
+	vvd(t, dx, dpsi*math.Sin(Obl06(DJ00, 0)), 1e-20, fname, "J2000 dx")
+	vvd(t, dy, deps, 1e-20, fname, "J2000 dy")
+
+	// The offsets move the pole of the nutation matrix so, but for the
+	// nutation itself, which the relations neglect.
+	dx, dy = Dpde2dxdy(date1, date2, dpsi, deps)
+	r := Pnm06a(date1, date2)
+	x, y := Bpn2xy(r)
+	xo, yo := Bpn2xy(Rxr(Numat(Obl06(date1, date2), dpsi, deps), r))
+	vvd(t, dx, xo-x, 1e-13, fname, "dx")
+	vvd(t, dy, yo-y, 1e-13, fname, "dy")
+
+	dp, de := Dxdy2dpde(date1, date2, dx, dy)
+	vvd(t, dp, dpsi, 1e-22, fname, "dpsi")
+	vvd(t, de, deps, 1e-22, fname, "deps")
+}
+
+func TestCIPOffsets(t *testing.T) {
+	const fname = "CIPOffsets"
+	const tt1, tt2 = 2400000.5, 58849.0
+	const dx, dy = 0.15e-3 * DAS2R, -0.08e-3 * DAS2R
+
+	e := EOP{DX: dx, DY: dy}
+	x, y := e.CIPOffsets(nil, tt1, tt2)
+	vvd(t, x, dx, 0, fname, "dX")
+	vvd(t, y, dy, 0, fname, "dY")
+	e.Flags = EOPNoOffsets
+	x, _ = e.CIPOffsets(nil, tt1, tt2)
+	vvd(t, x, 0, 0, fname, "none")
+
+	// dX,dY through the IAU 1980 model, as dpsi,deps, and back.  The
+	// offsets from IAU 1980 are tens of mas, of which the relations of
+	// Dxdy2dpde neglect a part in 1e4.
+	e.Flags = 0
+	x80, y80 := e.CIPOffsets(IAU1980{}, tt1, tt2)
+	if math.Abs(x80) < 10e-3*DAS2R {
+		t.Errorf("%s: IAU 1980 dX %g arcsec", fname, x80/DAS2R)
+	}
+	dp, de := Dxdy2dpde(tt1, tt2, x80, y80)
+	e80 := EOP{DX: dp, DY: de, Offsets: OffsetsDpsiDeps}
+	x, y = e80.CIPOffsets(IAU1980{}, tt1, tt2)
+	vvd(t, x, x80, 1e-18, fname, "IAU1980 dX")
+	vvd(t, y, y80, 1e-18, fname, "IAU1980 dY")
+	x, y = e80.CIPOffsets(IAU2006A{}, tt1, tt2)
+	vvd(t, x, dx, 5e-11, fname, "round trip dX")
+	vvd(t, y, dy, 5e-11, fname, "round trip dY")
+
+	// The other models reach the same observed pole as IAU2006A.
+	x06, y06 := Bpn2xy(Pnm06a(tt1, tt2))
+	for _, m := range []PNModel{IAU2000A{}, IAU2000B{}} {
+		x, y = e.CIPOffsets(m, tt1, tt2)
+		xm, ym := Bpn2xy(m.Bpn(tt1, tt2))
+		vvd(t, xm+x, x06+dx, 1e-18, fname, "observed X")
+		vvd(t, ym+y, y06+dy, 1e-18, fname, "observed Y")
+	}
+
+	// The obliquity of each model, for the offsets as dpsi,deps.
+	epsa, _, _, _, _, _ := Pn00(tt1, tt2, 0, 0)
+	vvd(t, pnObliquity(IAU2000B{}, tt1, tt2), epsa, 0, fname,
+		"IAU2000B epsa")
+	vvd(t, pnObliquity(IAU1980{}, tt1, tt2), Obl80(tt1, tt2), 0, fname,
+		"IAU1980 epsa")
+	vvd(t, pnObliquity(IAU2006A{}, tt1, tt2), Obl06(tt1, tt2), 0, fname,
+		"IAU2006A epsa")
+}
+
+func TestC2tOffsets(t *testing.T) {
+	const fname = "C2tOffsets"
+	const tta, ttb, uta, utb = 2400000.5, 53736.0, 2400000.5, 53736.0
+	const xp, yp = 2.55060238e-7, 1.860359247e-6
+	const dx, dy = 0.15e-3 * DAS2R, -0.08e-3 * DAS2R
+
+	// The CIO based route, as the SOFA cookbook.
+	x, y, _ := Xys06a(tta, ttb)
+	x, y = x+dx, y+dy
+	want := C2tcio(C2ixys(x, y, S06(tta, ttb, x, y)), Era00(uta, utb),
+		Pom00(xp, yp, Sp00(tta, ttb)))
+	got := C2tOffsets(nil, tta, ttb, uta, utb, xp, yp, dx, dy)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			vvd(t, got[i][j], want[i][j], 0, fname, "IAU2006A")
+		}
+	}
+
+	// The equinox based route, with the offsets added to the nutation.
+	dp, de := Nut80(tta, ttb)
+	ddp, dde := 0.3e-3*DAS2R, -0.2e-3*DAS2R
+	epsa := Obl80(tta, ttb)
+	rbpn := Rxr(Numat(epsa, dp+ddp, de+dde), Pmat76(tta, ttb))
+	gst := Anp(Gmst82(uta, utb) + Eqeq94(tta, ttb) + ddp*math.Cos(epsa))
+	want = C2teqx(rbpn, gst, Pom00(xp, yp, 0))
+	x, y = Dpde2dxdy(tta, ttb, ddp, dde)
+	got = C2tOffsets(IAU1980{}, tta, ttb, uta, utb, xp, yp, x, y)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			vvd(t, got[i][j], want[i][j], 1e-12, fname, "IAU1980")
+		}
+	}
+
+	// IAU2000B, which neglects s'.
+	x, y = Bpn2xy(Pnm00b(tta, ttb))
+	x, y = x+dx, y+dy
+	want = C2tcio(C2ixys(x, y, S00(tta, ttb, x, y)), Era00(uta, utb),
+		Pom00(xp, yp, 0))
+	got = C2tOffsets(IAU2000B{}, tta, ttb, uta, utb, xp, yp, dx, dy)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			vvd(t, got[i][j], want[i][j], 0, fname, "IAU2000B")
+		}
+	}
+
+	// No offsets.
+	got = C2tOffsets(IAU2000B{}, tta, ttb, uta, utb, xp, yp, 0, 0)
+	want = C2t00b(tta, ttb, uta, utb, xp, yp)
+	if got != want {
+		t.Errorf("%s: no offsets differs from C2t00b", fname)
+	}
+}
+
+func TestOffsetsEOP(t *testing.T) {
+	const fname = "Offsets EOP"
+	const utc1, utc2 = 2456384.5, 0.969254051
+	const dx, dy = 0.15e-3 * DAS2R, -0.08e-3 * DAS2R
+	eop := obsEOP()
+	for i := range eop.Records {
+		eop.Records[i].DX, eop.Records[i].DY = dx, dy
+	}
+	e, _ := eop.EOP(utc1, utc2)
+	tt1, tt2 := utctt(utc1, utc2)
+	ut1, ut2, _ := Utcut1(utc1, utc2, e.DUT1)
+
+	got, err := C2tEOP(nil, eop, utc1, utc2)
+	errT(t, nil, err, fname, "C2tEOP err")
+	want := C2tOffsets(nil, tt1, tt2, ut1, ut2, e.Xp, e.Yp, e.DX, e.DY)
+	if got != want {
+		t.Errorf("%s: C2tEOP differs from C2tOffsets", fname)
+	}
+	_, err = C2tEOP(nil, eop, utc1+30, utc2)
+	errT(t, errEOPRange, err, fname, "C2tEOP range")
+
+	// The offsets shift the CIRS place by their size, and the equation
+	// of the origins by the equivalent nutation in longitude.
+	astr, eo, err := Apco13EOP(nil, nil, eop, utc1, utc2, -0.527800806,
+		-1.2345856, 2738.0, 0, 0, 0, 0, ASTROM{})
+	errT(t, nil, err, fname, "Apco13EOP err")
+	astr0, eo0, _ := Apco13(utc1, utc2, e.DUT1, -0.527800806, -1.2345856,
+		2738.0, e.Xp, e.Yp, 0, 0, 0, 0, ASTROM{})
+	ri, di := Atciqz(2.71, 0.174, astr)
+	ri0, di0 := Atciqz(2.71, 0.174, astr0)
+	s := Seps(ri, di, ri0, di0)
+	if s < 0.05e-3*DAS2R || s > 0.2e-3*DAS2R {
+		t.Errorf("%s: offsets move the place %g arcsec", fname, s/DAS2R)
+	}
+	dp, _ := Dxdy2dpde(tt1, tt2, dx, dy)
+	vvd(t, eo-eo0, -dp*math.Cos(Obl06(tt1, tt2)), 1e-13, fname, "eo")
+
+	// The Observer takes them from its EOP.
+	o := NewObserver(nil, obsSite, Weather{}, 0.55, eop)
+	oa, _, _ := o.Astrom(utc1, utc2)
+	vvd(t, oa.Bpn[2][0], astr.Bpn[2][0], 1e-15, fname, "Observer")
+}
@@ -0,0 +1,25 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoP06e Precession angles, IAU 2006, equinox based.
+func CgoP06e(date1, date2 float64) (
+	eps0, psia, oma, bpa, bqa, pia, bpia, epsa, chia, za, zetaa,
+	thetaa, pa, gam, phi, psi float64) {
+	var cEps0, cPsia, cOma, cBpa, cBqa, cPia, cBpia, cEpsa, cChia,
+		cZa, cZetaa, cThetaa, cPa, cGam, cPhi, cPsi C.double
+	C.iauP06e(C.double(date1), C.double(date2),
+		&cEps0, &cPsia, &cOma, &cBpa, &cBqa, &cPia, &cBpia,
+		&cEpsa, &cChia, &cZa, &cZetaa, &cThetaa, &cPa, &cGam,
+		&cPhi, &cPsi)
+	return float64(cEps0), float64(cPsia), float64(cOma),
+		float64(cBpa), float64(cBqa), float64(cPia),
+		float64(cBpia), float64(cEpsa), float64(cChia),
+		float64(cZa), float64(cZetaa), float64(cThetaa),
+		float64(cPa), float64(cGam), float64(cPhi),
+		float64(cPsi)
+}
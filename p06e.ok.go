@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoP06e Precession angles, IAU 2006, equinox based.
+//  P06e Precession angles, IAU 2006, equinox based.
 //
 //  - - - - -
 //   P 0 6 e
@@ -134,26 +131,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoP06e Precession angles, IAU 2006, equinox based.
-func CgoP06e(date1, date2 float64) (
-	eps0, psia, oma, bpa, bqa, pia, bpia, epsa, chia, za, zetaa,
-	thetaa, pa, gam, phi, psi float64) {
-	var cEps0, cPsia, cOma, cBpa, cBqa, cPia, cBpia, cEpsa, cChia,
-		cZa, cZetaa, cThetaa, cPa, cGam, cPhi, cPsi C.double
-	C.iauP06e(C.double(date1), C.double(date2),
-		&cEps0, &cPsia, &cOma, &cBpa, &cBqa, &cPia, &cBpia,
-		&cEpsa, &cChia, &cZa, &cZetaa, &cThetaa, &cPa, &cGam,
-		&cPhi, &cPsi)
-	return float64(cEps0), float64(cPsia), float64(cOma),
-		float64(cBpa), float64(cBqa), float64(cPia),
-		float64(cBpia), float64(cEpsa), float64(cChia),
-		float64(cZa), float64(cZetaa), float64(cThetaa),
-		float64(cPa), float64(cGam), float64(cPhi),
-		float64(cPsi)
-}
-
-//  GoP06e Precession angles, IAU 2006, equinox based.
-func GoP06e(date1, date2 float64) (
+//  P06e Precession angles, IAU 2006, equinox based.
+func P06e(date1, date2 float64) (
 	eps0, psia, oma, bpa, bqa, pia, bpia, epsa, chia, za, zetaa,
 	thetaa, pa, gam, phi, psi float64) {
 	var t float64
@@ -210,7 +189,7 @@ func GoP06e(date1, date2 float64) (
 
 	// Mean obliquity of the ecliptic.
 
-	epsa = GoObl06(date1, date2)
+	epsa = Obl06(date1, date2)
 
 	// Planetary precession.
 
@@ -25,7 +25,7 @@ func TestP06e(t *testing.T) {
 			c9, c10, c11, c12, c13, c14, c15, c16 float64)
 	}{
 		{"cgo", CgoP06e},
-		{"go", GoP06e},
+		{"go", P06e},
 	}
 
 	for _, test := range tests {
@@ -77,7 +77,7 @@ func BenchmarkP06e(b *testing.B) {
 			c9, c10, c11, c12, c13, c14, c15, c16 float64)
 	}{
 		{"cgo", CgoP06e},
-		{"go", GoP06e},
+		{"go", P06e},
 	}
 
 	for _, test := range tests {
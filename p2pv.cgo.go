@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoP2pv Extend a p-vector to a pv-vector by appending a zero
+//  velocity.
+func CgoP2pv(p [3]float64) (pv [2][3]float64) {
+	var cPv [2][3]C.double
+	cP := v3sGo2C(p)
+	C.iauP2pv(&cP[0], &cPv[0])
+	return v3dC2Go(cPv)
+}
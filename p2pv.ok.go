@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoP2pv Extend a p-vector to a pv-vector by appending a zero
+//  P2pv Extend a p-vector to a pv-vector by appending a zero
 //  velocity.
 //
 //  - - - - -
@@ -31,18 +28,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoP2pv Extend a p-vector to a pv-vector by appending a zero
-//  velocity.
-func CgoP2pv(p [3]float64) (pv [2][3]float64) {
-	var cPv [2][3]C.double
-	cP := v3sGo2C(p)
-	C.iauP2pv(&cP[0], &cPv[0])
-	return v3dC2Go(cPv)
-}
-
-//  GoP2pv Extend a p-vector to a pv-vector by appending a zero
+//  P2pv Extend a p-vector to a pv-vector by appending a zero
 //  velocity.
-func GoP2pv(p [3]float64) (pv [2][3]float64) {
+func P2pv(p [3]float64) (pv [2][3]float64) {
 
 	pv[0] = p
 	return
@@ -36,7 +36,7 @@ func TestP2pv(t *testing.T) {
 		fn  func([3]float64) [2][3]float64
 	}{
 		{"cgo", CgoP2pv},
-		{"go", GoP2pv},
+		{"go", P2pv},
 	}
 
 	for _, test := range tests {
@@ -66,7 +66,7 @@ func BenchmarkP2pv(b *testing.B) {
 		fn  func([3]float64) [2][3]float64
 	}{
 		{"cgo", CgoP2pv},
-		{"go", GoP2pv},
+		{"go", P2pv},
 	}
 
 	for _, test := range tests {
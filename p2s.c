@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauP2s(double p[3], double *theta, double *phi, double *r)
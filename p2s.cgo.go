@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoP2s P-vector to spherical polar coordinates.
+func CgoP2s(p [3]float64) (theta, phi, r float64) {
+	var cTheta, cPhi, cR C.double
+	cP := v3sGo2C(p)
+	C.iauP2s(&cP[0], &cTheta, &cPhi, &cR)
+	return float64(cTheta), float64(cPhi), float64(cR)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoP2s P-vector to spherical polar coordinates.
+//  P2s P-vector to spherical polar coordinates.
 //
 //  - - - -
 //   P 2 s
@@ -38,18 +35,10 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoP2s P-vector to spherical polar coordinates.
-func CgoP2s(p [3]float64) (theta, phi, r float64) {
-	var cTheta, cPhi, cR C.double
-	cP := v3sGo2C(p)
-	C.iauP2s(&cP[0], &cTheta, &cPhi, &cR)
-	return float64(cTheta), float64(cPhi), float64(cR)
-}
-
-//  GoP2s P-vector to spherical polar coordinates.
-func GoP2s(p [3]float64) (theta, phi, r float64) {
+//  P2s P-vector to spherical polar coordinates.
+func P2s(p [3]float64) (theta, phi, r float64) {
 
-	theta, phi = GoC2s(p)
-	r = GoPm(p)
+	theta, phi = C2s(p)
+	r = Pm(p)
 	return
 }
@@ -27,7 +27,7 @@ func TestP2s(t *testing.T) {
 		fn  func(p [3]float64) (c1, c2, c3 float64)
 	}{
 		{"cgo", CgoP2s},
-		{"go", GoP2s},
+		{"go", P2s},
 	}
 
 	for _, test := range tests {
@@ -53,7 +53,7 @@ func BenchmarkP2s(b *testing.B) {
 		fn  func(p [3]float64) (c1, c2, c3 float64)
 	}{
 		{"cgo", CgoP2s},
-		{"go", GoP2s},
+		{"go", P2s},
 	}
 
 	for _, test := range tests {
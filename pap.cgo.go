@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPap Position-angle from two p-vectors.
+func CgoPap(a, b [3]float64) (pa float64) {
+	var cPa C.double
+	cA := v3sGo2C(a)
+	cB := v3sGo2C(b)
+	cPa = C.iauPap(&cA[0], &cB[0])
+	return float64(cPa)
+}
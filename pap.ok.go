@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoPap Position-angle from two p-vectors.
+//  Pap Position-angle from two p-vectors.
 //
 //  - - - -
 //   P a p
@@ -50,25 +48,16 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPap Position-angle from two p-vectors.
-func CgoPap(a, b [3]float64) (pa float64) {
-	var cPa C.double
-	cA := v3sGo2C(a)
-	cB := v3sGo2C(b)
-	cPa = C.iauPap(&cA[0], &cB[0])
-	return float64(cPa)
-}
-
-//  GoPap Position-angle from two p-vectors.
-func GoPap(a, b [3]float64) (pa float64) {
+//  Pap Position-angle from two p-vectors.
+func Pap(a, b [3]float64) (pa float64) {
 	var am, bm, st, ct, xa, ya, za float64
 	var au, eta, xi, a2b [3]float64
 
 	// Modulus and direction of the a vector.
-	am, au = GoPn(a)
+	am, au = Pn(a)
 
 	// Modulus of the b vector.
-	bm = GoPm(b)
+	bm = Pm(b)
 
 	// Deal with the case of a null vector.
 	if (am == 0.0) || (bm == 0.0) {
@@ -85,14 +74,14 @@ func GoPap(a, b [3]float64) (pa float64) {
 		eta[2] = xa*xa + ya*ya
 
 		// The "east" axis tangential from a (same length).
-		xi = GoPxp(eta, au)
+		xi = Pxp(eta, au)
 
 		// The vector from a to b.
-		a2b = GoPmp(b, a)
+		a2b = Pmp(b, a)
 
 		// Resolve into components along the north and east axes.
-		st = GoPdp(a2b, xi)
-		ct = GoPdp(a2b, eta)
+		st = Pdp(a2b, xi)
+		ct = Pdp(a2b, eta)
 
 		// Deal with degenerate cases.
 		if (st == 0.0) && (ct == 0.0) {
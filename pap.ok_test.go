@@ -31,7 +31,7 @@ func TestPap(t *testing.T) {
 		fn  func(a1, a2 [3]float64) float64
 	}{
 		{"cgo", CgoPap},
-		{"go", GoPap},
+		{"go", Pap},
 	}
 
 	for _, test := range tests {
@@ -58,7 +58,7 @@ func BenchmarkPap(bm *testing.B) {
 		fn  func(a1, a2 [3]float64) float64
 	}{
 		{"cgo", CgoPap},
-		{"go", GoPap},
+		{"go", Pap},
 	}
 
 	for _, test := range tests {
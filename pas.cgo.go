@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPas Position-angle from spherical coordinates.
+func CgoPas(al, ap, bl, bp float64) (pa float64) {
+	var cPa C.double
+	cPa = C.iauPas(C.double(al), C.double(ap), C.double(bl), C.double(bp))
+	return float64(cPa)
+}
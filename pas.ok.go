@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoPas Position-angle from spherical coordinates.
+//  Pas Position-angle from spherical coordinates.
 //
 //  - - - -
 //   P a s
@@ -39,15 +37,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPas Position-angle from spherical coordinates.
-func CgoPas(al, ap, bl, bp float64) (pa float64) {
-	var cPa C.double
-	cPa = C.iauPas(C.double(al), C.double(ap), C.double(bl), C.double(bp))
-	return float64(cPa)
-}
-
-//  GoPas Position-angle from spherical coordinates.
-func GoPas(al, ap, bl, bp float64) (pa float64) {
+//  Pas Position-angle from spherical coordinates.
+func Pas(al, ap, bl, bp float64) (pa float64) {
 
 	var dl, x, y float64
 
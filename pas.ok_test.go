@@ -27,7 +27,7 @@ func TestPas(t *testing.T) {
 		fn  func(a1, a2, a3, a4 float64) float64
 	}{
 		{"cgo", CgoPas},
-		{"go", GoPas},
+		{"go", Pas},
 	}
 
 	for _, test := range tests {
@@ -52,7 +52,7 @@ func BenchmarkPas(b *testing.B) {
 		fn  func(a1, a2, a3, a4 float64) float64
 	}{
 		{"cgo", CgoPas},
-		{"go", GoPas},
+		{"go", Pas},
 	}
 
 	for _, test := range tests {
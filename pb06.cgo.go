@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPb06 This function forms three Euler angles which implement
+//  general precession from epoch J2000.0, using the IAU 2006 model.
+//  Frame bias (the offset between ICRS and mean J2000.0) is included.
+func CgoPb06(date1, date2 float64) (bzeta, bz, btheta float64) {
+	var cBzeta, cBz, cBtheta C.double
+	C.iauPb06(C.double(date1), C.double(date2), &cBzeta, &cBz, &cBtheta)
+	return float64(cBzeta), float64(cBz), float64(cBtheta)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoPb06 This function forms three Euler angles which implement
+//  Pb06 This function forms three Euler angles which implement
 //  general precession from epoch J2000.0, using the IAU 2006 model.
 //  Frame bias (the offset between ICRS and mean J2000.0) is included.
 //
@@ -75,24 +73,15 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPb06 This function forms three Euler angles which implement
+//  Pb06 This function forms three Euler angles which implement
 //  general precession from epoch J2000.0, using the IAU 2006 model.
 //  Frame bias (the offset between ICRS and mean J2000.0) is included.
-func CgoPb06(date1, date2 float64) (bzeta, bz, btheta float64) {
-	var cBzeta, cBz, cBtheta C.double
-	C.iauPb06(C.double(date1), C.double(date2), &cBzeta, &cBz, &cBtheta)
-	return float64(cBzeta), float64(cBz), float64(cBtheta)
-}
-
-//  GoPb06 This function forms three Euler angles which implement
-//  general precession from epoch J2000.0, using the IAU 2006 model.
-//  Frame bias (the offset between ICRS and mean J2000.0) is included.
-func GoPb06(date1, date2 float64) (bzeta, bz, btheta float64) {
+func Pb06(date1, date2 float64) (bzeta, bz, btheta float64) {
 	var r [3][3]float64
 	var y, x float64
 
 	// Precession matrix via Fukushima-Williams angles.
-	r = GoPmat06(date1, date2)
+	r = Pmat06(date1, date2)
 
 	// Solve for z, choosing the +/- pi alternative.
 	y = r[1][2]
@@ -106,7 +95,7 @@ func GoPb06(date1, date2 float64) (bzeta, bz, btheta float64) {
 	}
 
 	// Derotate it out of the matrix.
-	r = GoRz(bz, r)
+	r = Rz(bz, r)
 
 	// Solve for the remaining two angles.
 	y = r[0][2]
@@ -22,7 +22,7 @@ func TestPb06(t *testing.T) {
 		fn  func(a1,a2 float64) (c1,c2,c3 float64)
 	}{
 		{"cgo", CgoPb06},
-		{"go", GoPb06},
+		{"go", Pb06},
 	}
 
 	for _, test := range tests {
@@ -45,7 +45,7 @@ func BenchmarkPb06(b *testing.B) {
 		fn  func(a1,a2 float64) (c1,c2,c3 float64)
 	}{
 		{"cgo", CgoPb06},
-		{"go", GoPb06},
+		{"go", Pb06},
 	}
 
 	for _, test := range tests {
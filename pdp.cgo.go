@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPdp p-vector inner (=scalar=dot) product.
+func CgoPdp(a, b [3]float64) float64 {
+	var cF C.double
+	var cA, cB [3]C.double
+	cA = v3sGo2C(a)
+	cB = v3sGo2C(b)
+	cF = C.iauPdp(&cA[0], &cB[0])
+	return float64(cF)
+}
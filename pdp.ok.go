@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPdp p-vector inner (=scalar=dot) product.
+//  Pdp p-vector inner (=scalar=dot) product.
 //
 //  - - - -
 //   P d p
@@ -27,17 +24,7 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPdp p-vector inner (=scalar=dot) product.
-func CgoPdp(a, b [3]float64) float64 {
-	var cF C.double
-	var cA, cB [3]C.double
-	cA = v3sGo2C(a)
-	cB = v3sGo2C(b)
-	cF = C.iauPdp(&cA[0], &cB[0])
-	return float64(cF)
-}
-
-// GoPdp p-vector inner (=scalar=dot) product.
-func GoPdp(a, b [3]float64) float64 {
+//  Pdp p-vector inner (=scalar=dot) product.
+func Pdp(a, b [3]float64) float64 {
 	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
 }
@@ -30,7 +30,7 @@ func TestPdp(t *testing.T) {
 		fn  func(a, b [3]float64) float64
 	}{
 		{"cgo", CgoPdp},
-		{"go", GoPdp},
+		{"go", Pdp},
 	}
 
 	for _, test := range tests {
@@ -56,7 +56,7 @@ func BenchmarkPdp(b *testing.B) {
 		fn  func(c, d [3]float64) float64
 	}{
 		{"cgo", CgoPdp},
-		{"go", GoPdp},
+		{"go", Pdp},
 	}
 
 	for _, test := range tests {
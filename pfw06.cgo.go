@@ -0,0 +1,18 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPfw06 Precession angles, IAU 2006 (Fukushima-Williams 4-angle
+//  formulation).
+// void iauPfw06(double date1, double date2,
+//               double *gamb, double *phib, double *psib, double *epsa)
+func CgoPfw06(date1, date2 float64) (gamb, phib, psib, epsa float64) {
+	var cF1, cF2, cF3, cF4 C.double
+	C.iauPfw06(C.double(date1), C.double(date2),
+		&cF1, &cF2, &cF3, &cF4)
+	return float64(cF1), float64(cF2), float64(cF3), float64(cF4)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPfw06 Precession angles, IAU 2006 (Fukushima-Williams 4-angle
+//  Pfw06 Precession angles, IAU 2006 (Fukushima-Williams 4-angle
 //  formulation).
 //
 //  - - - - - -
@@ -85,18 +82,11 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPfw06 Precession angles, IAU 2006 (Fukushima-Williams 4-angle
+//  Pfw06 Precession angles, IAU 2006 (Fukushima-Williams 4-angle
 //  formulation).
 // void iauPfw06(double date1, double date2,
 //               double *gamb, double *phib, double *psib, double *epsa)
-func CgoPfw06(date1, date2 float64) (gamb, phib, psib, epsa float64) {
-	var cF1, cF2, cF3, cF4 C.double
-	C.iauPfw06(C.double(date1), C.double(date2),
-		&cF1, &cF2, &cF3, &cF4)
-	return float64(cF1), float64(cF2), float64(cF3), float64(cF4)
-}
-
-func GoPfw06(date1, date2 float64) (gamb, phib, psib, epsa float64) {
+func Pfw06(date1, date2 float64) (gamb, phib, psib, epsa float64) {
 	var t float64
 
 	// Interval between fundamental date J2000.0 and given date
@@ -119,7 +109,7 @@ func GoPfw06(date1, date2 float64) (gamb, phib, psib, epsa float64) {
 			(-0.00018522+(-0.000026452+
 				(-0.0000000148)*
 					t)*t)*t)*t)*t) * DAS2R
-	epsa = GoObl06(date1, date2)
+	epsa = Obl06(date1, date2)
 
 	return
 }
@@ -20,7 +20,7 @@ func TestPfw06(t *testing.T) {
 		fn  func(a1, a2 float64) (b1, b2, b3, b4 float64)
 	}{
 		{"cgo", CgoPfw06},
-		{"go", GoPfw06},
+		{"go", Pfw06},
 	}
 
 	for _, test := range tests {
@@ -45,7 +45,7 @@ func BenchmarkPfw06(b *testing.B) {
 		fn  func(a1, a2 float64) (b1, b2, b3, b4 float64)
 	}{
 		{"cgo", CgoPfw06},
-		{"go", GoPfw06},
+		{"go", Pfw06},
 	}
 
 	for _, test := range tests {
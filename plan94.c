@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauPlan94(double date1, double date2, int np, double pv[2][3])
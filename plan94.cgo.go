@@ -0,0 +1,23 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoPlan94 This function is part of the International Astronomical
+//  Union's SOFA (Standards Of Fundamental Astronomy) software
+//  collection.
+func CgoPlan94(date1, date2 float64, np int) (
+	pv [2][3]float64, err en.ErrNum) {
+
+	var cPv [2][3]C.double
+	cI := C.iauPlan94(C.double(date1), C.double(date2),
+		C.int(np), &cPv[0])
+	if n := int(cI); n != 0 {
+		err = errPlan94.Set(n)
+	}
+	return v3dC2Go(cPv), err
+}
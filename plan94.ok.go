@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -15,7 +13,7 @@ var errPlan94 = en.New(1, "Plan96", []string{
 	"warning: failed to converge",
 })
 
-//  CgoPlan96 This function is part of the International Astronomical
+//  Plan94 This function is part of the International Astronomical
 //  Union's SOFA (Standards Of Fundamental Astronomy) software
 //  collection.
 //
@@ -182,25 +180,10 @@ var errPlan94 = en.New(1, "Plan96", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPlan94 This function is part of the International Astronomical
+//  Plan94 This function is part of the International Astronomical
 //  Union's SOFA (Standards Of Fundamental Astronomy) software
 //  collection.
-func CgoPlan94(date1, date2 float64, np int) (
-	pv [2][3]float64, err en.ErrNum) {
-
-	var cPv [2][3]C.double
-	cI := C.iauPlan94(C.double(date1), C.double(date2),
-		C.int(np), &cPv[0])
-	if n := int(cI); n != 0 {
-		err = errPlan94.Set(n)
-	}
-	return v3dC2Go(cPv), err
-}
-
-//  GoPlan94 This function is part of the International Astronomical
-//  Union's SOFA (Standards Of Fundamental Astronomy) software
-//  collection.
-func GoPlan94(date1, date2 float64, np int) (
+func Plan94(date1, date2 float64, np int) (
 	pv [2][3]float64, err en.ErrNum) {
 
 	// Gaussian constant
@@ -418,13 +401,13 @@ func GoPlan94(date1, date2 float64, np int) (
 		de = e[np][0] +
 			(e[np][1]+
 				e[np][2]*t)*t
-		dp = GoAnpm((3600.0*pi[np][0] +
+		dp = Anpm((3600.0*pi[np][0] +
 			(pi[np][1]+
 				pi[np][2]*t)*t) * DAS2R)
 		di = (3600.0*dinc[np][0] +
 			(dinc[np][1]+
 				dinc[np][2]*t)*t) * DAS2R
-		dom = GoAnpm((3600.0*omega[np][0] +
+		dom = Anpm((3600.0*omega[np][0] +
 			(omega[np][1]+
 				omega[np][2]*t)*t) * DAS2R)
 
@@ -28,7 +28,7 @@ func TestPlan94(t *testing.T) {
 			[2][3]float64, en.ErrNum)
 	}{
 		{"cgo", CgoPlan94},
-		{"go", GoPlan94},
+		{"go", Plan94},
 	}
 
 	for _, test := range tests {
@@ -94,7 +94,7 @@ func BenchmarkPlan94(b *testing.B) {
 			[2][3]float64, en.ErrNum)
 	}{
 		{"cgo", CgoPlan94},
-		{"go", GoPlan94},
+		{"go", Plan94},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPm Modulus of p-vector.
+func CgoPm(p [3]float64) float64 {
+	var cP [3]C.double
+	var cF C.double
+	cP = v3sGo2C(p)
+	cF = C.iauPm(&cP[0])
+	return float64(cF)
+}
@@ -24,7 +24,7 @@ func TestPm(t *testing.T) {
 		fn  func([3]float64) float64
 	}{
 		{"cgo", CgoPm},
-		{"go", GoPm},
+		{"go", Pm},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -43,7 +43,7 @@ func BenchmarkPm(b *testing.B) {
 		fn  func([3]float64) float64
 	}{
 		{"cgo", CgoPm},
-		{"go", GoPm},
+		{"go", Pm},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPmat00 Precession matrix (including frame bias) from GCRS to a
+//  specified date, IAU 2000 model.
+func CgoPmat00(date1, date2 float64) (rbp [3][3]float64) {
+	var cFbp [3][3]C.double
+	C.iauPmat00(C.double(date1), C.double(date2), &cFbp[0])
+	return v3tC2Go(cFbp)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPmat00 Precession matrix (including frame bias) from GCRS to a
+//  Pmat00 Precession matrix (including frame bias) from GCRS to a
 //  specified date, IAU 2000 model.
 //
 //  - - - - - - -
@@ -62,19 +59,11 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPmat00 Precession matrix (including frame bias) from GCRS to a
-//  specified date, IAU 2000 model.
-func CgoPmat00(date1, date2 float64) (rbp [3][3]float64) {
-	var cFbp [3][3]C.double
-	C.iauPmat00(C.double(date1), C.double(date2), &cFbp[0])
-	return v3tC2Go(cFbp)
-}
-
-//  GoPmat00 Precession matrix (including frame bias) from GCRS to a
+//  Pmat00 Precession matrix (including frame bias) from GCRS to a
 //  specified date, IAU 2000 model.
-func GoPmat00(date1, date2 float64) (rbp [3][3]float64) {
+func Pmat00(date1, date2 float64) (rbp [3][3]float64) {
 
 	// Obtain the required matrix (discarding others).
-	_, _, rbp = GoBp00(date1, date2)
+	_, _, rbp = Bp00(date1, date2)
 	return
 }
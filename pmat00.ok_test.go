@@ -22,7 +22,7 @@ func TestPmat00(t *testing.T) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoPmat00},
-		{"go", GoPmat00},
+		{"go", Pmat00},
 	}
 
 	for _, test := range tests {
@@ -59,7 +59,7 @@ func BenchmarkPmat00(b *testing.B) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoPmat00},
-		{"go", GoPmat00},
+		{"go", Pmat00},
 	}
 
 	for _, test := range tests {
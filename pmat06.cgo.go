@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPmat06 Precession matrix (including frame bias) from GCRS to a
+//  specified date, IAU 2006 model.
+func CgoPmat06(date1, date2 float64) (rbp [3][3]float64) {
+	var cRbp [3][3]C.double
+	C.iauPmat06(C.double(date1), C.double(date2), &cRbp[0])
+	return v3tC2Go(cRbp)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPmat06 Precession matrix (including frame bias) from GCRS to a
+//  Pmat06 Precession matrix (including frame bias) from GCRS to a
 //  specified date, IAU 2006 model.
 //
 //  - - - - - - -
@@ -63,23 +60,15 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPmat06 Precession matrix (including frame bias) from GCRS to a
-//  specified date, IAU 2006 model.
-func CgoPmat06(date1, date2 float64) (rbp [3][3]float64) {
-	var cRbp [3][3]C.double
-	C.iauPmat06(C.double(date1), C.double(date2), &cRbp[0])
-	return v3tC2Go(cRbp)
-}
-
-//  GoPmat06 Precession matrix (including frame bias) from GCRS to a
+//  Pmat06 Precession matrix (including frame bias) from GCRS to a
 //  specified date, IAU 2006 model.
-func GoPmat06(date1, date2 float64) (rbp [3][3]float64) {
+func Pmat06(date1, date2 float64) (rbp [3][3]float64) {
 	var gamb, phib, psib, epsa float64
 
 	// Bias-precession Fukushima-Williams angles.
-	gamb, phib, psib, epsa = GoPfw06(date1, date2)
+	gamb, phib, psib, epsa = Pfw06(date1, date2)
 
 	// Form the matrix.
-	rbp = GoFw2m(gamb, phib, psib, epsa)
+	rbp = Fw2m(gamb, phib, psib, epsa)
 	return
 }
@@ -22,7 +22,7 @@ func TestPmat06(t *testing.T) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoPmat06},
-		{"go", GoPmat06},
+		{"go", Pmat06},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -57,7 +57,7 @@ func BenchmarkPmat06(b *testing.B) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoPmat06},
-		{"go", GoPmat06},
+		{"go", Pmat06},
 	}
 
 	for _, test := range tests {
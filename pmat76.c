@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauPmat76(double date1, double date2, double rmatp[3][3])
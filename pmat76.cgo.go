@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPmat76 Precession matrix from J2000.0 to a specified date, IAU
+//  1976 model.
+func CgoPmat76(date1, date2 float64) (rmatp [3][3]float64) {
+	var cRmatp [3][3]C.double
+	C.iauPmat76(C.double(date1), C.double(date2), &cRmatp[0])
+	return v3tC2Go(cRmatp)
+}
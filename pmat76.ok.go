@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPmat76 Precession matrix from J2000.0 to a specified date, IAU
+//  Pmat76 Precession matrix from J2000.0 to a specified date, IAU
 //  1976 model.
 //
 //  - - - - - - -
@@ -79,27 +76,19 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPmat76 Precession matrix from J2000.0 to a specified date, IAU
-//  1976 model.
-func CgoPmat76(date1, date2 float64) (rmatp [3][3]float64) {
-	var cRmatp [3][3]C.double
-	C.iauPmat76(C.double(date1), C.double(date2), &cRmatp[0])
-	return v3tC2Go(cRmatp)
-}
-
-//  GoPmat76 Precession matrix from J2000.0 to a specified date, IAU
+//  Pmat76 Precession matrix from J2000.0 to a specified date, IAU
 //  1976 model.
-func GoPmat76(date1, date2 float64) (rmatp [3][3]float64) {
+func Pmat76(date1, date2 float64) (rmatp [3][3]float64) {
 
 	var zeta, z, theta float64
 
 	// Precession Euler angles, J2000.0 to specified date.
-	zeta, z, theta = GoPrec76(DJ00, 0.0, date1, date2)
+	zeta, z, theta = Prec76(DJ00, 0.0, date1, date2)
 
 	// Form the rotation matrix.
-	rmatp = GoIr()
-	rmatp = GoRz(-zeta, rmatp)
-	rmatp = GoRy(theta, rmatp)
-	rmatp = GoRz(-z, rmatp)
+	rmatp = Ir()
+	rmatp = Rz(-zeta, rmatp)
+	rmatp = Ry(theta, rmatp)
+	rmatp = Rz(-z, rmatp)
 	return
 }
@@ -22,7 +22,7 @@ func TestPmat76(t *testing.T) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoPmat76},
-		{"go", GoPmat76},
+		{"go", Pmat76},
 	}
 
 	for _, test := range tests {
@@ -59,7 +59,7 @@ func BenchmarkPmat76(b *testing.B) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoPmat76},
-		{"go", GoPmat76},
+		{"go", Pmat76},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPmp P-vector subtraction.
+func CgoPmp(a, b [3]float64) (amb [3]float64) {
+	var cAmb [3]C.double
+	cA, cB := v3sGo2C(a), v3sGo2C(b)
+	C.iauPmp(&cA[0], &cB[0], &cAmb[0])
+	return v3sC2Go(cAmb)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPmp P-vector subtraction.
+//  Pmp P-vector subtraction.
 //
 //  - - - -
 //   P m p
@@ -31,16 +28,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPmp P-vector subtraction.
-func CgoPmp(a, b [3]float64) (amb [3]float64) {
-	var cAmb [3]C.double
-	cA, cB := v3sGo2C(a), v3sGo2C(b)
-	C.iauPmp(&cA[0], &cB[0], &cAmb[0])
-	return v3sC2Go(cAmb)
-}
-
-//  GoPmp P-vector subtraction.
-func GoPmp(a, b [3]float64) (amb [3]float64) {
+//  Pmp P-vector subtraction.
+func Pmp(a, b [3]float64) (amb [3]float64) {
 
 	amb[0] = a[0] - b[0]
 	amb[1] = a[1] - b[1]
@@ -30,7 +30,7 @@ func TestPmp(t *testing.T) {
 		fn  func(a1, a2 [3]float64) [3]float64
 	}{
 		{"cgo", CgoPmp},
-		{"go", GoPmp},
+		{"go", Pmp},
 	}
 
 	for _, test := range tests {
@@ -59,7 +59,7 @@ func BenchmarkPmp(b *testing.B) {
 		fn  func(a1, a2 [3]float64) [3]float64
 	}{
 		{"cgo", CgoPmp},
-		{"go", GoPmp},
+		{"go", Pmp},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,20 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPmpx Proper motion and parallax.
+func CgoPmpx(rc, dc, pr, pd, px, rv, pmt float64,
+	pob [3]float64) (pco [3]float64) {
+
+	var cPco [3]C.double
+	cPob := v3sGo2C(pob)
+
+	C.iauPmpx(C.double(rc), C.double(dc), C.double(pr),
+		C.double(pd), C.double(px), C.double(rv), C.double(pmt),
+		&cPob[0], &cPco[0])
+	return v3sC2Go(cPco)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoPmpx Proper motion and parallax.
+//  Pmpx Proper motion and parallax.
 //
 //  - - - - -
 //   P m p x
@@ -59,21 +57,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPmpx Proper motion and parallax.
-func CgoPmpx(rc, dc, pr, pd, px, rv, pmt float64,
-	pob [3]float64) (pco [3]float64) {
-
-	var cPco [3]C.double
-	cPob := v3sGo2C(pob)
-
-	C.iauPmpx(C.double(rc), C.double(dc), C.double(pr),
-		C.double(pd), C.double(px), C.double(rv), C.double(pmt),
-		&cPob[0], &cPco[0])
-	return v3sC2Go(cPco)
-}
-
-//  GoPmpx Proper motion and parallax.
-func GoPmpx(rc, dc, pr, pd, px, rv, pmt float64,
+//  Pmpx Proper motion and parallax.
+func Pmpx(rc, dc, pr, pd, px, rv, pmt float64,
 	pob [3]float64) (pco [3]float64) {
 
 	// Km/s to au/year
@@ -98,7 +83,7 @@ func GoPmpx(rc, dc, pr, pd, px, rv, pmt float64,
 	p[2] = z
 
 	// Proper motion time interval (y) including Roemer effect.
-	dt = pmt + GoPdp(p, pob)*AULTY
+	dt = pmt + Pdp(p, pob)*AULTY
 
 	// Space motion (radians per year).
 	pxr = px * DAS2R
@@ -112,7 +97,7 @@ func GoPmpx(rc, dc, pr, pd, px, rv, pmt float64,
 	for i := 0; i < 3; i++ {
 		p[i] += dt*pm[i] - pxr*pob[i]
 	}
-	_, pco = GoPn(p)
+	_, pco = Pn(p)
 
 	return
 }
@@ -35,7 +35,7 @@ func TestPmpx(t *testing.T) {
 			a8 [3]float64) [3]float64
 	}{
 		{"cgo", CgoPmpx},
-		{"go", GoPmpx},
+		{"go", Pmpx},
 	}
 
 	for _, test := range tests {
@@ -72,7 +72,7 @@ func BenchmarkPmpx(b *testing.B) {
 			a8 [3]float64) [3]float64
 	}{
 		{"cgo", CgoPmpx},
-		{"go", GoPmpx},
+		{"go", Pmpx},
 	}
 
 	for _, test := range tests {
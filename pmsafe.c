@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauPmsafe(double ra1, double dec1, double pmr1, double pmd1,
@@ -0,0 +1,28 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import (
+	"github.com/8i8/sofa/en"
+)
+
+//  CgoPmsafe Star proper motion:  update star catalog data for space
+//  motion, with special handling to handle the zero parallax case.
+func CgoPmsafe(ra1, dec1, pmr1, pmd1, px1,
+	rv1, ep1a, ep1b, ep2a, ep2b float64) (
+	ra2, dec2, pmr2, pmd2, px2, rv2 float64, err en.ErrNum) {
+	var cRa2, cDec2, cPmr2, cPmd2, cPx2, cRv2 C.double
+	cI := C.iauPmsafe(C.double(ra1), C.double(dec1), C.double(pmr1),
+		C.double(pmd1), C.double(px1), C.double(rv1),
+		C.double(ep1a), C.double(ep1b), C.double(ep2a),
+		C.double(ep2b), &cRa2, &cDec2, &cPmr2, &cPmd2, &cPx2,
+		&cRv2)
+	if n := int(cI); n != 0 {
+		err = errPmsafe.Set(n)
+	}
+	return float64(cRa2), float64(cDec2), float64(cPmr2),
+		float64(cPmd2), float64(cPx2), float64(cRv2), err
+}
@@ -1,8 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
 import "github.com/8i8/sofa/en"
 
 var errPmsafe = en.New(1, "Pmsafe", []string{
@@ -14,7 +11,7 @@ var errPmsafe = en.New(1, "Pmsafe", []string{
 	"binary logical OR of the above warnings",
 })
 
-//  CgoPmsafe Star proper motion:  update star catalog data for space
+//  Pmsafe Star proper motion:  update star catalog data for space
 //  motion, with special handling to handle the zero parallax case.
 //
 //  - - - - - - -
@@ -124,27 +121,9 @@ var errPmsafe = en.New(1, "Pmsafe", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPmsafe Star proper motion:  update star catalog data for space
+//  Pmsafe Star proper motion:  update star catalog data for space
 //  motion, with special handling to handle the zero parallax case.
-func CgoPmsafe(ra1, dec1, pmr1, pmd1, px1,
-	rv1, ep1a, ep1b, ep2a, ep2b float64) (
-	ra2, dec2, pmr2, pmd2, px2, rv2 float64, err en.ErrNum) {
-	var cRa2, cDec2, cPmr2, cPmd2, cPx2, cRv2 C.double
-	cI := C.iauPmsafe(C.double(ra1), C.double(dec1), C.double(pmr1),
-		C.double(pmd1), C.double(px1), C.double(rv1),
-		C.double(ep1a), C.double(ep1b), C.double(ep2a),
-		C.double(ep2b), &cRa2, &cDec2, &cPmr2, &cPmd2, &cPx2,
-		&cRv2)
-	if n := int(cI); n != 0 {
-		err = errPmsafe.Set(n)
-	}
-	return float64(cRa2), float64(cDec2), float64(cPmr2),
-		float64(cPmd2), float64(cPx2), float64(cRv2), err
-}
-
-//  GoPmsafe Star proper motion:  update star catalog data for space
-//  motion, with special handling to handle the zero parallax case.
-func GoPmsafe(ra1, dec1, pmr1, pmd1, px1,
+func Pmsafe(ra1, dec1, pmr1, pmd1, px1,
 	rv1, ep1a, ep1b, ep2a, ep2b float64) (
 	ra2, dec2, pmr2, pmd2, px2, rv2 float64, err en.ErrNum) {
 	// Minimum allowed parallax (arcsec)
@@ -153,40 +132,40 @@ func GoPmsafe(ra1, dec1, pmr1, pmd1, px1,
 	// Factor giving maximum allowed transverse speed of about 1% c
 	const F = 326.0
 
-	//var jpx, j int
-	var err1 en.ErrNum
+	var jpx, j int
 	var pm, px1a float64
 
 	// Proper motion in one year (radians).
-	pm = GoSeps(ra1, dec1, ra1+pmr1, dec1+pmd1)
+	pm = Seps(ra1, dec1, ra1+pmr1, dec1+pmd1)
 
 	// Override the parallax to reduce the chances of a warning
 	// status.
-	//jpx = 0
 	px1a = px1
 	pm *= F
 	if px1a < pm {
-		//jpx = 1
-		err = errPmsafe.Set(1)
+		jpx = 1
 		px1a = pm
 	}
 	if px1a < PXMIN {
-		//jpx = 1
-		err = errPmsafe.Set(1)
+		jpx = 1
 		px1a = PXMIN
 	}
 
 	// Carry out the transformation using the modified parallax.
-	ra2, dec2, pmr2, pmd2, px2, rv2, err = GoStarpm(
+	ra2, dec2, pmr2, pmd2, px2, rv2, err = Starpm(
 		ra1, dec1, pmr1, pmd1, px1a, rv1,
 		ep1a, ep1b, ep2a, ep2b)
+	if err != nil {
+		j = err.Code()
+	}
 
 	// Revise and return the status.
-	if err != nil && (err.Is()%2) == 0 {
-		if err1 != nil {
-			err = err.Add(err, err1.Is())
-			//j += jpx
-		}
+	if j%2 == 0 {
+		j += jpx
+	}
+	err = nil
+	if j != 0 {
+		err = errPmsafe.Set(j)
 	}
 	return
 }
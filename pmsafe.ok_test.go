@@ -41,7 +41,7 @@ func TestPmsafe(t *testing.T) {
 			c1, c2, c3, c4, c5, c6 float64, c7 en.ErrNum)
 	}{
 		{"cgo", CgoPmsafe},
-		{"go", GoPmsafe},
+		{"go", Pmsafe},
 	}
 
 	for _, test := range tests {
@@ -64,6 +64,10 @@ func TestPmsafe(t *testing.T) {
 		vvd(t, rv2, 10.38468380293920069, 1e-10,
 			tname, "rv2")
 		errT(t, nil, err, tname, "err")
+
+		_, _, _, _, _, _, err = test.fn(ra1, dec1, pmr1, pmd1, 0.0,
+			rv1, ep1a, ep1b, ep2a, ep2b)
+		errEN(t, 1, err, tname, "px override")
 	}
 }
 
@@ -89,7 +93,7 @@ func BenchmarkPmsafe(b *testing.B) {
 			c1, c2, c3, c4, c5, c6 float64, c7 en.ErrNum)
 	}{
 		{"cgo", CgoPmsafe},
-		{"go", GoPmsafe},
+		{"go", Pmsafe},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPn Convert a p-vector into modulus and unit vector.
+func CgoPn(p [3]float64) (r float64, u [3]float64) {
+	var cU [3]C.double
+	var cR C.double
+	cP := v3sGo2C(p)
+	C.iauPn(&cP[0], &cR, &cU[0])
+	return float64(cR), v3sC2Go(cU)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPn Convert a p-vector into modulus and unit vector.
+//  Pn Convert a p-vector into modulus and unit vector.
 //
 //  - - -
 //   P n
@@ -40,25 +37,16 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPn Convert a p-vector into modulus and unit vector.
-func CgoPn(p [3]float64) (r float64, u [3]float64) {
-	var cU [3]C.double
-	var cR C.double
-	cP := v3sGo2C(p)
-	C.iauPn(&cP[0], &cR, &cU[0])
-	return float64(cR), v3sC2Go(cU)
-}
-
-// GoPn Convert a p-vector into modulus and unit vector.
-func GoPn(p [3]float64) (r float64, u [3]float64) {
+//  Pn Convert a p-vector into modulus and unit vector.
+func Pn(p [3]float64) (r float64, u [3]float64) {
 
 	// Obtain the modulus and test for zero.
-	r = GoPm(p)
+	r = Pm(p)
 	if r == 0.0 {
 		// Null vector.
 		return
 	}
 	// Unit vector.
-	u = GoSxp(1.0/r, p)
+	u = Sxp(1.0/r, p)
 	return
 }
@@ -27,7 +27,7 @@ func TestPn(t *testing.T) {
 		fn  func([3]float64) (float64, [3]float64)
 	}{
 		{"cgo", CgoPn},
-		{"go", GoPn},
+		{"go", Pn},
 	}
 
 	for _, test := range tests {
@@ -53,7 +53,7 @@ func BenchmarkPn(b *testing.B) {
 		fn  func([3]float64) (float64, [3]float64)
 	}{
 		{"cgo", CgoPn},
-		{"go", GoPn},
+		{"go", Pn},
 	}
 
 	for _, test := range tests {
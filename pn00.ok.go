@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPn00 Precession-nutation, IAU 2000 model:  a multi-purpose
+//  Pn00 Precession-nutation, IAU 2000 model:  a multi-purpose
 //  function, supporting classical (equinox-based) use directly and
 //  CIO-based use indirectly.
 //
@@ -104,41 +101,27 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPn00 Precession-nutation, IAU 2000 model:  a multi-purpose
-//  function, supporting classical (equinox-based) use directly and
-//  CIO-based use indirectly.
-func CgoPn00(date1, date2, dpsi, deps float64) (epsa float64, rb, rp,
-	rbp, rn, rbpn [3][3]float64) {
-	var cEpsa C.double
-	var cRb, cRp, cRbp, cRn, cRbpn [3][3]C.double
-	C.iauPn00(C.double(date1), C.double(date2), C.double(dpsi),
-		C.double(deps), &cEpsa, &cRb[0], &cRp[0], &cRbp[0],
-		&cRn[0], &cRbpn[0])
-	return float64(cEpsa), v3tC2Go(cRb), v3tC2Go(cRp),
-		v3tC2Go(cRbp), v3tC2Go(cRn), v3tC2Go(cRbpn)
-}
-
-//  GoPn00 Precession-nutation, IAU 2000 model:  a multi-purpose
+//  Pn00 Precession-nutation, IAU 2000 model:  a multi-purpose
 //  function, supporting classical (equinox-based) use directly and
 //  CIO-based use indirectly.
-func GoPn00(date1, date2, dpsi, deps float64) (epsa float64, rb, rp,
+func Pn00(date1, date2, dpsi, deps float64) (epsa float64, rb, rp,
 	rbp, rn, rbpn [3][3]float64) {
 
 	var depspr float64
 
 	// IAU 2000 precession-rate adjustments. 
-	_, depspr = GoPr00(date1, date2)
+	_, depspr = Pr00(date1, date2)
 
 	// Mean obliquity, consistent with IAU 2000 precession-nutation. 
-	epsa = GoObl80(date1, date2) + depspr
+	epsa = Obl80(date1, date2) + depspr
 
 	// Frame bias and precession matrices and their product. 
-	rb, rp, rbp = GoBp00(date1, date2)
+	rb, rp, rbp = Bp00(date1, date2)
 
 	// Nutation matrix. 
-	rn = GoNumat(epsa, dpsi, deps)
+	rn = Numat(epsa, dpsi, deps)
 
 	// Bias-precession-nutation matrix (classical). 
-	rbpn = GoRxr(rn, rbp)
+	rbpn = Rxr(rn, rbp)
 	return
 }
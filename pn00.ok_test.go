@@ -24,7 +24,7 @@ func TestPn00(t *testing.T) {
 			c1 float64, c2, c3, c4, c5, c6 [3][3]float64)
 	}{
 		{"cgo", CgoPn00},
-		{"go", GoPn00},
+		{"go", Pn00},
 	}
 
 	dpsi = -0.9632552291149335877e-5
@@ -155,7 +155,7 @@ func BenchmarkPn00(b *testing.B) {
 			c1 float64, c2, c3, c4, c5, c6 [3][3]float64)
 	}{
 		{"cgo", CgoPn00},
-		{"go", GoPn00},
+		{"go", Pn00},
 	}
 
 	dpsi = -0.9632552291149335877e-5
@@ -0,0 +1,21 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPn00a Precession-nutation, IAU 2000A model:  a multi-purpose
+//  function, supporting classical (equinox-based) use directly and
+//  CIO-based use indirectly.
+func CgoPn00a(date1, date2 float64) (dpsi, deps, epsa float64, rb, rp,
+	rbp, rn, rbpn [3][3]float64) {
+	var cDpsi, cDeps, cEpsa C.double
+	var cRb, cRp, cRbp, cRn, cRbpn [3][3]C.double
+	C.iauPn00a(C.double(date1), C.double(date2), &cDpsi, &cDeps,
+		&cEpsa, &cRb[0], &cRp[0], &cRbp[0], &cRn[0], &cRbpn[0])
+	return float64(cDpsi), float64(cDeps), float64(cEpsa),
+		v3tC2Go(cRb), v3tC2Go(cRp), v3tC2Go(cRbp), v3tC2Go(cRn),
+		v3tC2Go(cRbpn)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPn00a Precession-nutation, IAU 2000A model:  a multi-purpose
+//  Pn00a Precession-nutation, IAU 2000A model:  a multi-purpose
 //  function, supporting classical (equinox-based) use directly and
 //  CIO-based use indirectly.
 //
@@ -104,30 +101,16 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPn00a Precession-nutation, IAU 2000A model:  a multi-purpose
-//  function, supporting classical (equinox-based) use directly and
-//  CIO-based use indirectly.
-func CgoPn00a(date1, date2 float64) (dpsi, deps, epsa float64, rb, rp,
-	rbp, rn, rbpn [3][3]float64) {
-	var cDpsi, cDeps, cEpsa C.double
-	var cRb, cRp, cRbp, cRn, cRbpn [3][3]C.double
-	C.iauPn00a(C.double(date1), C.double(date2), &cDpsi, &cDeps,
-		&cEpsa, &cRb[0], &cRp[0], &cRbp[0], &cRn[0], &cRbpn[0])
-	return float64(cDpsi), float64(cDeps), float64(cEpsa),
-		v3tC2Go(cRb), v3tC2Go(cRp), v3tC2Go(cRbp), v3tC2Go(cRn),
-		v3tC2Go(cRbpn)
-}
-
-//  GoPn00a Precession-nutation, IAU 2000A model:  a multi-purpose
+//  Pn00a Precession-nutation, IAU 2000A model:  a multi-purpose
 //  function, supporting classical (equinox-based) use directly and
 //  CIO-based use indirectly.
-func GoPn00a(date1, date2 float64) (dpsi, deps, epsa float64, rb, rp,
+func Pn00a(date1, date2 float64) (dpsi, deps, epsa float64, rb, rp,
 	rbp, rn, rbpn [3][3]float64) {
 
 	// Nutation.
-	dpsi, deps = GoNut00a(date1, date2)
+	dpsi, deps = Nut00a(date1, date2)
 
 	// Remaining results.
-	epsa, rb, rp, rbp, rn, rbpn = GoPn00(date1, date2, dpsi, deps)
+	epsa, rb, rp, rbp, rn, rbpn = Pn00(date1, date2, dpsi, deps)
 	return
 }
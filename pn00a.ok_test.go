@@ -24,7 +24,7 @@ func TestPn00a(t *testing.T) {
 			c6, c7, c8 [3][3]float64)
 	}{
 		{"cgo", CgoPn00a},
-		{"go", GoPn00a},
+		{"go", Pn00a},
 	}
 
 	for _, test := range tests {
@@ -155,7 +155,7 @@ func BenchmarkPn00a(b *testing.B) {
 			c6, c7, c8 [3][3]float64)
 	}{
 		{"cgo", CgoPn00a},
-		{"go", GoPn00a},
+		{"go", Pn00a},
 	}
 
 	for _, test := range tests {
@@ -25,7 +25,7 @@ func TestPn00b(t *testing.T) {
 			c4, c5, c6, c7, c8 [3][3]float64)
 	}{
 		{"cgo", CgoPn00b},
-		{"go", GoPn00b},
+		{"go", Pn00b},
 	}
 
 	for _, test := range tests {
@@ -156,7 +156,7 @@ func BenchmarkPn00b(b *testing.B) {
 			c4, c5, c6, c7, c8 [3][3]float64)
 	}{
 		{"cgo", CgoPn00b},
-		{"go", GoPn00b},
+		{"go", Pn00b},
 	}
 
 	for _, test := range tests {
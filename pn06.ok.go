@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPn06 Precession-nutation, IAU 2006 model:  a multi-purpose
+//  Pn06 Precession-nutation, IAU 2006 model:  a multi-purpose
 //  function, supporting classical (equinox-based) use directly and
 //  CIO-based use indirectly.
 //
@@ -102,54 +99,40 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPn06 Precession-nutation, IAU 2006 model:  a multi-purpose
-//  function, supporting classical (equinox-based) use directly and
-//  CIO-based use indirectly.
-func CgoPn06(date1, date2, dpsi, deps float64) (epsa float64,
-	rb, rp, rbp, rn, rbpn [3][3]float64) {
-	var cEpsa C.double
-	var cRb, cRp, cRbp, cRn, cRbpn [3][3]C.double
-	C.iauPn06(C.double(date1), C.double(date2), C.double(dpsi),
-		C.double(deps),
-		&cEpsa, &cRb[0], &cRp[0], &cRbp[0], &cRn[0], &cRbpn[0])
-	return float64(cEpsa), v3tC2Go(cRb), v3tC2Go(cRp),
-		v3tC2Go(cRbp), v3tC2Go(cRn), v3tC2Go(cRbpn)
-}
-
-//  GoPn06 Precession-nutation, IAU 2006 model:  a multi-purpose
+//  Pn06 Precession-nutation, IAU 2006 model:  a multi-purpose
 //  function, supporting classical (equinox-based) use directly and
 //  CIO-based use indirectly.
-func GoPn06(date1, date2, dpsi, deps float64) (epsa float64,
+func Pn06(date1, date2, dpsi, deps float64) (epsa float64,
 	rb, rp, rbp, rn, rbpn [3][3]float64) {
 	var gamb, phib, psib, eps float64
 	var r1, r2, rt [3][3]float64
 
 	// Bias-precession Fukushima-Williams angles of J2000.0 = frame
 	// bias. 
-	gamb, phib, psib, eps = GoPfw06(DJM0, DJM00)
+	gamb, phib, psib, eps = Pfw06(DJM0, DJM00)
 
 	// B matrix. 
-	r1 = GoFw2m(gamb, phib, psib, eps)
+	r1 = Fw2m(gamb, phib, psib, eps)
 	rb = r1
 
 	// Bias-precession Fukushima-Williams angles of date. 
-	gamb, phib, psib, eps = GoPfw06(date1, date2)
+	gamb, phib, psib, eps = Pfw06(date1, date2)
 
 	// Bias-precession matrix. 
-	r2 = GoFw2m(gamb, phib, psib, eps)
+	r2 = Fw2m(gamb, phib, psib, eps)
 	rbp = r2
 
 	// Solve for precession matrix. 
-	rt = GoTr(r1)
-	rp = GoRxr(r2, rt)
+	rt = Tr(r1)
+	rp = Rxr(r2, rt)
 
 	// Equinox-based bias-precession-nutation matrix. 
-	r1 = GoFw2m(gamb, phib, psib+dpsi, eps+deps)
+	r1 = Fw2m(gamb, phib, psib+dpsi, eps+deps)
 	rbpn = r1
 
 	// Solve for nutation matrix. 
-	rt = GoTr(r2)
-	rn = GoRxr(r1, rt)
+	rt = Tr(r2)
+	rn = Rxr(r1, rt)
 
 	// Obliquity, mean of date. 
 	epsa = eps
@@ -27,7 +27,7 @@ func TestPn06(t *testing.T) {
 			c2, c3, c4, c5, c6 [3][3]float64)
 	}{
 		{"cgo", CgoPn06},
-		{"go", GoPn06},
+		{"go", Pn06},
 	}
 
 	for _, test := range tests {
@@ -157,7 +157,7 @@ func BenchmarkPn06(b *testing.B) {
 			c2, c3, c4, c5, c6 [3][3]float64)
 	}{
 		{"cgo", CgoPn06},
-		{"go", GoPn06},
+		{"go", Pn06},
 	}
 
 	for _, test := range tests {
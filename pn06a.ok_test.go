@@ -24,7 +24,7 @@ func TestPn06a(t *testing.T) {
 			c4, c5, c6, c7, c8 [3][3]float64)
 	}{
 		{"cgo", CgoPn06a},
-		{"go", GoPn06a},
+		{"go", Pn06a},
 	}
 
 	for _, test := range tests {
@@ -154,7 +154,7 @@ func BenchmarkPn06a(b *testing.B) {
 			c4, c5, c6, c7, c8 [3][3]float64)
 	}{
 		{"cgo", CgoPn06a},
-		{"go", GoPn06a},
+		{"go", Pn06a},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,287 @@
+package sofa
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	errPNChebSpan = errors.New("invalid span or tolerance")
+	errPNChebTol  = errors.New("tolerance not reached")
+)
+
+// The quantities fitted by a PNCheb.
+const (
+	pncX = iota
+	pncY
+	pncS
+	pncDpsi // Nut00a
+	pncDeps
+	pncEO
+	pncN
+)
+
+const (
+	pnchebDeg  = 16        // degree of the fits
+	pnchebTail = 8         // extra degree of the fits that bound them
+	pnchebSeg  = 64.0      // longest segment (days)
+	pnchebMin  = 1.0 / 8.0 // shortest segment (days)
+	pnchebTest = 4         // test points per fitting node
+)
+
+// PNCheb is a piecewise Chebyshev approximation of the IAU 2006/2000A
+// precession-nutation over a span of dates, for evaluation at high
+// rates.  It fits the CIP X,Y, the CIO locator s, the nutation of
+// Nut00a and the equation of the origins, and provides the functions
+// that depend on them with the same signatures, so that a method value
+// such as p.Xys06a may stand in for Xys06a.  Dates outside the span
+// are given the full series.  A PNCheb is also a PNModel.
+//
+// A PNCheb does not change once made and is safe for concurrent use.
+type PNCheb struct {
+	t01, t02 float64 // TT start of the span
+	days     float64 // length of the span
+	seg      float64 // length of a segment (days)
+	nseg     int
+	bound    float64
+	c        [pncN][]float64 // pnchebDeg+1 coefficients per segment
+}
+
+// pnFull returns the quantities of a PNCheb from the full series at the
+// TT date date1+date2, as do Nut00a, Xys06a and Eo06a.
+func pnFull(date1, date2 float64) (q [pncN]float64) {
+	dp, de := Nut00a(date1, date2)
+	t := ((date1 - DJ00) + date2) / DJC
+	fj2 := -2.7774e-6 * t
+	gamb, phib, psib, epsa := Pfw06(date1, date2)
+	r := Fw2m(gamb, phib, psib+(dp+dp*(0.4697e-6+fj2)), epsa+(de+de*fj2))
+	q[pncX], q[pncY] = Bpn2xy(r)
+	q[pncS] = S06(date1, date2, q[pncX], q[pncY])
+	q[pncDpsi], q[pncDeps] = dp, de
+	q[pncEO] = Eors(r, q[pncS])
+	return
+}
+
+// NewPNCheb fits the days from the TT date date1+date2, in segments
+// short enough that the error of each fitted quantity, against the
+// full series, is bounded by tol (radians).  The largest of the bounds
+// is given by ErrorBound.  Tolerances much below 1e-15 radians cannot
+// be reached.
+//
+// Each segment is fitted to a degree higher by pnchebTail than is kept.
+// The coefficients dropped bound the difference between the kept fit
+// and the higher one;  to them is added twice the error of the higher
+// fit, the largest of its errors at test points four times as dense as
+// its nodes and of its last two coefficients.
+func NewPNCheb(date1, date2, days, tol float64) (*PNCheb, error) {
+	if !(days > 0) || !(tol > 0) {
+		return nil, errPNChebSpan
+	}
+	for seg := pnchebSeg; seg >= pnchebMin; seg /= 2 {
+		p := &PNCheb{t01: date1, t02: date2, days: days,
+			nseg: int(math.Ceil(days / seg))}
+		p.seg = days / float64(p.nseg)
+		if p.fit(tol) {
+			return p, nil
+		}
+	}
+	return nil, errPNChebTol
+}
+
+// fit fits each segment, reporting false as soon as the error bound of
+// one exceeds tol.
+func (p *PNCheb) fit(tol float64) bool {
+	const n = pnchebDeg + 1
+	const nn = n + pnchebTail
+	var f [nn][pncN]float64
+	var cf [pncN][nn]float64
+	for q := range p.c {
+		p.c[q] = make([]float64, p.nseg*n)
+	}
+	for i := 0; i < p.nseg; i++ {
+		t0 := float64(i) * p.seg
+
+		// The series at the Chebyshev nodes, and the coefficients, of
+		// which the first n are kept.
+		for j := 0; j < nn; j++ {
+			x := math.Cos(DPI * (float64(j) + 0.5) / nn)
+			f[j] = pnFull(p.t01, p.t02+t0+0.5*p.seg*(x+1.0))
+		}
+		for q := 0; q < pncN; q++ {
+			for k := 0; k < nn; k++ {
+				var s float64
+				for j := 0; j < nn; j++ {
+					s += f[j][q] * math.Cos(DPI*float64(k)*
+						(float64(j)+0.5)/nn)
+				}
+				cf[q][k] = 2.0 * s / nn
+			}
+			cf[q][0] /= 2.0
+			copy(p.c[q][i*n:(i+1)*n], cf[q][:n])
+		}
+
+		// The errors of the higher fit at the test points, including
+		// the ends, and at its last coefficients.
+		var e [pncN]float64
+		for q := range e {
+			e[q] = math.Abs(cf[q][nn-2]) + math.Abs(cf[q][nn-1])
+		}
+		m := pnchebTest * nn
+		for j := 0; j <= m; j++ {
+			x := 2.0*float64(j)/float64(m) - 1.0
+			want := pnFull(p.t01, p.t02+t0+0.5*p.seg*(x+1.0))
+			for q := 0; q < pncN; q++ {
+				e[q] = math.Max(e[q],
+					math.Abs(clenshaw(cf[q][:], x)-want[q]))
+			}
+		}
+
+		// The bound:  the coefficients dropped, and twice the error of
+		// the higher fit.
+		for q := 0; q < pncN; q++ {
+			b := 2.0 * e[q]
+			for k := n; k < nn; k++ {
+				b += math.Abs(cf[q][k])
+			}
+			if b > tol {
+				return false
+			}
+			p.bound = math.Max(p.bound, b)
+		}
+	}
+	return true
+}
+
+// eval returns the quantity q from the segment i at d days from the
+// start of the span.
+func (p *PNCheb) eval(q, i int, d float64) float64 {
+	const n = pnchebDeg + 1
+	return clenshaw(p.c[q][i*n:(i+1)*n],
+		2.0*(d-float64(i)*p.seg)/p.seg-1.0)
+}
+
+// clenshaw returns the Chebyshev series c at x in [-1,+1], by Clenshaw's
+// recurrence.
+func clenshaw(c []float64, x float64) float64 {
+	var b1, b2 float64
+	for k := len(c) - 1; k > 0; k-- {
+		b1, b2 = 2.0*x*b1-b2+c[k], b1
+	}
+	return x*b1 - b2 + c[0]
+}
+
+// segment returns the segment of the TT date date1+date2, and its
+// offset from the start of the span, or ok false if it is outside.
+func (p *PNCheb) segment(date1, date2 float64) (i int, d float64,
+	ok bool) {
+	d = (date1 - p.t01) + (date2 - p.t02)
+	if !(d >= 0 && d <= p.days) {
+		return 0, 0, false
+	}
+	i = int(d / p.seg)
+	if i >= p.nseg {
+		i = p.nseg - 1
+	}
+	return i, d, true
+}
+
+// Span returns the TT start of the span as a 2-part Julian Date, and
+// its length (days).
+func (p *PNCheb) Span() (date1, date2, days float64) {
+	return p.t01, p.t02, p.days
+}
+
+// ErrorBound returns the largest of the error bounds of the fits
+// (radians), which is no more than the tolerance of NewPNCheb.
+func (p *PNCheb) ErrorBound() float64 {
+	return p.bound
+}
+
+// Nut00a is Nut00a from the fit.
+func (p *PNCheb) Nut00a(date1, date2 float64) (dpsi, deps float64) {
+	i, d, ok := p.segment(date1, date2)
+	if !ok {
+		return Nut00a(date1, date2)
+	}
+	return p.eval(pncDpsi, i, d), p.eval(pncDeps, i, d)
+}
+
+// Nut06a is Nut06a from the fit.
+func (p *PNCheb) Nut06a(date1, date2 float64) (dpsi, deps float64) {
+	t := ((date1 - DJ00) + date2) / DJC
+	fj2 := -2.7774e-6 * t
+	dp, de := p.Nut00a(date1, date2)
+	dpsi = dp + dp*(0.4697e-6+fj2)
+	deps = de + de*fj2
+	return
+}
+
+// Pnm06a is Pnm06a from the fit.
+func (p *PNCheb) Pnm06a(date1, date2 float64) (rnpb [3][3]float64) {
+	gamb, phib, psib, epsa := Pfw06(date1, date2)
+	dp, de := p.Nut06a(date1, date2)
+	return Fw2m(gamb, phib, psib+dp, epsa+de)
+}
+
+// Xys06a is Xys06a from the fit.
+func (p *PNCheb) Xys06a(date1, date2 float64) (x, y, s float64) {
+	i, d, ok := p.segment(date1, date2)
+	if !ok {
+		return Xys06a(date1, date2)
+	}
+	return p.eval(pncX, i, d), p.eval(pncY, i, d), p.eval(pncS, i, d)
+}
+
+// Eo06a is Eo06a from the fit.
+func (p *PNCheb) Eo06a(date1, date2 float64) (eo float64) {
+	i, d, ok := p.segment(date1, date2)
+	if !ok {
+		return Eo06a(date1, date2)
+	}
+	return p.eval(pncEO, i, d)
+}
+
+// C2i06a is C2i06a from the fit.
+func (p *PNCheb) C2i06a(date1, date2 float64) (rc2i [3][3]float64) {
+	x, y, s := p.Xys06a(date1, date2)
+	return C2ixys(x, y, s)
+}
+
+// C2t06a is C2t06a from the fit.
+func (p *PNCheb) C2t06a(tta, ttb, uta, utb, xp, yp float64) (
+	rc2t [3][3]float64) {
+	rc2i := p.C2i06a(tta, ttb)
+	era := Era00(uta, utb)
+	sp := Sp00(tta, ttb)
+	rpom := Pom00(xp, yp, sp)
+	return C2tcio(rc2i, era, rpom)
+}
+
+// Bpn is Pnm06a from the fit, so that a PNCheb is a PNModel.
+func (p *PNCheb) Bpn(date1, date2 float64) [3][3]float64 {
+	return p.Pnm06a(date1, date2)
+}
+
+// S returns the fitted s, or S06 outside the span.
+func (p *PNCheb) S(date1, date2, x, y float64) float64 {
+	i, d, ok := p.segment(date1, date2)
+	if !ok {
+		return S06(date1, date2, x, y)
+	}
+	return p.eval(pncS, i, d)
+}
+
+// Nut is Nut06a from the fit.
+func (p *PNCheb) Nut(date1, date2 float64) (dpsi, deps float64) {
+	return p.Nut06a(date1, date2)
+}
+
+// Gst is Gst06a from the fit.
+func (p *PNCheb) Gst(uta, utb, tta, ttb float64) float64 {
+	return Gst06(uta, utb, tta, ttb, p.Pnm06a(tta, ttb))
+}
+
+// C2t is C2t06a from the fit.
+func (p *PNCheb) C2t(tta, ttb, uta, utb, xp, yp float64) [3][3]float64 {
+	return p.C2t06a(tta, ttb, uta, utb, xp, yp)
+}
@@ -0,0 +1,111 @@
+package sofa
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestPNCheb(t *testing.T) {
+	const fname = "PNCheb"
+	const tol = 1e-12
+	p, err := NewPNCheb(2459000.5, 0.25, 365.0, tol)
+	errT(t, nil, err, fname, "err")
+	if p.ErrorBound() <= 0 || p.ErrorBound() > tol {
+		t.Errorf("%s: error bound %g", fname, p.ErrorBound())
+	}
+	d1, d2, days := p.Span()
+	vvd(t, d1+d2+days, 2459365.75, 0, fname, "span")
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		d := r.Float64() * 365.0
+		date1, date2 := 2459000.5, 0.25+d
+
+		x, y, s := p.Xys06a(date1, date2)
+		xf, yf, sf := Xys06a(date1, date2)
+		vvd(t, x, xf, tol, fname, "x")
+		vvd(t, y, yf, tol, fname, "y")
+		vvd(t, s, sf, tol, fname, "s")
+		dp, de := p.Nut00a(date1, date2)
+		dpf, def := Nut00a(date1, date2)
+		vvd(t, dp, dpf, tol, fname, "Nut00a dpsi")
+		vvd(t, de, def, tol, fname, "Nut00a deps")
+		dp, de = p.Nut06a(date1, date2)
+		dpf, def = Nut06a(date1, date2)
+		vvd(t, dp, dpf, tol, fname, "Nut06a dpsi")
+		vvd(t, de, def, tol, fname, "Nut06a deps")
+		vvd(t, p.Eo06a(date1, date2), Eo06a(date1, date2), tol, fname,
+			"eo")
+
+		m, mf := p.Pnm06a(date1, date2), Pnm06a(date1, date2)
+		c, cf := p.C2t06a(date1, date2, date1, date2-1e-3, 1e-7, 2e-7),
+			C2t06a(date1, date2, date1, date2-1e-3, 1e-7, 2e-7)
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				vvd(t, m[j][k], mf[j][k], 2*tol, fname, "Pnm06a")
+				vvd(t, c[j][k], cf[j][k], 2*tol, fname, "C2t06a")
+			}
+		}
+	}
+
+	// Outside the span, the full series.
+	x, y, s := p.Xys06a(2459400.5, 0)
+	xf, yf, sf := Xys06a(2459400.5, 0)
+	vvd(t, x, xf, 0, fname, "outside x")
+	vvd(t, y, yf, 0, fname, "outside y")
+	vvd(t, s, sf, 0, fname, "outside s")
+	vvd(t, p.Eo06a(2400000.5, 0), Eo06a(2400000.5, 0), 0, fname,
+		"outside eo")
+
+	_, err = NewPNCheb(2459000.5, 0, 0, tol)
+	errT(t, errPNChebSpan, err, fname, "days")
+	_, err = NewPNCheb(2459000.5, 0, 10, 0)
+	errT(t, errPNChebSpan, err, fname, "tol")
+	_, err = NewPNCheb(2459000.5, 0, 10, 1e-22)
+	errT(t, errPNChebTol, err, fname, "tol reached")
+}
+
+// TestPNChebDense checks the fitted quantities against the full series
+// at dates dense enough to fall between the test points of the fits.
+func TestPNChebDense(t *testing.T) {
+	const fname = "PNCheb dense"
+	const tol = 1e-12
+	const days = 20.0
+	p, err := NewPNCheb(2459000.5, 0, days, tol)
+	errT(t, nil, err, fname, "err")
+	b := p.ErrorBound()
+	if b <= 0 || b > tol {
+		t.Fatalf("%s: error bound %g", fname, b)
+	}
+	name := [pncN]string{"x", "y", "s", "dpsi", "deps", "eo"}
+
+	r := rand.New(rand.NewSource(2))
+	n := 50 * int(days/p.seg) * pnchebTest * (pnchebDeg + pnchebTail + 1)
+	for j := 0; j < n; j++ {
+		i, d, ok := p.segment(2459000.5, r.Float64()*days)
+		if !ok {
+			t.Fatalf("%s: outside the span", fname)
+		}
+		want := pnFull(2459000.5, d)
+		for q := 0; q < pncN; q++ {
+			vvd(t, p.eval(q, i, d), want[q], b, fname, name[q])
+		}
+	}
+}
+
+func BenchmarkPNChebXys06a(b *testing.B) {
+	p, _ := NewPNCheb(2459000.5, 0, 30, 1e-12)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.Xys06a(2459000.5, float64(i%1000)*0.01)
+	}
+}
+
+func BenchmarkPNChebC2t06a(b *testing.B) {
+	p, _ := NewPNCheb(2459000.5, 0, 30, 1e-12)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.C2t06a(2459000.5, float64(i%1000)*0.01, 2459000.5,
+			float64(i%1000)*0.01, 1e-7, 2e-7)
+	}
+}
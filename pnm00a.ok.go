@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPnm00a Form the matrix of precession-nutation for a given date
+//  Pnm00a Form the matrix of precession-nutation for a given date
 //  (including frame bias), equinox-based, IAU 2000A model.
 //
 //  - - - - - - -
@@ -65,19 +62,11 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPnm00a Form the matrix of precession-nutation for a given date
-//  (including frame bias), equinox-based, IAU 2000A model.
-func CgoPnm00a(date1, date2 float64) (rbpn [3][3]float64) {
-	var cRbpn [3][3]C.double
-	C.iauPnm00a(C.double(date1), C.double(date2), &cRbpn[0])
-	return v3tC2Go(cRbpn)
-}
-
-//  GoPnm00a Form the matrix of precession-nutation for a given date
+//  Pnm00a Form the matrix of precession-nutation for a given date
 //  (including frame bias), equinox-based, IAU 2000A model.
-func GoPnm00a(date1, date2 float64) (rbpn [3][3]float64) {
+func Pnm00a(date1, date2 float64) (rbpn [3][3]float64) {
 
 	// Obtain the required matrix (discarding other results).
-	_, _, _, _, _, _, _, rbpn = GoPn00a(date1, date2)
+	_, _, _, _, _, _, _, rbpn = Pn00a(date1, date2)
 	return
 }
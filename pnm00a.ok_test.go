@@ -22,7 +22,7 @@ func TestPnm00a(t *testing.T) {
 		fn func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoPnm00a},
-		{"go", GoPnm00a},
+		{"go", Pnm00a},
 	}
 
 	for _, test := range tests {
@@ -59,7 +59,7 @@ func BenchmarkPnm00a(b *testing.B) {
 		fn func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoPnm00a},
-		{"go", GoPnm00a},
+		{"go", Pnm00a},
 	}
 
 	for _, test := range tests {
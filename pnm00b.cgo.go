@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPnm00b Form the matrix of precession-nutation for a given date
+//  (including frame bias), equinox-based, IAU 2000B model.
+func CgoPnm00b(date1, date2 float64) (rbpn [3][3]float64) {
+	var cRbpn [3][3]C.double
+	C.iauPnm00b(C.double(date1), C.double(date2), &cRbpn[0])
+	return v3tC2Go(cRbpn)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPnm00b Form the matrix of precession-nutation for a given date
+//  Pnm00b Form the matrix of precession-nutation for a given date
 //  (including frame bias), equinox-based, IAU 2000B model.
 //
 //  - - - - - - -
@@ -65,19 +62,11 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPnm00b Form the matrix of precession-nutation for a given date
-//  (including frame bias), equinox-based, IAU 2000B model.
-func CgoPnm00b(date1, date2 float64) (rbpn [3][3]float64) {
-	var cRbpn [3][3]C.double
-	C.iauPnm00b(C.double(date1), C.double(date2), &cRbpn[0])
-	return v3tC2Go(cRbpn)
-}
-
-//  GoPnm00b Form the matrix of precession-nutation for a given date
+//  Pnm00b Form the matrix of precession-nutation for a given date
 //  (including frame bias), equinox-based, IAU 2000B model.
-func GoPnm00b(date1, date2 float64) (rbpn [3][3]float64) {
+func Pnm00b(date1, date2 float64) (rbpn [3][3]float64) {
 
 	// Obtain the required matrix (discarding other results).
-	_, _, _, _, _, _, _, rbpn = GoPn00b(date1, date2)
+	_, _, _, _, _, _, _, rbpn = Pn00b(date1, date2)
 	return
 }
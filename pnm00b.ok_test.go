@@ -22,7 +22,7 @@ func TestPnm00b(t *testing.T) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoPnm00b},
-		{"go", GoPnm00b},
+		{"go", Pnm00b},
 	}
 
 	for _, test := range tests {
@@ -58,7 +58,7 @@ func BenchmarkPnm00b(b *testing.B) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoPnm00b},
-		{"go", GoPnm00b},
+		{"go", Pnm00b},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPnm06a Form the matrix of precession-nutation for a given date
+//  (including frame bias), IAU 2006 precession and IAU 2000A nutation
+//  models.
+// void iauPnm06a(double date1, double date2, double rnpb[3][3])
+func CgoPnm06a(date1, date2 float64) (rnpb [3][3]float64) {
+	var cRnpb [3][3]C.double
+	C.iauPnm06a(C.double(date1), C.double(date2), &cRnpb[0])
+	return v3tC2Go(cRnpb)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPnm06a Form the matrix of precession-nutation for a given date
+//  Pnm06a Form the matrix of precession-nutation for a given date
 //  (including frame bias), IAU 2006 precession and IAU 2000A nutation
 //  models.
 //
@@ -63,30 +60,21 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPnm06a Form the matrix of precession-nutation for a given date
+//  Pnm06a Form the matrix of precession-nutation for a given date
 //  (including frame bias), IAU 2006 precession and IAU 2000A nutation
 //  models.
 // void iauPnm06a(double date1, double date2, double rnpb[3][3])
-func CgoPnm06a(date1, date2 float64) (rnpb [3][3]float64) {
-	var cRnpb [3][3]C.double
-	C.iauPnm06a(C.double(date1), C.double(date2), &cRnpb[0])
-	return v3tC2Go(cRnpb)
-}
-
-// GoPnm06a Form the matrix of precession-nutation for a given date
-// (including frame bias), IAU 2006 precession and IAU 2000A nutation
-// models.
-func GoPnm06a(date1, date2 float64) (rnpb [3][3]float64) {
+func Pnm06a(date1, date2 float64) (rnpb [3][3]float64) {
 	var gamb, phib, psib, epsa, dp, de float64
 
 	// Fukushima-Williams angles for frame bias and precession.
-	gamb, phib, psib, epsa = GoPfw06(date1, date2)
+	gamb, phib, psib, epsa = Pfw06(date1, date2)
 
 	// Nutation components.
-	dp, de = GoNut06a(date1, date2)
+	dp, de = Nut06a(date1, date2)
 
 	// Equinox based nutation x precession x bias matrix.
-	rnpb = GoFw2m(gamb, phib, psib+dp, epsa+de)
+	rnpb = Fw2m(gamb, phib, psib+dp, epsa+de)
 
 	return
 }
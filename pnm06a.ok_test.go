@@ -20,7 +20,7 @@ func TestPnm06a(t *testing.T) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoPnm06a},
-		{"go", GoPnm06a},
+		{"go", Pnm06a},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -55,7 +55,7 @@ func BenchmarkPnm06a(b *testing.B) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoPnm06a},
-		{"go", GoPnm06a},
+		{"go", Pnm06a},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
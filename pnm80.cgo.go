@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPnm80 Form the matrix of precession/nutation for a given date,
+//  IAU 1976 precession model, IAU 1980 nutation model.
+func CgoPnm80(date1, date2 float64) (rmatpn [3][3]float64) {
+	var cRmatpn [3][3]C.double
+	C.iauPnm80(C.double(date1), C.double(date2), &cRmatpn[0])
+	return v3tC2Go(cRmatpn)
+}
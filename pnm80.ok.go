@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPnm80 Form the matrix of precession/nutation for a given date,
+//  Pnm80 Form the matrix of precession/nutation for a given date,
 //  IAU 1976 precession model, IAU 1980 nutation model.
 //
 //  - - - - - -
@@ -64,26 +61,18 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPnm80 Form the matrix of precession/nutation for a given date,
-//  IAU 1976 precession model, IAU 1980 nutation model.
-func CgoPnm80(date1, date2 float64) (rmatpn [3][3]float64) {
-	var cRmatpn [3][3]C.double
-	C.iauPnm80(C.double(date1), C.double(date2), &cRmatpn[0])
-	return v3tC2Go(cRmatpn)
-}
-
-//  GoPnm80 Form the matrix of precession/nutation for a given date,
+//  Pnm80 Form the matrix of precession/nutation for a given date,
 //  IAU 1976 precession model, IAU 1980 nutation model.
-func GoPnm80(date1, date2 float64) (rmatpn [3][3]float64) {
+func Pnm80(date1, date2 float64) (rmatpn [3][3]float64) {
 	var rmatp, rmatn [3][3]float64
 
 	// Precession matrix, J2000.0 to date.
-	rmatp = GoPmat76(date1, date2)
+	rmatp = Pmat76(date1, date2)
 
 	// Nutation matrix.
-	rmatn = GoNutm80(date1, date2)
+	rmatn = Nutm80(date1, date2)
 
 	// Combine the matrices:  PN = N x P.
-	rmatpn = GoRxr(rmatn, rmatp)
+	rmatpn = Rxr(rmatn, rmatp)
 	return
 }
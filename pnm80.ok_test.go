@@ -22,7 +22,7 @@ func TestPnm80(t *testing.T) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoPnm80},
-		{"go", GoPnm80},
+		{"go", Pnm80},
 	}
 
 	for _, test := range tests {
@@ -59,7 +59,7 @@ func BenchmarkPnm80(b *testing.B) {
 		fn  func(a1, a2 float64) [3][3]float64
 	}{
 		{"cgo", CgoPnm80},
-		{"go", GoPnm80},
+		{"go", Pnm80},
 	}
 
 	for _, test := range tests {
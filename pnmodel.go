@@ -0,0 +1,175 @@
+package sofa
+
+// PNModel is a generation of the precession-nutation models, for the
+// functions that accept a choice of them.  The dates date1+date2 and
+// tta+ttb are TT, and uta+utb UT1, as 2-part Julian Dates.
+type PNModel interface {
+	// Bpn returns the bias-precession-nutation matrix, from the GCRS
+	// to the true equator and equinox of date.
+	Bpn(date1, date2 float64) [3][3]float64
+
+	// S returns the CIO locator s, given the CIP X,Y.
+	S(date1, date2, x, y float64) float64
+
+	// Nut returns the nutation in longitude and obliquity (radians).
+	Nut(date1, date2 float64) (dpsi, deps float64)
+
+	// Gst returns the Greenwich apparent sidereal time (radians).
+	Gst(uta, utb, tta, ttb float64) float64
+
+	// C2t returns the celestial to terrestrial matrix, given the polar
+	// motion xp,yp (radians).
+	C2t(tta, ttb, uta, utb, xp, yp float64) [3][3]float64
+}
+
+// The generations of the models.  IAU1980 has no CIO, and takes s from
+// the IAU 2000 series;  its sidereal time is Gst94, from UT1 alone, as
+// is that of IAU2000B.
+type (
+	IAU1980  struct{} // IAU 1976 precession and 1980 nutation
+	IAU2000A struct{} // IAU 2000A, with the IAU 2000 precession rates
+	IAU2000B struct{} // IAU 2000B, the truncated nutation
+	IAU2006A struct{} // IAU 2006 precession and IAU 2000A nutation
+)
+
+// pnModel returns m, or IAU2006A if m is nil.
+func pnModel(m PNModel) PNModel {
+	if m == nil {
+		return IAU2006A{}
+	}
+	return m
+}
+
+// Bpn returns Pnm80, which has no frame bias.
+func (IAU1980) Bpn(date1, date2 float64) [3][3]float64 {
+	return Pnm80(date1, date2)
+}
+
+// S returns S00.
+func (IAU1980) S(date1, date2, x, y float64) float64 {
+	return S00(date1, date2, x, y)
+}
+
+// Nut returns Nut80.
+func (IAU1980) Nut(date1, date2 float64) (dpsi, deps float64) {
+	return Nut80(date1, date2)
+}
+
+// Gst returns Gst94.
+func (IAU1980) Gst(uta, utb, tta, ttb float64) float64 {
+	return Gst94(uta, utb)
+}
+
+// C2t returns the equinox based matrix of Pnm80 and Gst94, with the
+// polar motion of Pom00 and no TIO locator.
+func (IAU1980) C2t(tta, ttb, uta, utb, xp, yp float64) [3][3]float64 {
+	return C2teqx(Pnm80(tta, ttb), Gst94(uta, utb), Pom00(xp, yp, 0))
+}
+
+// Bpn returns Pnm00a.
+func (IAU2000A) Bpn(date1, date2 float64) [3][3]float64 {
+	return Pnm00a(date1, date2)
+}
+
+// S returns S00.
+func (IAU2000A) S(date1, date2, x, y float64) float64 {
+	return S00(date1, date2, x, y)
+}
+
+// Nut returns Nut00a.
+func (IAU2000A) Nut(date1, date2 float64) (dpsi, deps float64) {
+	return Nut00a(date1, date2)
+}
+
+// Gst returns Gst00a.
+func (IAU2000A) Gst(uta, utb, tta, ttb float64) float64 {
+	return Gst00a(uta, utb, tta, ttb)
+}
+
+// C2t returns C2t00a.
+func (IAU2000A) C2t(tta, ttb, uta, utb, xp, yp float64) [3][3]float64 {
+	return C2t00a(tta, ttb, uta, utb, xp, yp)
+}
+
+// Bpn returns Pnm00b.
+func (IAU2000B) Bpn(date1, date2 float64) [3][3]float64 {
+	return Pnm00b(date1, date2)
+}
+
+// S returns S00.
+func (IAU2000B) S(date1, date2, x, y float64) float64 {
+	return S00(date1, date2, x, y)
+}
+
+// Nut returns Nut00b.
+func (IAU2000B) Nut(date1, date2 float64) (dpsi, deps float64) {
+	return Nut00b(date1, date2)
+}
+
+// Gst returns Gst00b.
+func (IAU2000B) Gst(uta, utb, tta, ttb float64) float64 {
+	return Gst00b(uta, utb)
+}
+
+// C2t returns C2t00b.
+func (IAU2000B) C2t(tta, ttb, uta, utb, xp, yp float64) [3][3]float64 {
+	return C2t00b(tta, ttb, uta, utb, xp, yp)
+}
+
+// Bpn returns Pnm06a.
+func (IAU2006A) Bpn(date1, date2 float64) [3][3]float64 {
+	return Pnm06a(date1, date2)
+}
+
+// S returns S06.
+func (IAU2006A) S(date1, date2, x, y float64) float64 {
+	return S06(date1, date2, x, y)
+}
+
+// Nut returns Nut06a.
+func (IAU2006A) Nut(date1, date2 float64) (dpsi, deps float64) {
+	return Nut06a(date1, date2)
+}
+
+// Gst returns Gst06a.
+func (IAU2006A) Gst(uta, utb, tta, ttb float64) float64 {
+	return Gst06a(uta, utb, tta, ttb)
+}
+
+// C2t returns C2t06a.
+func (IAU2006A) C2t(tta, ttb, uta, utb, xp, yp float64) [3][3]float64 {
+	return C2t06a(tta, ttb, uta, utb, xp, yp)
+}
+
+// Apci13Model is Apci13 with the precession-nutation of m, or of
+// IAU2006A if m is nil, and the Earth from eph, or from
+// DefaultEphemeris if eph is nil.
+func Apci13Model(m PNModel, eph Ephemeris, date1, date2 float64,
+	astrom ASTROM) (ASTROM, float64, error) {
+	return Apci13Offsets(m, eph, date1, date2, 0, 0, astrom)
+}
+
+// Apco13Model is Apco13 with the precession-nutation of m, or of
+// IAU2006A if m is nil, and the Earth from eph, or from
+// DefaultEphemeris if eph is nil.  The warnings of Apco13 and of eph
+// are returned as err.
+func Apco13Model(m PNModel, eph Ephemeris, utc1, utc2, dut1, elong,
+	phi, hm, xp, yp, phpa, tc, rh, wl float64, astr ASTROM) (
+	astrom ASTROM, eo float64, err error) {
+	return Apco13Offsets(m, eph, utc1, utc2, dut1, elong, phi, hm, xp, yp,
+		0, 0, phpa, tc, rh, wl, astr)
+}
+
+// GstModel returns the Greenwich apparent sidereal time (radians) of
+// m, or of IAU2006A if m is nil, at the UT1 date uta+utb and the TT
+// date tta+ttb.
+func GstModel(m PNModel, uta, utb, tta, ttb float64) float64 {
+	return pnModel(m).Gst(uta, utb, tta, ttb)
+}
+
+// C2tModel returns the celestial to terrestrial matrix of m, or of
+// IAU2006A if m is nil, at the TT date tta+ttb and the UT1 date
+// uta+utb, for the polar motion xp,yp (radians).
+func C2tModel(m PNModel, tta, ttb, uta, utb, xp, yp float64) [3][3]float64 {
+	return pnModel(m).C2t(tta, ttb, uta, utb, xp, yp)
+}
@@ -0,0 +1,100 @@
+package sofa
+
+import "testing"
+
+func TestPNModel(t *testing.T) {
+	const fname = "PNModel"
+	const tta, ttb, uta, utb = 2400000.5, 53736.0, 2400000.5, 53736.0
+	const xp, yp = 2.55060238e-7, 1.860359247e-6
+
+	// Each model is its functions.
+	for _, test := range []struct {
+		m    PNModel
+		bpn  [3][3]float64
+		s    float64
+		dpsi float64
+		gst  float64
+		c2t  [3][3]float64
+	}{
+		{IAU1980{}, Pnm80(tta, ttb), S00(tta, ttb, 1e-4, 2e-5),
+			func() float64 { d, _ := Nut80(tta, ttb); return d }(),
+			Gst94(uta, utb),
+			C2teqx(Pnm80(tta, ttb), Gst94(uta, utb), Pom00(xp, yp, 0))},
+		{IAU2000A{}, Pnm00a(tta, ttb), S00(tta, ttb, 1e-4, 2e-5),
+			func() float64 { d, _ := Nut00a(tta, ttb); return d }(),
+			Gst00a(uta, utb, tta, ttb), C2t00a(tta, ttb, uta, utb, xp, yp)},
+		{IAU2000B{}, Pnm00b(tta, ttb), S00(tta, ttb, 1e-4, 2e-5),
+			func() float64 { d, _ := Nut00b(tta, ttb); return d }(),
+			Gst00b(uta, utb), C2t00b(tta, ttb, uta, utb, xp, yp)},
+		{IAU2006A{}, Pnm06a(tta, ttb), S06(tta, ttb, 1e-4, 2e-5),
+			func() float64 { d, _ := Nut06a(tta, ttb); return d }(),
+			Gst06a(uta, utb, tta, ttb), C2t06a(tta, ttb, uta, utb, xp, yp)},
+	} {
+		m := test.m
+		bpn, c2t := m.Bpn(tta, ttb), C2tModel(m, tta, ttb, uta, utb, xp, yp)
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				vvd(t, bpn[i][j], test.bpn[i][j], 0, fname, "Bpn")
+				vvd(t, c2t[i][j], test.c2t[i][j], 0, fname, "C2t")
+			}
+		}
+		vvd(t, m.S(tta, ttb, 1e-4, 2e-5), test.s, 0, fname, "S")
+		dpsi, _ := m.Nut(tta, ttb)
+		vvd(t, dpsi, test.dpsi, 0, fname, "Nut")
+		vvd(t, GstModel(m, uta, utb, tta, ttb), test.gst, 0, fname, "Gst")
+
+		// The generations agree to about 0.1 arcsec.
+		vvd(t, m.Gst(uta, utb, tta, ttb), Gst06a(uta, utb, tta, ttb),
+			0.1*DAS2R, fname, "Gst generations")
+	}
+	vvd(t, GstModel(nil, uta, utb, tta, ttb), Gst06a(uta, utb, tta, ttb),
+		0, fname, "Gst nil")
+}
+
+func TestApco13Model(t *testing.T) {
+	const fname = "Apco13Model"
+	const utc1, utc2, dut1 = 2456384.5, 0.969254051, 0.1550675
+	const elong, phi, hm = -0.527800806, -1.2345856, 2738.0
+	const xp, yp = 2.47230737e-7, 1.82640464e-6
+
+	// The IAU 2006/2000A model is Apco13.
+	want, weo, _ := Apco13(utc1, utc2, dut1, elong, phi, hm, xp, yp,
+		731.0, 12.8, 0.59, 0.55, ASTROM{})
+	got, eo, err := Apco13Model(nil, nil, utc1, utc2, dut1, elong, phi, hm,
+		xp, yp, 731.0, 12.8, 0.59, 0.55, ASTROM{})
+	errT(t, nil, err, fname, "err")
+	if got != want || eo != weo {
+		t.Errorf("%s: IAU2006A differs from Apco13", fname)
+	}
+
+	// The older generations move a star by tens of mas, the frame bias
+	// and the precession rates.
+	var ri, di [2]float64
+	for i, m := range []PNModel{IAU1980{}, IAU2000B{}} {
+		astr, _, err := Apco13Model(m, nil, utc1, utc2, dut1, elong, phi,
+			hm, xp, yp, 731.0, 12.8, 0.59, 0.55, ASTROM{})
+		errT(t, nil, err, fname, "model err")
+		ri[i], di[i] = Atciq(2.71, 0.174, 0, 0, 0, 0, astr)
+	}
+	r, d := Atciq(2.71, 0.174, 0, 0, 0, 0, want)
+	if s := Seps(ri[0], di[0], r, d); s < 1e-3*DAS2R || s > 0.5*DAS2R {
+		t.Errorf("%s: IAU1980 differs by %g arcsec", fname, s/DAS2R)
+	}
+	vvd(t, Seps(ri[1], di[1], r, d), 0, 1e-3*DAS2R, fname, "IAU2000B")
+
+	// Apci13Model, and a PNCheb as a model.
+	tt1, tt2 := 2456384.5, 0.970031644
+	wa, we := Apci13(tt1, tt2, ASTROM{})
+	p, _ := NewPNCheb(tt1, 0, 1, 1e-12)
+	ga, ge, err := Apci13Model(p, nil, tt1, tt2, ASTROM{})
+	errT(t, nil, err, fname, "Apci13Model err")
+	vvd(t, ge, we, 1e-12, fname, "Apci13Model eo")
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			vvd(t, ga.Bpn[i][j], wa.Bpn[i][j], 2e-12, fname,
+				"Apci13Model bpn")
+		}
+	}
+	vvd(t, GstModel(p, tt1, tt2, tt1, tt2), Gst06a(tt1, tt2, tt1, tt2),
+		2e-12, fname, "PNCheb Gst")
+}
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauPom00(double xp, double yp, double sp, double rpom[3][3])
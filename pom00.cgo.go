@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPom00 Form the matrix of polar motion for a given date, IAU 2000.
+func CgoPom00(xp, yp, sp float64) (rpom [3][3]float64) {
+	var cRpom [3][3]C.double
+	C.iauPom00(C.double(xp), C.double(yp), C.double(sp), &cRpom[0])
+	return v3tC2Go(cRpom)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPom00 Form the matrix of polar motion for a given date, IAU 2000.
+//  Pom00 Form the matrix of polar motion for a given date, IAU 2000.
 //
 //  - - - - - - -
 //   P o m 0 0
@@ -58,20 +55,13 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPom00 Form the matrix of polar motion for a given date, IAU 2000.
-func CgoPom00(xp, yp, sp float64) (rpom [3][3]float64) {
-	var cRpom [3][3]C.double
-	C.iauPom00(C.double(xp), C.double(yp), C.double(sp), &cRpom[0])
-	return v3tC2Go(cRpom)
-}
-
-// GoPom00 Form the matrix of polar motion for a given date, IAU 2000.
-func GoPom00(xp, yp, sp float64) (rpom [3][3]float64) {
+//  Pom00 Form the matrix of polar motion for a given date, IAU 2000.
+func Pom00(xp, yp, sp float64) (rpom [3][3]float64) {
 
 	// Construct the matrix.
-	rpom = GoIr()
-	rpom = GoRz(sp, rpom)
-	rpom = GoRy(-xp, rpom)
-	rpom = GoRx(-yp, rpom)
+	rpom = Ir()
+	rpom = Rz(sp, rpom)
+	rpom = Ry(-xp, rpom)
+	rpom = Rx(-yp, rpom)
 	return
 }
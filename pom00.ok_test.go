@@ -24,7 +24,7 @@ func TestPom00(t *testing.T) {
 		fn  func(a1, a2, a3 float64) [3][3]float64
 	}{
 		{"cgo", CgoPom00},
-		{"go", GoPom00},
+		{"go", Pom00},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -63,7 +63,7 @@ func BenchmarkPom00(b *testing.B) {
 		fn  func(a1, a2, a3 float64) [3][3]float64
 	}{
 		{"cgo", CgoPom00},
-		{"go", GoPom00},
+		{"go", Pom00},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPpp P-vector addition.
+func CgoPpp(a, b [3]float64) (apb [3]float64) {
+	var cApb [3]C.double
+	cA, cB := v3sGo2C(a), v3sGo2C(b)
+	C.iauPpp(&cA[0], &cB[0], &cApb[0])
+	return v3sC2Go(cApb)
+}
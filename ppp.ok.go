@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPpp P-vector addition.
+//  Ppp P-vector addition.
 //
 //  - - - -
 //   P p p
@@ -31,16 +28,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPpp P-vector addition.
-func CgoPpp(a, b [3]float64) (apb [3]float64) {
-	var cApb [3]C.double
-	cA, cB := v3sGo2C(a), v3sGo2C(b)
-	C.iauPpp(&cA[0], &cB[0], &cApb[0])
-	return v3sC2Go(cApb)
-}
-
-//  GoPpp P-vector addition.
-func GoPpp(a, b [3]float64) (apb [3]float64) {
+//  Ppp P-vector addition.
+func Ppp(a, b [3]float64) (apb [3]float64) {
 
 	apb[0] = a[0] + b[0]
 	apb[1] = a[1] + b[1]
@@ -30,7 +30,7 @@ func TestPpp(t *testing.T) {
 		fn  func(a1, a2 [3]float64) [3]float64
 	}{
 		{"cgo", CgoPpp},
-		{"go", GoPpp},
+		{"go", Ppp},
 	}
 
 	for _, test := range tests {
@@ -60,7 +60,7 @@ func BenchmarkPpp(b *testing.B) {
 		fn  func(a1, a2 [3]float64) [3]float64
 	}{
 		{"cgo", CgoPpp},
-		{"go", GoPpp},
+		{"go", Ppp},
 	}
 
 	for _, test := range tests {
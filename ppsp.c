@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauPpsp(double a[3], double s, double b[3], double apsb[3])
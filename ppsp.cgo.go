@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPpsp P-vector plus scaled p-vector.
+func CgoPpsp(a [3]float64, s float64, b [3]float64) (apsb [3]float64) {
+	var cApsb [3]C.double
+	cA, cB := v3sGo2C(a), v3sGo2C(b)
+	C.iauPpsp(&cA[0], C.double(s), &cB[0], &cApsb[0])
+	return v3sC2Go(cApsb)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPpsp P-vector plus scaled p-vector.
+//  Ppsp P-vector plus scaled p-vector.
 //
 //  - - - - -
 //   P p s p
@@ -35,24 +32,16 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPpsp P-vector plus scaled p-vector.
-func CgoPpsp(a [3]float64, s float64, b [3]float64) (apsb [3]float64) {
-	var cApsb [3]C.double
-	cA, cB := v3sGo2C(a), v3sGo2C(b)
-	C.iauPpsp(&cA[0], C.double(s), &cB[0], &cApsb[0])
-	return v3sC2Go(cApsb)
-}
-
-//  GoPpsp P-vector plus scaled p-vector.
-func GoPpsp(a [3]float64, s float64, b [3]float64) (apsb [3]float64) {
+//  Ppsp P-vector plus scaled p-vector.
+func Ppsp(a [3]float64, s float64, b [3]float64) (apsb [3]float64) {
 
 	var sb [3]float64
 
 	// s*b.
-	sb = GoSxp(s, b)
+	sb = Sxp(s, b)
 
 	// a + s*b.
-	apsb = GoPpp(a, sb)
+	apsb = Ppp(a, sb)
 
 	return
 }
@@ -33,7 +33,7 @@ func TestPpsp(t *testing.T) {
 		fn  func([3]float64, float64, [3]float64) [3]float64
 	}{
 		{"cgo", CgoPpsp},
-		{"go", GoPpsp},
+		{"go", Ppsp},
 	}
 
 	for _, test := range tests {
@@ -65,7 +65,7 @@ func BenchmarkPpsp(b *testing.B) {
 		fn  func([3]float64, float64, [3]float64) [3]float64
 	}{
 		{"cgo", CgoPpsp},
-		{"go", GoPpsp},
+		{"go", Ppsp},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauPr00(double date1, double date2, double *dpsipr, double *depspr)
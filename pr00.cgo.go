@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPr00 Precession-rate part of the IAU 2000 precession-nutation
+//  models (part of MHB2000).
+func CgoPr00(date1, date2 float64) (dpsipr, depspr float64) {
+	var cDepspr, cDpsipr C.double
+	C.iauPr00(C.double(date1), C.double(date2), &cDpsipr, &cDepspr)
+	return float64(cDpsipr), float64(cDepspr)
+}
@@ -21,11 +21,11 @@ func TestPr00(t *testing.T) {
 		fn  func(d1, d2 float64) (d3, d4 float64)
 	}{
 		{"cgo", CgoPr00},
-		{"go", GoPr00},
+		{"go", Pr00},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
-		dpsipr, depspr = GoPr00(2400000.5, 53736)
+		dpsipr, depspr = Pr00(2400000.5, 53736)
 
 		vvd(t, dpsipr, -0.8716465172668347629e-7, 1e-22,
 			tname, "dpsipr")
@@ -40,7 +40,7 @@ func BenchmarkPr00(b *testing.B) {
 		fn  func(d1, d2 float64) (d3, d4 float64)
 	}{
 		{"cgo", CgoPr00},
-		{"go", GoPr00},
+		{"go", Pr00},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
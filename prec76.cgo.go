@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPrec76 IAU 1976 precession model.
+func CgoPrec76(date01, date02, date11, date12 float64) (zeta, z, theta float64) {
+	var cZeta, cZ, cTheta C.double
+	C.iauPrec76(C.double(date01), C.double(date02), C.double(date11), C.double(date12),
+		&cZeta, &cZ, &cTheta)
+	return float64(cZeta), float64(cZ), float64(cTheta)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPrec76 IAU 1976 precession model.
+//  Prec76 IAU 1976 precession model.
 //
 //  - - - - - - -
 //   P r e c 7 6
@@ -77,16 +74,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPrec76 IAU 1976 precession model.
-func CgoPrec76(date01, date02, date11, date12 float64) (zeta, z, theta float64) {
-	var cZeta, cZ, cTheta C.double
-	C.iauPrec76(C.double(date01), C.double(date02), C.double(date11), C.double(date12),
-		&cZeta, &cZ, &cTheta)
-	return float64(cZeta), float64(cZ), float64(cTheta)
-}
-
-//  GoPrec76 IAU 1976 precession model.
-func GoPrec76(date01, date02, date11, date12 float64) (zeta, z, theta float64) {
+//  Prec76 IAU 1976 precession model.
+func Prec76(date01, date02, date11, date12 float64) (zeta, z, theta float64) {
 	var t0, t, tas2r, w float64
 
 	// Interval between fundamental epoch J2000.0 and start date (JC).
@@ -27,7 +27,7 @@ func TestPrec76(t *testing.T) {
 		fn  func(a1, a2, a3, a4 float64) (c1, c2, c3 float64)
 	}{
 		{"cgo", CgoPrec76},
-		{"go", GoPrec76},
+		{"go", Prec76},
 	}
 
 	for _, test := range tests {
@@ -56,7 +56,7 @@ func BenchmarkPrec76(b *testing.B) {
 		fn  func(a1, a2, a3, a4 float64) (c1, c2, c3 float64)
 	}{
 		{"cgo", CgoPrec76},
-		{"go", GoPrec76},
+		{"go", Prec76},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPv2p Discard velocity component of a pv-vector.
+func CgoPv2p(pv [2][3]float64) (p [3]float64) {
+	var cP [3]C.double
+	cPv := v3dGo2C(pv)
+	C.iauPv2p(&cPv[0], &cP[0])
+	return v3sC2Go(cP)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPv2p Discard velocity component of a pv-vector.
+//  Pv2p Discard velocity component of a pv-vector.
 //
 //  - - - - -
 //   P v 2 p
@@ -29,16 +26,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPv2p Discard velocity component of a pv-vector.
-func CgoPv2p(pv [2][3]float64) (p [3]float64) {
-	var cP [3]C.double
-	cPv := v3dGo2C(pv)
-	C.iauPv2p(&cPv[0], &cP[0])
-	return v3sC2Go(cP)
-}
-
-//  GoPv2p Discard velocity component of a pv-vector.
-func GoPv2p(pv [2][3]float64) (p [3]float64) {
+//  Pv2p Discard velocity component of a pv-vector.
+func Pv2p(pv [2][3]float64) (p [3]float64) {
 	p = pv[0]
 	return
 }
@@ -31,7 +31,7 @@ func TestPv2p(t *testing.T) {
 		fn  func([2][3]float64) [3]float64
 	}{
 		{"cgo", CgoPv2p},
-		{"go", GoPv2p},
+		{"go", Pv2p},
 	}
 
 	for _, test := range tests {
@@ -61,7 +61,7 @@ func BenchmarkPv2p(b *testing.B) {
 		fn  func([2][3]float64) [3]float64
 	}{
 		{"cgo", CgoPv2p},
-		{"go", GoPv2p},
+		{"go", Pv2p},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPv2s Convert position/velocity from Cartesian to spherical
+//  coordinates.
+func CgoPv2s(pv [2][3]float64) (theta, phi, r, td, pd, rd float64) {
+	var cTheta, cPhi, cR, cTd, cPd, cRd C.double
+	cPv := v3dGo2C(pv)
+	C.iauPv2s(&cPv[0], &cTheta, &cPhi, &cR, &cTd, &cPd, &cRd)
+	return float64(cTheta), float64(cPhi), float64(cR),
+		float64(cTd), float64(cPd), float64(cRd)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoPv2s Convert position/velocity from Cartesian to spherical
+//  Pv2s Convert position/velocity from Cartesian to spherical
 //  coordinates.
 //
 //  - - - - -
@@ -46,19 +44,9 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPv2s Convert position/velocity from Cartesian to spherical
+//  Pv2s Convert position/velocity from Cartesian to spherical
 //  coordinates.
-func CgoPv2s(pv [2][3]float64) (theta, phi, r, td, pd, rd float64) {
-	var cTheta, cPhi, cR, cTd, cPd, cRd C.double
-	cPv := v3dGo2C(pv)
-	C.iauPv2s(&cPv[0], &cTheta, &cPhi, &cR, &cTd, &cPd, &cRd)
-	return float64(cTheta), float64(cPhi), float64(cR),
-		float64(cTd), float64(cPd), float64(cRd)
-}
-
-//  GoPv2s Convert position/velocity from Cartesian to spherical
-//  coordinates.
-func GoPv2s(pv [2][3]float64) (theta, phi, r, td, pd, rd float64) {
+func Pv2s(pv [2][3]float64) (theta, phi, r, td, pd, rd float64) {
 
 	var x, y, z, xd, yd, zd, rxy2, rxy, r2, rtrue, rw, xyp float64
 
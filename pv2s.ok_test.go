@@ -31,7 +31,7 @@ func TestPv2s(t *testing.T) {
 		fn  func([2][3]float64) (c1, c2, c3, c4, c5, c6 float64)
 	}{
 		{"cgo", CgoPv2s},
-		{"go", GoPv2s},
+		{"go", Pv2s},
 	}
 
 	for _, test := range tests {
@@ -63,7 +63,7 @@ func BenchmarkPv2s(b *testing.B) {
 		fn  func([2][3]float64) (c1, c2, c3, c4, c5, c6 float64)
 	}{
 		{"cgo", CgoPv2s},
-		{"go", GoPv2s},
+		{"go", Pv2s},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauPvdpv(double a[2][3], double b[2][3], double adb[2])
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPvdpv Inner (=scalar=dot) product of two pv-vectors.
+func CgoPvdpv(a, b [2][3]float64) (adb [2]float64) {
+	var cAdb [2]C.double
+	cA, cB := v3dGo2C(a), v3dGo2C(b)
+	C.iauPvdpv(&cA[0], &cB[0], &cAdb[0])
+	return v2sC2Go(cAdb)
+}
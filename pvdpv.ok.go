@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPvdpv Inner (=scalar=dot) product of two pv-vectors.
+//  Pvdpv Inner (=scalar=dot) product of two pv-vectors.
 //
 //  - - - - - -
 //   P v d p v
@@ -37,26 +34,18 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPvdpv Inner (=scalar=dot) product of two pv-vectors.
-func CgoPvdpv(a, b [2][3]float64) (adb [2]float64) {
-	var cAdb [2]C.double
-	cA, cB := v3dGo2C(a), v3dGo2C(b)
-	C.iauPvdpv(&cA[0], &cB[0], &cAdb[0])
-	return v2sC2Go(cAdb)
-}
-
-//  GoPvdpv Inners(=scalar=dot) product of two pv-vectors.
-func GoPvdpv(a, b [2][3]float64) (adb [2]float64) {
+//  Pvdpv Inner (=scalar=dot) product of two pv-vectors.
+func Pvdpv(a, b [2][3]float64) (adb [2]float64) {
 	var adbd, addb float64
 
 	// a . b = constant part of result.
-	adb[0] = GoPdp(a[0], b[0])
+	adb[0] = Pdp(a[0], b[0])
 
 	// a . bdot
-	adbd = GoPdp(a[0], b[1])
+	adbd = Pdp(a[0], b[1])
 
 	// adot . b
-	addb = GoPdp(a[1], b[0])
+	addb = Pdp(a[1], b[0])
 
 	// Velocity part of result.
 	adb[1] = adbd + addb
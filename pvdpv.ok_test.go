@@ -39,7 +39,7 @@ func TestPvdpv(t *testing.T) {
 		fn  func(a1, a2 [2][3]float64) [2]float64
 	}{
 		{"cgo", CgoPvdpv},
-		{"go", GoPvdpv},
+		{"go", Pvdpv},
 	}
 
 	for _, test := range tests {
@@ -76,7 +76,7 @@ func BenchmarkPvdpv(bm *testing.B) {
 		fn  func(a1, a2 [2][3]float64) [2]float64
 	}{
 		{"cgo", CgoPvdpv},
-		{"go", GoPvdpv},
+		{"go", Pvdpv},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPvm Modulus of pv-vector.
+func CgoPvm(pv [2][3]float64) (r, s float64) {
+	var cR, cS C.double
+	cPv := v3dGo2C(pv)
+	C.iauPvm(&cPv[0], &cR, &cS)
+	return float64(cR), float64(cS)
+}
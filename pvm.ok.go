@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPvm Modulus of pv-vector.
+//  Pvm Modulus of pv-vector.
 //
 //  - - - -
 //   P v m
@@ -30,21 +27,13 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPvm Modulus of pv-vector.
-func CgoPvm(pv [2][3]float64) (r, s float64) {
-	var cR, cS C.double
-	cPv := v3dGo2C(pv)
-	C.iauPvm(&cPv[0], &cR, &cS)
-	return float64(cR), float64(cS)
-}
-
-//  GoPvm Modulus of pv-vector.
-func GoPvm(pv [2][3]float64) (r, s float64) {
+//  Pvm Modulus of pv-vector.
+func Pvm(pv [2][3]float64) (r, s float64) {
 
 	// Distance.
-	r = GoPm(pv[0])
+	r = Pm(pv[0])
 
 	// Speed.
-	s = GoPm(pv[1])
+	s = Pm(pv[1])
 	return
 }
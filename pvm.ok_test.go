@@ -31,7 +31,7 @@ func TestPvm(t *testing.T) {
 		fn  func([2][3]float64) (c1,c2 float64)
 	}{
 		{"cgo", CgoPvm},
-		{"go", GoPvm},
+		{"go", Pvm},
 	}
 
 	for _, test := range tests {
@@ -60,7 +60,7 @@ func BenchmarkPvm(b *testing.B) {
 		fn  func([2][3]float64) (c1,c2 float64)
 	}{
 		{"cgo", CgoPvm},
-		{"go", GoPvm},
+		{"go", Pvm},
 	}
 
 	for _, test := range tests {
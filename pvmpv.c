@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauPvmpv(double a[2][3], double b[2][3], double amb[2][3])
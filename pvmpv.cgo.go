@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPvmpv Subtract one pv-vector from another.
+func CgoPvmpv(a, b [2][3]float64) (amb [2][3]float64) {
+	cA, cB := v3dGo2C(a), v3dGo2C(b)
+	var cAmb [2][3]C.double
+	C.iauPvmpv(&cA[0], &cB[0], &cAmb[0])
+	return v3dC2Go(cAmb)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPvmpv Subtract one pv-vector from another.
+//  Pvmpv Subtract one pv-vector from another.
 //
 //  - - - - - -
 //   P v m p v
@@ -34,18 +31,10 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPvmpv Subtract one pv-vector from another.
-func CgoPvmpv(a, b [2][3]float64) (amb [2][3]float64) {
-	cA, cB := v3dGo2C(a), v3dGo2C(b)
-	var cAmb [2][3]C.double
-	C.iauPvmpv(&cA[0], &cB[0], &cAmb[0])
-	return v3dC2Go(cAmb)
-}
-
-//  GoPvmpv Subtract one pv-vector from another.
-func GoPvmpv(a, b [2][3]float64) (amb [2][3]float64) {
+//  Pvmpv Subtract one pv-vector from another.
+func Pvmpv(a, b [2][3]float64) (amb [2][3]float64) {
 
-	amb[0] = GoPmp(a[0], b[0])
-	amb[1] = GoPmp(a[1], b[1])
+	amb[0] = Pmp(a[0], b[0])
+	amb[1] = Pmp(a[1], b[1])
 	return
 }
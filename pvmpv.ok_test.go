@@ -38,7 +38,7 @@ func TestPvmpv(t *testing.T) {
 		fn  func(a1, a2 [2][3]float64) [2][3]float64
 	}{
 		{"cgo", CgoPvmpv},
-		{"go", GoPvmpv},
+		{"go", Pvmpv},
 	}
 
 	for _, test := range tests {
@@ -80,7 +80,7 @@ func BenchmarkPvmpv(b *testing.B) {
 		fn  func(a1, a2 [2][3]float64) [2][3]float64
 	}{
 		{"cgo", CgoPvmpv},
-		{"go", GoPvmpv},
+		{"go", Pvmpv},
 	}
 
 	for _, test := range tests {
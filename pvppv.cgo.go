@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPvppv Add one pv-vector to another.
+func CgoPvppv(a, b [2][3]float64) (apb [2][3]float64) {
+	var cApb [2][3]C.double
+	cA, cB := v3dGo2C(a), v3dGo2C(b)
+	C.iauPvppv(&cA[0], &cB[0], &cApb[0])
+	return v3dC2Go(cApb)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPvppv Add one pv-vector to another.
+//  Pvppv Add one pv-vector to another.
 //
 //  - - - - - -
 //   P v p p v
@@ -34,17 +31,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPvppv Add one pv-vector to another.
-func CgoPvppv(a, b [2][3]float64) (apb [2][3]float64) {
-	var cApb [2][3]C.double
-	cA, cB := v3dGo2C(a), v3dGo2C(b)
-	C.iauPvppv(&cA[0], &cB[0], &cApb[0])
-	return v3dC2Go(cApb)
-}
-
-//  GoPvppv Add one pv-vector to another.
-func GoPvppv(a, b [2][3]float64) (apb [2][3]float64) {
-	apb[0] = GoPpp(a[0], b[0])
-	apb[1] = GoPpp(a[1], b[1])
+//  Pvppv Add one pv-vector to another.
+func Pvppv(a, b [2][3]float64) (apb [2][3]float64) {
+	apb[0] = Ppp(a[0], b[0])
+	apb[1] = Ppp(a[1], b[1])
 	return
 }
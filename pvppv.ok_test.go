@@ -38,7 +38,7 @@ func TestPvppv(t *testing.T) {
 		fn  func(a1, a2 [2][3]float64) [2][3]float64
 	}{
 		{"cgo", CgoPvppv},
-		{"go", GoPvppv},
+		{"go", Pvppv},
 	}
 
 	for _, test := range tests {
@@ -80,7 +80,7 @@ func BenchmarkPvppv(bm *testing.B) {
 		fn  func(a1, a2 [2][3]float64) [2][3]float64
 	}{
 		{"cgo", CgoPvppv},
-		{"go", GoPvppv},
+		{"go", Pvppv},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauPvstar(double pv[2][3], double *ra, double *dec,
@@ -0,0 +1,24 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoPvstar Convert star position+velocity vector to catalog
+//  coordinates.
+func CgoPvstar(pv [2][3]float64) (ra, dec, pmr, pmd, px, rv float64,
+	err en.ErrNum) {
+
+	var cRa, cDec, cPmr, cPmd, cPx, cRv C.double
+	cPv := v3dGo2C(pv)
+	cI := C.iauPvstar(&cPv[0], &cRa, &cDec, &cPmr, &cPmd, &cPx, &cRv)
+	if n := int(cI); n != 0 {
+		err = errPvstar.Set(n)
+	}
+
+	return float64(cRa), float64(cDec), float64(cPmr),
+		float64(cPmd), float64(cPx), float64(cRv), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -14,7 +12,7 @@ var errPvstar = en.New(2, "Pvstar", []string{
 	"",
 })
 
-//  CgoPvstar Convert star position+velocity vector to catalog
+//  Pvstar Convert star position+velocity vector to catalog
 //  coordinates.
 //
 //  - - - - - - -
@@ -116,25 +114,9 @@ var errPvstar = en.New(2, "Pvstar", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPvstar Convert star position+velocity vector to catalog
+//  Pvstar Convert star position+velocity vector to catalog
 //  coordinates.
-func CgoPvstar(pv [2][3]float64) (ra, dec, pmr, pmd, px, rv float64,
-	err en.ErrNum) {
-
-	var cRa, cDec, cPmr, cPmd, cPx, cRv C.double
-	cPv := v3dGo2C(pv)
-	cI := C.iauPvstar(&cPv[0], &cRa, &cDec, &cPmr, &cPmd, &cPx, &cRv)
-	if n := int(cI); n != 0 {
-		err = errPvstar.Set(n)
-	}
-
-	return float64(cRa), float64(cDec), float64(cPmr),
-		float64(cPmd), float64(cPx), float64(cRv), err
-}
-
-//  GoPvstar Convert star position+velocity vector to catalog
-//  coordinates.
-func GoPvstar(pv [2][3]float64) (ra, dec, pmr, pmd, px, rv float64,
+func Pvstar(pv [2][3]float64) (ra, dec, pmr, pmd, px, rv float64,
 	err en.ErrNum) {
 
 	var r, vr, vt, bett, betr, d, w, del, a, rad, decd, rd float64
@@ -142,14 +124,14 @@ func GoPvstar(pv [2][3]float64) (ra, dec, pmr, pmd, px, rv float64,
 
 	// Isolate the radial component of the velocity (au/day,
 	// inertial).
-	r, x = GoPn(pv[0])
-	vr = GoPdp(x, pv[1])
-	ur = GoSxp(vr, x)
+	r, x = Pn(pv[0])
+	vr = Pdp(x, pv[1])
+	ur = Sxp(vr, x)
 
 	// Isolate the transverse component of the velocity (au/day,
 	// inertial).
-	ut = GoPmp(pv[1], ur)
-	vt = GoPm(ut)
+	ut = Pmp(pv[1], ur)
+	vt = Pm(ut)
 
 	// Special-relativity dimensionless parameters.
 	bett = vt / DC
@@ -169,25 +151,25 @@ func GoPvstar(pv [2][3]float64) (ra, dec, pmr, pmd, px, rv float64,
 	if betr != 0 {
 		w = (betr - del) / (betr * d)
 	}
-	usr = GoSxp(w, ur)
+	usr = Sxp(w, ur)
 
 	// Apply relativistic correction factor to tangential velocity
 	// component.
-	ust = GoSxp(1.0/d, ut)
+	ust = Sxp(1.0/d, ut)
 
 	// Combine the two to obtain the observed velocity vector
 	// (au/day).
-	pv[1] = GoPpp(usr, ust)
+	pv[1] = Ppp(usr, ust)
 
 	// Cartesian to spherical.
-	a, dec, r, rad, decd, rd = GoPv2s(pv)
+	a, dec, r, rad, decd, rd = Pv2s(pv)
 	if r == 0.0 {
 		err = errPvstar.Set(-2)
 		return
 	}
 
 	// Return RA in range 0 to 2pi.
-	ra = GoAnp(a)
+	ra = Anp(a)
 
 	// Return proper motions in radians per year.
 	pmr = rad * DJY
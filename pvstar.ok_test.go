@@ -37,7 +37,7 @@ func TestPvstar(t *testing.T) {
 			c7 en.ErrNum)
 	}{
 		{"cgo", CgoPvstar},
-		{"go", GoPvstar},
+		{"go", Pvstar},
 	}
 
 	for _, test := range tests {
@@ -75,7 +75,7 @@ func BenchmarkPvstar(b *testing.B) {
 			c7 en.ErrNum)
 	}{
 		{"cgo", CgoPvstar},
-		{"go", GoPvstar},
+		{"go", Pvstar},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,23 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPvtob Position and velocity of a terrestrial observing station.
+// void iauPvtob(double elong, double phi, double hm,
+//               double xp, double yp, double sp, double theta,
+//               double pv[2][3])
+func CgoPvtob(
+	elong, phi, hm, xp, yp, sp, theta float64) (pv [2][3]float64) {
+
+	var cPv [2][3]C.double
+
+	C.iauPvtob(C.double(elong), C.double(phi), C.double(hm),
+		C.double(xp), C.double(yp), C.double(sp),
+		C.double(theta), &cPv[0])
+
+	return v3dC2Go(cPv)
+}
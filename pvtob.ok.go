@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoPvtob Position and velocity of a terrestrial observing station.
+//  Pvtob Position and velocity of a terrestrial observing station.
 //
 //  - - - - - -
 //   P v t o b
@@ -72,24 +70,11 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPvtob Position and velocity of a terrestrial observing station.
+//  Pvtob Position and velocity of a terrestrial observing station.
 // void iauPvtob(double elong, double phi, double hm,
 //               double xp, double yp, double sp, double theta,
 //               double pv[2][3])
-func CgoPvtob(
-	elong, phi, hm, xp, yp, sp, theta float64) (pv [2][3]float64) {
-
-	var cPv [2][3]C.double
-
-	C.iauPvtob(C.double(elong), C.double(phi), C.double(hm),
-		C.double(xp), C.double(yp), C.double(sp),
-		C.double(theta), &cPv[0])
-
-	return v3dC2Go(cPv)
-}
-
-// GoPvtob Position and velocity of a terrestrial observing station.
-func GoPvtob(elong, phi, hm, xp, yp, sp, theta float64) (pv [2][3]float64) {
+func Pvtob(elong, phi, hm, xp, yp, sp, theta float64) (pv [2][3]float64) {
 	// Earth rotation rate in radians per UT1 second
 	const OM = 1.00273781191135448 * D2PI / DAYSEC
 
@@ -99,11 +84,11 @@ func GoPvtob(elong, phi, hm, xp, yp, sp, theta float64) (pv [2][3]float64) {
 	var x, y, z, s, c float64
 
 	// Geodetic to geocentric transformation (WGS84).
-	xyzm, _ = GoGd2gc(1, elong, phi, hm)
+	xyzm, _ = Gd2gc(1, elong, phi, hm)
 
 	// Polar motion and TIO position.
-	rpm = GoPom00(xp, yp, sp)
-	xyz = GoTrxp(rpm, xyzm)
+	rpm = Pom00(xp, yp, sp)
+	xyz = Trxp(rpm, xyzm)
 	x = xyz[0]
 	y = xyz[1]
 	z = xyz[2]
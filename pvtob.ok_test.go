@@ -31,7 +31,7 @@ func TestPvtob(t *testing.T) {
 			a5, a6, a7 float64) [2][3]float64
 	}{
 		{"cgo", CgoPvtob},
-		{"go", GoPvtob},
+		{"go", Pvtob},
 	}
 
 	for _, test := range tests {
@@ -68,7 +68,7 @@ func BenchmarkPvtob(b *testing.B) {
 			a5, a6, a7 float64) [2][3]float64
 	}{
 		{"cgo", CgoPvtob},
-		{"go", GoPvtob},
+		{"go", Pvtob},
 	}
 
 	for _, test := range tests {
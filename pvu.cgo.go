@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPvu Update a pv-vector.
+func CgoPvu(dt float64, pv [2][3]float64) (upv [2][3]float64) {
+	var cUpv [2][3]C.double
+	cPv := v3dGo2C(pv)
+	C.iauPvu(C.double(dt), &cPv[0], &cUpv[0])
+	return v3dC2Go(cUpv)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPvu Update a pv-vector.
+//  Pvu Update a pv-vector.
 //
 //  - - - -
 //   P v u
@@ -40,18 +37,10 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPvu Update a pv-vector.
-func CgoPvu(dt float64, pv [2][3]float64) (upv [2][3]float64) {
-	var cUpv [2][3]C.double
-	cPv := v3dGo2C(pv)
-	C.iauPvu(C.double(dt), &cPv[0], &cUpv[0])
-	return v3dC2Go(cUpv)
-}
-
-//  GoPvu Update a pv-vector.
-func GoPvu(dt float64, pv [2][3]float64) (upv [2][3]float64) {
+//  Pvu Update a pv-vector.
+func Pvu(dt float64, pv [2][3]float64) (upv [2][3]float64) {
 
-	upv[0] = GoPpsp(pv[0], dt, pv[1])
+	upv[0] = Ppsp(pv[0], dt, pv[1])
 	upv[1] = pv[1]
 	return
 }
@@ -30,7 +30,7 @@ func TestPvu(t *testing.T) {
 		fn  func(float64, [2][3]float64) [2][3]float64
 	}{
 		{"cgo", CgoPvu},
-		{"go", GoPvu},
+		{"go", Pvu},
 	}
 
 	for _, test := range tests {
@@ -69,7 +69,7 @@ func BenchmarkPvu(b *testing.B) {
 		fn  func(float64, [2][3]float64) [2][3]float64
 	}{
 		{"cgo", CgoPvu},
-		{"go", GoPvu},
+		{"go", Pvu},
 	}
 
 	for _, test := range tests {
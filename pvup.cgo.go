@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPvup Update a pv-vector, discarding the velocity component.
+func CgoPvup(dt float64, pv [2][3]float64) (p [3]float64) {
+	var cP [3]C.double
+	cPv := v3dGo2C(pv)
+	C.iauPvup(C.double(dt), &cPv[0], &cP[0])
+	return v3sC2Go(cP)
+}
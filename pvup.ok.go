@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPvup Update a pv-vector, discarding the velocity component.
+//  Pvup Update a pv-vector, discarding the velocity component.
 //
 //  - - - - -
 //   P v u p
@@ -34,16 +31,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPvup Update a pv-vector, discarding the velocity component.
-func CgoPvup(dt float64, pv [2][3]float64) (p [3]float64) {
-	var cP [3]C.double
-	cPv := v3dGo2C(pv)
-	C.iauPvup(C.double(dt), &cPv[0], &cP[0])
-	return v3sC2Go(cP)
-}
-
-//  GoPvup Update a pv-vector, discarding the velocity component.
-func GoPvup(dt float64, pv [2][3]float64) (p [3]float64) {
+//  Pvup Update a pv-vector, discarding the velocity component.
+func Pvup(dt float64, pv [2][3]float64) (p [3]float64) {
 	p[0] = pv[0][0] + dt*pv[1][0]
 	p[1] = pv[0][1] + dt*pv[1][1]
 	p[2] = pv[0][2] + dt*pv[1][2]
@@ -31,7 +31,7 @@ func TestPvup(t *testing.T) {
 		fn  func(float64, [2][3]float64) [3]float64
 	}{
 		{"cgo", CgoPvup},
-		{"go", GoPvup},
+		{"go", Pvup},
 	}
 
 	for _, test := range tests {
@@ -61,7 +61,7 @@ func BenchmarkPvup(b *testing.B) {
 		fn  func(float64, [2][3]float64) [3]float64
 	}{
 		{"cgo", CgoPvup},
-		{"go", GoPvup},
+		{"go", Pvup},
 	}
 
 	for _, test := range tests {
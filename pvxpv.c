@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauPvxpv(double a[2][3], double b[2][3], double axb[2][3])
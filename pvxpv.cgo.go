@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPvxpv Outer (=vector=cross) product of two pv-vectors.
+func CgoPvxpv(a, b [2][3]float64) (axb [2][3]float64) {
+	var cAxb [2][3]C.double
+	cA, cB := v3dGo2C(a), v3dGo2C(b)
+	C.iauPvxpv(&cA[0], &cB[0], &cAxb[0])
+	return v3dC2Go(cAxb)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPvxpv Outer (=vector=cross) product of two pv-vectors.
+//  Pvxpv Outer (=vector=cross) product of two pv-vectors.
 //
 //  - - - - - -
 //   P v x p v
@@ -42,25 +39,17 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPvxpv Outer (=vector=cross) product of two pv-vectors.
-func CgoPvxpv(a, b [2][3]float64) (axb [2][3]float64) {
-	var cAxb [2][3]C.double
-	cA, cB := v3dGo2C(a), v3dGo2C(b)
-	C.iauPvxpv(&cA[0], &cB[0], &cAxb[0])
-	return v3dC2Go(cAxb)
-}
-
-//  GoPvxpv Outer (=vector=cross) product of two pv-vectors.
-func GoPvxpv(a, b [2][3]float64) (axb [2][3]float64) {
+//  Pvxpv Outer (=vector=cross) product of two pv-vectors.
+func Pvxpv(a, b [2][3]float64) (axb [2][3]float64) {
 
 	var axbd, adxb [3]float64
 
 	// a x b = position part of result.
-	axb[0] = GoPxp(a[0], b[0])
+	axb[0] = Pxp(a[0], b[0])
 
 	// a x bdot + adot x b = velocity part of result.
-	axbd = GoPxp(a[0], b[1])
-	adxb = GoPxp(a[1], b[0])
-	axb[1] = GoPpp(axbd, adxb)
+	axbd = Pxp(a[0], b[1])
+	adxb = Pxp(a[1], b[0])
+	axb[1] = Ppp(axbd, adxb)
 	return
 }
@@ -39,7 +39,7 @@ func TestPvxpv(t *testing.T) {
 		fn  func(a1,a2 [2][3]float64) [2][3]float64
 	}{
 		{"cgo", CgoPvxpv},
-		{"go", GoPvxpv},
+		{"go", Pvxpv},
 	}
 
 	for _, test := range tests {
@@ -81,7 +81,7 @@ func BenchmarkPvxpv(bm *testing.B) {
 		fn  func(a1,a2 [2][3]float64) [2][3]float64
 	}{
 		{"cgo", CgoPvxpv},
-		{"go", GoPvxpv},
+		{"go", Pvxpv},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoPxp p-vector outer (=vector=cross) product.
+func CgoPxp(a, b [3]float64) (axb [3]float64) {
+	var cAxb [3]C.double
+	cA, cB := v3sGo2C(a), v3sGo2C(b)
+	C.iauPxp(&cA[0], &cB[0], &cAxb[0])
+	return v3sC2Go(cAxb)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoPxp p-vector outer (=vector=cross) product.
+//  Pxp p-vector outer (=vector=cross) product.
 //
 //  - - - -
 //   P x p
@@ -33,16 +30,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoPxp p-vector outer (=vector=cross) product.
-func CgoPxp(a, b [3]float64) (axb [3]float64) {
-	var cAxb [3]C.double
-	cA, cB := v3sGo2C(a), v3sGo2C(b)
-	C.iauPxp(&cA[0], &cB[0], &cAxb[0])
-	return v3sC2Go(cAxb)
-}
-
-//  GoPxp p-vector outer (=vector=cross) product.
-func GoPxp(a, b [3]float64) (axb [3]float64) {
+//  Pxp p-vector outer (=vector=cross) product.
+func Pxp(a, b [3]float64) (axb [3]float64) {
 
 	var xa, ya, za, xb, yb, zb float64
 
@@ -30,7 +30,7 @@ func TestPxp(t *testing.T) {
 		fn  func(a1, a2 [3]float64) [3]float64
 	}{
 		{"cgo", CgoPxp},
-		{"go", GoPxp},
+		{"go", Pxp},
 	}
 
 	for _, test := range tests {
@@ -60,7 +60,7 @@ func BenchmarkPxp(b *testing.B) {
 		fn  func(a1, a2 [3]float64) [3]float64
 	}{
 		{"cgo", CgoPxp},
-		{"go", GoPxp},
+		{"go", Pxp},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoRefco Determine the constants A and B in the atmospheric
+//  refraction model dZ = A tan Z + B tan^3 Z.
+func CgoRefco(phpa, tc, rh, wl float64) (refa, refb float64) {
+	var cRefa, cRefb C.double
+	C.iauRefco(C.double(phpa), C.double(tc), C.double(rh),
+		C.double(wl), &cRefa, &cRefb)
+	return float64(cRefa), float64(cRefb)
+}
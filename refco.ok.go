@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoRefco Determine the constants A and B in the atmospheric
+//  Refco Determine the constants A and B in the atmospheric
 //  refraction model dZ = A tan Z + B tan^3 Z.
 //
 //  - - - - - -
@@ -157,18 +155,9 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoRefco Determine the constants A and B in the atmospheric
+//  Refco Determine the constants A and B in the atmospheric
 //  refraction model dZ = A tan Z + B tan^3 Z.
-func CgoRefco(phpa, tc, rh, wl float64) (refa, refb float64) {
-	var cRefa, cRefb C.double
-	C.iauRefco(C.double(phpa), C.double(tc), C.double(rh),
-		C.double(wl), &cRefa, &cRefb)
-	return float64(cRefa), float64(cRefb)
-}
-
-// GoRefco Determine the constants A and B in the atmospheric refraction
-// model dZ = A tan Z + B tan^3 Z.
-func GoRefco(phpa, tc, rh, wl float64) (refa, refb float64) {
+func Refco(phpa, tc, rh, wl float64) (refa, refb float64) {
 
 	var optic bool
 	var p, t, r, w, ps, pw, tk, wlsq, gamma, beta float64
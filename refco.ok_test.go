@@ -27,7 +27,7 @@ func TestRefco(t *testing.T) {
 		fn  func(a1,a2,a3,a4 float64) (a5, a6 float64)
 	}{
 		{"cgo", CgoRefco},
-		{"go", GoRefco},
+		{"go", Refco},
 	}
 
 	for _, test := range tests {
@@ -54,7 +54,7 @@ func BenchmarkRefco(b *testing.B) {
 		fn  func(a1,a2,a3,a4 float64) (a5, a6 float64)
 	}{
 		{"cgo", CgoRefco},
-		{"go", GoRefco},
+		{"go", Refco},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,334 @@
+package sofa
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var errRiseSetDays = errors.New("search interval is negative")
+
+// HorizonRefraction is the conventional refraction at the horizon,
+// 34 arcminutes.
+const HorizonRefraction = 34.0 * 60.0 * DAS2R
+
+// Horizon defines the altitude at which a target rises and sets:  the
+// geometric altitude of its centre, or of its upper limb if Limb is
+// set, at the event is Altitude less Refraction.
+type Horizon struct {
+	Altitude   float64 // altitude of the horizon (radians)
+	Refraction float64 // refraction at that altitude (radians)
+	Limb       bool    // the upper limb rather than the centre
+}
+
+// The horizons of the usual events.  StandardHorizon gives the rising
+// and setting of the upper limb on a sea horizon, as tabulated for the
+// Sun and the Moon;  the twilights are given by the centre of the Sun.
+var (
+	StandardHorizon      = Horizon{Refraction: HorizonRefraction, Limb: true}
+	CivilTwilight        = Horizon{Altitude: -6.0 * DD2R}
+	NauticalTwilight     = Horizon{Altitude: -12.0 * DD2R}
+	AstronomicalTwilight = Horizon{Altitude: -18.0 * DD2R}
+)
+
+// Site is a place on the Earth.
+type Site struct {
+	Elong float64 // longitude (radians, east +ve)
+	Phi   float64 // geodetic latitude (radians)
+	Hm    float64 // height above the WGS84 ellipsoid (m)
+}
+
+// Star is a star of the ICRS catalogue, given as to Atco13.
+type Star struct {
+	RA, Dec     float64 // ICRS at J2000.0 (radians)
+	PMRA, PMDec float64 // proper motion (radians/year, RA as dRA/dt)
+	Parallax    float64 // arcsec
+	RV          float64 // radial velocity (km/s, +ve if receding)
+}
+
+// Target is an object of which the events are sought.
+type Target interface {
+	// Place returns the target as a star of the ICRS catalogue at
+	// the TDB date date1+date2, taking the solar system from eph, and
+	// its geocentric semidiameter (radians).
+	Place(eph Ephemeris, date1, date2 float64) (s Star, sd float64,
+		err error)
+}
+
+// Place returns the star, which has no semidiameter.
+func (s Star) Place(eph Ephemeris, date1, date2 float64) (Star, float64,
+	error) {
+	return s, 0, nil
+}
+
+// Body is a solar system body, by its NAIF code, as a Target.
+type Body struct {
+	Code   int
+	Radius float64 // km, for the semidiameter;  if zero, that of Code
+}
+
+// bodyRadii are the equatorial radii (km) of the bodies, from the IAU
+// WG on cartographic coordinates;  a barycentre is given the radius of
+// its planet.
+var bodyRadii = map[int]float64{
+	NaifSun: 695700.0, NaifMoon: 1738.1,
+	1: 2440.53, NaifMercury: 2440.53, 2: 6051.8, NaifVenus: 6051.8,
+	NaifEarth: 6378.137, NaifMars: 3396.19, 499: 3396.19,
+	NaifJupiter: 71492.0, 599: 71492.0, NaifSaturn: 60268.0,
+	699: 60268.0, NaifUranus: 25559.0, 799: 25559.0,
+	NaifNeptune: 24764.0, 899: 24764.0,
+}
+
+// Place returns the body as a star with the barycentric direction and
+// distance, at the time of departure of the light that reaches the
+// Earth at date1+date2, so that Atciq applies the parallax of the
+// observer.  The semidiameter is that seen from the centre of the
+// Earth.
+func (b Body) Place(eph Ephemeris, date1, date2 float64) (s Star,
+	sd float64, err error) {
+	eph = ephemeris(eph)
+	var warn error
+	_, pvb, err := eph.Epv(date1, date2)
+	if err = ephemerisErr(err, &warn); err != nil {
+		return s, 0, err
+	}
+
+	// Iterate for the light time.
+	var pv [2][3]float64
+	var dl float64
+	for i := 0; i < 3; i++ {
+		pv, err = eph.Body(b.Code, date1, date2-dl)
+		if err = ephemerisErr(err, &warn); err != nil {
+			return s, 0, err
+		}
+		dl = Pm(Pmp(pv[0], pvb[0])) / DC
+	}
+	s.RA, s.Dec = C2s(pv[0])
+	s.RA = Anp(s.RA)
+	s.Parallax = DR2AS / Pm(pv[0])
+
+	r := b.Radius
+	if r == 0 {
+		r = bodyRadii[b.Code]
+	}
+	sd = math.Asin(math.Min(r*1e3/(dl*DC*DAU), 1.0))
+	return s, sd, warn
+}
+
+// SkyEventKind is the kind of a SkyEvent.
+type SkyEventKind int
+
+// The kinds of events.
+const (
+	EventRise SkyEventKind = iota
+	EventSet
+	EventUpperTransit
+	EventLowerTransit
+)
+
+var skyEventNames = [...]string{"rise", "set", "upper transit",
+	"lower transit"}
+
+func (k SkyEventKind) String() string {
+	if k < 0 || int(k) >= len(skyEventNames) {
+		return fmt.Sprintf("SkyEventKind(%d)", int(k))
+	}
+	return skyEventNames[k]
+}
+
+// SkyEvent is the rising, setting or transit of a target.
+type SkyEvent struct {
+	Kind       SkyEventKind
+	UTC1, UTC2 float64 // UTC 2-part quasi Julian Date
+	Az, El     float64 // geometric azimuth and altitude (radians)
+
+	// Above reports whether the target is above the horizon, which
+	// for a transit tells whether the target is circumpolar (at a
+	// lower transit) or never rises (at an upper transit).
+	Above bool
+}
+
+// skyFinder evaluates a target for a site.
+type skyFinder struct {
+	eph  Ephemeris
+	t    Target
+	site Site
+	eop  EOPSource
+	h0   float64 // altitude of the event
+	limb bool
+}
+
+// skyPoint is a target seen at an instant.
+type skyPoint struct {
+	utc1, utc2 float64
+	az, el, ha float64
+	g          float64 // altitude above that of the event
+}
+
+// at returns the target seen from the site at utc1+utc2, with no
+// refraction.
+func (f *skyFinder) at(utc1, utc2 float64) (p skyPoint, err error) {
+	var e EOP
+	if f.eop != nil {
+		if e, err = f.eop.EOP(utc1, utc2); err != nil {
+			return p, err
+		}
+	}
+	var astr ASTROM
+	astr, _, err = Apco13Eph(f.eph, utc1, utc2, e.DUT1, f.site.Elong,
+		f.site.Phi, f.site.Hm, e.Xp, e.Yp, 0, 0, 0, 0, astr)
+	if err != nil && !IsWarning(err) {
+		return p, err
+	}
+	tai1, tai2, _ := Utctai(utc1, utc2)
+	tt1, tt2, _ := Taitt(tai1, tai2)
+	s, sd, err := f.t.Place(f.eph, tt1, tt2)
+	if err != nil && !IsWarning(err) {
+		return p, err
+	}
+	ri, di := Atciq(s.RA, s.Dec, s.PMRA, s.PMDec, s.Parallax, s.RV, astr)
+	aob, zob, hob, _, _ := Atioq(ri, di, astr)
+	p = skyPoint{utc1: utc1, utc2: utc2, az: aob, el: DPI/2 - zob,
+		ha: hob}
+	p.g = p.el - f.h0
+	if f.limb {
+		p.g += sd
+	}
+	return p, nil
+}
+
+// SkyEvents returns the risings, settings and transits of the target t
+// seen from the site in the days from the UTC date utc1+utc2, in order
+// of time.  The solar system is taken from eph, or from
+// DefaultEphemeris if eph is nil, and the Earth orientation from eop,
+// if not nil.  The times are found to about 0.1 s.
+//
+// The altitude of a target is taken to change monotonically between
+// its transits, which holds for the stars, the Sun and the planets at
+// all latitudes but may miss a grazing rising of the Moon near the
+// poles.  A target that stays above or below the horizon has only its
+// transits, the Above field of which tells which.
+func SkyEvents(eph Ephemeris, t Target, site Site, eop EOPSource,
+	h Horizon, utc1, utc2, days float64) ([]SkyEvent, error) {
+	if days < 0 {
+		return nil, errRiseSetDays
+	}
+	f := skyFinder{eph: eph, t: t, site: site, eop: eop,
+		h0: h.Altitude - h.Refraction, limb: h.Limb}
+
+	// Find the transits by stepping the hour angle, then the rising
+	// and setting between each pair of them.
+	const step = 1.0 / 24.0
+	prev, err := f.at(utc1, utc2)
+	if err != nil {
+		return nil, err
+	}
+	bounds := []skyPoint{prev}
+	var es []SkyEvent
+	n := int(math.Ceil(days / step))
+	for i := 1; i <= n; i++ {
+		p, err := f.at(utc1, utc2+math.Min(float64(i)*step, days))
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range []SkyEventKind{EventUpperTransit,
+			EventLowerTransit} {
+			a, b := transitAngle(k, prev.ha), transitAngle(k, p.ha)
+			if a < 0 && b >= 0 && b-a < DPI {
+				tp, err := f.bisect(prev, p, func(q skyPoint) bool {
+					return transitAngle(k, q.ha) >= 0
+				})
+				if err != nil {
+					return nil, err
+				}
+				bounds = append(bounds, tp)
+				es = append(es, f.event(k, tp))
+			}
+		}
+		prev = p
+	}
+	bounds = append(bounds, prev)
+
+	for i := 1; i < len(bounds); i++ {
+		a, b := bounds[i-1], bounds[i]
+		if (a.g >= 0) == (b.g >= 0) {
+			continue
+		}
+		k := EventRise
+		if a.g >= 0 {
+			k = EventSet
+		}
+		p, err := f.bisect(a, b, func(q skyPoint) bool {
+			return (q.g >= 0) == (b.g >= 0)
+		})
+		if err != nil {
+			return nil, err
+		}
+		es = append(es, f.event(k, p))
+	}
+	sortSkyEvents(es)
+	return es, nil
+}
+
+// Twilight returns the dawns, as EventRise, and the dusks, as
+// EventSet, of the twilight h, such as CivilTwilight, seen from the
+// site in the days from the UTC date utc1+utc2.
+func Twilight(eph Ephemeris, site Site, eop EOPSource, h Horizon,
+	utc1, utc2, days float64) ([]SkyEvent, error) {
+	es, err := SkyEvents(eph, Body{Code: NaifSun}, site, eop, h, utc1,
+		utc2, days)
+	if err != nil {
+		return nil, err
+	}
+	tw := es[:0]
+	for _, e := range es {
+		if e.Kind == EventRise || e.Kind == EventSet {
+			tw = append(tw, e)
+		}
+	}
+	return tw, nil
+}
+
+// transitAngle returns the hour angle ha from the upper or the lower
+// meridian, in the range -pi to +pi.
+func transitAngle(k SkyEventKind, ha float64) float64 {
+	if k == EventLowerTransit {
+		return Anpm(ha - DPI)
+	}
+	return Anpm(ha)
+}
+
+func (f *skyFinder) event(k SkyEventKind, p skyPoint) SkyEvent {
+	return SkyEvent{Kind: k, UTC1: p.utc1, UTC2: p.utc2, Az: p.az,
+		El: p.el, Above: p.g >= 0}
+}
+
+// bisect returns the point at which after first holds between a,
+// where it does not, and b, where it does.
+func (f *skyFinder) bisect(a, b skyPoint, after func(skyPoint) bool) (
+	skyPoint, error) {
+	const tol = 1e-6 // days
+	for (b.utc1-a.utc1)+(b.utc2-a.utc2) > tol {
+		m, err := f.at(a.utc1, a.utc2+0.5*((b.utc1-a.utc1)+
+			(b.utc2-a.utc2)))
+		if err != nil {
+			return m, err
+		}
+		if after(m) {
+			b = m
+		} else {
+			a = m
+		}
+	}
+	return b, nil
+}
+
+// sortSkyEvents sorts es into order of time.
+func sortSkyEvents(es []SkyEvent) {
+	for i := 1; i < len(es); i++ {
+		for j := i; j > 0 && (es[j].UTC1-es[j-1].UTC1)+
+			(es[j].UTC2-es[j-1].UTC2) < 0; j-- {
+			es[j], es[j-1] = es[j-1], es[j]
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package sofa
+
+import "testing"
+
+// kinds returns the kinds of the events es.
+func kinds(es []SkyEvent) []SkyEventKind {
+	k := make([]SkyEventKind, len(es))
+	for i, e := range es {
+		k[i] = e.Kind
+	}
+	return k
+}
+
+func sameKinds(a, b []SkyEventKind) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSkyEventsSun(t *testing.T) {
+	const fname = "SkyEvents Sun"
+	london := Site{Elong: -0.1278 * DD2R, Phi: 51.5074 * DD2R}
+	sun := Body{Code: NaifSun}
+
+	// 2020 June 21, sunrise 03:43 and sunset 20:21 UTC.
+	es, err := SkyEvents(nil, sun, london, nil, StandardHorizon,
+		2459021.5, 0.0, 1.0)
+	errT(t, nil, err, fname, "err")
+	want := []SkyEventKind{EventLowerTransit, EventRise,
+		EventUpperTransit, EventSet}
+	if !sameKinds(kinds(es), want) {
+		t.Fatalf("%s: want %v got %v", fname, want, kinds(es))
+	}
+	vvd(t, es[1].UTC2*24.0, 3.0+43.0/60.0, 1.0/60.0, fname, "rise")
+	vvd(t, es[3].UTC2*24.0, 20.0+21.0/60.0, 1.0/60.0, fname, "set")
+	vvd(t, es[2].Az, DPI, 1e-4, fname, "transit az")
+
+	// At the event the upper limb is at the horizon.
+	f := skyFinder{t: sun, site: london}
+	_, sd, _ := sun.Place(nil, es[1].UTC1, es[1].UTC2)
+	for _, e := range []SkyEvent{es[1], es[3]} {
+		p, _ := f.at(e.UTC1, e.UTC2)
+		vvd(t, p.el+sd, -HorizonRefraction, 1e-5, fname, "limb")
+	}
+
+	// The Sun is 15 degrees down at midnight, so there is no
+	// astronomical night.
+	tw, err := Twilight(nil, london, nil, AstronomicalTwilight,
+		2459021.5, 0.0, 1.0)
+	errT(t, nil, err, fname, "twilight err")
+	viv(t, len(tw), 0, fname, "astronomical")
+	tw, _ = Twilight(nil, london, nil, CivilTwilight, 2459021.5, 0.0,
+		1.0)
+	if !sameKinds(kinds(tw), []SkyEventKind{EventRise, EventSet}) ||
+		tw[0].UTC2 > es[1].UTC2 || tw[1].UTC2 < es[3].UTC2 {
+		t.Errorf("%s: civil twilight %v", fname, tw)
+	}
+}
+
+func TestSkyEventsPolar(t *testing.T) {
+	const fname = "SkyEvents polar"
+	tromso := Site{Elong: 18.96 * DD2R, Phi: 69.65 * DD2R}
+	sun := Body{Code: NaifSun}
+
+	// Midnight Sun and polar night.
+	for _, test := range []struct {
+		utc1  float64
+		above bool
+	}{{2459021.5, true}, {2459204.5, false}} {
+		es, err := SkyEvents(nil, sun, tromso, nil, StandardHorizon,
+			test.utc1, 0.0, 2.0)
+		errT(t, nil, err, fname, "err")
+		viv(t, len(es), 4, fname, "transits")
+		for _, e := range es {
+			if e.Kind != EventUpperTransit && e.Kind != EventLowerTransit ||
+				e.Above != test.above {
+				t.Errorf("%s: got %v above %v", fname, e.Kind, e.Above)
+			}
+		}
+	}
+
+	// At the North Pole the Sun rises once, at the March equinox.
+	pole := Site{Phi: DPI / 2}
+	es, err := SkyEvents(nil, sun, pole, nil, StandardHorizon,
+		2458910.5, 0.0, 20.0)
+	errT(t, nil, err, fname, "pole err")
+	n := 0
+	for _, e := range es {
+		if e.Kind == EventRise {
+			n++
+			if e.UTC1+e.UTC2 < 2458920.5 || e.UTC1+e.UTC2 > 2458930.5 {
+				t.Errorf("%s: pole rise at %v", fname, e.UTC1+e.UTC2)
+			}
+		} else if e.Kind == EventSet {
+			t.Errorf("%s: pole set", fname)
+		}
+	}
+	viv(t, n, 1, fname, "pole rises")
+}
+
+func TestSkyEventsStar(t *testing.T) {
+	const fname = "SkyEvents star"
+	sirius := Star{RA: 1.7677943, Dec: -0.2917512, PMRA: -2.6e-8,
+		PMDec: -5.9e-9, Parallax: 0.379, RV: -5.5}
+	polaris := Star{RA: 0.6622870, Dec: 1.5579526}
+	site := Site{Elong: 2.35 * DD2R, Phi: 48.84 * DD2R}
+
+	es, err := SkyEvents(nil, sirius, site, nil, Horizon{}, 2459000.5,
+		0.0, 3.0)
+	errT(t, nil, err, fname, "err")
+	var rises []SkyEvent
+	for _, e := range es {
+		if e.Kind == EventRise {
+			rises = append(rises, e)
+		}
+	}
+	viv(t, len(rises), 3, fname, "rises")
+
+	// A sidereal day apart, rising in the south-east.
+	for i := 1; i < len(rises); i++ {
+		vvd(t, (rises[i].UTC1-rises[i-1].UTC1)+(rises[i].UTC2-
+			rises[i-1].UTC2), 0.99726957, 2e-5, fname, "sidereal day")
+	}
+	if rises[0].Az < DPI/2 || rises[0].Az > DPI {
+		t.Errorf("%s: rising at azimuth %g", fname, rises[0].Az/DD2R)
+	}
+
+	// Polaris is circumpolar, and Sirius never rises at 80 degrees
+	// north.
+	es, _ = SkyEvents(nil, polaris, site, nil, Horizon{}, 2459000.5,
+		0.0, 1.0)
+	for _, e := range es {
+		if !e.Above || e.Kind == EventRise || e.Kind == EventSet {
+			t.Errorf("%s: Polaris %v above %v", fname, e.Kind, e.Above)
+		}
+	}
+	site.Phi = 80.0 * DD2R
+	es, _ = SkyEvents(nil, sirius, site, nil, Horizon{}, 2459000.5,
+		0.0, 1.0)
+	for _, e := range es {
+		if e.Above || e.Kind == EventRise || e.Kind == EventSet {
+			t.Errorf("%s: Sirius %v above %v", fname, e.Kind, e.Above)
+		}
+	}
+
+	if _, err = SkyEvents(nil, sirius, site, nil, Horizon{}, 2459000.5,
+		0.0, -1.0); err != errRiseSetDays {
+		t.Errorf("%s: want %v got %v", fname, errRiseSetDays, err)
+	}
+}
+
+func TestBodyPlace(t *testing.T) {
+	const fname = "Body Place"
+	date1, date2 := 2459021.5, 0.5
+
+	// The Sun, 16 arcminutes across at 1.016 au, and the Moon at the
+	// time its light left it.
+	_, pvb, _ := Epv00(date1, date2)
+	s, sd, err := Body{Code: NaifSun}.Place(nil, date1, date2)
+	errT(t, nil, err, fname, "Sun err")
+	vvd(t, sd, 15.74*60.0*DAS2R, 0.05*60.0*DAS2R, fname, "Sun sd")
+	sb := S2p(s.RA, s.Dec, DR2AS/s.Parallax)
+	vvd(t, Pm(Pmp(sb, pvb[0])), 1.0163, 1e-3, fname, "Sun distance")
+
+	m, sd, err := Body{Code: NaifMoon}.Place(nil, date1, date2)
+	errT(t, nil, err, fname, "Moon err")
+	if sd < 14.0*60.0*DAS2R || sd > 17.0*60.0*DAS2R {
+		t.Errorf("%s: Moon semidiameter %g", fname, sd/DAS2R)
+	}
+	mb := S2p(m.RA, m.Dec, DR2AS/m.Parallax)
+	dl := Pm(Pmp(mb, pvb[0])) / DC
+	_, pve, _ := Epv00(date1, date2-dl)
+	g := Pvppv(pve, Moon98(date1, date2-dl))
+	vvd(t, Sepp(mb, g[0]), 0, 1e-9, fname, "Moon")
+
+	_, _, err = Body{Code: 42}.Place(nil, date1, date2)
+	if err == nil {
+		t.Errorf("%s: want an error for body 42", fname)
+	}
+}
+
+func BenchmarkSkyEvents(b *testing.B) {
+	site := Site{Elong: 2.35 * DD2R, Phi: 48.84 * DD2R}
+	for i := 0; i < b.N; i++ {
+		SkyEvents(nil, Body{Code: NaifSun}, site, nil, StandardHorizon,
+			2459000.5, 0.0, 1.0)
+	}
+}
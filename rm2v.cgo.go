@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoRm2v Express an r-matrix as an r-vector.
+func CgoRm2v(r [3][3]float64) (w [3]float64) {
+	var cW [3]C.double
+	cR := v3tGo2C(r)
+	C.iauRm2v(&cR[0], &cW[0])
+	return v3sC2Go(cW)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoRm2v Express an r-matrix as an r-vector.
+//  Rm2v Express an r-matrix as an r-vector.
 //
 //  - - - - -
 //   R m 2 v
@@ -42,16 +40,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoRm2v Express an r-matrix as an r-vector.
-func CgoRm2v(r [3][3]float64) (w [3]float64) {
-	var cW [3]C.double
-	cR := v3tGo2C(r)
-	C.iauRm2v(&cR[0], &cW[0])
-	return v3sC2Go(cW)
-}
-
-//  GoRm2v Express an r-matrix as an r-vector.
-func GoRm2v(r [3][3]float64) (w [3]float64) {
+//  Rm2v Express an r-matrix as an r-vector.
+func Rm2v(r [3][3]float64) (w [3]float64) {
 	var x, y, z, s2, c2, phi, f float64
 
 	x = r[1][2] - r[2][1]
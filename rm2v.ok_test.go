@@ -35,7 +35,7 @@ func TestRm2v(t *testing.T) {
 		fn  func([3][3]float64) [3]float64
 	}{
 		{"cgo", CgoRm2v},
-		{"go", GoRm2v},
+		{"go", Rm2v},
 	}
 
 	for _, test := range tests {
@@ -69,7 +69,7 @@ func BenchmarkRm2v(b *testing.B) {
 		fn  func([3][3]float64) [3]float64
 	}{
 		{"cgo", CgoRm2v},
-		{"go", GoRm2v},
+		{"go", Rm2v},
 	}
 
 	for _, test := range tests {
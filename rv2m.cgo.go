@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoRv2m Form the r-matrix corresponding to a given r-vector.
+func CgoRv2m(w [3]float64) (r [3][3]float64) {
+	var cR [3][3]C.double
+	cW := v3sGo2C(w)
+	C.iauRv2m(&cW[0], &cR[0])
+	return v3tC2Go(cR)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoRv2m Form the r-matrix corresponding to a given r-vector.
+//  Rv2m Form the r-matrix corresponding to a given r-vector.
 //
 //  - - - - -
 //   R v 2 m
@@ -39,16 +37,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoRv2m Form the r-matrix corresponding to a given r-vector.
-func CgoRv2m(w [3]float64) (r [3][3]float64) {
-	var cR [3][3]C.double
-	cW := v3sGo2C(w)
-	C.iauRv2m(&cW[0], &cR[0])
-	return v3tC2Go(cR)
-}
-
-//  GoRv2m Form the r-matrix corresponding to a given r-vector.
-func GoRv2m(w [3]float64) (r [3][3]float64) {
+//  Rv2m Form the r-matrix corresponding to a given r-vector.
+func Rv2m(w [3]float64) (r [3][3]float64) {
 	var x, y, z, phi, s, c, f float64
 
 	// Euler angle (magnitude of rotation vector) and functions.
@@ -27,7 +27,7 @@ func TestRv2m(t *testing.T) {
 		fn  func([3]float64) [3][3]float64
 	}{
 		{"cgo", CgoRv2m},
-		{"go", GoRv2m},
+		{"go", Rv2m},
 	}
 
 	for _, test := range tests {
@@ -61,7 +61,7 @@ func BenchmarkRv2m(b *testing.B) {
 		fn  func([3]float64) [3][3]float64
 	}{
 		{"cgo", CgoRv2m},
-		{"go", GoRv2m},
+		{"go", Rv2m},
 	}
 
 	for _, test := range tests {
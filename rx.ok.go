@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
 //  Rx Rotate an r-matrix about the x-axis.
@@ -41,15 +39,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoRx Rotate an r-matrix about the x-axis.
-func CgoRx(phi float64, r [3][3]float64) (rotated [3][3]float64) {
-	cR := v3tGo2C(r)
-	C.iauRx(C.double(phi), &cR[0])
-	return v3tC2Go(cR)
-}
-
-// GoRx Rotate an r-matrix about the x-axis.
-func GoRx(phi float64, r [3][3]float64) (rotated [3][3]float64) {
+//  Rx Rotate an r-matrix about the x-axis.
+func Rx(phi float64, r [3][3]float64) (rotated [3][3]float64) {
 	var s, c, a10, a11, a12, a20, a21, a22 float64
 
 	s = math.Sin(phi)
@@ -36,7 +36,7 @@ func TestRx(t *testing.T) {
 		fn  func(float64, [3][3]float64) [3][3]float64
 	}{
 		{"cgo", CgoRx},
-		{"go", GoRx},
+		{"go", Rx},
 	}
 
 	for _, test := range tests {
@@ -86,7 +86,7 @@ func BenchmarkRx(b *testing.B) {
 		fn  func(float64, [3][3]float64) [3][3]float64
 	}{
 		{"cgo", CgoRx},
-		{"go", GoRx},
+		{"go", Rx},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoRxp Multiply a p-vector by an r-matrix.
+func CgoRxp(r [3][3]float64, p [3]float64) (rp [3]float64) {
+	var cRp [3]C.double
+	cR := v3tGo2C(r)
+	cP := v3sGo2C(p)
+	C.iauRxp(&cR[0], &cP[0], &cRp[0])
+	return v3sC2Go(cRp)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoRxp Multiply a p-vector by an r-matrix.
+//  Rxp Multiply a p-vector by an r-matrix.
 //
 //  - - - -
 //   R x p
@@ -33,17 +30,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoRxp Multiply a p-vector by an r-matrix.
-func CgoRxp(r [3][3]float64, p [3]float64) (rp [3]float64) {
-	var cRp [3]C.double
-	cR := v3tGo2C(r)
-	cP := v3sGo2C(p)
-	C.iauRxp(&cR[0], &cP[0], &cRp[0])
-	return v3sC2Go(cRp)
-}
-
-// GoRxp Multiply a p-vector by an r-matrix.
-func GoRxp(r [3][3]float64, p [3]float64) (rp [3]float64) {
+//  Rxp Multiply a p-vector by an r-matrix.
+func Rxp(r [3][3]float64, p [3]float64) (rp [3]float64) {
 	var w float64
 	var wrp [3]float64
 	var i, j int
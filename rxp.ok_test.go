@@ -42,7 +42,7 @@ func TestRxp(t *testing.T) {
 		fn  func([3][3]float64, [3]float64) [3]float64
 	}{
 		{"cgo", CgoRxp},
-		{"go", GoRxp},
+		{"go", Rxp},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -79,7 +79,7 @@ func BenchmarkRxp(b *testing.B) {
 		fn  func([3][3]float64, [3]float64) [3]float64
 	}{
 		{"cgo", CgoRxp},
-		{"go", GoRxp},
+		{"go", Rxp},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoRxpv Multiply a pv-vector by an r-matrix.
+func CgoRxpv(r [3][3]float64, pv [2][3]float64) (rpv [2][3]float64) {
+	var cRpv [2][3]C.double
+	cR := v3tGo2C(r)
+	cPv := v3dGo2C(pv)
+	C.iauRxpv(&cR[0], &cPv[0], &cRpv[0])
+	return v3dC2Go(cRpv)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoRxpv Multiply a pv-vector by an r-matrix.
+//  Rxpv Multiply a pv-vector by an r-matrix.
 //
 //  - - - - -
 //   R x p v
@@ -33,18 +30,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoRxpv Multiply a pv-vector by an r-matrix.
-func CgoRxpv(r [3][3]float64, pv [2][3]float64) (rpv [2][3]float64) {
-	var cRpv [2][3]C.double
-	cR := v3tGo2C(r)
-	cPv := v3dGo2C(pv)
-	C.iauRxpv(&cR[0], &cPv[0], &cRpv[0])
-	return v3dC2Go(cRpv)
-}
-
-//  GoRxpv Multiply a pv-vector by an r-matrix.
-func GoRxpv(r [3][3]float64, pv [2][3]float64) (rpv [2][3]float64) {
-	rpv[0] = GoRxp(r, pv[0])
-	rpv[1] = GoRxp(r, pv[1])
+//  Rxpv Multiply a pv-vector by an r-matrix.
+func Rxpv(r [3][3]float64, pv [2][3]float64) (rpv [2][3]float64) {
+	rpv[0] = Rxp(r, pv[0])
+	rpv[1] = Rxp(r, pv[1])
 	return
 }
@@ -43,7 +43,7 @@ func TestRxpv(t *testing.T) {
 		fn  func([3][3]float64, [2][3]float64) [2][3]float64
 	}{
 		{"cgo", CgoRxpv},
-		{"go", GoRxpv},
+		{"go", Rxpv},
 	}
 
 	for _, test := range tests {
@@ -90,7 +90,7 @@ func BenchmarkRxpv(b *testing.B) {
 		fn  func([3][3]float64, [2][3]float64) [2][3]float64
 	}{
 		{"cgo", CgoRxpv},
-		{"go", GoRxpv},
+		{"go", Rxpv},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauRxr(double a[3][3], double b[3][3], double atb[3][3])
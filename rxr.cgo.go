@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoRxr is the SOFA C version of Rxr.
+func CgoRxr(a, b [3][3]float64) (atb [3][3]float64) {
+	var cAtb [3][3]C.double
+	cA := v3tGo2C(a)
+	cB := v3tGo2C(b)
+	C.iauRxr(&cA[0], &cB[0], &cAtb[0])
+	return v3tC2Go(cAtb)
+}
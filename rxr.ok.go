@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoRxt Multiply two r-matrices.
+//  Rxr Multiply two r-matrices.
 //
 //  - - - -
 //   R x r
@@ -31,17 +28,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoRxt Multiply two r-matrices.
-func CgoRxr(a, b [3][3]float64) (atb [3][3]float64) {
-	var cAtb [3][3]C.double
-	cA := v3tGo2C(a)
-	cB := v3tGo2C(b)
-	C.iauRxr(&cA[0], &cB[0], &cAtb[0])
-	return v3tC2Go(cAtb)
-}
-
-// GoRxt Multiply two r-matrices.
-func GoRxr(a, b [3][3]float64) (atb [3][3]float64) {
+//  Rxr Multiply two r-matrices.
+func Rxr(a, b [3][3]float64) (atb [3][3]float64) {
 	var i, j, k int
 	var w float64
 
@@ -46,7 +46,7 @@ func TestRxr(t *testing.T) {
 		fn  func(c, d [3][3]float64) [3][3]float64
 	}{
 		{"cgo", CgoRxr},
-		{"go", GoRxr},
+		{"go", Rxr},
 	}
 
 	for _, test := range tests {
@@ -100,7 +100,7 @@ func BenchmarkRxr(b *testing.B) {
 		fn  func(c, d [3][3]float64) [3][3]float64
 	}{
 		{"cgo", CgoRxr},
-		{"go", GoRxr},
+		{"go", Rxr},
 	}
 
 	for _, test := range tests {
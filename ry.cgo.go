@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoRy Rotate an r-matrix about the y-axis.
+func CgoRy(theta float64, r [3][3]float64) (rotated [3][3]float64) {
+	cR := v3tGo2C(r)
+	C.iauRy(C.double(theta), &cR[0])
+	return v3tC2Go(cR)
+}
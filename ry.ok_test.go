@@ -37,7 +37,7 @@ func TestRy(t *testing.T) {
 		fn  func(float64, [3][3]float64) [3][3]float64
 	}{
 		{"cgo", CgoRy},
-		{"go", GoRy},
+		{"go", Ry},
 	}
 
 	for _, test := range tests {
@@ -83,7 +83,7 @@ func BenchmarkRy(b *testing.B) {
 		fn  func(float64, [3][3]float64) [3][3]float64
 	}{
 		{"cgo", CgoRy},
-		{"go", GoRy},
+		{"go", Ry},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoRz Rotate an r-matrix about the z-axis.
+func CgoRz(psi float64, r [3][3]float64) (rotated [3][3]float64) {
+	cR := v3tGo2C(r)
+	C.iauRz(C.double(psi), &cR[0])
+	return v3tC2Go(cR)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoRz Rotate an r-matrix about the z-axis.
+//  Rz Rotate an r-matrix about the z-axis.
 //
 //  - - -
 //   R z
@@ -41,15 +39,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoRz Rotate an r-matrix about the z-axis.
-func CgoRz(psi float64, r [3][3]float64) (rotated [3][3]float64) {
-	cR := v3tGo2C(r)
-	C.iauRz(C.double(psi), &cR[0])
-	return v3tC2Go(cR)
-}
-
-// GoRz Rotate an r-matrix about the z-axis.
-func GoRz(psi float64, r [3][3]float64) (rotated [3][3]float64) {
+//  Rz Rotate an r-matrix about the z-axis.
+func Rz(psi float64, r [3][3]float64) (rotated [3][3]float64) {
 	var s, c, a00, a01, a02, a10, a11, a12 float64
 
 	s = math.Sin(psi)
@@ -37,7 +37,7 @@ func TestRz(t *testing.T) {
 		fn  func(float64, [3][3]float64) [3][3]float64
 	}{
 		{"cgo", CgoRz},
-		{"go", GoRz},
+		{"go", Rz},
 	}
 
 	for _, test := range tests {
@@ -81,7 +81,7 @@ func BenchmarkRz(b *testing.B) {
 		fn  func(float64, [3][3]float64) [3][3]float64
 	}{
 		{"cgo", CgoRz},
-		{"go", GoRz},
+		{"go", Rz},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,18 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoS00 The CIO locator s, positioning the Celestial Intermediate
+//  Origin on the equator of the Celestial Intermediate Pole, given the
+//  CIP's X,Y coordinates.  Compatible with IAU 2000A
+//  precession-nutation.
+func CgoS00(date1, date2, x, y float64) float64 {
+	var cF C.double
+	cF = C.iauS00(C.double(date1), C.double(date2), C.double(x),
+		C.double(y))
+	return float64(cF)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoS00 The CIO locator s, positioning the Celestial Intermediate
+//  S00 The CIO locator s, positioning the Celestial Intermediate
 //  Origin on the equator of the Celestial Intermediate Pole, given the
 //  CIP's X,Y coordinates.  Compatible with IAU 2000A
 //  precession-nutation.
@@ -90,22 +88,11 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoS00 The CIO locator s, positioning the Celestial Intermediate
+//  S00 The CIO locator s, positioning the Celestial Intermediate
 //  Origin on the equator of the Celestial Intermediate Pole, given the
 //  CIP's X,Y coordinates.  Compatible with IAU 2000A
 //  precession-nutation.
-func CgoS00(date1, date2, x, y float64) float64 {
-	var cF C.double
-	cF = C.iauS00(C.double(date1), C.double(date2), C.double(x),
-		C.double(y))
-	return float64(cF)
-}
-
-//  GoS00 The CIO locator s, positioning the Celestial Intermediate
-//  Origin on the equator of the Celestial Intermediate Pole, given the
-//  CIP's X,Y coordinates.  Compatible with IAU 2000A
-//  precession-nutation.
-func GoS00(date1, date2, x, y float64) float64 {
+func S00(date1, date2, x, y float64) float64 {
 
 	// Time math.Since J2000.0, in Julian centuries
 	var t float64
@@ -262,28 +249,28 @@ func GoS00(date1, date2, x, y float64) float64 {
 	// Fundamental Arguments (from IERS Conventions 2003)
 
 	// Mean anomaly of the Moon.
-	fa[0] = GoFal03(t)
+	fa[0] = Fal03(t)
 
 	// Mean anomaly of the Sun.
-	fa[1] = GoFalp03(t)
+	fa[1] = Falp03(t)
 
 	// Mean longitude of the Moon minus that of the ascending node.
-	fa[2] = GoFaf03(t)
+	fa[2] = Faf03(t)
 
 	// Mean elongation of the Moon from the Sun.
-	fa[3] = GoFad03(t)
+	fa[3] = Fad03(t)
 
 	// Mean longitude of the ascending node of the Moon.
-	fa[4] = GoFaom03(t)
+	fa[4] = Faom03(t)
 
 	// Mean longitude of Venus.
-	fa[5] = GoFave03(t)
+	fa[5] = Fave03(t)
 
 	// Mean longitude of Earth.
-	fa[6] = GoFae03(t)
+	fa[6] = Fae03(t)
 
 	// General precession in longitude.
-	fa[7] = GoFapa03(t)
+	fa[7] = Fapa03(t)
 
 	// Evaluate s.
 	w0 = sp[0]
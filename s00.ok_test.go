@@ -25,7 +25,7 @@ func TestS00(t *testing.T) {
 		fn  func(a1, a2, a3, a4 float64) float64
 	}{
 		{"cgo", CgoS00},
-		{"go", GoS00},
+		{"go", S00},
 	}
 
 	for _, test := range tests {
@@ -48,7 +48,7 @@ func BenchmarkS00(b *testing.B) {
 		fn  func(a1, a2, a3, a4 float64) float64
 	}{
 		{"cgo", CgoS00},
-		{"go", GoS00},
+		{"go", S00},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoS00a The CIO locator s, positioning the Celestial Intermediate
+//  Origin on the equator of the Celestial Intermediate Pole, using the
+//  IAU 2000A precession-nutation model.
+func CgoS00a(date1, date2 float64) (s float64) {
+	var cF C.double
+	cF = C.iauS00a(C.double(date1), C.double(date2))
+	return float64(cF)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoS00a The CIO locator s, positioning the Celestial Intermediate
+//  S00a The CIO locator s, positioning the Celestial Intermediate
 //  Origin on the equator of the Celestial Intermediate Pole, using the
 //  IAU 2000A precession-nutation model.
 //
@@ -81,30 +78,21 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoS00a The CIO locator s, positioning the Celestial Intermediate
-//  Origin on the equator of the Celestial Intermediate Pole, using the
-//  IAU 2000A precession-nutation model.
-func CgoS00a(date1, date2 float64) (s float64) {
-	var cF C.double
-	cF = C.iauS00a(C.double(date1), C.double(date2))
-	return float64(cF)
-}
-
-//  GoS00a The CIO locator s, positioning the Celestial Intermediate
+//  S00a The CIO locator s, positioning the Celestial Intermediate
 //  Origin on the equator of the Celestial Intermediate Pole, using the
 //  IAU 2000A precession-nutation model.
-func GoS00a(date1, date2 float64) (s float64) {
+func S00a(date1, date2 float64) (s float64) {
 
 	var rbpn [3][3]float64
 	var x, y float64
 
 	// Bias-precession-nutation-matrix, IAU 2000A.
-	rbpn = GoPnm00a(date1, date2)
+	rbpn = Pnm00a(date1, date2)
 
 	// Extract the CIP coordinates.
-	x, y = GoBpn2xy(rbpn)
+	x, y = Bpn2xy(rbpn)
 
 	// Compute the CIO locator s, given the CIP coordinates.
-	s = GoS00(date1, date2, x, y)
+	s = S00(date1, date2, x, y)
 	return
 }
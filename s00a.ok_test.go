@@ -22,7 +22,7 @@ func TestS00a(t *testing.T) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoS00a},
-		{"go", GoS00a},
+		{"go", S00a},
 	}
 
 	for _, test := range tests {
@@ -40,7 +40,7 @@ func BenchmarkS00a(b *testing.B) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoS00a},
-		{"go", GoS00a},
+		{"go", S00a},
 	}
 
 	for _, test := range tests {
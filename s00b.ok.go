@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoS00b The CIO locator s, positioning the Celestial Intermediate
+//  S00b The CIO locator s, positioning the Celestial Intermediate
 //  Origin on the equator of the Celestial Intermediate Pole, using the
 //  IAU 2000B precession-nutation model.
 //
@@ -81,29 +78,20 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoS00b The CIO locator s, positioning the Celestial Intermediate
-//  Origin on the equator of the Celestial Intermediate Pole, using the
-//  IAU 2000B precession-nutation model.
-func CgoS00b(date1, date2 float64) (s float64) {
-	var cS C.double
-	cS = C.iauS00b(C.double(date1), C.double(date2))
-	return float64(cS)
-}
-
-//  GoS00b The CIO locator s, positioning the Celestial Intermediate
+//  S00b The CIO locator s, positioning the Celestial Intermediate
 //  Origin on the equator of the Celestial Intermediate Pole, using the
 //  IAU 2000B precession-nutation model.
-func GoS00b(date1, date2 float64) (s float64) {
+func S00b(date1, date2 float64) (s float64) {
 	var rbpn [3][3]float64
 	var x, y float64
 
 	// Bias-precession-nutation-matrix, IAU 2000B.
-	rbpn = GoPnm00b(date1, date2)
+	rbpn = Pnm00b(date1, date2)
 
 	// Extract the CIP coordinates.
-	x, y = GoBpn2xy(rbpn)
+	x, y = Bpn2xy(rbpn)
 
 	// Compute the CIO locator s, given the CIP coordinates.
-	s = GoS00(date1, date2, x, y)
+	s = S00(date1, date2, x, y)
 	return
 }
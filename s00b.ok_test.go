@@ -22,7 +22,7 @@ func TestS00b(t *testing.T) {
 		fn  func(a1,a2 float64) float64
 	}{
 		{"cgo", CgoS00b},
-		{"go", GoS00b},
+		{"go", S00b},
 	}
 
 	for _, test := range tests {
@@ -40,7 +40,7 @@ func BenchmarkS00b(b *testing.B) {
 		fn  func(a1,a2 float64) float64
 	}{
 		{"cgo", CgoS00b},
-		{"go", GoS00b},
+		{"go", S00b},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoS06 The CIO locator s, positioning the Celestial Intermediate
+//  Origin on the equator of the Celestial Intermediate Pole, given the
+//  CIP's X,Y coordinates.  Compatible with IAU 2006/2000A
+//  precession-nutation.
+func CgoS06(date1, date2, x, y float64) (cio float64) {
+	var cCio C.double
+	cCio = C.iauS06(C.double(date1), C.double(date2), C.double(x), C.double(y))
+	return float64(cCio)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoS06 The CIO locator s, positioning the Celestial Intermediate
+//  S06 The CIO locator s, positioning the Celestial Intermediate
 //  Origin on the equator of the Celestial Intermediate Pole, given the
 //  CIP's X,Y coordinates.  Compatible with IAU 2006/2000A
 //  precession-nutation.
@@ -87,21 +85,11 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoS06 The CIO locator s, positioning the Celestial Intermediate
+//  S06 The CIO locator s, positioning the Celestial Intermediate
 //  Origin on the equator of the Celestial Intermediate Pole, given the
 //  CIP's X,Y coordinates.  Compatible with IAU 2006/2000A
 //  precession-nutation.
-func CgoS06(date1, date2, x, y float64) (cio float64) {
-	var cCio C.double
-	cCio = C.iauS06(C.double(date1), C.double(date2), C.double(x), C.double(y))
-	return float64(cCio)
-}
-
-//  GoS06 The CIO locator s, positioning the Celestial Intermediate
-//  Origin on the equator of the Celestial Intermediate Pole, given the
-//  CIP's X,Y coordinates.  Compatible with IAU 2006/2000A
-//  precession-nutation.
-func GoS06(date1, date2, x, y float64) (cio float64) {
+func S06(date1, date2, x, y float64) (cio float64) {
 	// Time since J2000.0, in Julian centuries
 	var t float64
 
@@ -257,28 +245,28 @@ func GoS06(date1, date2, x, y float64) (cio float64) {
 	// Fundamental Arguments (from IERS Conventions 2003)
 
 	// Mean anomaly of the Moon.
-	fa[0] = GoFal03(t)
+	fa[0] = Fal03(t)
 
 	// Mean anomaly of the Sun.
-	fa[1] = GoFalp03(t)
+	fa[1] = Falp03(t)
 
 	// Mean longitude of the Moon minus that of the ascending node.
-	fa[2] = GoFaf03(t)
+	fa[2] = Faf03(t)
 
 	// Mean elongation of the Moon from the Sun.
-	fa[3] = GoFad03(t)
+	fa[3] = Fad03(t)
 
 	// Mean longitude of the ascending node of the Moon.
-	fa[4] = GoFaom03(t)
+	fa[4] = Faom03(t)
 
 	// Mean longitude of Venus.
-	fa[5] = GoFave03(t)
+	fa[5] = Fave03(t)
 
 	// Mean longitude of Earth.
-	fa[6] = GoFae03(t)
+	fa[6] = Fae03(t)
 
 	// General precession in longitude.
-	fa[7] = GoFapa03(t)
+	fa[7] = Fapa03(t)
 
 	// Evaluate s.
 	w0 = sp[0]
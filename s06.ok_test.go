@@ -23,7 +23,7 @@ func TestS06(t *testing.T) {
 		fn  func(a1, a2, a3, a4 float64) float64
 	}{
 		{"cgo", CgoS06},
-		{"go", GoS06},
+		{"go", S06},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -41,7 +41,7 @@ func BenchmarkS06(b *testing.B) {
 		fn  func(a1, a2, a3, a4 float64) float64
 	}{
 		{"cgo", CgoS06},
-		{"go", GoS06},
+		{"go", S06},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoS06a The CIO locator s, positioning the Celestial Intermediate
+//  Origin on the equator of the Celestial Intermediate Pole, using the
+//  IAU 2006 precession and IAU 2000A nutation models.
+func CgoS06a(date1, date2 float64) (s float64) {
+	var cS C.double
+	cS = C.iauS06a(C.double(date1), C.double(date2))
+	return float64(cS)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoS06a The CIO locator s, positioning the Celestial Intermediate
+//  S06a The CIO locator s, positioning the Celestial Intermediate
 //  Origin on the equator of the Celestial Intermediate Pole, using the
 //  IAU 2006 precession and IAU 2000A nutation models.
 //
@@ -83,29 +80,20 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoS06a The CIO locator s, positioning the Celestial Intermediate
-//  Origin on the equator of the Celestial Intermediate Pole, using the
-//  IAU 2006 precession and IAU 2000A nutation models.
-func CgoS06a(date1, date2 float64) (s float64) {
-	var cS C.double
-	cS = C.iauS06a(C.double(date1), C.double(date2))
-	return float64(cS)
-}
-
-//  GoS06a The CIO locator s, positioning the Celestial Intermediate
+//  S06a The CIO locator s, positioning the Celestial Intermediate
 //  Origin on the equator of the Celestial Intermediate Pole, using the
 //  IAU 2006 precession and IAU 2000A nutation models.
-func GoS06a(date1, date2 float64) (s float64) {
+func S06a(date1, date2 float64) (s float64) {
 	var rnpb [3][3]float64
 	var x, y float64
 
 	// Bias-precession-nutation-matrix, IAU 20006/2000A.
-	rnpb = GoPnm06a(date1, date2)
+	rnpb = Pnm06a(date1, date2)
 
 	// Extract the CIP coordinates.
-	x, y = GoBpn2xy(rnpb)
+	x, y = Bpn2xy(rnpb)
 
 	// Compute the CIO locator s, given the CIP coordinates.
-	s = GoS06(date1, date2, x, y)
+	s = S06(date1, date2, x, y)
 	return
 }
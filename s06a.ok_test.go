@@ -22,7 +22,7 @@ func TestS06a(t *testing.T) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoS06a},
-		{"go", GoS06a},
+		{"go", S06a},
 	}
 
 	for _, test := range tests {
@@ -39,7 +39,7 @@ func BenchmarkS06a(b *testing.B) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoS06a},
-		{"go", GoS06a},
+		{"go", S06a},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoS2c Convert spherical coordinates to Cartesian.
+func CgoS2c(theta, phi float64) (c [3]float64) {
+	var cC [3]C.double
+	C.iauS2c(C.double(theta), C.double(phi), &cC[0])
+	return v3sC2Go(cC)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoS2c Convert spherical coordinates to Cartesian.
+//  S2c Convert spherical coordinates to Cartesian.
 //
 //  - - - -
 //   S 2 c
@@ -28,15 +26,8 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoS2c Convert spherical coordinates to Cartesian.
-func CgoS2c(theta, phi float64) (c [3]float64) {
-	var cC [3]C.double
-	C.iauS2c(C.double(theta), C.double(phi), &cC[0])
-	return v3sC2Go(cC)
-}
-
-//  GoS2c Convert spherical coordinates to Cartesian.
-func GoS2c(theta, phi float64) (c [3]float64) {
+//  S2c Convert spherical coordinates to Cartesian.
+func S2c(theta, phi float64) (c [3]float64) {
 	var cp float64
 	cp = math.Cos(phi)
 	c[0] = math.Cos(theta) * cp
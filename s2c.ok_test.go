@@ -21,7 +21,7 @@ func TestS2c(t *testing.T) {
 		fn  func(a1, a2 float64) [3]float64
 	}{
 		{"cgo", CgoS2c},
-		{"go", GoS2c},
+		{"go", S2c},
 	}
 
 	for _, test := range tests {
@@ -41,7 +41,7 @@ func BenchmarkS2c(b *testing.B) {
 		fn  func(a1, a2 float64) [3]float64
 	}{
 		{"cgo", CgoS2c},
-		{"go", GoS2c},
+		{"go", S2c},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,14 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoS2p Convert spherical polar coordinates to p-vector.
+func CgoS2p(theta, phi, r float64) (p [3]float64) {
+	var cP [3]C.double
+	C.iauS2p(C.double(theta), C.double(phi), C.double(r), &cP[0])
+	return v3sC2Go(cP)
+}
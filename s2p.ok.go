@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoS2p Convert spherical polar coordinates to p-vector.
+//  S2p Convert spherical polar coordinates to p-vector.
 //
 //  - - - -
 //   S 2 p
@@ -32,19 +29,12 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoS2p Convert spherical polar coordinates to p-vector.
-func CgoS2p(theta, phi, r float64) (p [3]float64) {
-	var cP [3]C.double
-	C.iauS2p(C.double(theta), C.double(phi), C.double(r), &cP[0])
-	return v3sC2Go(cP)
-}
-
-//  GoS2p Convert spherical polar coordinates to p-vector.
-func GoS2p(theta, phi, r float64) (p [3]float64) {
+//  S2p Convert spherical polar coordinates to p-vector.
+func S2p(theta, phi, r float64) (p [3]float64) {
 
 	var u [3]float64
 
-	u = GoS2c(theta, phi)
-	p = GoSxp(r, u)
+	u = S2c(theta, phi)
+	p = Sxp(r, u)
 	return
 }
@@ -22,7 +22,7 @@ func TestS2p(t *testing.T) {
 		fn  func(a1, a2, a3 float64) [3]float64
 	}{
 		{"cgo", CgoS2p},
-		{"go", GoS2p},
+		{"go", S2p},
 	}
 
 	for _, test := range tests {
@@ -42,7 +42,7 @@ func BenchmarkS2p(b *testing.B) {
 		fn  func(a1, a2, a3 float64) [3]float64
 	}{
 		{"cgo", CgoS2p},
-		{"go", GoS2p},
+		{"go", S2p},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoS2pv Convert position/velocity from spherical to Cartesian
+//  coordinates.
+func CgoS2pv(theta, phi, r, td, pd, rd float64) (pv [2][3]float64) {
+	var cPv [2][3]C.double
+	C.iauS2pv(C.double(theta), C.double(phi), C.double(r),
+		C.double(td), C.double(pd), C.double(rd), &cPv[0])
+	return v3dC2Go(cPv)
+}
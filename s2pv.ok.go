@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoS2pv Convert position/velocity from spherical to Cartesian
+//  S2pv Convert position/velocity from spherical to Cartesian
 //  coordinates.
 //
 //  - - - - -
@@ -33,18 +31,9 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoS2pv Convert position/velocity from spherical to Cartesian
+//  S2pv Convert position/velocity from spherical to Cartesian
 //  coordinates.
-func CgoS2pv(theta, phi, r, td, pd, rd float64) (pv [2][3]float64) {
-	var cPv [2][3]C.double
-	C.iauS2pv(C.double(theta), C.double(phi), C.double(r),
-		C.double(td), C.double(pd), C.double(rd), &cPv[0])
-	return v3dC2Go(cPv)
-}
-
-//  GoS2pv Convert position/velocity from spherical to Cartesian
-//  coordinates.
-func GoS2pv(theta, phi, r, td, pd, rd float64) (pv [2][3]float64) {
+func S2pv(theta, phi, r, td, pd, rd float64) (pv [2][3]float64) {
 
 	var st, ct, sp, cp, rcp, x, y, rpd, w float64
 
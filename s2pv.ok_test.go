@@ -22,7 +22,7 @@ func TestS2pv(t *testing.T) {
 		fn  func(a1, a2, a3, a4, a5, a6 float64) [2][3]float64
 	}{
 		{"cgo", CgoS2pv},
-		{"go", GoS2pv},
+		{"go", S2pv},
 	}
 
 	for _, test := range tests {
@@ -53,7 +53,7 @@ func BenchmarkS2pv(b *testing.B) {
 		fn  func(a1, a2, a3, a4, a5, a6 float64) [2][3]float64
 	}{
 		{"cgo", CgoS2pv},
-		{"go", GoS2pv},
+		{"go", S2pv},
 	}
 
 	for _, test := range tests {
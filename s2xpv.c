@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauS2xpv(double s1, double s2, double pv[2][3], double spv[2][3])
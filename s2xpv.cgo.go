@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoS2xpv Multiply a pv-vector by two scalars.
+func CgoS2xpv(s1, s2 float64, pv [2][3]float64) (spv [2][3]float64) {
+	var cSpv [2][3]C.double
+	cPv := v3dGo2C(pv)
+	C.iauS2xpv(C.double(s1), C.double(s2), &cPv[0], &cSpv[0])
+	return v3dC2Go(cSpv)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoS2xpv Multiply a pv-vector by two scalars.
+//  S2xpv Multiply a pv-vector by two scalars.
 //
 //  - - - - - -
 //   S 2 x p v
@@ -34,18 +31,10 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoS2xpv Multiply a pv-vector by two scalars.
-func CgoS2xpv(s1, s2 float64, pv [2][3]float64) (spv [2][3]float64) {
-	var cSpv [2][3]C.double
-	cPv := v3dGo2C(pv)
-	C.iauS2xpv(C.double(s1), C.double(s2), &cPv[0], &cSpv[0])
-	return v3dC2Go(cSpv)
-}
-
-//  GoS2xpv Multiply a pv-vector by two scalars.
-func GoS2xpv(s1, s2 float64, pv [2][3]float64) (spv [2][3]float64) {
+//  S2xpv Multiply a pv-vector by two scalars.
+func S2xpv(s1, s2 float64, pv [2][3]float64) (spv [2][3]float64) {
 
-	spv[0] = GoSxp(s1, pv[0])
-	spv[1] = GoSxp(s2, pv[1])
+	spv[0] = Sxp(s1, pv[0])
+	spv[1] = Sxp(s2, pv[1])
 	return
 }
@@ -34,7 +34,7 @@ func TestS2xpv(t *testing.T) {
 		fn  func(a1, a2 float64, a3 [2][3]float64) [2][3]float64
 	}{
 		{"cgo", CgoS2xpv},
-		{"go", GoS2xpv},
+		{"go", S2xpv},
 	}
 
 	for _, test := range tests {
@@ -72,7 +72,7 @@ func BenchmarkS2xpv(b *testing.B) {
 		fn  func(a1, a2 float64, a3 [2][3]float64) [2][3]float64
 	}{
 		{"cgo", CgoS2xpv},
-		{"go", GoS2xpv},
+		{"go", S2xpv},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoSepp Angular separation between two p-vectors.
+func CgoSepp(a, b [3]float64) (r float64) {
+	var cR C.double
+	cA, cB := v3sGo2C(a), v3sGo2C(b)
+	cR = C.iauSepp(&cA[0], &cB[0])
+	return float64(cR)
+}
@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoSepp Angular separation between two p-vectors.
+//  Sepp Angular separation between two p-vectors.
 //
 //  - - - - -
 //   S e p p
@@ -43,27 +41,19 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoSepp Angular separation between two p-vectors.
-func CgoSepp(a, b [3]float64) (r float64) {
-	var cR C.double
-	cA, cB := v3sGo2C(a), v3sGo2C(b)
-	cR = C.iauSepp(&cA[0], &cB[0])
-	return float64(cR)
-}
-
-//  GoSepp Angular separation between two p-vectors.
-func GoSepp(a, b [3]float64) (s float64) {
+//  Sepp Angular separation between two p-vectors.
+func Sepp(a, b [3]float64) (s float64) {
 
 	var axb [3]float64
 	var ss, cs float64
 
 	// Sine of angle between the vectors, multiplied by the two
 	// moduli.
-	axb = GoPxp(a, b)
-	ss = GoPm(axb)
+	axb = Pxp(a, b)
+	ss = Pm(axb)
 
 	// Cosine of the angle, multiplied by the two moduli.
-	cs = GoPdp(a, b)
+	cs = Pdp(a, b)
 
 	// The angle.
 	if (ss != 0.0) || (cs != 0.0) {
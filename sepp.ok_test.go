@@ -31,7 +31,7 @@ func TestSepp(t *testing.T) {
 		fn  func(a1, a2 [3]float64) float64
 	}{
 		{"cgo", CgoSepp},
-		{"go", GoSepp},
+		{"go", Sepp},
 	}
 
 	for _, test := range tests {
@@ -59,7 +59,7 @@ func BenchmarkSepp(bm *testing.B) {
 		fn  func(a1, a2 [3]float64) float64
 	}{
 		{"cgo", CgoSepp},
-		{"go", GoSepp},
+		{"go", Sepp},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoSeps Angular separation between two sets of spherical
+//  coordinates.
+func CgoSeps(al, ap, bl, bp float64) float64 {
+	var cF C.double
+	cF = C.iauSeps(C.double(al), C.double(ap), C.double(bl),
+	C.double(bp))
+	return float64(cF)
+}
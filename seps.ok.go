@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoSeps Angular separation between two sets of spherical
+//  Seps Angular separation between two sets of spherical
 //  coordinates.
 //
 //  - - - - -
@@ -34,28 +31,19 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoSeps Angular separation between two sets of spherical
-//  coordinates.
-func CgoSeps(al, ap, bl, bp float64) float64 {
-	var cF C.double
-	cF = C.iauSeps(C.double(al), C.double(ap), C.double(bl),
-	C.double(bp))
-	return float64(cF)
-}
-
-//  GoSeps Angular separation between two sets of spherical
+//  Seps Angular separation between two sets of spherical
 //  coordinates.
-func GoSeps(al, ap, bl, bp float64) float64 {
+func Seps(al, ap, bl, bp float64) float64 {
 
    var ac, bc[3]float64
    var s float64
 
 // Spherical to Cartesian. 
-   ac = GoS2c(al, ap);
-   bc = GoS2c(bl, bp);
+   ac = S2c(al, ap);
+   bc = S2c(bl, bp);
 
 // Angle between the vectors. 
-   s = GoSepp(ac, bc);
+   s = Sepp(ac, bc);
 
    return s;
 
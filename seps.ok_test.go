@@ -28,7 +28,7 @@ func TestSeps(t *testing.T) {
 		fn  func(a1, a2, a3, a4 float64) float64
 	}{
 		{"cgo", CgoSeps},
-		{"go", GoSeps},
+		{"go", Seps},
 	}
 
 	for _, test := range tests {
@@ -54,7 +54,7 @@ func BenchmarkSeps(b *testing.B) {
 		fn  func(a1, a2, a3, a4 float64) float64
 	}{
 		{"cgo", CgoSeps},
-		{"go", GoSeps},
+		{"go", Seps},
 	}
 
 	for _, test := range tests {
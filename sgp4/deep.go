@@ -0,0 +1,523 @@
+package sgp4
+
+import "math"
+
+// Constants of the lunar-solar perturbations.
+const (
+	zes  = 0.01675
+	zel  = 0.05490
+	zns  = 1.19459e-5
+	znl  = 1.5835218e-4
+	c1ss = 2.9864797e-6
+	c1l  = 4.7968065e-7
+
+	rptim = 4.37526908801129966e-3 // rotation of the Earth, rad/min
+)
+
+// dscomOut are the quantities of dscom used by dsinit.
+type dscomOut struct {
+	sinim, cosim, emsq                           float64
+	s1, s2, s3, s4, s5, ss1, ss2, ss3, ss4, ss5  float64
+	sz1, sz3, sz11, sz13, sz21, sz23, sz31, sz33 float64
+	z1, z3, z11, z13, z21, z23, z31, z33         float64
+}
+
+// deepInit initializes the deep space terms, as the calls of dscom and
+// dsinit of sgp4init in the reference code.
+func (s *Satellite) deepInit(eccsq, xpidot float64) {
+	d := s.dscom()
+	s.dsinit(d, eccsq, xpidot)
+}
+
+// dscom sets the coefficients of the lunar-solar terms at epoch.
+func (s *Satellite) dscom() (o dscomOut) {
+	const (
+		zsinis = 0.39785416
+		zcosis = 0.91744867
+		zcosgs = 0.1945905
+		zsings = -0.98088458
+	)
+	nm := s.no
+	em := s.ecco
+	snodm := math.Sin(s.nodeo)
+	cnodm := math.Cos(s.nodeo)
+	sinomm := math.Sin(s.argpo)
+	cosomm := math.Cos(s.argpo)
+	o.sinim = math.Sin(s.inclo)
+	o.cosim = math.Cos(s.inclo)
+	o.emsq = em * em
+	betasq := 1.0 - o.emsq
+	rtemsq := math.Sqrt(betasq)
+
+	// Initialize lunar solar terms.
+	day := s.epoch + 18261.5
+	xnodce := math.Mod(4.5236020-9.2422029e-4*day, twopi)
+	stem := math.Sin(xnodce)
+	ctem := math.Cos(xnodce)
+	zcosil := 0.91375164 - 0.03568096*ctem
+	zsinil := math.Sqrt(1.0 - zcosil*zcosil)
+	zsinhl := 0.089683511 * stem / zsinil
+	zcoshl := math.Sqrt(1.0 - zsinhl*zsinhl)
+	gam := 5.8351514 + 0.0019443680*day
+	zx := 0.39785416 * stem / zsinil
+	zy := zcoshl*ctem + 0.91744867*zsinhl*stem
+	zx = math.Atan2(zx, zy)
+	zx = gam + zx - xnodce
+	zcosgl := math.Cos(zx)
+	zsingl := math.Sin(zx)
+
+	// Do solar terms, then lunar terms.
+	zcosg := zcosgs
+	zsing := zsings
+	zcosi := zcosis
+	zsini := zsinis
+	zcosh := cnodm
+	zsinh := snodm
+	cc := c1ss
+	xnoi := 1.0 / nm
+
+	var z2, z12, z22, z32, s6, s7 float64
+	var sz2, sz12, sz22, sz32, ss6, ss7 float64
+	for lsflg := 1; lsflg <= 2; lsflg++ {
+		a1 := zcosg*zcosh + zsing*zcosi*zsinh
+		a3 := -zsing*zcosh + zcosg*zcosi*zsinh
+		a7 := -zcosg*zsinh + zsing*zcosi*zcosh
+		a8 := zsing * zsini
+		a9 := zsing*zsinh + zcosg*zcosi*zcosh
+		a10 := zcosg * zsini
+		a2 := o.cosim*a7 + o.sinim*a8
+		a4 := o.cosim*a9 + o.sinim*a10
+		a5 := -o.sinim*a7 + o.cosim*a8
+		a6 := -o.sinim*a9 + o.cosim*a10
+
+		x1 := a1*cosomm + a2*sinomm
+		x2 := a3*cosomm + a4*sinomm
+		x3 := -a1*sinomm + a2*cosomm
+		x4 := -a3*sinomm + a4*cosomm
+		x5 := a5 * sinomm
+		x6 := a6 * sinomm
+		x7 := a5 * cosomm
+		x8 := a6 * cosomm
+
+		o.z31 = 12.0*x1*x1 - 3.0*x3*x3
+		z32 = 24.0*x1*x2 - 6.0*x3*x4
+		o.z33 = 12.0*x2*x2 - 3.0*x4*x4
+		o.z1 = 3.0*(a1*a1+a2*a2) + o.z31*o.emsq
+		z2 = 6.0*(a1*a3+a2*a4) + z32*o.emsq
+		o.z3 = 3.0*(a3*a3+a4*a4) + o.z33*o.emsq
+		o.z11 = -6.0*a1*a5 + o.emsq*(-24.0*x1*x7-6.0*x3*x5)
+		z12 = -6.0*(a1*a6+a3*a5) + o.emsq*
+			(-24.0*(x2*x7+x1*x8)-6.0*(x3*x6+x4*x5))
+		o.z13 = -6.0*a3*a6 + o.emsq*(-24.0*x2*x8-6.0*x4*x6)
+		o.z21 = 6.0*a2*a5 + o.emsq*(24.0*x1*x5-6.0*x3*x7)
+		z22 = 6.0*(a4*a5+a2*a6) + o.emsq*
+			(24.0*(x2*x5+x1*x6)-6.0*(x4*x7+x3*x8))
+		o.z23 = 6.0*a4*a6 + o.emsq*(24.0*x2*x6-6.0*x4*x8)
+		o.z1 = o.z1 + o.z1 + betasq*o.z31
+		z2 = z2 + z2 + betasq*z32
+		o.z3 = o.z3 + o.z3 + betasq*o.z33
+		o.s3 = cc * xnoi
+		o.s2 = -0.5 * o.s3 / rtemsq
+		o.s4 = o.s3 * rtemsq
+		o.s1 = -15.0 * em * o.s4
+		o.s5 = x1*x3 + x2*x4
+		s6 = x2*x3 + x1*x4
+		s7 = x2*x4 - x1*x3
+
+		if lsflg == 1 {
+			o.ss1, o.ss2, o.ss3, o.ss4, o.ss5 = o.s1, o.s2, o.s3, o.s4,
+				o.s5
+			ss6, ss7 = s6, s7
+			o.sz1, sz2, o.sz3 = o.z1, z2, o.z3
+			o.sz11, sz12, o.sz13 = o.z11, z12, o.z13
+			o.sz21, sz22, o.sz23 = o.z21, z22, o.z23
+			o.sz31, sz32, o.sz33 = o.z31, z32, o.z33
+			zcosg = zcosgl
+			zsing = zsingl
+			zcosi = zcosil
+			zsini = zsinil
+			zcosh = zcoshl*cnodm + zsinhl*snodm
+			zsinh = snodm*zcoshl - cnodm*zsinhl
+			cc = c1l
+		}
+	}
+
+	s.zmol = math.Mod(4.7199672+0.22997150*day-gam, twopi)
+	s.zmos = math.Mod(6.2565837+0.017201977*day, twopi)
+
+	// Do solar terms.
+	s.se2 = 2.0 * o.ss1 * ss6
+	s.se3 = 2.0 * o.ss1 * ss7
+	s.si2 = 2.0 * o.ss2 * sz12
+	s.si3 = 2.0 * o.ss2 * (o.sz13 - o.sz11)
+	s.sl2 = -2.0 * o.ss3 * sz2
+	s.sl3 = -2.0 * o.ss3 * (o.sz3 - o.sz1)
+	s.sl4 = -2.0 * o.ss3 * (-21.0 - 9.0*o.emsq) * zes
+	s.sgh2 = 2.0 * o.ss4 * sz32
+	s.sgh3 = 2.0 * o.ss4 * (o.sz33 - o.sz31)
+	s.sgh4 = -18.0 * o.ss4 * zes
+	s.sh2 = -2.0 * o.ss2 * sz22
+	s.sh3 = -2.0 * o.ss2 * (o.sz23 - o.sz21)
+
+	// Do lunar terms.
+	s.ee2 = 2.0 * o.s1 * s6
+	s.e3 = 2.0 * o.s1 * s7
+	s.xi2 = 2.0 * o.s2 * z12
+	s.xi3 = 2.0 * o.s2 * (o.z13 - o.z11)
+	s.xl2 = -2.0 * o.s3 * z2
+	s.xl3 = -2.0 * o.s3 * (o.z3 - o.z1)
+	s.xl4 = -2.0 * o.s3 * (-21.0 - 9.0*o.emsq) * zel
+	s.xgh2 = 2.0 * o.s4 * z32
+	s.xgh3 = 2.0 * o.s4 * (o.z33 - o.z31)
+	s.xgh4 = -18.0 * o.s4 * zel
+	s.xh2 = -2.0 * o.s2 * z22
+	s.xh3 = -2.0 * o.s2 * (o.z23 - o.z21)
+	return
+}
+
+// dsinit sets the secular rates of the lunar-solar terms and the
+// coefficients of the resonances of 12 and 24 hour orbits.
+func (s *Satellite) dsinit(d dscomOut, eccsq, xpidot float64) {
+	const (
+		q22    = 1.7891679e-6
+		q31    = 2.1460748e-6
+		q33    = 2.2123015e-7
+		root22 = 1.7891679e-6
+		root44 = 7.3636953e-9
+		root54 = 2.1765803e-9
+		root32 = 3.7393792e-7
+		root52 = 1.1428639e-7
+	)
+	nm := s.no
+	em := s.ecco
+	cosim, sinim, emsq := d.cosim, d.sinim, d.emsq
+	inclm := s.inclo
+
+	// Deep space resonance flag.
+	s.irez = 0
+	if nm < 0.0052359877 && nm > 0.0034906585 {
+		s.irez = 1
+	}
+	if nm >= 8.26e-3 && nm <= 9.24e-3 && em >= 0.5 {
+		s.irez = 2
+	}
+
+	// Do solar terms.
+	ses := d.ss1 * zns * d.ss5
+	sis := d.ss2 * zns * (d.sz11 + d.sz13)
+	sls := -zns * d.ss3 * (d.sz1 + d.sz3 - 14.0 - 6.0*emsq)
+	sghs := d.ss4 * zns * (d.sz31 + d.sz33 - 6.0)
+	shs := -zns * d.ss2 * (d.sz21 + d.sz23)
+	if inclm < 5.2359877e-2 || inclm > math.Pi-5.2359877e-2 {
+		shs = 0.0
+	}
+	if sinim != 0.0 {
+		shs = shs / sinim
+	}
+	sgs := sghs - cosim*shs
+
+	// Do lunar terms.
+	s.dedt = ses + d.s1*znl*d.s5
+	s.didt = sis + d.s2*znl*(d.z11+d.z13)
+	s.dmdt = sls - znl*d.s3*(d.z1+d.z3-14.0-6.0*emsq)
+	sghl := d.s4 * znl * (d.z31 + d.z33 - 6.0)
+	shll := -znl * d.s2 * (d.z21 + d.z23)
+	if inclm < 5.2359877e-2 || inclm > math.Pi-5.2359877e-2 {
+		shll = 0.0
+	}
+	s.domdt = sgs + sghl
+	s.dnodt = shs
+	if sinim != 0.0 {
+		s.domdt = s.domdt - cosim/sinim*shll
+		s.dnodt = s.dnodt + shll/sinim
+	}
+
+	// Initialize the resonance terms.
+	if s.irez == 0 {
+		return
+	}
+	theta := math.Mod(s.gsto, twopi)
+	aonv := math.Pow(nm/s.grav.xke, x2o3)
+
+	// Geopotential resonance for 12 hour orbits.
+	if s.irez == 2 {
+		cosisq := cosim * cosim
+		em = s.ecco
+		emsq = eccsq
+		eoc := em * emsq
+		g201 := -0.306 - (em-0.64)*0.440
+
+		var g211, g310, g322, g410, g422, g520, g521, g532, g533 float64
+		if em <= 0.65 {
+			g211 = 3.616 - 13.2470*em + 16.2900*emsq
+			g310 = -19.302 + 117.3900*em - 228.4190*emsq + 156.5910*eoc
+			g322 = -18.9068 + 109.7927*em - 214.6334*emsq + 146.5816*eoc
+			g410 = -41.122 + 242.6940*em - 471.0940*emsq + 313.9530*eoc
+			g422 = -146.407 + 841.8800*em - 1629.014*emsq + 1083.4350*eoc
+			g520 = -532.114 + 3017.977*em - 5740.032*emsq + 3708.2760*eoc
+		} else {
+			g211 = -72.099 + 331.819*em - 508.738*emsq + 266.724*eoc
+			g310 = -346.844 + 1582.851*em - 2415.925*emsq + 1246.113*eoc
+			g322 = -342.585 + 1554.908*em - 2366.899*emsq + 1215.972*eoc
+			g410 = -1052.797 + 4758.686*em - 7193.992*emsq + 3651.957*eoc
+			g422 = -3581.690 + 16178.110*em - 24462.770*emsq +
+				12422.520*eoc
+			if em > 0.715 {
+				g520 = -5149.66 + 29936.92*em - 54087.36*emsq +
+					31324.56*eoc
+			} else {
+				g520 = 1464.74 - 4664.75*em + 3763.64*emsq
+			}
+		}
+		if em < 0.7 {
+			g533 = -919.22770 + 4988.6100*em - 9064.7700*emsq +
+				5542.21*eoc
+			g521 = -822.71072 + 4568.6173*em - 8491.4146*emsq +
+				5337.524*eoc
+			g532 = -853.66600 + 4690.2500*em - 8624.7700*emsq +
+				5341.4*eoc
+		} else {
+			g533 = -37995.780 + 161616.52*em - 229838.20*emsq +
+				109377.94*eoc
+			g521 = -51752.104 + 218913.95*em - 309468.16*emsq +
+				146349.42*eoc
+			g532 = -40023.880 + 170470.89*em - 242699.48*emsq +
+				115605.82*eoc
+		}
+
+		sini2 := sinim * sinim
+		f220 := 0.75 * (1.0 + 2.0*cosim + cosisq)
+		f221 := 1.5 * sini2
+		f321 := 1.875 * sinim * (1.0 - 2.0*cosim - 3.0*cosisq)
+		f322 := -1.875 * sinim * (1.0 + 2.0*cosim - 3.0*cosisq)
+		f441 := 35.0 * sini2 * f220
+		f442 := 39.3750 * sini2 * sini2
+		f522 := 9.84375 * sinim * (sini2*(1.0-2.0*cosim-5.0*cosisq) +
+			0.33333333*(-2.0+4.0*cosim+6.0*cosisq))
+		f523 := sinim * (4.92187512*sini2*(-2.0-4.0*cosim+
+			10.0*cosisq) + 6.56250012*(1.0+2.0*cosim-3.0*cosisq))
+		f542 := 29.53125 * sinim * (2.0 - 8.0*cosim +
+			cosisq*(-12.0+8.0*cosim+10.0*cosisq))
+		f543 := 29.53125 * sinim * (-2.0 - 8.0*cosim +
+			cosisq*(12.0+8.0*cosim-10.0*cosisq))
+		xno2 := nm * nm
+		ainv2 := aonv * aonv
+		temp1 := 3.0 * xno2 * ainv2
+		temp := temp1 * root22
+		s.d2201 = temp * f220 * g201
+		s.d2211 = temp * f221 * g211
+		temp1 = temp1 * aonv
+		temp = temp1 * root32
+		s.d3210 = temp * f321 * g310
+		s.d3222 = temp * f322 * g322
+		temp1 = temp1 * aonv
+		temp = 2.0 * temp1 * root44
+		s.d4410 = temp * f441 * g410
+		s.d4422 = temp * f442 * g422
+		temp1 = temp1 * aonv
+		temp = temp1 * root52
+		s.d5220 = temp * f522 * g520
+		s.d5232 = temp * f523 * g532
+		temp = 2.0 * temp1 * root54
+		s.d5421 = temp * f542 * g521
+		s.d5433 = temp * f543 * g533
+		s.xlamo = math.Mod(s.mo+s.nodeo+s.nodeo-theta-theta, twopi)
+		s.xfact = s.mdot + s.dmdt + 2.0*(s.nodedot+s.dnodt-rptim) - s.no
+	}
+
+	// Synchronous resonance terms.
+	if s.irez == 1 {
+		g200 := 1.0 + emsq*(-2.5+0.8125*emsq)
+		g310 := 1.0 + 2.0*emsq
+		g300 := 1.0 + emsq*(-6.0+6.60937*emsq)
+		f220 := 0.75 * (1.0 + cosim) * (1.0 + cosim)
+		f311 := 0.9375*sinim*sinim*(1.0+3.0*cosim) - 0.75*(1.0+cosim)
+		f330 := 1.0 + cosim
+		f330 = 1.875 * f330 * f330 * f330
+		s.del1 = 3.0 * nm * nm * aonv * aonv
+		s.del2 = 2.0 * s.del1 * f220 * g200 * q22
+		s.del3 = 3.0 * s.del1 * f330 * g300 * q33 * aonv
+		s.del1 = s.del1 * f311 * g310 * q31 * aonv
+		s.xlamo = math.Mod(s.mo+s.nodeo+s.argpo-theta, twopi)
+		s.xfact = s.mdot + xpidot - rptim + s.dmdt + s.domdt + s.dnodt -
+			s.no
+	}
+}
+
+// dspace applies the secular lunar-solar terms and integrates the
+// resonances from epoch to t minutes.  Unlike the reference code, the
+// integration starts afresh at each call, so that a Satellite may be
+// propagated from several goroutines;  the results are the same.
+func (s *Satellite) dspace(t, em, argpm, inclm, mm, nodem, nm float64) (
+	float64, float64, float64, float64, float64, float64) {
+	const (
+		fasx2 = 0.13130908
+		fasx4 = 2.8843198
+		fasx6 = 0.37448087
+		g22   = 5.7686396
+		g32   = 0.95240898
+		g44   = 1.8014998
+		g52   = 1.0508330
+		g54   = 4.4108898
+		stepp = 720.0
+		stepn = -720.0
+		step2 = 259200.0
+	)
+	theta := math.Mod(s.gsto+t*rptim, twopi)
+	em = em + s.dedt*t
+	inclm = inclm + s.didt*t
+	argpm = argpm + s.domdt*t
+	nodem = nodem + s.dnodt*t
+	mm = mm + s.dmdt*t
+
+	if s.irez == 0 {
+		return em, argpm, inclm, mm, nodem, nm
+	}
+
+	// Integrate the resonances with Euler-Maclaurin steps.
+	atime := 0.0
+	xni := s.no
+	xli := s.xlamo
+	delt := stepn
+	if t > 0.0 {
+		delt = stepp
+	}
+	var xndt, xldot, xnddt, ft float64
+	for {
+		if s.irez != 2 {
+			xndt = s.del1*math.Sin(xli-fasx2) +
+				s.del2*math.Sin(2.0*(xli-fasx4)) +
+				s.del3*math.Sin(3.0*(xli-fasx6))
+			xldot = xni + s.xfact
+			xnddt = s.del1*math.Cos(xli-fasx2) +
+				2.0*s.del2*math.Cos(2.0*(xli-fasx4)) +
+				3.0*s.del3*math.Cos(3.0*(xli-fasx6))
+			xnddt = xnddt * xldot
+		} else {
+			xomi := s.argpo + s.argpdot*atime
+			x2omi := xomi + xomi
+			x2li := xli + xli
+			xndt = s.d2201*math.Sin(x2omi+xli-g22) +
+				s.d2211*math.Sin(xli-g22) +
+				s.d3210*math.Sin(xomi+xli-g32) +
+				s.d3222*math.Sin(-xomi+xli-g32) +
+				s.d4410*math.Sin(x2omi+x2li-g44) +
+				s.d4422*math.Sin(x2li-g44) +
+				s.d5220*math.Sin(xomi+xli-g52) +
+				s.d5232*math.Sin(-xomi+xli-g52) +
+				s.d5421*math.Sin(xomi+x2li-g54) +
+				s.d5433*math.Sin(-xomi+x2li-g54)
+			xldot = xni + s.xfact
+			xnddt = s.d2201*math.Cos(x2omi+xli-g22) +
+				s.d2211*math.Cos(xli-g22) +
+				s.d3210*math.Cos(xomi+xli-g32) +
+				s.d3222*math.Cos(-xomi+xli-g32) +
+				s.d5220*math.Cos(xomi+xli-g52) +
+				s.d5232*math.Cos(-xomi+xli-g52) +
+				2.0*(s.d4410*math.Cos(x2omi+x2li-g44)+
+					s.d4422*math.Cos(x2li-g44)+
+					s.d5421*math.Cos(xomi+x2li-g54)+
+					s.d5433*math.Cos(-xomi+x2li-g54))
+			xnddt = xnddt * xldot
+		}
+		if math.Abs(t-atime) < stepp {
+			ft = t - atime
+			break
+		}
+		xli = xli + xldot*delt + xndt*step2
+		xni = xni + xndt*delt + xnddt*step2
+		atime = atime + delt
+	}
+
+	nm = xni + xndt*ft + xnddt*ft*ft*0.5
+	xl := xli + xldot*ft + xndt*ft*ft*0.5
+	if s.irez != 1 {
+		mm = xl - 2.0*nodem + 2.0*theta
+	} else {
+		mm = xl - nodem - argpm + theta
+	}
+	return em, argpm, inclm, mm, nodem, nm
+}
+
+// dpper returns the elements with the lunar-solar periodics applied at
+// t minutes from epoch.
+func (s *Satellite) dpper(t, ep, inclp, nodep, argpp, mp float64) (
+	float64, float64, float64, float64, float64) {
+
+	// Solar terms.
+	zm := s.zmos + zns*t
+	zf := zm + 2.0*zes*math.Sin(zm)
+	sinzf := math.Sin(zf)
+	f2 := 0.5*sinzf*sinzf - 0.25
+	f3 := -0.5 * sinzf * math.Cos(zf)
+	ses := s.se2*f2 + s.se3*f3
+	sis := s.si2*f2 + s.si3*f3
+	sls := s.sl2*f2 + s.sl3*f3 + s.sl4*sinzf
+	sghs := s.sgh2*f2 + s.sgh3*f3 + s.sgh4*sinzf
+	shs := s.sh2*f2 + s.sh3*f3
+
+	// Lunar terms.
+	zm = s.zmol + znl*t
+	zf = zm + 2.0*zel*math.Sin(zm)
+	sinzf = math.Sin(zf)
+	f2 = 0.5*sinzf*sinzf - 0.25
+	f3 = -0.5 * sinzf * math.Cos(zf)
+	sel := s.ee2*f2 + s.e3*f3
+	sil := s.xi2*f2 + s.xi3*f3
+	sll := s.xl2*f2 + s.xl3*f3 + s.xl4*sinzf
+	sghl := s.xgh2*f2 + s.xgh3*f3 + s.xgh4*sinzf
+	shll := s.xh2*f2 + s.xh3*f3
+
+	pe := ses + sel
+	pinc := sis + sil
+	pl := sls + sll
+	pgh := sghs + sghl
+	ph := shs + shll
+
+	inclp = inclp + pinc
+	ep = ep + pe
+	sinip := math.Sin(inclp)
+	cosip := math.Cos(inclp)
+
+	if inclp >= 0.2 {
+		// Apply periodics directly.
+		ph = ph / sinip
+		pgh = pgh - cosip*ph
+		argpp = argpp + pgh
+		nodep = nodep + ph
+		mp = mp + pl
+		return ep, inclp, nodep, argpp, mp
+	}
+
+	// Apply periodics with the Lyddane modification.
+	sinop := math.Sin(nodep)
+	cosop := math.Cos(nodep)
+	alfdp := sinip * sinop
+	betdp := sinip * cosop
+	dalf := ph*cosop + pinc*cosip*sinop
+	dbet := -ph*sinop + pinc*cosip*cosop
+	alfdp = alfdp + dalf
+	betdp = betdp + dbet
+	nodep = math.Mod(nodep, twopi)
+	if nodep < 0.0 && s.mode == AFSPC {
+		nodep = nodep + twopi
+	}
+	xls := mp + argpp + cosip*nodep
+	dls := pl + pgh - pinc*nodep*sinip
+	xls = xls + dls
+	xnoh := nodep
+	nodep = math.Atan2(alfdp, betdp)
+	if nodep < 0.0 && s.mode == AFSPC {
+		nodep = nodep + twopi
+	}
+	if math.Abs(xnoh-nodep) > math.Pi {
+		if nodep < xnoh {
+			nodep = nodep + twopi
+		} else {
+			nodep = nodep - twopi
+		}
+	}
+	mp = mp + pl
+	argpp = xls - mp - cosip*nodep
+	return ep, inclp, nodep, argpp, mp
+}
@@ -0,0 +1,200 @@
+package sgp4
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/8i8/sofa"
+)
+
+// ReadOMM returns the element sets of the CCSDS orbit mean-elements
+// messages of r, in the JSON form used by CelesTrak and Space-Track,
+// an object or an array of them, or in the keyword = value form.  The
+// mean element theory, where given, must be SGP4.
+func ReadOMM(r io.Reader) ([]*Elements, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	b = bytes.TrimSpace(b)
+	var ms []map[string]string
+	if len(b) > 0 && (b[0] == '[' || b[0] == '{') {
+		ms, err = ommJSON(b)
+	} else {
+		ms, err = ommKVN(b)
+	}
+	if err != nil {
+		return nil, err
+	}
+	es := make([]*Elements, 0, len(ms))
+	for i, m := range ms {
+		e, err := ommElements(m)
+		if err != nil {
+			return es, fmt.Errorf("OMM %d: %w", i+1, err)
+		}
+		es = append(es, e)
+	}
+	return es, nil
+}
+
+// ommJSON returns the messages of b as maps of strings, the values
+// being numbers or strings depending on the source.
+func ommJSON(b []byte) ([]map[string]string, error) {
+	var vs []map[string]interface{}
+	if b[0] == '{' {
+		b = append(append([]byte{'['}, b...), ']')
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&vs); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSyntax, err)
+	}
+	ms := make([]map[string]string, len(vs))
+	for i, v := range vs {
+		ms[i] = make(map[string]string, len(v))
+		for k, x := range v {
+			switch x := x.(type) {
+			case string:
+				ms[i][k] = x
+			case json.Number:
+				ms[i][k] = x.String()
+			}
+		}
+	}
+	return ms, nil
+}
+
+// ommKVN returns the messages of b, each of which begins with its
+// CCSDS_OMM_VERS line.  Units in square brackets are dropped.
+func ommKVN(b []byte) ([]map[string]string, error) {
+	var ms []map[string]string
+	var m map[string]string
+	sc := bufio.NewScanner(bytes.NewReader(b))
+	for sc.Scan() {
+		l := strings.TrimSpace(sc.Text())
+		if l == "" || strings.HasPrefix(l, "COMMENT") {
+			continue
+		}
+		i := strings.IndexByte(l, '=')
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %q", ErrSyntax, l)
+		}
+		k := strings.TrimSpace(l[:i])
+		v := strings.TrimSpace(l[i+1:])
+		if j := strings.IndexByte(v, '['); j >= 0 {
+			v = strings.TrimSpace(v[:j])
+		}
+		if k == "CCSDS_OMM_VERS" || m == nil {
+			m = make(map[string]string)
+			ms = append(ms, m)
+		}
+		m[k] = v
+	}
+	return ms, sc.Err()
+}
+
+// ommElements returns the elements of the message m.
+func ommElements(m map[string]string) (*Elements, error) {
+	if t, ok := m["MEAN_ELEMENT_THEORY"]; ok && !strings.Contains(
+		strings.ToUpper(t), "SGP4") {
+		return nil, fmt.Errorf("%w: mean element theory %q", ErrSyntax, t)
+	}
+	var e Elements
+	p := parser{}
+	get := func(k string, required bool) string {
+		v, ok := m[k]
+		if !ok && required {
+			p.fail("missing", k)
+		}
+		return v
+	}
+	e.Name = get("OBJECT_NAME", false)
+	e.IntlDesignator = get("OBJECT_ID", false)
+	if c := get("CLASSIFICATION_TYPE", false); c != "" {
+		e.Classification = c[0]
+	}
+	if c := get("NORAD_CAT_ID", false); c != "" {
+		e.CatalogNumber = p.int("NORAD_CAT_ID", c)
+	}
+	if c := get("ELEMENT_SET_NO", false); c != "" {
+		e.ElementSet = p.int("ELEMENT_SET_NO", c)
+	}
+	if c := get("REV_AT_EPOCH", false); c != "" {
+		e.RevNumber = p.int("REV_AT_EPOCH", c)
+	}
+	opt := func(k string) float64 {
+		if v := get(k, false); v != "" {
+			return p.float(k, v)
+		}
+		return 0
+	}
+	e.BStar = opt("BSTAR")
+	e.NDot = opt("MEAN_MOTION_DOT")
+	e.NDDot = opt("MEAN_MOTION_DDOT")
+	e.MeanMotion = p.float("MEAN_MOTION", get("MEAN_MOTION", true))
+	e.Eccentricity = p.float("ECCENTRICITY", get("ECCENTRICITY", true))
+	e.Inclination = p.float("INCLINATION", get("INCLINATION", true))
+	e.RAAN = p.float("RA_OF_ASC_NODE", get("RA_OF_ASC_NODE", true))
+	e.ArgPerigee = p.float("ARG_OF_PERICENTER",
+		get("ARG_OF_PERICENTER", true))
+	e.MeanAnomaly = p.float("MEAN_ANOMALY", get("MEAN_ANOMALY", true))
+	epoch := get("EPOCH", true)
+	if p.err != nil {
+		return nil, p.err
+	}
+	var err error
+	e.Epoch1, e.Epoch2, err = isoEpoch(epoch)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// isoEpoch returns the UTC Julian Date of an epoch of the form
+// YYYY-MM-DDThh:mm:ss.d or YYYY-DDDThh:mm:ss.d, with or without a
+// trailing Z.
+func isoEpoch(s string) (d1, d2 float64, err error) {
+	bad := fmt.Errorf("%w: EPOCH %q", ErrSyntax, s)
+	t := strings.TrimSuffix(s, "Z")
+	i := strings.IndexByte(t, 'T')
+	if i < 0 {
+		return 0, 0, bad
+	}
+	date := strings.Split(t[:i], "-")
+	clock := strings.Split(t[i+1:], ":")
+	if len(clock) != 3 || len(date) < 2 || len(date) > 3 {
+		return 0, 0, bad
+	}
+	p := parser{}
+	iy := p.int("year", date[0])
+	ihr := p.int("hour", clock[0])
+	imn := p.int("minute", clock[1])
+	sec := p.float("second", clock[2])
+	if p.err != nil {
+		return 0, 0, bad
+	}
+	if len(date) == 2 {
+		doy := p.int("day", date[1])
+		if p.err != nil {
+			return 0, 0, bad
+		}
+		d1, d2, err = dayEpoch(iy, float64(doy))
+		d2 += (60.0*(60.0*float64(ihr)+float64(imn)) + sec) / sofa.DAYSEC
+		return d1, d2, err
+	}
+	im := p.int("month", date[1])
+	id := p.int("day", date[2])
+	if p.err != nil {
+		return 0, 0, bad
+	}
+	d1, d2, errn := sofa.Dtf2d("UTC", iy, im, id, ihr, imn, sec)
+	if errn != nil && errn.Code() < 0 {
+		return 0, 0, bad
+	}
+	return d1, d2, nil
+}
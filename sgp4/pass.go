@@ -0,0 +1,319 @@
+package sgp4
+
+import (
+	"errors"
+	"math"
+
+	"github.com/8i8/sofa"
+)
+
+// Shadow is a model of the shadow of the Earth.
+type Shadow int
+
+// A Cone shadow has an umbra and a penumbra, from the discs of the Sun
+// and the Earth as seen by the satellite;  a Cylinder shadow is the
+// cylinder of the radius of the Earth behind it, with no penumbra.
+const (
+	Cone Shadow = iota
+	Cylinder
+)
+
+const (
+	sunRadius = 695700.0 // nominal solar radius (km)
+	auKm      = sofa.DAU / 1e3
+)
+
+// Site is an observer on the Earth.
+type Site struct {
+	Elong, Phi float64 // geodetic longitude and latitude (radians)
+	Height     float64 // height above the WGS84 ellipsoid (m)
+	XP, YP     float64 // polar motion (radians)
+	DUT1       float64 // UT1-UTC (s)
+}
+
+// DefaultSunElevation is the elevation of the Sun (radians) below
+// which the observer is in darkness, unless PassOptions.SunElevation
+// is set:  that of civil twilight.
+const DefaultSunElevation = -6.0 * sofa.DD2R
+
+// PassOptions are the settings of a search for passes.  The zero value
+// finds passes above the horizon, with a darkness of civil twilight,
+// the cone shadow and a step of one minute.
+type PassOptions struct {
+	MinElevation float64 // elevation of rise and set (radians)
+
+	// SunElevation is the elevation of the Sun (radians) below which
+	// the observer is in darkness, or DefaultSunElevation if nil.
+	SunElevation *float64
+
+	Shadow Shadow
+	Step   float64 // search step (days)
+}
+
+// sunElevation returns the elevation of the Sun of darkness of o.
+func (o PassOptions) sunElevation() float64 {
+	if o.SunElevation == nil {
+		return DefaultSunElevation
+	}
+	return *o.SunElevation
+}
+
+// Event is the satellite as seen by a site at an instant.
+type Event struct {
+	UTC1, UTC2 float64 // UTC 2-part Julian Date
+	Az, El     float64 // azimuth and elevation (radians)
+	Range      float64 // km
+	Eclipse    float64 // eclipsed fraction of the solar disc
+	Dark       bool    // the Sun is below the darkness of PassOptions
+}
+
+// Sunlit reports whether any of the Sun is seen from the satellite.
+func (e Event) Sunlit() bool {
+	return e.Eclipse < 1.0
+}
+
+// Visible reports whether the satellite is sunlit while the observer
+// is in darkness.
+func (e Event) Visible() bool {
+	return e.Sunlit() && e.Dark
+}
+
+// Pass is the passage of a satellite above a site.
+type Pass struct {
+	Rise, Max, Set Event
+
+	// Sunlit and Visible report whether the satellite is sunlit, and
+	// visible, at any step of the pass.
+	Sunlit, Visible bool
+}
+
+// ErrSite is returned for a site of impossible latitude, or a search
+// of negative length.
+var ErrSite = errors.New("sgp4: invalid site or interval")
+
+// Passes returns the passes of the satellite s above the site in the
+// days from the UTC date utc1+utc2.  A pass under way at either end of
+// the interval is cut there.  The times of rise, culmination and set
+// are found to about 0.1 s;  a pass shorter than the step may be
+// missed.  The Sun is from Epv00, to the accuracy of which the
+// illumination and the darkness are given.
+func Passes(s *Satellite, site Site, utc1, utc2, days float64,
+	opt PassOptions) ([]Pass, error) {
+	if math.Abs(site.Phi) > math.Pi/2 || days < 0 {
+		return nil, ErrSite
+	}
+	if opt.Step <= 0 {
+		opt.Step = 1.0 / 1440.0
+	}
+	tai1, tai2, errn := sofa.Utctai(utc1, utc2)
+	if errn != nil && errn.Code() < 0 {
+		return nil, errn
+	}
+	tt1, tt2, _ := sofa.Taitt(tai1, tai2)
+	re, _, _ := sofa.Eform(sofa.WGS84)
+	f := passFinder{s: s, site: site, opt: opt, re: re / 1e3,
+		dtt: (tt1 - utc1) + (tt2 - utc2)}
+
+	var ps []Pass
+	var p *Pass
+	prev, err := f.event(utc1, utc2)
+	if err != nil {
+		return nil, err
+	}
+	if f.up(prev) {
+		p = &Pass{Rise: prev, Max: prev}
+		f.note(p, prev)
+	}
+	n := int(math.Ceil(days / opt.Step))
+	for i := 1; i <= n; i++ {
+		d := math.Min(float64(i)*opt.Step, days)
+		e, err := f.event(utc1, utc2+d)
+		if err != nil {
+			return ps, err
+		}
+		switch {
+		case p == nil && f.up(e):
+			r, err := f.horizon(prev, e)
+			if err != nil {
+				return ps, err
+			}
+			p = &Pass{Rise: r, Max: r}
+			f.note(p, r)
+		case p != nil && !f.up(e):
+			st, err := f.horizon(prev, e)
+			if err != nil {
+				return ps, err
+			}
+			p.Set = st
+			f.note(p, st)
+			if err = f.culminate(p); err != nil {
+				return ps, err
+			}
+			ps = append(ps, *p)
+			p = nil
+		}
+		if p != nil {
+			f.note(p, e)
+		}
+		prev = e
+	}
+	if p != nil {
+		p.Set = prev
+		if err = f.culminate(p); err != nil {
+			return ps, err
+		}
+		ps = append(ps, *p)
+	}
+	return ps, nil
+}
+
+// passFinder holds the settings of a search.
+type passFinder struct {
+	s    *Satellite
+	site Site
+	opt  PassOptions
+	re   float64 // equatorial radius of the Earth (km)
+	dtt  float64 // TT-UTC (days)
+}
+
+func (f *passFinder) up(e Event) bool {
+	return e.El >= f.opt.MinElevation
+}
+
+// note keeps the illumination of an event of the pass p, and the
+// highest of them as its culmination.
+func (f *passFinder) note(p *Pass, e Event) {
+	if e.El > p.Max.El {
+		p.Max = e
+	}
+	p.Sunlit = p.Sunlit || e.Sunlit()
+	p.Visible = p.Visible || e.Visible()
+}
+
+// event returns the satellite as seen by the site at utc1+utc2.
+func (f *passFinder) event(utc1, utc2 float64) (e Event, err error) {
+	pv, err := f.s.PV(utc1, utc2)
+	if err != nil {
+		return e, err
+	}
+	site := f.site
+	ut2 := utc2 + site.DUT1/sofa.DAYSEC
+	tt2 := utc2 + f.dtt
+	e.UTC1, e.UTC2 = utc1, utc2
+	e.Az, e.El, e.Range, _ = Topocentric(utc1, ut2, site.Elong, site.Phi,
+		site.Height, site.XP, site.YP, pv)
+
+	// The Sun, geocentric in TEME.  Epv00 only warns of dates beyond
+	// its span.
+	pvh, _, _ := sofa.Epv00(utc1, tt2)
+	var sun [2][3]float64
+	for j := 0; j < 3; j++ {
+		sun[0][j] = -pvh[0][j] * auKm
+	}
+	sun = GCRSToTEME(utc1, tt2, sun)
+	_, sel, _, _ := Topocentric(utc1, ut2, site.Elong, site.Phi,
+		site.Height, site.XP, site.YP, sun)
+	e.Dark = sel < f.opt.sunElevation()
+	e.Eclipse = f.eclipse(pv[0], sun[0])
+	return e, nil
+}
+
+// horizon returns the crossing of the minimum elevation between the
+// events a and b, one up and one down, by bisection.
+func (f *passFinder) horizon(a, b Event) (Event, error) {
+	const tol = 1e-6 // days
+	upa := f.up(a)
+	for (b.UTC1-a.UTC1)+(b.UTC2-a.UTC2) > tol {
+		m, err := f.event(a.UTC1, a.UTC2+0.5*((b.UTC1-a.UTC1)+
+			(b.UTC2-a.UTC2)))
+		if err != nil {
+			return m, err
+		}
+		if f.up(m) == upa {
+			a = m
+		} else {
+			b = m
+		}
+	}
+	if upa {
+		return a, nil
+	}
+	return b, nil
+}
+
+// culminate refines the culmination of the pass p, by golden section
+// search about the highest step.
+func (f *passFinder) culminate(p *Pass) error {
+	const tol = 1e-6 // days
+	r := (math.Sqrt(5.0) - 1.0) / 2.0
+	t1 := p.Rise.UTC1
+	lo := math.Max((p.Rise.UTC1-t1)+p.Rise.UTC2,
+		(p.Max.UTC1-t1)+p.Max.UTC2-f.opt.Step)
+	hi := math.Min((p.Set.UTC1-t1)+p.Set.UTC2,
+		(p.Max.UTC1-t1)+p.Max.UTC2+f.opt.Step)
+	c := hi - r*(hi-lo)
+	d := lo + r*(hi-lo)
+	ec, err := f.event(t1, c)
+	if err != nil {
+		return err
+	}
+	ed, err := f.event(t1, d)
+	if err != nil {
+		return err
+	}
+	for hi-lo > tol {
+		if ec.El > ed.El {
+			hi, d, ed = d, c, ec
+			c = hi - r*(hi-lo)
+			if ec, err = f.event(t1, c); err != nil {
+				return err
+			}
+		} else {
+			lo, c, ec = c, d, ed
+			d = lo + r*(hi-lo)
+			if ed, err = f.event(t1, d); err != nil {
+				return err
+			}
+		}
+	}
+	for _, e := range []Event{ec, ed} {
+		f.note(p, e)
+	}
+	return nil
+}
+
+// eclipse returns the eclipsed fraction of the solar disc seen from
+// the satellite at r, with the Sun at sun (both geocentric, km).
+func (f *passFinder) eclipse(r, sun [3]float64) float64 {
+	rm := sofa.Pm(r)
+	rs := sofa.Pmp(sun, r)
+	rsm := sofa.Pm(rs)
+	if f.opt.Shadow == Cylinder {
+		d := sofa.Pdp(r, sun) / sofa.Pm(sun)
+		if d < 0 && rm*rm-d*d < f.re*f.re {
+			return 1.0
+		}
+		return 0.0
+	}
+
+	// The apparent radii of the Sun and the Earth, and the separation
+	// of their centres.
+	a := math.Asin(math.Min(sunRadius/rsm, 1.0))
+	b := math.Asin(math.Min(f.re/rm, 1.0))
+	c := math.Acos(math.Max(-1.0, math.Min(1.0,
+		-sofa.Pdp(rs, r)/(rsm*rm))))
+	switch {
+	case c >= a+b:
+		return 0.0
+	case c <= b-a:
+		return 1.0
+	case c <= a-b:
+		return b * b / (a * a)
+	}
+
+	// The overlap of the two discs.
+	x := (c*c + a*a - b*b) / (2.0 * c)
+	y := math.Sqrt(math.Max(a*a-x*x, 0.0))
+	area := a*a*math.Acos(x/a) + b*b*math.Acos((c-x)/b) - c*y
+	return area / (math.Pi * a * a)
+}
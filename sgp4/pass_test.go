@@ -0,0 +1,141 @@
+package sgp4
+
+import (
+	"math"
+	"testing"
+
+	"github.com/8i8/sofa"
+)
+
+func TestPasses(t *testing.T) {
+	const fname = "Passes"
+	s, _ := New(parse(t, tle00005), WGS72, Improved)
+	site := Site{Elong: -0.0872665, Phi: 0.6981317, Height: 100.0}
+	utc1, utc2 := s.Elements.Epoch1, s.Elements.Epoch2
+	opt := PassOptions{MinElevation: 10.0 * sofa.DD2R}
+	ps, err := Passes(s, site, utc1, utc2, 3.0, opt)
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	if len(ps) == 0 {
+		t.Fatalf("%s: no passes", fname)
+	}
+	f := passFinder{s: s, site: site, opt: opt}
+	for _, p := range ps {
+		day := func(e Event) float64 {
+			return (e.UTC1 - utc1) + (e.UTC2 - utc2)
+		}
+		if !(day(p.Rise) < day(p.Max) && day(p.Max) < day(p.Set)) {
+			t.Errorf("%s: events out of order %v", fname, p)
+		}
+		if day(p.Rise) > 0 {
+			vvd(t, p.Rise.El, opt.MinElevation, 1e-3, fname, "rise")
+		}
+		if day(p.Set) < 3.0 {
+			vvd(t, p.Set.El, opt.MinElevation, 1e-3, fname, "set")
+		}
+
+		// The culmination is a maximum.
+		for _, h := range []float64{-1e-4, 1e-4} {
+			e, _ := f.event(p.Max.UTC1, p.Max.UTC2+h)
+			if e.El > p.Max.El {
+				t.Errorf("%s: not the culmination, %g > %g", fname,
+					e.El, p.Max.El)
+			}
+		}
+		if p.Visible && !p.Sunlit {
+			t.Errorf("%s: visible in eclipse", fname)
+		}
+	}
+
+	// With the darkness set above the zenith, a sunlit pass is visible.
+	zenith := math.Pi
+	opt.SunElevation = &zenith
+	ps, _ = Passes(s, site, utc1, utc2, 1.0, opt)
+	for _, p := range ps {
+		if p.Sunlit != p.Visible || !p.Max.Dark {
+			t.Errorf("%s: sunlit %v visible %v", fname, p.Sunlit,
+				p.Visible)
+		}
+	}
+
+	if _, err = Passes(s, Site{Phi: 2.0}, utc1, utc2, 1.0,
+		opt); err != ErrSite {
+		t.Errorf("%s: want %v got %v", fname, ErrSite, err)
+	}
+}
+
+func TestSunElevation(t *testing.T) {
+	const fname = "SunElevation"
+	s, _ := New(parse(t, tle00005), WGS72, Improved)
+	site := Site{Elong: -0.0872665, Phi: 0.6981317, Height: 100.0}
+	vvd(t, PassOptions{}.sunElevation(), DefaultSunElevation, 0, fname,
+		"default")
+
+	// The horizon, an elevation of zero, is not taken for the default:
+	// over a day, some instants of twilight are dark for one and not
+	// for the other.
+	horizon := 0.0
+	f0 := passFinder{s: s, site: site}
+	f1 := passFinder{s: s, site: site,
+		opt: PassOptions{SunElevation: &horizon}}
+	n := 0
+	for i := 0; i < 1440; i++ {
+		d := float64(i) / 1440.0
+		e0, _ := f0.event(s.Elements.Epoch1, s.Elements.Epoch2+d)
+		e1, _ := f1.event(s.Elements.Epoch1, s.Elements.Epoch2+d)
+		if e0.Dark && !e1.Dark {
+			t.Errorf("%s: dark below -6 deg but not below 0", fname)
+		}
+		if e1.Dark && !e0.Dark {
+			n++
+		}
+	}
+	if n == 0 {
+		t.Errorf("%s: the horizon is taken for the default", fname)
+	}
+}
+
+func TestEclipse(t *testing.T) {
+	const fname = "Eclipse"
+	sun := [3]float64{auKm, 0, 0}
+	cone := passFinder{re: 6378.137}
+	cyl := passFinder{re: 6378.137, opt: PassOptions{Shadow: Cylinder}}
+	tests := []struct {
+		r         [3]float64
+		cone, cyl float64
+	}{
+		{[3]float64{7000, 0, 0}, 0, 0},
+		{[3]float64{-7000, 0, 0}, 1, 1},
+		{[3]float64{0, 7000, 0}, 0, 0},
+		{[3]float64{-7000, 6378.137 + 1.0, 0}, 0.5, 0},
+		{[3]float64{-7000, 6378.137 + 50.0, 0}, 0, 0},
+	}
+	for _, test := range tests {
+		vvd(t, cone.eclipse(test.r, sun), test.cone, 0.1, fname, "cone")
+		vvd(t, cyl.eclipse(test.r, sun), test.cyl, 0, fname, "cylinder")
+	}
+
+	// A penumbra between the umbra and the light.
+	prev := 1.0
+	for y := 6300.0; y < 6500.0; y += 10.0 {
+		e := cone.eclipse([3]float64{-7000, y, 0}, sun)
+		if e > prev || e < 0 || e > 1 {
+			t.Errorf("%s: penumbra not monotonic at %g: %g", fname, y, e)
+		}
+		prev = e
+	}
+	if math.IsNaN(prev) {
+		t.Errorf("%s: NaN", fname)
+	}
+}
+
+func BenchmarkPasses(b *testing.B) {
+	l := [2]string{tle00005[:69], tle00005[70:]}
+	e, _ := ParseTLE(l[0], l[1])
+	s, _ := New(e, WGS72, Improved)
+	site := Site{Elong: -0.0872665, Phi: 0.6981317, Height: 100.0}
+	for i := 0; i < b.N; i++ {
+		Passes(s, site, e.Epoch1, e.Epoch2, 1.0, PassOptions{})
+	}
+}
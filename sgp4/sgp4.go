@@ -0,0 +1,521 @@
+// Package sgp4 propagates the orbits of artificial satellites from
+// two-line element sets (TLE) or orbit mean-elements messages (OMM)
+// with the SGP4 and SDP4 models.
+//
+// The propagator follows that of Vallado, Crawford, Hujsak and Kelso,
+// "Revisiting Spacetrack Report #3" (AIAA 2006-6753), and gives the
+// same results as its reference code.  Positions and velocities are in
+// km and km/s in the TEME frame, the true equator and mean equinox of
+// the epoch of the elements, which may be taken to the GCRS or the ITRS
+// with TEMEToGCRS and TEMEToITRS, or seen from an observer on the Earth
+// with Topocentric.
+package sgp4
+
+import (
+	"errors"
+	"math"
+)
+
+// Errors of the propagation, as the error codes 1 to 6 of the
+// reference code.
+var (
+	ErrEccentricity    = errors.New("sgp4: mean eccentricity out of range")
+	ErrMeanMotion      = errors.New("sgp4: mean motion less than zero")
+	ErrPerturbedEcc    = errors.New("sgp4: perturbed eccentricity out of range")
+	ErrSemiLatusRectum = errors.New("sgp4: semi-latus rectum less than zero")
+	ErrDecayed         = errors.New("sgp4: satellite has decayed")
+)
+
+const (
+	twopi = 2.0 * math.Pi
+	x2o3  = 2.0 / 3.0
+)
+
+// Gravity is a set of the gravitational constants of the Earth.
+type Gravity int
+
+// The sets of constants.  WGS72 is that of the SGP4 model as it is
+// used to generate the elements, and should normally be used.
+const (
+	WGS72 Gravity = iota
+	WGS72Old
+	WGS84
+)
+
+// gravConst are the constants of a Gravity:  the gravitational
+// parameter (km^3/s^2), the radius of the Earth (km), the reciprocal
+// of the time unit (1/min) and the zonal harmonics.
+type gravConst struct {
+	mu, radius, xke, j2, j3, j4, j3oj2 float64
+}
+
+func (g Gravity) constants() gravConst {
+	var c gravConst
+	switch g {
+	case WGS72Old:
+		c.mu = 398600.79964
+		c.radius = 6378.135
+		c.xke = 0.0743669161
+		c.j2 = 0.001082616
+		c.j3 = -0.00000253881
+		c.j4 = -0.00000165597
+	case WGS84:
+		c.mu = 398600.5
+		c.radius = 6378.137
+		c.j2 = 0.00108262998905
+		c.j3 = -0.00000253215306
+		c.j4 = -0.00000161098761
+	default:
+		c.mu = 398600.8
+		c.radius = 6378.135
+		c.j2 = 0.001082616
+		c.j3 = -0.00000253881
+		c.j4 = -0.00000165597
+	}
+	if c.xke == 0 {
+		c.xke = 60.0 / math.Sqrt(c.radius*c.radius*c.radius/c.mu)
+	}
+	c.j3oj2 = c.j3 / c.j2
+	return c
+}
+
+// Mode is the mode of operation of the propagator.
+type Mode int
+
+// In AFSPC mode the sidereal time at epoch and the angles of the deep
+// space periodics are reduced as by the operational code of the US Air
+// Force Space Command;  Improved mode uses the full sidereal time and
+// drops those reductions.  The differences are small.
+const (
+	Improved Mode = iota
+	AFSPC
+)
+
+// Satellite is an element set prepared for propagation.
+type Satellite struct {
+	Elements *Elements
+	grav     gravConst
+	mode     Mode
+
+	// Near earth.
+	isimp                                              bool
+	aycof, con41, cc1, cc4, cc5, d2, d3, d4, delmo     float64
+	eta, argpdot, omgcof, sinmao, t2cof, t3cof, t4cof  float64
+	t5cof, x1mth2, x7thm1, mdot, nodedot, xlcof, xmcof float64
+	nodecf                                             float64
+
+	// Deep space.
+	deep                                            bool
+	irez                                            int
+	d2201, d2211, d3210, d3222, d4410, d4422, d5220 float64
+	d5232, d5421, d5433, dedt, del1, del2, del3     float64
+	didt, dmdt, dnodt, domdt, e3, ee2, se2, se3     float64
+	sgh2, sgh3, sgh4, sh2, sh3, si2, si3, sl2, sl3  float64
+	sl4, gsto, xfact, xgh2, xgh3, xgh4, xh2, xh3    float64
+	xi2, xi3, xl2, xl3, xl4, xlamo, zmol, zmos      float64
+
+	// The elements in the units of the model.
+	epoch                                    float64 // days from 1949 December 31 0h
+	bstar, ecco, argpo, inclo, mo, no, nodeo float64
+	noKozai                                  float64
+}
+
+// New returns the satellite of the elements e, for the constants grav
+// and the mode of operation mode.  An error is returned if the
+// elements cannot be propagated.
+func New(e *Elements, grav Gravity, mode Mode) (*Satellite, error) {
+	s := &Satellite{Elements: e, grav: grav.constants(), mode: mode}
+	const xpdotp = 1440.0 / twopi // rev/day per rad/min
+	d2r := math.Pi / 180.0
+	s.noKozai = e.MeanMotion / xpdotp
+	s.bstar = e.BStar
+	s.ecco = e.Eccentricity
+	s.argpo = e.ArgPerigee * d2r
+	s.inclo = e.Inclination * d2r
+	s.mo = e.MeanAnomaly * d2r
+	s.nodeo = e.RAAN * d2r
+	s.epoch = (e.Epoch1 - 2433281.5) + e.Epoch2
+	if err := s.init(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// initl initializes the orbit, returning the quantities used by init.
+func (s *Satellite) initl() (ainv, ao, con42, cosio, cosio2, eccsq,
+	omeosq, posq, rp, rteosq, sinio float64) {
+	g := &s.grav
+
+	// Calculate auxillary epoch quantities.
+	eccsq = s.ecco * s.ecco
+	omeosq = 1.0 - eccsq
+	rteosq = math.Sqrt(omeosq)
+	cosio = math.Cos(s.inclo)
+	cosio2 = cosio * cosio
+
+	// Un-kozai the mean motion.
+	ak := math.Pow(g.xke/s.noKozai, x2o3)
+	d1 := 0.75 * g.j2 * (3.0*cosio2 - 1.0) / (rteosq * omeosq)
+	del := d1 / (ak * ak)
+	adel := ak * (1.0 - del*del - del*(1.0/3.0+134.0*del*del/81.0))
+	del = d1 / (adel * adel)
+	s.no = s.noKozai / (1.0 + del)
+
+	ao = math.Pow(g.xke/s.no, x2o3)
+	sinio = math.Sin(s.inclo)
+	po := ao * omeosq
+	con42 = 1.0 - 5.0*cosio2
+	s.con41 = -con42 - cosio2 - cosio2
+	ainv = 1.0 / ao
+	posq = po * po
+	rp = ao * (1.0 - s.ecco)
+
+	// Sidereal time at epoch.
+	if s.mode == AFSPC {
+		ts70 := s.epoch - 7305.0
+		ds70 := math.Floor(ts70 + 1.0e-8)
+		tfrac := ts70 - ds70
+		const (
+			c1     = 1.72027916940703639e-2
+			thgr70 = 1.7321343856509374
+			fk5r   = 5.07551419432269442e-15
+		)
+		c1p2p := c1 + twopi
+		s.gsto = math.Mod(thgr70+c1*ds70+c1p2p*tfrac+
+			ts70*ts70*fk5r, twopi)
+		if s.gsto < 0.0 {
+			s.gsto += twopi
+		}
+	} else {
+		s.gsto = gstime(s.epoch + 2433281.5)
+	}
+	return
+}
+
+// gstime returns the Greenwich sidereal time (IAU 1982) of the UT1
+// Julian Date jdut1, as the reference code does.
+func gstime(jdut1 float64) float64 {
+	tut1 := (jdut1 - 2451545.0) / 36525.0
+	temp := -6.2e-6*tut1*tut1*tut1 + 0.093104*tut1*tut1 +
+		(876600.0*3600+8640184.812866)*tut1 + 67310.54841
+	temp = math.Mod(temp*math.Pi/180.0/240.0, twopi)
+	if temp < 0.0 {
+		temp += twopi
+	}
+	return temp
+}
+
+// init initializes the propagator, as sgp4init of the reference code.
+func (s *Satellite) init() error {
+	g := &s.grav
+	const temp4 = 1.5e-12
+
+	ss := 78.0/g.radius + 1.0
+	qzms2ttemp := (120.0 - 78.0) / g.radius
+	qzms2t := qzms2ttemp * qzms2ttemp * qzms2ttemp * qzms2ttemp
+
+	_, ao, con42, cosio, cosio2, eccsq, omeosq, posq, rp, rteosq,
+		sinio := s.initl()
+
+	if omeosq >= 0.0 || s.no >= 0.0 {
+		s.isimp = rp < 220.0/g.radius+1.0
+		sfour := ss
+		qzms24 := qzms2t
+		perige := (rp - 1.0) * g.radius
+
+		// For perigees below 156 km, s and qoms2t are altered.
+		if perige < 156.0 {
+			sfour = perige - 78.0
+			if perige < 98.0 {
+				sfour = 20.0
+			}
+			qzms24 = math.Pow((120.0-sfour)/g.radius, 4.0)
+			sfour = sfour/g.radius + 1.0
+		}
+		pinvsq := 1.0 / posq
+
+		tsi := 1.0 / (ao - sfour)
+		s.eta = ao * s.ecco * tsi
+		etasq := s.eta * s.eta
+		eeta := s.ecco * s.eta
+		psisq := math.Abs(1.0 - etasq)
+		coef := qzms24 * math.Pow(tsi, 4.0)
+		coef1 := coef / math.Pow(psisq, 3.5)
+		cc2 := coef1 * s.no * (ao*(1.0+1.5*etasq+eeta*(4.0+etasq)) +
+			0.375*g.j2*tsi/psisq*s.con41*
+				(8.0+3.0*etasq*(8.0+etasq)))
+		s.cc1 = s.bstar * cc2
+		cc3 := 0.0
+		if s.ecco > 1.0e-4 {
+			cc3 = -2.0 * coef * tsi * g.j3oj2 * s.no * sinio / s.ecco
+		}
+		s.x1mth2 = 1.0 - cosio2
+		s.cc4 = 2.0 * s.no * coef1 * ao * omeosq *
+			(s.eta*(2.0+0.5*etasq) + s.ecco*(0.5+2.0*etasq) -
+				g.j2*tsi/(ao*psisq)*
+					(-3.0*s.con41*(1.0-2.0*eeta+etasq*(1.5-0.5*eeta))+
+						0.75*s.x1mth2*(2.0*etasq-eeta*(1.0+etasq))*
+							math.Cos(2.0*s.argpo)))
+		s.cc5 = 2.0 * coef1 * ao * omeosq *
+			(1.0 + 2.75*(etasq+eeta) + eeta*etasq)
+		cosio4 := cosio2 * cosio2
+		temp1 := 1.5 * g.j2 * pinvsq * s.no
+		temp2 := 0.5 * temp1 * g.j2 * pinvsq
+		temp3 := -0.46875 * g.j4 * pinvsq * pinvsq * s.no
+		s.mdot = s.no + 0.5*temp1*rteosq*s.con41 +
+			0.0625*temp2*rteosq*(13.0-78.0*cosio2+137.0*cosio4)
+		s.argpdot = -0.5*temp1*con42 +
+			0.0625*temp2*(7.0-114.0*cosio2+395.0*cosio4) +
+			temp3*(3.0-36.0*cosio2+49.0*cosio4)
+		xhdot1 := -temp1 * cosio
+		s.nodedot = xhdot1 + (0.5*temp2*(4.0-19.0*cosio2)+
+			2.0*temp3*(3.0-7.0*cosio2))*cosio
+		xpidot := s.argpdot + s.nodedot
+		s.omgcof = s.bstar * cc3 * math.Cos(s.argpo)
+		s.xmcof = 0.0
+		if s.ecco > 1.0e-4 {
+			s.xmcof = -x2o3 * coef * s.bstar / eeta
+		}
+		s.nodecf = 3.5 * omeosq * xhdot1 * s.cc1
+		s.t2cof = 1.5 * s.cc1
+		if math.Abs(cosio+1.0) > 1.5e-12 {
+			s.xlcof = -0.25 * g.j3oj2 * sinio * (3.0 + 5.0*cosio) /
+				(1.0 + cosio)
+		} else {
+			s.xlcof = -0.25 * g.j3oj2 * sinio * (3.0 + 5.0*cosio) /
+				temp4
+		}
+		s.aycof = -0.5 * g.j3oj2 * sinio
+		delmotemp := 1.0 + s.eta*math.Cos(s.mo)
+		s.delmo = delmotemp * delmotemp * delmotemp
+		s.sinmao = math.Sin(s.mo)
+		s.x7thm1 = 7.0*cosio2 - 1.0
+
+		// Deep space initialization.
+		if twopi/s.no >= 225.0 {
+			s.deep = true
+			s.isimp = true
+			s.deepInit(eccsq, xpidot)
+		}
+
+		// Set variables if not deep space.
+		if !s.isimp {
+			cc1sq := s.cc1 * s.cc1
+			s.d2 = 4.0 * ao * tsi * cc1sq
+			temp := s.d2 * tsi * s.cc1 / 3.0
+			s.d3 = (17.0*ao + sfour) * temp
+			s.d4 = 0.5 * temp * ao * tsi * (221.0*ao + 31.0*sfour) *
+				s.cc1
+			s.t3cof = s.d2 + 2.0*cc1sq
+			s.t4cof = 0.25 * (3.0*s.d3 + s.cc1*(12.0*s.d2+10.0*cc1sq))
+			s.t5cof = 0.2 * (3.0*s.d4 + 12.0*s.cc1*s.d3 +
+				6.0*s.d2*s.d2 + 15.0*cc1sq*(2.0*s.d2+cc1sq))
+		}
+	}
+
+	// Propagate to zero epoch to initialize the rest.
+	_, _, err := s.Propagate(0.0)
+	return err
+}
+
+// Propagate returns the position (km) and velocity (km/s) in the TEME
+// frame at tsince minutes from the epoch of the elements.
+func (s *Satellite) Propagate(tsince float64) (r, v [3]float64,
+	err error) {
+	g := &s.grav
+	const temp4 = 1.5e-12
+	vkmpersec := g.radius * g.xke / 60.0
+
+	// Update for secular gravity and atmospheric drag.
+	t := tsince
+	xmdf := s.mo + s.mdot*t
+	argpdf := s.argpo + s.argpdot*t
+	nodedf := s.nodeo + s.nodedot*t
+	argpm := argpdf
+	mm := xmdf
+	t2 := t * t
+	nodem := nodedf + s.nodecf*t2
+	tempa := 1.0 - s.cc1*t
+	tempe := s.bstar * s.cc4 * t
+	templ := s.t2cof * t2
+
+	if !s.isimp {
+		delomg := s.omgcof * t
+		delmtemp := 1.0 + s.eta*math.Cos(xmdf)
+		delm := s.xmcof * (delmtemp*delmtemp*delmtemp - s.delmo)
+		temp := delomg + delm
+		mm = xmdf + temp
+		argpm = argpdf - temp
+		t3 := t2 * t
+		t4 := t3 * t
+		tempa = tempa - s.d2*t2 - s.d3*t3 - s.d4*t4
+		tempe = tempe + s.bstar*s.cc5*(math.Sin(mm)-s.sinmao)
+		templ = templ + s.t3cof*t3 + t4*(s.t4cof+t*s.t5cof)
+	}
+
+	nm := s.no
+	em := s.ecco
+	inclm := s.inclo
+	if s.deep {
+		em, argpm, inclm, mm, nodem, nm = s.dspace(t, em, argpm, inclm,
+			mm, nodem, nm)
+	}
+
+	if nm <= 0.0 {
+		return r, v, ErrMeanMotion
+	}
+	am := math.Pow(g.xke/nm, x2o3) * tempa * tempa
+	nm = g.xke / math.Pow(am, 1.5)
+	em = em - tempe
+
+	if em >= 1.0 || em < -0.001 {
+		return r, v, ErrEccentricity
+	}
+	if em < 1.0e-6 {
+		em = 1.0e-6
+	}
+	mm = mm + s.no*templ
+	xlm := mm + argpm + nodem
+	nodem = math.Mod(nodem, twopi)
+	argpm = math.Mod(argpm, twopi)
+	xlm = math.Mod(xlm, twopi)
+	mm = math.Mod(xlm-argpm-nodem, twopi)
+
+	// Compute extra mean quantities.
+	sinim := math.Sin(inclm)
+	cosim := math.Cos(inclm)
+
+	// Add lunar-solar periodics.
+	ep := em
+	xincp := inclm
+	argpp := argpm
+	nodep := nodem
+	mp := mm
+	sinip := sinim
+	cosip := cosim
+	aycof, xlcof := s.aycof, s.xlcof
+	con41, x1mth2, x7thm1 := s.con41, s.x1mth2, s.x7thm1
+	if s.deep {
+		ep, xincp, nodep, argpp, mp = s.dpper(t, ep, xincp,
+			nodep, argpp, mp)
+		if xincp < 0.0 {
+			xincp = -xincp
+			nodep = nodep + math.Pi
+			argpp = argpp - math.Pi
+		}
+		if ep < 0.0 || ep > 1.0 {
+			return r, v, ErrPerturbedEcc
+		}
+
+		// Long period periodics.
+		sinip = math.Sin(xincp)
+		cosip = math.Cos(xincp)
+		aycof = -0.5 * g.j3oj2 * sinip
+		if math.Abs(cosip+1.0) > 1.5e-12 {
+			xlcof = -0.25 * g.j3oj2 * sinip * (3.0 + 5.0*cosip) /
+				(1.0 + cosip)
+		} else {
+			xlcof = -0.25 * g.j3oj2 * sinip * (3.0 + 5.0*cosip) /
+				temp4
+		}
+	}
+	axnl := ep * math.Cos(argpp)
+	temp := 1.0 / (am * (1.0 - ep*ep))
+	aynl := ep*math.Sin(argpp) + temp*aycof
+	xl := mp + argpp + nodep + temp*xlcof*axnl
+
+	// Solve Kepler's equation.
+	u := math.Mod(xl-nodep, twopi)
+	eo1 := u
+	tem5 := 9999.9
+	var sineo1, coseo1 float64
+	for ktr := 1; math.Abs(tem5) >= 1.0e-12 && ktr <= 10; ktr++ {
+		sineo1 = math.Sin(eo1)
+		coseo1 = math.Cos(eo1)
+		tem5 = 1.0 - coseo1*axnl - sineo1*aynl
+		tem5 = (u - aynl*coseo1 + axnl*sineo1 - eo1) / tem5
+		if math.Abs(tem5) >= 0.95 {
+			if tem5 > 0.0 {
+				tem5 = 0.95
+			} else {
+				tem5 = -0.95
+			}
+		}
+		eo1 = eo1 + tem5
+	}
+
+	// Short period preliminary quantities.
+	ecose := axnl*coseo1 + aynl*sineo1
+	esine := axnl*sineo1 - aynl*coseo1
+	el2 := axnl*axnl + aynl*aynl
+	pl := am * (1.0 - el2)
+	if pl < 0.0 {
+		return r, v, ErrSemiLatusRectum
+	}
+	rl := am * (1.0 - ecose)
+	rdotl := math.Sqrt(am) * esine / rl
+	rvdotl := math.Sqrt(pl) / rl
+	betal := math.Sqrt(1.0 - el2)
+	temp = esine / (1.0 + betal)
+	sinu := am / rl * (sineo1 - aynl - axnl*temp)
+	cosu := am / rl * (coseo1 - axnl + aynl*temp)
+	su := math.Atan2(sinu, cosu)
+	sin2u := (cosu + cosu) * sinu
+	cos2u := 1.0 - 2.0*sinu*sinu
+	temp = 1.0 / pl
+	temp1 := 0.5 * g.j2 * temp
+	temp2 := temp1 * temp
+
+	// Update for short period periodics.
+	if s.deep {
+		cosisq := cosip * cosip
+		con41 = 3.0*cosisq - 1.0
+		x1mth2 = 1.0 - cosisq
+		x7thm1 = 7.0*cosisq - 1.0
+	}
+	mrt := rl*(1.0-1.5*temp2*betal*con41) +
+		0.5*temp1*x1mth2*cos2u
+	su = su - 0.25*temp2*x7thm1*sin2u
+	xnode := nodep + 1.5*temp2*cosip*sin2u
+	xinc := xincp + 1.5*temp2*cosip*sinip*cos2u
+	mvt := rdotl - nm*temp1*x1mth2*sin2u/g.xke
+	rvdot := rvdotl + nm*temp1*(x1mth2*cos2u+1.5*con41)/g.xke
+
+	// Orientation vectors.
+	sinsu := math.Sin(su)
+	cossu := math.Cos(su)
+	snod := math.Sin(xnode)
+	cnod := math.Cos(xnode)
+	sini := math.Sin(xinc)
+	cosi := math.Cos(xinc)
+	xmx := -snod * cosi
+	xmy := cnod * cosi
+	ux := xmx*sinsu + cnod*cossu
+	uy := xmy*sinsu + snod*cossu
+	uz := sini * sinsu
+	vx := xmx*cossu - cnod*sinsu
+	vy := xmy*cossu - snod*sinsu
+	vz := sini * cossu
+
+	// Position and velocity (in km and km/sec).
+	r = [3]float64{mrt * ux * g.radius, mrt * uy * g.radius,
+		mrt * uz * g.radius}
+	v = [3]float64{(mvt*ux + rvdot*vx) * vkmpersec,
+		(mvt*uy + rvdot*vy) * vkmpersec,
+		(mvt*uz + rvdot*vz) * vkmpersec}
+
+	// Decaying satellites.
+	if mrt < 1.0 {
+		return r, v, ErrDecayed
+	}
+	return r, v, nil
+}
+
+// PV returns the position (km) and velocity (km/s) in the TEME frame
+// at the UTC 2-part Julian Date jd1+jd2.
+func (s *Satellite) PV(jd1, jd2 float64) (pv [2][3]float64, err error) {
+	tsince := ((jd1 - s.Elements.Epoch1) + (jd2 - s.Elements.Epoch2)) *
+		1440.0
+	pv[0], pv[1], err = s.Propagate(tsince)
+	return
+}
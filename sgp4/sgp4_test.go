@@ -0,0 +1,449 @@
+package sgp4
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/8i8/sofa"
+)
+
+// Element sets of the verification of Vallado et al.
+const (
+	tle00005 = "1 00005U 58002B   00179.78495062  .00000023  00000-0  28098-4 0  4753\n" +
+		"2 00005  34.2682 348.7242 1859667 331.7664  19.3264 10.82419157413667"
+	tle08195 = "1 08195U 75081A   06176.33215444  .00000099  00000-0  11873-3 0   813\n" +
+		"2 08195  64.1586 279.0717 6877146 264.7651  20.2257  2.00491383225656"
+)
+
+// The directory of SGP4-VER.TLE and tcppver.out, the element sets and
+// the output of the C++ SGP4 of Vallado et al., for TestVerification.
+var verDir = flag.String("sgp4ver", "testdata",
+	"directory of SGP4-VER.TLE and tcppver.out")
+
+func vvd(t *testing.T, val, valok, dval float64, fname, test string) {
+	t.Helper()
+	if math.Abs(val-valok) > dval || math.IsNaN(val) {
+		t.Errorf("%s failed: %s want %.20g got %.20g", fname, test,
+			valok, val)
+	}
+}
+
+func parse(t *testing.T, tle string) *Elements {
+	t.Helper()
+	l := strings.Split(tle, "\n")
+	e, err := ParseTLE(l[0], l[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestPropagate(t *testing.T) {
+	const fname = "Propagate"
+	tests := []struct {
+		tle    string
+		tsince float64
+		r, v   [3]float64
+	}{
+		{tle00005, 0.0,
+			[3]float64{7022.46529266, -1400.08296755, 0.03995155},
+			[3]float64{1.893841015, 6.405893759, 4.534807250}},
+		{tle00005, 360.0,
+			[3]float64{-7154.03120202, -3783.17682504, -3536.19412294},
+			[3]float64{4.741887409, -4.151817765, -2.093935425}},
+		{tle00005, 720.0,
+			[3]float64{-7134.59340119, 6531.68641334, 3260.27186483},
+			[3]float64{-4.113793027, -2.911922039, -2.557327851}},
+		{tle00005, 1440.0,
+			[3]float64{-938.55923943, -6268.18748831, -4294.02924751},
+			[3]float64{7.536105209, -0.427127707, 0.989878080}},
+		{tle08195, 0.0,
+			[3]float64{2349.89483350, -14785.93811562, 0.02119378},
+			[3]float64{2.721488096, -3.256811655, 4.498416672}},
+	}
+	for _, test := range tests {
+		s, err := New(parse(t, test.tle), WGS72, Improved)
+		if err != nil {
+			t.Fatalf("%s: %v", fname, err)
+		}
+		r, v, err := s.Propagate(test.tsince)
+		if err != nil {
+			t.Fatalf("%s: %v", fname, err)
+		}
+		for j := 0; j < 3; j++ {
+			vvd(t, r[j], test.r[j], 1e-7, fname, "r")
+			vvd(t, v[j], test.v[j], 1e-9, fname, "v")
+		}
+	}
+}
+
+// TestVerification runs the complete verification of Vallado et al.,
+// near earth, deep space, resonant and decaying, against tcppver.out,
+// the output of their C++ SGP4 in the improved mode with WGS72.  The
+// files are not distributed with the package;  without them the test
+// is skipped.
+func TestVerification(t *testing.T) {
+	const fname = "Verification"
+	tles, err := readVerTLE(filepath.Join(*verDir, "SGP4-VER.TLE"))
+	if os.IsNotExist(err) {
+		t.Skipf("%s: %v", fname, err)
+	}
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	f, err := os.Open(filepath.Join(*verDir, "tcppver.out"))
+	if os.IsNotExist(err) {
+		t.Skipf("%s: %v", fname, err)
+	}
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	defer f.Close()
+
+	// tcppver.out is, for each satellite, a line of the catalog number
+	// and "xx", then one of tsince, r and v for each time.
+	var s *Satellite
+	var sat int
+	n := 0
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fs := strings.Fields(sc.Text())
+		if len(fs) == 2 && fs[1] == "xx" {
+			sat, _ = strconv.Atoi(fs[0])
+			s = nil
+			e, ok := tles[sat]
+			if !ok {
+				t.Errorf("%s: %d has no element set", fname, sat)
+				continue
+			}
+			if s, err = New(e, WGS72, Improved); err != nil {
+				t.Errorf("%s: %d: %v", fname, sat, err)
+			}
+			continue
+		}
+		if s == nil || len(fs) < 7 {
+			continue
+		}
+		var x [7]float64
+		for j := range x {
+			if x[j], err = strconv.ParseFloat(fs[j], 64); err != nil {
+				break
+			}
+		}
+		if err != nil {
+			continue
+		}
+		r, v, err := s.Propagate(x[0])
+		if err != nil {
+			t.Errorf("%s: %d at %g: %v", fname, sat, x[0], err)
+			continue
+		}
+		test := strconv.Itoa(sat) + " at " + fs[0]
+		for j := 0; j < 3; j++ {
+			vvd(t, r[j], x[1+j], 1e-7, fname, test+" r")
+			vvd(t, v[j], x[4+j], 1e-9, fname, test+" v")
+		}
+		n++
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	if n == 0 {
+		t.Errorf("%s: no states in tcppver.out", fname)
+	}
+}
+
+// readVerTLE returns the element sets of the file name, by catalog
+// number.  The sets of SGP4-VER.TLE carry the start, stop and step
+// times after column 69, and some are malformed by design;  those are
+// left out.
+func readVerTLE(name string) (map[int]*Elements, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	tles := make(map[int]*Elements)
+	var line1 string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		l := sc.Text()
+		switch {
+		case strings.HasPrefix(l, "1 "):
+			line1 = l
+		case strings.HasPrefix(l, "2 ") && line1 != "":
+			if e, err := ParseTLE(line1, l); err == nil {
+				tles[e.CatalogNumber] = e
+			}
+			line1 = ""
+		}
+	}
+	return tles, sc.Err()
+}
+
+func TestDeepSpace(t *testing.T) {
+	const fname = "Deep space"
+	s, _ := New(parse(t, tle08195), WGS72, Improved)
+	if !s.deep || s.irez != 2 {
+		t.Fatalf("%s: want a 12 hour resonance got %v %d", fname, s.deep,
+			s.irez)
+	}
+
+	// Integration backward and forward, and the velocity as the rate
+	// of the position, to the consistency of the model.
+	for _, ts := range []float64{-2880.0, 1440.0, 4320.0} {
+		pv, err := s.PV(s.Elements.Epoch1, s.Elements.Epoch2+ts/1440.0)
+		if err != nil {
+			t.Fatalf("%s: %v", fname, err)
+		}
+		r0, _, _ := s.Propagate(ts - 0.01)
+		r1, _, _ := s.Propagate(ts + 0.01)
+		for j := 0; j < 3; j++ {
+			vvd(t, pv[1][j], (r1[j]-r0[j])/1.2, 1e-3, fname, "v")
+		}
+	}
+
+	// AFSPC mode differs little.
+	a, _ := New(parse(t, tle08195), WGS72, AFSPC)
+	ra, _, _ := a.Propagate(1440.0)
+	ri, _, _ := s.Propagate(1440.0)
+	for j := 0; j < 3; j++ {
+		vvd(t, ra[j], ri[j], 1e-2, fname, "AFSPC")
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	const fname = "New errors"
+	e := parse(t, tle00005)
+	e.Eccentricity = 1.2
+	if _, err := New(e, WGS72, Improved); err != ErrEccentricity {
+		t.Errorf("%s: want %v got %v", fname, ErrEccentricity, err)
+	}
+
+	// A low satellite whose drag brings it down.
+	e = parse(t, tle00005)
+	e.BStar = 0.01
+	e.MeanMotion = 16.0
+	e.Eccentricity = 0.001
+	s, err := New(e, WGS72, Improved)
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	if _, _, err = s.Propagate(4000.0); err != ErrDecayed {
+		t.Errorf("%s: want %v got %v", fname, ErrDecayed, err)
+	}
+}
+
+func TestParseTLE(t *testing.T) {
+	const fname = "ParseTLE"
+	e := parse(t, tle00005)
+	if e.CatalogNumber != 5 || e.Classification != 'U' ||
+		e.IntlDesignator != "58002B" || e.ElementSet != 475 ||
+		e.RevNumber != 41366 {
+		t.Errorf("%s: got %+v", fname, e)
+	}
+	vvd(t, e.Epoch1, 2451722.5, 0, fname, "epoch1")
+	vvd(t, e.Epoch2, 0.78495062, 1e-12, fname, "epoch2")
+	vvd(t, e.NDot, 0.00000023, 1e-20, fname, "ndot")
+	vvd(t, e.NDDot, 0, 0, fname, "nddot")
+	vvd(t, e.BStar, 0.28098e-4, 1e-20, fname, "bstar")
+	vvd(t, e.Inclination, 34.2682, 1e-12, fname, "incl")
+	vvd(t, e.RAAN, 348.7242, 1e-12, fname, "node")
+	vvd(t, e.Eccentricity, 0.1859667, 1e-15, fname, "ecc")
+	vvd(t, e.ArgPerigee, 331.7664, 1e-12, fname, "argp")
+	vvd(t, e.MeanAnomaly, 19.3264, 1e-12, fname, "M")
+	vvd(t, e.MeanMotion, 10.82419157, 1e-12, fname, "n")
+
+	l := strings.Split(tle00005, "\n")
+	if _, err := ParseTLE(l[0][:68]+"4", l[1]); !errors.Is(err,
+		ErrChecksum) {
+		t.Errorf("%s: want %v got %v", fname, ErrChecksum, err)
+	}
+	bad := l[0][:20] + "x" + l[0][21:68]
+	bad += string(rune('0' + checksum(bad)))
+	if _, err := ParseTLE(bad, l[1]); !errors.Is(err, ErrSyntax) {
+		t.Errorf("%s: want %v got %v", fname, ErrSyntax, err)
+	}
+
+	// Alpha-5 catalog numbers, and a negative exponent field.
+	a1 := "1 T0005U 58002B   00179.78495062  .00000023  00000-0 -28098-4 0  475"
+	a2 := "2 T0005  34.2682 348.7242 1859667 331.7664  19.3264 10.82419157413667"
+	a1 += string(rune('0' + checksum(a1)))
+	a2 = a2[:68] + string(rune('0'+checksum(a2[:68])))
+	e, err := ParseTLE(a1, a2)
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	if e.CatalogNumber != 270005 {
+		t.Errorf("%s: want 270005 got %d", fname, e.CatalogNumber)
+	}
+	vvd(t, e.BStar, -0.28098e-4, 1e-20, fname, "bstar")
+}
+
+func TestReadTLE(t *testing.T) {
+	const fname = "ReadTLE"
+	in := "# verification\nVANGUARD 1\n" + tle00005 + "\n\n0 MOLNIYA 2-14\n" +
+		tle08195 + "\n"
+	es, err := ReadTLE(strings.NewReader(in))
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	if len(es) != 2 || es[0].Name != "VANGUARD 1" ||
+		es[1].Name != "MOLNIYA 2-14" || es[1].CatalogNumber != 8195 {
+		t.Errorf("%s: got %d sets", fname, len(es))
+	}
+	l := strings.Split(tle00005, "\n")
+	if _, err = ReadTLE(strings.NewReader(l[0])); !errors.Is(err,
+		ErrSyntax) {
+		t.Errorf("%s: want %v got %v", fname, ErrSyntax, err)
+	}
+}
+
+func TestReadOMM(t *testing.T) {
+	const fname = "ReadOMM"
+	const json = `[{"OBJECT_NAME":"VANGUARD 1","OBJECT_ID":"1958-002B",
+"EPOCH":"2000-06-27T18:50:19.733568","MEAN_MOTION":10.82419157,
+"ECCENTRICITY":0.1859667,"INCLINATION":34.2682,"RA_OF_ASC_NODE":348.7242,
+"ARG_OF_PERICENTER":331.7664,"MEAN_ANOMALY":19.3264,"EPHEMERIS_TYPE":0,
+"CLASSIFICATION_TYPE":"U","NORAD_CAT_ID":5,"ELEMENT_SET_NO":475,
+"REV_AT_EPOCH":41366,"BSTAR":2.8098e-5,"MEAN_MOTION_DOT":2.3e-7,
+"MEAN_MOTION_DDOT":0}]`
+	const kvn = `CCSDS_OMM_VERS = 2.0
+COMMENT made up from the TLE
+OBJECT_NAME = VANGUARD 1
+OBJECT_ID = 1958-002B
+MEAN_ELEMENT_THEORY = SGP/SGP4
+EPOCH = 2000-179T18:50:19.733568
+MEAN_MOTION = 10.82419157 [rev/day]
+ECCENTRICITY = 0.1859667
+INCLINATION = 34.2682 [deg]
+RA_OF_ASC_NODE = 348.7242 [deg]
+ARG_OF_PERICENTER = 331.7664 [deg]
+MEAN_ANOMALY = 19.3264 [deg]
+NORAD_CAT_ID = 5
+BSTAR = 0.28098E-4 [1/ER]
+MEAN_MOTION_DOT = 0.00000023 [rev/day**2]
+`
+	s0, _ := New(parse(t, tle00005), WGS72, Improved)
+	r0, _, _ := s0.Propagate(360.0)
+	for _, in := range []string{json, kvn} {
+		es, err := ReadOMM(strings.NewReader(in))
+		if err != nil {
+			t.Fatalf("%s: %v", fname, err)
+		}
+		if len(es) != 1 || es[0].Name != "VANGUARD 1" ||
+			es[0].CatalogNumber != 5 {
+			t.Fatalf("%s: got %+v", fname, es)
+		}
+		vvd(t, es[0].Epoch1+es[0].Epoch2, 2451723.28495062, 1e-8,
+			fname, "epoch")
+		s, err := New(es[0], WGS72, Improved)
+		if err != nil {
+			t.Fatalf("%s: %v", fname, err)
+		}
+		r, _, _ := s.Propagate(360.0)
+		for j := 0; j < 3; j++ {
+			vvd(t, r[j], r0[j], 1e-6, fname, "r")
+		}
+	}
+
+	bad := strings.Replace(kvn, "SGP/SGP4", "DSST", 1)
+	if _, err := ReadOMM(strings.NewReader(bad)); !errors.Is(err,
+		ErrSyntax) {
+		t.Errorf("%s: want %v got %v", fname, ErrSyntax, err)
+	}
+	bad = strings.Replace(kvn, "EPOCH", "EPOCHS", 1)
+	if _, err := ReadOMM(strings.NewReader(bad)); !errors.Is(err,
+		ErrSyntax) {
+		t.Errorf("%s: want %v got %v", fname, ErrSyntax, err)
+	}
+}
+
+func TestTEME(t *testing.T) {
+	const fname = "TEME"
+	s, _ := New(parse(t, tle00005), WGS72, Improved)
+	pv, _ := s.PV(2451723.5, 0.25)
+	tt1, tt2 := 2451723.5, 0.25+64.184/sofa.DAYSEC
+	xp, yp := 2.5e-7, 1.7e-6
+
+	g := TEMEToGCRS(tt1, tt2, pv)
+	b := GCRSToTEME(tt1, tt2, g)
+	i := TEMEToITRS(tt1, 0.25, xp, yp, pv)
+	c := ITRSToTEME(tt1, 0.25, xp, yp, i)
+	for k := 0; k < 2; k++ {
+		for j := 0; j < 3; j++ {
+			vvd(t, b[k][j], pv[k][j], 1e-8, fname, "GCRS")
+			vvd(t, c[k][j], pv[k][j], 1e-8, fname, "ITRS")
+		}
+	}
+	vvd(t, sofa.Pm(g[0]), sofa.Pm(pv[0]), 1e-8, fname, "|r|")
+
+	// TEME differs from the GCRS by the precession since J2000.
+	vvd(t, math.Acos(sofa.Pdp(g[0], pv[0])/sofa.Pm(g[0])/
+		sofa.Pm(pv[0])), 0, 1e-3, fname, "angle")
+
+	// The Earth-fixed velocity is the rate of the Earth-fixed position.
+	const h = 1.0 / 86400.0
+	p0, _ := s.PV(2451723.5, 0.25-h)
+	p1, _ := s.PV(2451723.5, 0.25+h)
+	i0 := TEMEToITRS(tt1, 0.25-h, xp, yp, p0)
+	i1 := TEMEToITRS(tt1, 0.25+h, xp, yp, p1)
+	for j := 0; j < 3; j++ {
+		vvd(t, i[1][j], (i1[0][j]-i0[0][j])/2.0, 1e-3, fname, "v")
+	}
+}
+
+func TestTopocentric(t *testing.T) {
+	const fname = "Topocentric"
+	ut1, ut2 := 2451723.5, 0.25
+	elong, phi, hm := -0.5, 0.7, 100.0
+
+	// A point 500 km above the observer is at the zenith.
+	o := sofa.Pvtob(elong, phi, hm, 0, 0, 0, sofa.Gmst82(ut1, ut2))
+	var pv [2][3]float64
+	for k := 0; k < 2; k++ {
+		for j := 0; j < 3; j++ {
+			pv[k][j] = o[k][j] / 1e3
+		}
+	}
+	up := sofa.Rxp(sofa.Rz(-sofa.Gmst82(ut1, ut2), sofa.Ir()),
+		[3]float64{math.Cos(phi) * math.Cos(elong),
+			math.Cos(phi) * math.Sin(elong), math.Sin(phi)})
+	for j := 0; j < 3; j++ {
+		pv[0][j] += 500.0 * up[j]
+	}
+	_, el, rng, rrate := Topocentric(ut1, ut2, elong, phi, hm, 0, 0, pv)
+	vvd(t, el, math.Pi/2, 1e-12, fname, "el")
+	vvd(t, rng, 500.0, 1e-9, fname, "range")
+	vvd(t, rrate, 0, 1e-12, fname, "range rate")
+
+	// The range rate is the rate of the range.
+	s, _ := New(parse(t, tle00005), WGS72, Improved)
+	const h = 1.0 / 86400.0
+	pv, _ = s.PV(ut1, ut2)
+	az, el, rng, rrate := Topocentric(ut1, ut2, elong, phi, hm, 0, 0, pv)
+	pv0, _ := s.PV(ut1, ut2-h)
+	pv1, _ := s.PV(ut1, ut2+h)
+	_, _, r0, _ := Topocentric(ut1, ut2-h, elong, phi, hm, 0, 0, pv0)
+	_, _, r1, _ := Topocentric(ut1, ut2+h, elong, phi, hm, 0, 0, pv1)
+	vvd(t, rrate, (r1-r0)/2.0, 1e-3, fname, "rate")
+	if az < 0 || az >= 2*math.Pi || math.Abs(el) > math.Pi/2 || rng <= 0 {
+		t.Errorf("%s: got az %g el %g range %g", fname, az, el, rng)
+	}
+}
+
+func BenchmarkPropagate(b *testing.B) {
+	l := strings.Split(tle08195, "\n")
+	e, _ := ParseTLE(l[0], l[1])
+	s, _ := New(e, WGS72, Improved)
+	for i := 0; i < b.N; i++ {
+		s.Propagate(1440.0)
+	}
+}
@@ -0,0 +1,95 @@
+package sgp4
+
+import (
+	"math"
+
+	"github.com/8i8/sofa"
+)
+
+// The TEME frame of SGP4 is that of the true equator and the mean
+// equinox of date:  it differs from the true equator and equinox by
+// the equation of the equinoxes, and from the pseudo Earth-fixed frame
+// by the Greenwich mean sidereal time.  Both use the IAU 1976/1980
+// models, as does the reference code.  The velocities are in km/s, as
+// those of the propagator, and the positions in any unit.
+
+// omegaEarth is the rotation rate of the Earth of the IAU 1982 sidereal
+// time (rad/s).
+const omegaEarth = 7.292115146706979e-5
+
+// temeMatrix returns the matrix from the mean J2000 frame to TEME at
+// the TT date tt1+tt2.  The frame bias of the GCRS is neglected.
+func temeMatrix(tt1, tt2 float64) [3][3]float64 {
+	return sofa.Rz(sofa.Eqeq94(tt1, tt2), sofa.Pnm80(tt1, tt2))
+}
+
+// TEMEToGCRS returns the position and velocity pv, in the TEME frame
+// at the TT date tt1+tt2, in the GCRS.
+func TEMEToGCRS(tt1, tt2 float64, pv [2][3]float64) [2][3]float64 {
+	return sofa.Trxpv(temeMatrix(tt1, tt2), pv)
+}
+
+// GCRSToTEME returns the position and velocity pv, in the GCRS, in the
+// TEME frame at the TT date tt1+tt2.
+func GCRSToTEME(tt1, tt2 float64, pv [2][3]float64) [2][3]float64 {
+	return sofa.Rxpv(temeMatrix(tt1, tt2), pv)
+}
+
+// TEMEToITRS returns the position and velocity pv, in the TEME frame,
+// in the ITRS at the UT1 date ut11+ut12, for the polar motion xp, yp
+// (radians).  The velocity is that relative to the rotating Earth.
+func TEMEToITRS(ut11, ut12, xp, yp float64, pv [2][3]float64) (
+	itrs [2][3]float64) {
+	st := sofa.Rz(sofa.Gmst82(ut11, ut12), sofa.Ir())
+	pef := sofa.Rxpv(st, pv)
+	pef[1][0] += omegaEarth * pef[0][1]
+	pef[1][1] -= omegaEarth * pef[0][0]
+	return sofa.Rxpv(sofa.Pom00(xp, yp, 0), pef)
+}
+
+// ITRSToTEME returns the position and velocity pv, in the ITRS, in the
+// TEME frame at the UT1 date ut11+ut12, for the polar motion xp, yp
+// (radians).
+func ITRSToTEME(ut11, ut12, xp, yp float64, pv [2][3]float64) (
+	teme [2][3]float64) {
+	pef := sofa.Trxpv(sofa.Pom00(xp, yp, 0), pv)
+	pef[1][0] -= omegaEarth * pef[0][1]
+	pef[1][1] += omegaEarth * pef[0][0]
+	st := sofa.Rz(sofa.Gmst82(ut11, ut12), sofa.Ir())
+	return sofa.Trxpv(st, pef)
+}
+
+// Topocentric returns the azimuth (radians, from the north through the
+// east), the elevation (radians), the range (km) and the range rate
+// (km/s) of the position and velocity pv, in the TEME frame at the UT1
+// date ut11+ut12, as seen by an observer at the geodetic longitude
+// elong (radians, east positive), latitude phi (radians) and height
+// hm (m) above the WGS84 ellipsoid, for the polar motion xp, yp
+// (radians).  The elevation is geometric, without refraction.
+func Topocentric(ut11, ut12, elong, phi, hm, xp, yp float64,
+	pv [2][3]float64) (az, el, rng, rrate float64) {
+
+	// The observer in TEME, in km and km/s.
+	gmst := sofa.Gmst82(ut11, ut12)
+	obs := sofa.Pvtob(elong, phi, hm, xp, yp, 0, gmst)
+	var d [2][3]float64
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			d[i][j] = pv[i][j] - obs[i][j]/1e3
+		}
+	}
+	rng = sofa.Pm(d[0])
+	rrate = sofa.Pdp(d[0], d[1]) / rng
+
+	// The direction in the ITRS, then east, north and up.
+	r := sofa.Rxp(sofa.Pom00(xp, yp, 0),
+		sofa.Rxp(sofa.Rz(gmst, sofa.Ir()), d[0]))
+	sl, cl := math.Sincos(elong)
+	sp, cp := math.Sincos(phi)
+	e := -sl*r[0] + cl*r[1]
+	n := -sp*cl*r[0] - sp*sl*r[1] + cp*r[2]
+	u := cp*cl*r[0] + cp*sl*r[1] + sp*r[2]
+	az = sofa.Anp(math.Atan2(e, n))
+	el = math.Atan2(u, math.Hypot(e, n))
+	return
+}
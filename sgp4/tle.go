@@ -0,0 +1,239 @@
+package sgp4
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/8i8/sofa"
+)
+
+// Errors of the element sets.
+var (
+	ErrSyntax   = errors.New("sgp4: malformed element set")
+	ErrChecksum = errors.New("sgp4: bad TLE checksum")
+)
+
+// Elements are the mean elements of a satellite, as given by a TLE or
+// an OMM.
+type Elements struct {
+	Name           string
+	CatalogNumber  int
+	Classification byte
+	IntlDesignator string
+
+	// The epoch, as a UTC 2-part Julian Date.
+	Epoch1, Epoch2 float64
+
+	NDot  float64 // first derivative of mean motion / 2 (rev/day^2)
+	NDDot float64 // second derivative of mean motion / 6 (rev/day^3)
+	BStar float64 // drag term (1/Earth radii)
+
+	ElementSet int
+
+	Inclination  float64 // deg
+	RAAN         float64 // right ascension of the ascending node (deg)
+	Eccentricity float64
+	ArgPerigee   float64 // argument of perigee (deg)
+	MeanAnomaly  float64 // deg
+	MeanMotion   float64 // rev/day
+	RevNumber    int     // revolution number at epoch
+}
+
+// ParseTLE returns the elements of the two lines of a TLE.  Anything
+// after column 69 is ignored, and the checksums are verified.
+func ParseTLE(line1, line2 string) (*Elements, error) {
+	l1 := strings.TrimRight(line1, " \r\n")
+	l2 := strings.TrimRight(line2, " \r\n")
+	if len(l1) < 69 || len(l2) < 69 || l1[0] != '1' || l2[0] != '2' {
+		return nil, fmt.Errorf("%w: not a pair of TLE lines", ErrSyntax)
+	}
+	for i, l := range []string{l1, l2} {
+		if checksum(l[:68]) != int(l[68]-'0') {
+			return nil, fmt.Errorf("%w: line %d", ErrChecksum, i+1)
+		}
+	}
+
+	var e Elements
+	var err error
+	p := parser{}
+	e.CatalogNumber = p.catalog(l1[2:7])
+	if c := p.catalog(l2[2:7]); p.err == nil && c != e.CatalogNumber {
+		return nil, fmt.Errorf("%w: catalog numbers differ", ErrSyntax)
+	}
+	e.Classification = l1[7]
+	e.IntlDesignator = strings.TrimSpace(l1[9:17])
+	yy := p.int("epoch year", l1[18:20])
+	doy := p.float("epoch day", l1[20:32])
+	e.NDot = p.float("ndot", l1[33:43])
+	e.NDDot = p.exp("nddot", l1[44:52])
+	e.BStar = p.exp("bstar", l1[53:61])
+	e.ElementSet = p.int("element set", l1[64:68])
+
+	e.Inclination = p.float("inclination", l2[8:16])
+	e.RAAN = p.float("node", l2[17:25])
+	e.Eccentricity = p.float("eccentricity", "."+l2[26:33])
+	e.ArgPerigee = p.float("perigee", l2[34:42])
+	e.MeanAnomaly = p.float("mean anomaly", l2[43:51])
+	e.MeanMotion = p.float("mean motion", l2[52:63])
+	e.RevNumber = p.int("revolution", l2[63:68])
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	// Two digit years run from 1957 to 2056.
+	year := 1900 + yy
+	if yy < 57 {
+		year += 100
+	}
+	e.Epoch1, e.Epoch2, err = dayEpoch(year, doy)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// dayEpoch returns the Julian Date of the day of the year doy, the
+// first of January at 0h being day 1.
+func dayEpoch(year int, doy float64) (d1, d2 float64, err error) {
+	djm0, djm, errn := sofa.Cal2jd(year, 1, 1)
+	if errn != nil || doy < 1.0 || doy >= 367.0 {
+		return 0, 0, fmt.Errorf("%w: epoch %d day %g", ErrSyntax, year,
+			doy)
+	}
+	day := math.Floor(doy)
+	return djm0 + djm + day - 1.0, doy - day, nil
+}
+
+// checksum returns the TLE checksum of l, the sum modulo 10 of its
+// digits, a minus sign counting as 1.
+func checksum(l string) int {
+	n := 0
+	for i := 0; i < len(l); i++ {
+		switch c := l[i]; {
+		case c >= '0' && c <= '9':
+			n += int(c - '0')
+		case c == '-':
+			n++
+		}
+	}
+	return n % 10
+}
+
+// parser reads the fields of element sets, keeping the first error.
+type parser struct {
+	err error
+}
+
+func (p *parser) fail(name, s string) {
+	if p.err == nil {
+		p.err = fmt.Errorf("%w: %s %q", ErrSyntax, name, s)
+	}
+}
+
+func (p *parser) float(name, s string) float64 {
+	x, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		p.fail(name, s)
+	}
+	return x
+}
+
+func (p *parser) int(name, s string) int {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		p.fail(name, s)
+	}
+	return n
+}
+
+// exp reads a field of implied decimal point and exponent, as the
+// " 28098-4" that is 0.28098e-4.
+func (p *parser) exp(name, s string) float64 {
+	t := strings.TrimSpace(s)
+	if t == "" {
+		return 0
+	}
+	sign := ""
+	if t[0] == '-' || t[0] == '+' {
+		sign, t = t[:1], t[1:]
+	}
+	i := strings.LastIndexAny(t, "+-")
+	if i < 1 {
+		p.fail(name, s)
+		return 0
+	}
+	x, err := strconv.ParseFloat(sign+"."+t[:i]+"e"+t[i:], 64)
+	if err != nil {
+		p.fail(name, s)
+	}
+	return x
+}
+
+// catalog reads a catalog number, in the Alpha-5 form, whose leading
+// letter (I and O excepted) counts from 10, above 99999.
+func (p *parser) catalog(s string) int {
+	t := strings.TrimSpace(s)
+	if t == "" {
+		p.fail("catalog number", s)
+		return 0
+	}
+	c := t[0]
+	if c < 'A' || c > 'Z' || c == 'I' || c == 'O' {
+		return p.int("catalog number", t)
+	}
+	n := int(c-'A') + 10
+	if c > 'I' {
+		n--
+	}
+	if c > 'O' {
+		n--
+	}
+	return n*10000 + p.int("catalog number", t[1:])
+}
+
+// ReadTLE returns the element sets of r, in the two or three line
+// forms.  A line before the first line of a set is taken as its name,
+// without any leading "0 ";  blank lines and lines beginning with #
+// are skipped.
+func ReadTLE(r io.Reader) ([]*Elements, error) {
+	var es []*Elements
+	var name, line1 string
+	sc := bufio.NewScanner(r)
+	n := 0
+	for sc.Scan() {
+		n++
+		l := strings.TrimRight(sc.Text(), " \r")
+		switch {
+		case l == "" || l[0] == '#':
+			continue
+		case line1 != "":
+			e, err := ParseTLE(line1, l)
+			if err != nil {
+				return es, fmt.Errorf("line %d: %w", n, err)
+			}
+			e.Name = name
+			es = append(es, e)
+			name, line1 = "", ""
+		case strings.HasPrefix(l, "1 ") && len(l) >= 69:
+			line1 = l
+		default:
+			name = strings.TrimSpace(strings.TrimPrefix(l, "0 "))
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return es, err
+	}
+	if line1 != "" {
+		return es, fmt.Errorf("%w: line 1 without line 2", ErrSyntax)
+	}
+	return es, nil
+}
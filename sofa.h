@@ -74,6 +74,13 @@ int iauApio13(double utc1, double utc2, double dut1,
               double elong, double phi, double hm, double xp, double yp,
               double phpa, double tc, double rh, double wl,
               iauASTROM *astrom);
+void iauAtcc13(double rc, double dc,
+               double pr, double pd, double px, double rv,
+               double date1, double date2,
+               double *ra, double *da);
+void iauAtccq(double rc, double dc,
+              double pr, double pd, double px, double rv,
+              iauASTROM *astrom, double *ra, double *da);
 void iauAtci13(double rc, double dc,
                double pr, double pd, double px, double rv,
                double date1, double date2,
@@ -143,6 +150,7 @@ void iauRefco(double phpa, double tc, double rh, double wl,
 /* Astronomy/Ephemerides */
 int iauEpv00(double date1, double date2,
              double pvh[2][3], double pvb[2][3]);
+void iauMoon98(double date1, double date2, double pv[2][3]);
 int iauPlan94(double date1, double date2, int np, double pv[2][3]);
 
 /* Astronomy/FundamentalArgs */
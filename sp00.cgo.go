@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoSp00 The TIO locator s', positioning the Terrestrial Intermediate
+//  Origin on the equator of the Celestial Intermediate Pole.
+func CgoSp00(date1, date2 float64) float64 {
+	var cF C.double
+	cF = C.iauSp00(C.double(date1), C.double(date2))
+	return float64(cF)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoSp00 The TIO locator s', positioning the Terrestrial Intermediate
+//  Sp00 The TIO locator s', positioning the Terrestrial Intermediate
 //  Origin on the equator of the Celestial Intermediate Pole.
 //
 //  - - - - -
@@ -59,17 +56,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoSp00 The TIO locator s', positioning the Terrestrial Intermediate
+//  Sp00 The TIO locator s', positioning the Terrestrial Intermediate
 //  Origin on the equator of the Celestial Intermediate Pole.
-func CgoSp00(date1, date2 float64) float64 {
-	var cF C.double
-	cF = C.iauSp00(C.double(date1), C.double(date2))
-	return float64(cF)
-}
-
-// GoSp00 The TIO locator s', positioning the Terrestrial Intermediate
-// Origin on the equator of the Celestial Intermediate Pole.
-func GoSp00(date1, date2 float64) float64 {
+func Sp00(date1, date2 float64) float64 {
 
 	var t, sp float64
 
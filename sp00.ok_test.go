@@ -20,7 +20,7 @@ func TestSp00(t *testing.T) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoSp00},
-		{"go", GoSp00},
+		{"go", Sp00},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -35,7 +35,7 @@ func BenchmarkSp00(b *testing.B) {
 		fn  func(a1, a2 float64) float64
 	}{
 		{"cgo", CgoSp00},
-		{"go", GoSp00},
+		{"go", Sp00},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
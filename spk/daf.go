@@ -0,0 +1,126 @@
+package spk
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"strings"
+)
+
+var (
+	errDAFID     = errors.New("spk: not a DAF/SPK file")
+	errDAFFormat = errors.New("spk: unknown DAF binary format")
+	errDAFSize   = errors.New("spk: bad DAF summary size")
+	errDAFLoop   = errors.New("spk: DAF summary records loop")
+)
+
+// recordLen is the length in bytes of a DAF record.
+const recordLen = 1024
+
+// daf is the DAF container of an SPK file: a file record, a doubly
+// linked list of summary records, each followed by a name record, and
+// the arrays that they describe.  Addresses are of 8 byte words,
+// counted from 1.
+type daf struct {
+	r        io.ReaderAt
+	order    binary.ByteOrder
+	nd, ni   int
+	fward    int
+	internal string
+}
+
+// summary is one array summary, the double and integer components.
+type summary struct {
+	name string
+	d    []float64
+	i    []int
+}
+
+func readDAF(r io.ReaderAt) (*daf, error) {
+	b := make([]byte, recordLen)
+	if _, err := r.ReadAt(b, 0); err != nil {
+		return nil, err
+	}
+	if string(b[:8]) != "DAF/SPK " && string(b[:8]) != "NAIF/DAF" {
+		return nil, errDAFID
+	}
+	d := &daf{r: r}
+	switch strings.TrimSpace(string(b[88:96])) {
+	case "LTL-IEEE":
+		d.order = binary.LittleEndian
+	case "BIG-IEEE":
+		d.order = binary.BigEndian
+	case "":
+		// Files from before the format string:  ND is 2 for SPK.
+		d.order = binary.LittleEndian
+		if binary.LittleEndian.Uint32(b[8:]) != 2 {
+			d.order = binary.BigEndian
+		}
+	default:
+		return nil, errDAFFormat
+	}
+	d.nd = int(int32(d.order.Uint32(b[8:])))
+	d.ni = int(int32(d.order.Uint32(b[12:])))
+	d.internal = strings.TrimSpace(string(b[16:76]))
+	d.fward = int(int32(d.order.Uint32(b[76:])))
+	if d.nd < 0 || d.ni < 2 || d.nd+(d.ni+1)/2 > 125 {
+		return nil, errDAFSize
+	}
+	return d, nil
+}
+
+// words reads n words from word address addr.
+func (d *daf) words(addr, n int) ([]float64, error) {
+	b := make([]byte, 8*n)
+	if _, err := d.r.ReadAt(b, int64(addr-1)*8); err != nil {
+		return nil, err
+	}
+	w := make([]float64, n)
+	for i := range w {
+		w[i] = math.Float64frombits(d.order.Uint64(b[8*i:]))
+	}
+	return w, nil
+}
+
+// summaries returns the array summaries in the order of the file.
+func (d *daf) summaries() ([]summary, error) {
+	var sums []summary
+	ss := d.nd + (d.ni+1)/2
+	seen := map[int]bool{}
+	b := make([]byte, recordLen)
+	n := make([]byte, recordLen)
+	for rec := d.fward; rec != 0; {
+		if seen[rec] {
+			return nil, errDAFLoop
+		}
+		seen[rec] = true
+		off := int64(rec-1) * recordLen
+		if _, err := d.r.ReadAt(b, off); err != nil {
+			return nil, err
+		}
+		if _, err := d.r.ReadAt(n, off+recordLen); err != nil {
+			return nil, err
+		}
+		next := int(math.Float64frombits(d.order.Uint64(b[0:])))
+		nsum := int(math.Float64frombits(d.order.Uint64(b[16:])))
+		if nsum < 0 || 3+nsum*ss > recordLen/8 {
+			return nil, errDAFSize
+		}
+		for k := 0; k < nsum; k++ {
+			p := b[24+8*ss*k:]
+			s := summary{d: make([]float64, d.nd), i: make([]int, d.ni)}
+			for j := range s.d {
+				s.d[j] = math.Float64frombits(d.order.Uint64(p[8*j:]))
+			}
+			for j := range s.i {
+				s.i[j] = int(int32(d.order.Uint32(p[8*d.nd+4*j:])))
+			}
+			s.name = strings.TrimRight(string(n[8*ss*k:8*ss*(k+1)]),
+				" \x00")
+			sums = append(sums, s)
+		}
+		rec = next
+	}
+	return sums, nil
+}
@@ -0,0 +1,375 @@
+// Package spk reads JPL planetary ephemerides, such as DE430 and DE440,
+// from binary SPK files.
+//
+// An SPK file is a DAF container of segments, each giving the position
+// of a target body relative to a centre body over a span of time as
+// Chebyshev polynomials.  Segments of type 2 (position only) and type 3
+// (position and velocity) are supported, which covers the JPL DE
+// files.  The data are read from an io.ReaderAt as they are needed, so
+// that a large file is neither read whole nor kept in memory.
+//
+// Positions and velocities are returned as pv-vectors in au and au/day
+// with respect to the ICRS axes, as sofa.Epv00 returns them, and times
+// are TDB as 2-part Julian Dates.
+package spk
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sync"
+)
+
+var (
+	errSPKType  = errors.New("spk: unsupported segment type")
+	errSPKFrame = errors.New("spk: unsupported reference frame")
+	errSPKData  = errors.New("spk: bad segment data")
+	errSPKChain = errors.New("spk: segment chain too long")
+)
+
+// NAIF integer codes of the bodies in the JPL DE files.
+const (
+	SolarSystemBarycenter = 0
+	MercuryBarycenter     = 1
+	VenusBarycenter       = 2
+	EarthMoonBarycenter   = 3
+	MarsBarycenter        = 4
+	JupiterBarycenter     = 5
+	SaturnBarycenter      = 6
+	UranusBarycenter      = 7
+	NeptuneBarycenter     = 8
+	PlutoBarycenter       = 9
+	Sun                   = 10
+	Mercury               = 199
+	Venus                 = 299
+	Moon                  = 301
+	Earth                 = 399
+)
+
+// FrameJ2000 is the NAIF code of the J2000 frame, which for the DE
+// files is the ICRF.
+const FrameJ2000 = 1
+
+const (
+	// AU is the astronomical unit (km).
+	AU = 149597870.7
+
+	// dj00 is the Julian Date of the SPK time origin, J2000.0 TDB.
+	dj00 = 2451545.0
+
+	// daySec is the number of seconds in a day.
+	daySec = 86400.0
+)
+
+// NoCoverageError is returned when no segment gives the position of a
+// body at a time.
+type NoCoverageError struct {
+	Body int     // NAIF code of the body
+	ET   float64 // TDB seconds from J2000.0
+}
+
+func (e *NoCoverageError) Error() string {
+	return fmt.Sprintf("spk: no data for body %d at ET %.3f", e.Body, e.ET)
+}
+
+// Segment is one segment of an SPK file.
+type Segment struct {
+	Name           string
+	Start, End     float64 // coverage, TDB seconds from J2000.0
+	Target, Center int     // NAIF codes
+	Frame, Type    int
+
+	begin, end int // word addresses of the data
+
+	// The directory of the data, read when first used.
+	once    sync.Once
+	err     error
+	init    float64 // start of the first record (s)
+	intlen  float64 // length of each record (s)
+	rsize   int     // words in each record
+	nrec    int     // number of records
+	ncoef   int     // coefficients of each component
+	records *recordCache
+}
+
+// File is an open SPK file.
+type File struct {
+	Segments []*Segment
+
+	d      *daf
+	closer io.Closer
+}
+
+// Open opens the SPK file name.
+func Open(name string) (*File, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	s, err := New(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	s.closer = f
+	return s, nil
+}
+
+// New returns the SPK file read from r.
+func New(r io.ReaderAt) (*File, error) {
+	d, err := readDAF(r)
+	if err != nil {
+		return nil, err
+	}
+	if d.nd != 2 || d.ni != 6 {
+		return nil, errDAFSize
+	}
+	sums, err := d.summaries()
+	if err != nil {
+		return nil, err
+	}
+	f := &File{d: d}
+	for _, s := range sums {
+		f.Segments = append(f.Segments, &Segment{
+			Name:   s.name,
+			Start:  s.d[0],
+			End:    s.d[1],
+			Target: s.i[0],
+			Center: s.i[1],
+			Frame:  s.i[2],
+			Type:   s.i[3],
+			begin:  s.i[4],
+			end:    s.i[5],
+		})
+	}
+	return f, nil
+}
+
+// Close closes a file opened by Open.
+func (f *File) Close() error {
+	if f.closer == nil {
+		return nil
+	}
+	return f.closer.Close()
+}
+
+// et returns the TDB seconds from J2000.0 of the 2-part Julian Date.
+func et(date1, date2 float64) float64 {
+	return ((date1 - dj00) + date2) * daySec
+}
+
+// segment returns the segment for body at t.  Later segments take
+// precedence over earlier ones, as in the SPICE library.
+func (f *File) segment(body int, t float64) (*Segment, error) {
+	for i := len(f.Segments) - 1; i >= 0; i-- {
+		s := f.Segments[i]
+		if s.Target == body && t >= s.Start && t <= s.End {
+			return s, nil
+		}
+	}
+	return nil, &NoCoverageError{Body: body, ET: t}
+}
+
+// barycentric returns the position and velocity (km, km/s) of body
+// with respect to the solar system barycentre, by adding up the
+// segments from it.
+func (f *File) barycentric(body int, t float64) (pv [2][3]float64,
+	err error) {
+	for n := 0; body != SolarSystemBarycenter; n++ {
+		if n > 20 {
+			return pv, errSPKChain
+		}
+		s, err := f.segment(body, t)
+		if err != nil {
+			return pv, err
+		}
+		p, err := s.state(f.d, t)
+		if err != nil {
+			return pv, err
+		}
+		for i := 0; i < 2; i++ {
+			for j := 0; j < 3; j++ {
+				pv[i][j] += p[i][j]
+			}
+		}
+		body = s.Center
+	}
+	return pv, nil
+}
+
+// toAU converts a pv-vector from km and km/s to au and au/day.
+func toAU(pv [2][3]float64) [2][3]float64 {
+	for j := 0; j < 3; j++ {
+		pv[0][j] /= AU
+		pv[1][j] *= daySec / AU
+	}
+	return pv
+}
+
+// PV returns the position and velocity of target with respect to
+// center at the TDB date1+date2, in au and au/day.
+func (f *File) PV(target, center int, date1, date2 float64) (
+	pv [2][3]float64, err error) {
+	t := et(date1, date2)
+	a, err := f.barycentric(target, t)
+	if err != nil {
+		return pv, err
+	}
+	b, err := f.barycentric(center, t)
+	if err != nil {
+		return pv, err
+	}
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			pv[i][j] = a[i][j] - b[i][j]
+		}
+	}
+	return toAU(pv), nil
+}
+
+// Body returns the barycentric position and velocity of body at the
+// TDB date1+date2, in au and au/day.
+func (f *File) Body(body int, date1, date2 float64) ([2][3]float64,
+	error) {
+	pv, err := f.barycentric(body, et(date1, date2))
+	if err != nil {
+		return pv, err
+	}
+	return toAU(pv), nil
+}
+
+// Epv returns the heliocentric and barycentric position and velocity
+// of the Earth at the TDB date1+date2, in au and au/day, as sofa.Epv00
+// does.
+func (f *File) Epv(date1, date2 float64) (pvh, pvb [2][3]float64,
+	err error) {
+	t := et(date1, date2)
+	e, err := f.barycentric(Earth, t)
+	if err != nil {
+		return
+	}
+	s, err := f.barycentric(Sun, t)
+	if err != nil {
+		return
+	}
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			pvh[i][j] = e[i][j] - s[i][j]
+		}
+	}
+	return toAU(pvh), toAU(e), nil
+}
+
+// directory reads the four words at the end of the segment data.
+func (s *Segment) directory(d *daf) error {
+	s.once.Do(func() {
+		if s.Type != 2 && s.Type != 3 {
+			s.err = errSPKType
+			return
+		}
+		if s.Frame != FrameJ2000 {
+			s.err = errSPKFrame
+			return
+		}
+		w, err := d.words(s.end-3, 4)
+		if err != nil {
+			s.err = err
+			return
+		}
+		s.init, s.intlen = w[0], w[1]
+		s.rsize, s.nrec = int(w[2]), int(w[3])
+		nc := 3
+		if s.Type == 3 {
+			nc = 6
+		}
+		s.ncoef = (s.rsize - 2) / nc
+		if s.intlen <= 0 || s.nrec < 1 || s.ncoef < 1 ||
+			s.ncoef*nc+2 != s.rsize ||
+			s.begin+s.rsize*s.nrec+3 != s.end {
+			s.err = errSPKData
+			return
+		}
+		s.records = &recordCache{}
+	})
+	return s.err
+}
+
+// state returns the position and velocity (km, km/s) of the target of
+// the segment with respect to its centre at t.
+func (s *Segment) state(d *daf, t float64) (pv [2][3]float64,
+	err error) {
+	if err = s.directory(d); err != nil {
+		return
+	}
+	i := int(math.Floor((t - s.init) / s.intlen))
+	if i < 0 {
+		i = 0
+	}
+	if i >= s.nrec {
+		i = s.nrec - 1
+	}
+	rec, err := s.records.get(d, s.begin+i*s.rsize, s.rsize)
+	if err != nil {
+		return
+	}
+	mid, radius := rec[0], rec[1]
+	x := (t - mid) / radius
+	n := s.ncoef
+	for j := 0; j < 3; j++ {
+		c := rec[2+j*n : 2+(j+1)*n]
+		p, v := chebyshev(c, x)
+		pv[0][j] = p
+		pv[1][j] = v / radius
+		if s.Type == 3 {
+			pv[1][j], _ = chebyshev(rec[2+(j+3)*n:2+(j+4)*n], x)
+		}
+	}
+	return pv, nil
+}
+
+// chebyshev returns the sum of the Chebyshev series c at x, and its
+// derivative.
+func chebyshev(c []float64, x float64) (f, df float64) {
+	// T and its derivative U by the recurrences
+	// T(k+1) = 2x T(k) - T(k-1), T'(k+1) = 2T(k) + 2x T'(k) - T'(k-1).
+	t0, t1 := 1.0, x
+	d0, d1 := 0.0, 1.0
+	f = c[0]
+	if len(c) > 1 {
+		f += c[1] * x
+		df = c[1]
+	}
+	for k := 2; k < len(c); k++ {
+		t2 := 2.0*x*t1 - t0
+		d2 := 2.0*t1 + 2.0*x*d1 - d0
+		f += c[k] * t2
+		df += c[k] * d2
+		t0, t1 = t1, t2
+		d0, d1 = d1, d2
+	}
+	return f, df
+}
+
+// recordCache keeps the last record read from a segment, as successive
+// calls are mostly for nearby times.
+type recordCache struct {
+	mu   sync.Mutex
+	addr int
+	rec  []float64
+}
+
+func (c *recordCache) get(d *daf, addr, n int) ([]float64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.rec != nil && c.addr == addr {
+		return c.rec, nil
+	}
+	rec, err := d.words(addr, n)
+	if err != nil {
+		return nil, err
+	}
+	c.addr, c.rec = addr, rec
+	return rec, nil
+}
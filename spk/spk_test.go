@@ -0,0 +1,303 @@
+package spk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// testSeg is a segment to be written by writeSPK.  Each record is the
+// midpoint and radius followed by the coefficients.
+type testSeg struct {
+	target, center, typ int
+	name                string
+	init, intlen        float64
+	recs                [][]float64
+}
+
+// writeSPK returns an SPK file of the segments, with one summary
+// record.
+func writeSPK(order binary.ByteOrder, segs []testSeg) []byte {
+	var data []float64
+	type sum struct {
+		start, end float64
+		ints       [6]int32
+	}
+	var sums []sum
+	addr := 3*128 + 1
+	for _, s := range segs {
+		begin := addr + len(data)
+		for _, r := range s.recs {
+			data = append(data, r...)
+		}
+		rsize := len(s.recs[0])
+		data = append(data, s.init, s.intlen, float64(rsize),
+			float64(len(s.recs)))
+		end := addr + len(data) - 1
+		sums = append(sums, sum{s.init,
+			s.init + s.intlen*float64(len(s.recs)),
+			[6]int32{int32(s.target), int32(s.center), 1,
+				int32(s.typ), int32(begin), int32(end)}})
+	}
+
+	var buf bytes.Buffer
+	file := make([]byte, recordLen)
+	copy(file, "DAF/SPK ")
+	order.PutUint32(file[8:], 2)
+	order.PutUint32(file[12:], 6)
+	copy(file[16:76], "test ephemeris")
+	order.PutUint32(file[76:], 2)
+	order.PutUint32(file[80:], 2)
+	if order == binary.BigEndian {
+		copy(file[88:], "BIG-IEEE")
+	} else {
+		copy(file[88:], "LTL-IEEE")
+	}
+	buf.Write(file)
+
+	rec := make([]byte, recordLen)
+	names := bytes.Repeat([]byte{' '}, recordLen)
+	order.PutUint64(rec[16:], math.Float64bits(float64(len(sums))))
+	for k, s := range sums {
+		p := rec[24+40*k:]
+		order.PutUint64(p, math.Float64bits(s.start))
+		order.PutUint64(p[8:], math.Float64bits(s.end))
+		for j, v := range s.ints {
+			order.PutUint32(p[16+4*j:], uint32(v))
+		}
+		copy(names[40*k:], segs[k].name)
+	}
+	buf.Write(rec)
+	buf.Write(names)
+	for _, w := range data {
+		b := make([]byte, 8)
+		order.PutUint64(b, math.Float64bits(w))
+		buf.Write(b)
+	}
+	return buf.Bytes()
+}
+
+// cheb returns the Chebyshev series c and its derivative at x, from the
+// trigonometric form of the polynomials.
+func cheb(c []float64, x float64) (f, df float64) {
+	th := math.Acos(x)
+	for k := range c {
+		f += c[k] * math.Cos(float64(k)*th)
+		df += c[k] * float64(k) * math.Sin(float64(k)*th) / math.Sin(th)
+	}
+	return
+}
+
+// Two records of a day each from J2000.0, of degree 3.
+const (
+	testInit   = -43200.0
+	testIntlen = 86400.0
+)
+
+func testRecords(typ int, scale float64) [][]float64 {
+	var recs [][]float64
+	nc := 3
+	if typ == 3 {
+		nc = 6
+	}
+	for r := 0; r < 2; r++ {
+		rec := []float64{testInit + testIntlen*(float64(r)+0.5),
+			testIntlen / 2}
+		for j := 0; j < nc; j++ {
+			for k := 0; k < 4; k++ {
+				rec = append(rec, scale*float64((r+1)*(j+1))/
+					float64(k+1))
+			}
+		}
+		recs = append(recs, rec)
+	}
+	return recs
+}
+
+// want returns the state in km and km/s given by the records at t.
+func want(typ int, recs [][]float64, t float64) (pv [2][3]float64) {
+	r := int(math.Floor((t - testInit) / testIntlen))
+	rec := recs[r]
+	x := (t - rec[0]) / rec[1]
+	for j := 0; j < 3; j++ {
+		p, v := cheb(rec[2+4*j:6+4*j], x)
+		pv[0][j] = p
+		pv[1][j] = v / rec[1]
+		if typ == 3 {
+			pv[1][j], _ = cheb(rec[2+4*(j+3):6+4*(j+3)], x)
+		}
+	}
+	return
+}
+
+func vvd(t *testing.T, val, valok, dval float64, fname, test string) {
+	t.Helper()
+	if math.Abs(val-valok) > dval || math.IsNaN(val) {
+		t.Errorf("%s failed: %s want %.20g got %.20g", fname, test,
+			valok, val)
+	}
+}
+
+func testFile(order binary.ByteOrder) ([]testSeg, []byte) {
+	segs := []testSeg{
+		{Sun, SolarSystemBarycenter, 2, "SUN", testInit, testIntlen,
+			testRecords(2, 1e5)},
+		{EarthMoonBarycenter, SolarSystemBarycenter, 3, "EMB", testInit,
+			testIntlen, testRecords(3, 1e8)},
+		{Earth, EarthMoonBarycenter, 2, "EARTH", testInit, testIntlen,
+			testRecords(2, 4e3)},
+	}
+	return segs, writeSPK(order, segs)
+}
+
+func TestSPK(t *testing.T) {
+	const fname = "SPK"
+
+	for _, order := range []binary.ByteOrder{binary.LittleEndian,
+		binary.BigEndian} {
+		segs, b := testFile(order)
+		f, err := New(bytes.NewReader(b))
+		if err != nil {
+			t.Fatalf("%s: %v", fname, err)
+		}
+		if len(f.Segments) != 3 {
+			t.Fatalf("%s: want 3 segments got %d", fname,
+				len(f.Segments))
+		}
+		s := f.Segments[2]
+		if s.Name != "EARTH" || s.Target != Earth ||
+			s.Center != EarthMoonBarycenter || s.Type != 2 ||
+			s.Start != testInit || s.End != testInit+2*testIntlen {
+			t.Errorf("%s: bad segment %+v", fname, s)
+		}
+
+		for _, date2 := range []float64{-0.4, 0.0, 0.3, 1.2, 1.49} {
+			tt := date2 * daySec
+			sun := want(2, segs[0].recs, tt)
+			emb := want(3, segs[1].recs, tt)
+			earth := want(2, segs[2].recs, tt)
+
+			pvh, pvb, err := f.Epv(dj00, date2)
+			if err != nil {
+				t.Fatalf("%s: %v", fname, err)
+			}
+			pv, err := f.PV(Earth, Sun, dj00, date2)
+			if err != nil {
+				t.Fatalf("%s: %v", fname, err)
+			}
+			for j := 0; j < 3; j++ {
+				p := emb[0][j] + earth[0][j]
+				v := emb[1][j] + earth[1][j]
+				vh := (v - sun[1][j]) * daySec / AU
+				vvd(t, pvb[0][j], p/AU, 1e-14, fname, "pb")
+				vvd(t, pvb[1][j], v*daySec/AU, 1e-15*math.Abs(vh),
+					fname, "vb")
+				vvd(t, pvh[0][j], (p-sun[0][j])/AU, 1e-14, fname, "ph")
+				vvd(t, pvh[1][j], vh, 1e-15*math.Abs(vh), fname, "vh")
+				vvd(t, pv[0][j], pvh[0][j], 0, fname, "pv")
+				vvd(t, pv[1][j], pvh[1][j], 0, fname, "pv")
+			}
+
+			pv, err = f.Body(Sun, dj00, date2)
+			if err != nil {
+				t.Fatalf("%s: %v", fname, err)
+			}
+			vvd(t, pv[0][0], sun[0][0]/AU, 1e-17, fname, "sun")
+		}
+	}
+}
+
+func TestSPKVelocity(t *testing.T) {
+	const fname = "SPK velocity"
+
+	// The velocity of a type 2 segment is the derivative of the
+	// position.
+	_, b := testFile(binary.LittleEndian)
+	f, _ := New(bytes.NewReader(b))
+	const h = 1e-4
+	p0, _ := f.PV(Earth, EarthMoonBarycenter, dj00, 0.3-h)
+	p1, _ := f.PV(Earth, EarthMoonBarycenter, dj00, 0.3+h)
+	pv, _ := f.PV(Earth, EarthMoonBarycenter, dj00, 0.3)
+	for j := 0; j < 3; j++ {
+		vvd(t, pv[1][j], (p1[0][j]-p0[0][j])/(2*h), 1e-10, fname, "v")
+	}
+}
+
+func TestSPKCoverage(t *testing.T) {
+	const fname = "SPK coverage"
+	_, b := testFile(binary.LittleEndian)
+	f, _ := New(bytes.NewReader(b))
+
+	_, _, err := f.Epv(dj00, 3.0)
+	var nc *NoCoverageError
+	if !errors.As(err, &nc) || nc.Body != Earth {
+		t.Errorf("%s: want no coverage of the Earth got %v", fname, err)
+	}
+	_, err = f.Body(Mercury, dj00, 0.0)
+	if !errors.As(err, &nc) || nc.Body != Mercury {
+		t.Errorf("%s: want no coverage of Mercury got %v", fname, err)
+	}
+
+	// A later segment takes precedence.
+	segs := []testSeg{
+		{Sun, SolarSystemBarycenter, 2, "OLD", testInit, testIntlen,
+			testRecords(2, 1.0)},
+		{Sun, SolarSystemBarycenter, 2, "NEW", testInit, testIntlen,
+			testRecords(2, 2.0)},
+	}
+	f, err = New(bytes.NewReader(writeSPK(binary.LittleEndian, segs)))
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	pv, _ := f.Body(Sun, dj00, 0.25)
+	w := want(2, segs[1].recs, 0.25*daySec)
+	vvd(t, pv[0][1], w[0][1]/AU, 1e-20, fname, "precedence")
+}
+
+func TestSPKErrors(t *testing.T) {
+	const fname = "SPK errors"
+
+	b := make([]byte, 2*recordLen)
+	copy(b, "DAF/PCK ")
+	if _, err := New(bytes.NewReader(b)); err != errDAFID {
+		t.Errorf("%s: want %v got %v", fname, errDAFID, err)
+	}
+
+	segs := []testSeg{{Sun, SolarSystemBarycenter, 9, "TYPE 9", testInit,
+		testIntlen, testRecords(2, 1.0)}}
+	f, err := New(bytes.NewReader(writeSPK(binary.LittleEndian, segs)))
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	if _, err = f.Body(Sun, dj00, 0.0); err != errSPKType {
+		t.Errorf("%s: want %v got %v", fname, errSPKType, err)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	const fname = "Open"
+	dir, err := ioutil.TempDir("", "spk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	_, b := testFile(binary.BigEndian)
+	name := filepath.Join(dir, "test.bsp")
+	if err = ioutil.WriteFile(name, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := Open(name)
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	defer f.Close()
+	if _, _, err = f.Epv(dj00, 0.5); err != nil {
+		t.Errorf("%s: %v", fname, err)
+	}
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -23,7 +21,7 @@ var errStarpm = en.New(2, "Starpm", []string{
 	"binary logical OR of the above warnings",
 })
 
-//  CgoStarpm Star proper motion:  update star catalog data for space
+//  Starpm Star proper motion:  update star catalog data for space
 //  motion.
 //
 //  - - - - - - -
@@ -135,27 +133,9 @@ var errStarpm = en.New(2, "Starpm", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoStarpm Star proper motion:  update star catalog data for space
+//  Starpm Star proper motion:  update star catalog data for space
 //  motion.
-func CgoStarpm(ra1, dec1, pmr1, pmd1, px1, rv1,
-	ep1a, ep1b, ep2a, ep2b float64) (
-	ra2, dec2, pmr2, pmd2, px2, rv2 float64, err en.ErrNum) {
-	var cRa2, cDec2, cPmr2, cPmd2, cPx2, cRv2 C.double
-	cI := C.iauStarpm(C.double(ra1), C.double(dec1),
-		C.double(pmr1), C.double(pmd1), C.double(px1),
-		C.double(rv1), C.double(ep1a), C.double(ep1b),
-		C.double(ep2a), C.double(ep2b),
-		&cRa2, &cDec2, &cPmr2, &cPmd2, &cPx2, &cRv2)
-	if n := int(cI); n != 0 {
-		err = errStarpm.Set(n)
-	}
-	return float64(cRa2), float64(cDec2), float64(cPmr2),
-		float64(cPmd2), float64(cPx2), float64(cRv2), err
-}
-
-//  GoStarpm Star proper motion:  update star catalog data for space
-//  motion.
-func GoStarpm(ra1, dec1, pmr1, pmd1, px1, rv1,
+func Starpm(ra1, dec1, pmr1, pmd1, px1, rv1,
 	ep1a, ep1b, ep2a, ep2b float64) (
 	ra2, dec2, pmr2, pmd2, px2, rv2 float64, err en.ErrNum) {
 
@@ -165,23 +145,23 @@ func GoStarpm(ra1, dec1, pmr1, pmd1, px1, rv1,
 	var err1, err2 en.ErrNum
 
 	// RA,Dec etc. at the "before" epoch to space motion pv-vector.
-	pv1, err1 = GoStarpv(ra1, dec1, pmr1, pmd1, px1, rv1)
+	pv1, err1 = Starpv(ra1, dec1, pmr1, pmd1, px1, rv1)
 
 	// Light time when observed (days).
-	tl1 = GoPm(pv1[0]) / DC
+	tl1 = Pm(pv1[0]) / DC
 
 	// Time interval, "before" to "after" (days).
 	dt = (ep2a - ep1a) + (ep2b - ep1b)
 
 	// Move star along track from the "before" observed position to the
 	// "after" geometric position.
-	pv = GoPvu(dt+tl1, pv1)
+	pv = Pvu(dt+tl1, pv1)
 
 	// From this geometric position, deduce the observed light time (days)
 	// at the "after" epoch (with theoretically unneccessary error check).
-	r2 = GoPdp(pv[0], pv[0])
-	rdv = GoPdp(pv[0], pv[1])
-	v2 = GoPdp(pv[1], pv[1])
+	r2 = Pdp(pv[0], pv[0])
+	rdv = Pdp(pv[0], pv[1])
+	v2 = Pdp(pv[1], pv[1])
 	c2mv2 = DC*DC - v2
 	if c2mv2 <= 0 {
 		err = errStarpm.Set(-1)
@@ -191,10 +171,10 @@ func GoStarpm(ra1, dec1, pmr1, pmd1, px1, rv1,
 
 	// Move the position along track from the observed place at the
 	// "before" epoch to the observed place at the "after" epoch.
-	pv2 = GoPvu(dt+(tl1-tl2), pv1)
+	pv2 = Pvu(dt+(tl1-tl2), pv1)
 
 	// Space motion pv-vector to RA,Dec etc. at the "after" epoch.
-	ra2, dec2, pmr2, pmd2, px2, rv2, err2 = GoPvstar(pv2)
+	ra2, dec2, pmr2, pmd2, px2, rv2, err2 = Pvstar(pv2)
 
 	// Final status.
 	if err2 == nil {
@@ -37,7 +37,7 @@ func TestStarpm(t *testing.T) {
 			c1, c2, c3, c4, c5, c6 float64, c7 en.ErrNum)
 	}{
 		{"cgo", CgoStarpm},
-		{"go", GoStarpm},
+		{"go", Starpm},
 	}
 
 	for _, test := range tests {
@@ -81,7 +81,7 @@ func BenchmarkStarpm(b *testing.B) {
 			c1, c2, c3, c4, c5, c6 float64, c7 en.ErrNum)
 	}{
 		{"cgo", CgoStarpm},
-		{"go", GoStarpm},
+		{"go", Starpm},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,22 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoStarpv Convert star catalog coordinates to position+velocity
+//  vector.
+func CgoStarpv(ra, dec, pmr, pmd, px, rv float64) (
+	pv [2][3]float64, err en.ErrNum) {
+
+	var cPv [2][3]C.double
+	cI := C.iauStarpv(C.double(ra), C.double(dec), C.double(pmr),
+		C.double(pmd), C.double(px), C.double(rv), &cPv[0])
+	if n := int(cI); n != 0 {
+		err = errStarpv.Set(n)
+	}
+	return v3dC2Go(cPv), err
+}
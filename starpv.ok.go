@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -22,7 +20,7 @@ var errStarpv = en.New(0, "Starpv", []string{
 	"binary logical OR of the above",
 })
 
-//  CgoStarpv Convert star catalog coordinates to position+velocity
+//  Starpv Convert star catalog coordinates to position+velocity
 //  vector.
 //
 //  - - - - - - -
@@ -143,23 +141,9 @@ var errStarpv = en.New(0, "Starpv", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoStarpv Convert star catalog coordinates to position+velocity
+//  Starpv Convert star catalog coordinates to position+velocity
 //  vector.
-func CgoStarpv(ra, dec, pmr, pmd, px, rv float64) (
-	pv [2][3]float64, err en.ErrNum) {
-
-	var cPv [2][3]C.double
-	cI := C.iauStarpv(C.double(ra), C.double(dec), C.double(pmr),
-		C.double(pmd), C.double(px), C.double(rv), &cPv[0])
-	if n := int(cI); n != 0 {
-		err = errStarpv.Set(n)
-	}
-	return v3dC2Go(cPv), err
-}
-
-//  GoStarpv Convert star catalog coordinates to position+velocity
-//  vector.
-func GoStarpv(ra, dec, pmr, pmd, px, rv float64) (
+func Starpv(ra, dec, pmr, pmd, px, rv float64) (
 	pv [2][3]float64, err en.ErrNum) {
 
 	// Smallest allowed parallax
@@ -194,23 +178,23 @@ func GoStarpv(ra, dec, pmr, pmd, px, rv float64) (
 	decd = pmd / DJY
 
 	// To pv-vector (au,au/day).
-	pv = GoS2pv(ra, dec, r, rad, decd, rd)
+	pv = S2pv(ra, dec, r, rad, decd, rd)
 
 	// If excessive velocity, arbitrarily set it to zero.
-	v = GoPm(pv[1])
+	v = Pm(pv[1])
 	if v/DC > VMAX {
 		pv[1] = [3]float64{}
 		err = errStarpv.Add(err, 2)
 	}
 
 	// Isolate the radial component of the velocity (au/day).
-	w, x = GoPn(pv[0])
-	vsr = GoPdp(x, pv[1])
-	usr = GoSxp(vsr, x)
+	w, x = Pn(pv[0])
+	vsr = Pdp(x, pv[1])
+	usr = Sxp(vsr, x)
 
 	// Isolate the transverse component of the velocity (au/day).
-	ust = GoPmp(pv[1], usr)
-	vst = GoPm(ust)
+	ust = Pmp(pv[1], usr)
+	vst = Pm(ust)
 
 	// Special-relativity dimensionless parameters.
 	betsr = vsr / DC
@@ -247,13 +231,13 @@ func GoStarpv(ra, dec, pmr, pmd, px, rv float64) (
 	} else {
 		w = 1.0
 	}
-	ur = GoSxp(w, usr)
+	ur = Sxp(w, usr)
 
 	// Replace observed tangential velocity with inertial value.
-	ut = GoSxp(d, ust)
+	ut = Sxp(d, ust)
 
 	// Combine the two to obtain the inertial space velocity.
-	pv[1] = GoPpp(ur, ut)
+	pv[1] = Ppp(ur, ut)
 
 	// Return the status.
 	return
@@ -36,7 +36,7 @@ func TestStarpv(t *testing.T) {
 			[2][3]float64, en.ErrNum)
 	}{
 		{"cgo", CgoStarpv},
-		{"go", GoStarpv},
+		{"go", Starpv},
 	}
 
 	for _, test := range tests {
@@ -77,7 +77,7 @@ func BenchmarkStarpv(b *testing.B) {
 			[2][3]float64, en.ErrNum)
 	}{
 		{"cgo", CgoStarpv},
-		{"go", GoStarpv},
+		{"go", Starpv},
 	}
 
 	for _, test := range tests {
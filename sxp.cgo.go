@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoSxp Multiply a p-vector by a scalar.
+func CgoSxp(s float64, p [3]float64) (sp [3]float64) {
+	var cP = v3sGo2C(p)
+	var cSp [3]C.double
+	C.iauSxp(C.double(s), &cP[0], &cSp[0])
+	return v3sC2Go(cSp)
+}
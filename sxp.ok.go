@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoSxp Multiply a p-vector by a scalar.
+//  Sxp Multiply a p-vector by a scalar.
 //
 //  - - - -
 //   S x p
@@ -30,16 +27,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoSxp Multiply a p-vector by a scalar.
-func CgoSxp(s float64, p [3]float64) (sp [3]float64) {
-	var cP = v3sGo2C(p)
-	var cSp [3]C.double
-	C.iauSxp(C.double(s), &cP[0], &cSp[0])
-	return v3sC2Go(cSp)
-}
-
-// GoSxp Multiply a p-vector by a scalar.
-func GoSxp(s float64, p [3]float64) (sp [3]float64) {
+//  Sxp Multiply a p-vector by a scalar.
+func Sxp(s float64, p [3]float64) (sp [3]float64) {
 	sp[0] = s * p[0]
 	sp[1] = s * p[1]
 	sp[2] = s * p[2]
@@ -28,7 +28,7 @@ func TestSxp(t *testing.T) {
 		fn  func(float64, [3]float64) [3]float64
 	}{
 		{"cgo", CgoSxp},
-		{"go", GoSxp},
+		{"go", Sxp},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -55,7 +55,7 @@ func BenchmarkSxp(b *testing.B) {
 		fn  func(float64, [3]float64) [3]float64
 	}{
 		{"cgo", CgoSxp},
-		{"go", GoSxp},
+		{"go", Sxp},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
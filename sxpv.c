@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauSxpv(double s, double pv[2][3], double spv[2][3])
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoSxpv Multiply a pv-vector by a scalar.
+func CgoSxpv(s float64, pv [2][3]float64) (spv [2][3]float64) {
+	var cSpv [2][3]C.double
+	cPv := v3dGo2C(pv)
+	C.iauSxpv(C.double(s), &cPv[0], &cSpv[0])
+	return v3dC2Go(cSpv)
+}
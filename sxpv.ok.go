@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoSxpv Multiply a pv-vector by a scalar.
+//  Sxpv Multiply a pv-vector by a scalar.
 //
 //  - - - - -
 //   S x p v
@@ -33,17 +30,9 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoSxpv Multiply a pv-vector by a scalar.
-func CgoSxpv(s float64, pv [2][3]float64) (spv [2][3]float64) {
-	var cSpv [2][3]C.double
-	cPv := v3dGo2C(pv)
-	C.iauSxpv(C.double(s), &cPv[0], &cSpv[0])
-	return v3dC2Go(cSpv)
-}
-
-//  GoSxpv Multiply a pv-vector by a scalar.
-func GoSxpv(s float64, pv [2][3]float64) (spv [2][3]float64) {
+//  Sxpv Multiply a pv-vector by a scalar.
+func Sxpv(s float64, pv [2][3]float64) (spv [2][3]float64) {
 
-	spv = GoS2xpv(s, s, pv)
+	spv = S2xpv(s, s, pv)
 	return
 }
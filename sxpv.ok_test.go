@@ -33,7 +33,7 @@ func TestSxpv(t *testing.T) {
 		fn  func(float64, [2][3]float64) [2][3]float64
 	}{
 		{"cgo", CgoSxpv},
-		{"go", GoSxpv},
+		{"go", Sxpv},
 	}
 
 	for _, test := range tests {
@@ -70,7 +70,7 @@ func BenchmarkSxpv(b *testing.B) {
 		fn  func(float64, [2][3]float64) [2][3]float64
 	}{
 		{"cgo", CgoSxpv},
-		{"go", GoSxpv},
+		{"go", Sxpv},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauTaitt(double tai1, double tai2, double *tt1, double *tt2)
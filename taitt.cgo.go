@@ -0,0 +1,20 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoTaitt Time scale transformation:  International Atomic Time, TAI,
+//  to Terrestrial Time, TT.
+func CgoTaitt(tai1, tai2 float64) (tt1, tt2 float64, err error) {
+	var cTt1, cTt2 C.double
+	cI := C.iauTaitt(C.double(tai1), C.double(tai2), &cTt1, &cTt2)
+	switch int(cI) {
+	case 0:
+	default:
+		err = errAdmin
+	}
+	return float64(cTt1), float64(cTt2), err
+}
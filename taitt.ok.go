@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoTaitt Time scale transformation:  International Atomic Time, TAI,
+//  Taitt Time scale transformation:  International Atomic Time, TAI,
 //  to Terrestrial Time, TT.
 //
 //  - - - - - -
@@ -46,22 +44,9 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTaitt Time scale transformation:  International Atomic Time, TAI,
+//  Taitt Time scale transformation:  International Atomic Time, TAI,
 //  to Terrestrial Time, TT.
-func CgoTaitt(tai1, tai2 float64) (tt1, tt2 float64, err error) {
-	var cTt1, cTt2 C.double
-	cI := C.iauTaitt(C.double(tai1), C.double(tai2), &cTt1, &cTt2)
-	switch int(cI) {
-	case 0:
-	default:
-		err = errAdmin
-	}
-	return float64(cTt1), float64(cTt2), err
-}
-
-// GoTaitt Time scale transformation:  International Atomic Time, TAI,
-// to Terrestrial Time, TT.
-func GoTaitt(tai1, tai2 float64) (tt1, tt2 float64, err error) {
+func Taitt(tai1, tai2 float64) (tt1, tt2 float64, err error) {
 
 	// TT minus TAI (days).
 	const dtat = TTMTAI / DAYSEC
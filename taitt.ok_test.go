@@ -21,7 +21,7 @@ func TestTaitt(t *testing.T) {
 		fn  func(a1, a2 float64) (b1, b2 float64, b3 error)
 	}{
 		{"cgo", CgoTaitt},
-		{"go", GoTaitt},
+		{"go", Taitt},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -39,7 +39,7 @@ func BenchmarkTaitt(b *testing.B) {
 		fn  func(a1, a2 float64) (b1, b2 float64, l3 error)
 	}{
 		{"cgo", CgoTaitt},
-		{"go", GoTaitt},
+		{"go", Taitt},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -0,0 +1,23 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//
+//  CgoTaiut1 Time scale transformation:  International Atomic Time,
+//  TAI, to Universal Time, UT1.
+func CgoTaiut1(tai1, tai2, dta float64) (ut11, ut12 float64, err en.ErrNum) {
+	var cUt11, cUt12 C.double
+	cI := C.iauTaiut1(C.double(tai1), C.double(tai2), C.double(dta),
+		&cUt11, &cUt12)
+	switch int(cI) {
+	case 0:
+	default:
+		err = errTaiut1.Set(0)
+	}
+	return float64(cUt11), float64(cUt12), err
+}
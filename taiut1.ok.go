@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -12,7 +10,7 @@ var errTaiut1 = en.New(0, "Taiut1", []string{
 	"",
 })
 
-//  CgoTaiut1 Time scale transformation:  International Atomic Time,
+//  Taiut1 Time scale transformation:  International Atomic Time,
 //  TAI, to Universal Time, UT1.
 //
 //  - - - - - - -
@@ -56,23 +54,9 @@ var errTaiut1 = en.New(0, "Taiut1", []string{
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
 //
-//  CgoTaiut1 Time scale transformation:  International Atomic Time,
+//  Taiut1 Time scale transformation:  International Atomic Time,
 //  TAI, to Universal Time, UT1.
-func CgoTaiut1(tai1, tai2, dta float64) (ut11, ut12 float64, err en.ErrNum) {
-	var cUt11, cUt12 C.double
-	cI := C.iauTaiut1(C.double(tai1), C.double(tai2), C.double(dta),
-		&cUt11, &cUt12)
-	switch int(cI) {
-	case 0:
-	default:
-		err = errTaiut1.Set(0)
-	}
-	return float64(cUt11), float64(cUt12), err
-}
-
-//  GoTaiut1 Time scale transformation:  International Atomic Time,
-//  TAI, to Universal Time, UT1.
-func GoTaiut1(tai1, tai2, dta float64) (ut11, ut12 float64, err en.ErrNum) {
+func Taiut1(tai1, tai2, dta float64) (ut11, ut12 float64, err en.ErrNum) {
 	var dtad float64
 
 	// Result, safeguarding precision.
@@ -24,7 +24,7 @@ func TestTaiut1(t *testing.T) {
 		fn  func(a1, a2, a3 float64) (b1, b2 float64, b3 en.ErrNum)
 	}{
 		{"cgo", CgoTaiut1},
-		{"go", GoTaiut1},
+		{"go", Taiut1},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -43,7 +43,7 @@ func BenchmarkTaiut1(b *testing.B) {
 		fn  func(a1, a2, a3 float64) (b1, b2 float64, b3 en.ErrNum)
 	}{
 		{"cgo", CgoTaiut1},
-		{"go", GoTaiut1},
+		{"go", Taiut1},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauTaiutc(double tai1, double tai2, double *utc1, double *utc2)
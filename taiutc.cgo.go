@@ -0,0 +1,19 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoTaiutc Time scale transformation:  International Atomic Time,
+//  TAI, to Coordinated Universal Time, UTC.
+func CgoTaiutc(tai1, tai2 float64) (utc1, utc2 float64, err en.ErrNum) {
+	var cUtc1, cUtc2 C.double
+	cI := C.iauTaiutc(C.double(tai1), C.double(tai2), &cUtc1, &cUtc2)
+	if n := int(cI); n != 0 {
+		err = errTaiutc.Set(n)
+	}
+	return float64(cUtc1), float64(cUtc2), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -14,7 +12,7 @@ var errTaiutc = en.New(1, "Taiutc", []string{
 	"dubious year (Note 4)",
 })
 
-//  CgoTaiutc Time scale transformation:  International Atomic Time,
+//  Taiutc Time scale transformation:  International Atomic Time,
 //  TAI, to Coordinated Universal Time, UTC.
 //
 //  - - - - - - -
@@ -79,20 +77,15 @@ var errTaiutc = en.New(1, "Taiutc", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTaiutc Time scale transformation:  International Atomic Time,
+//  Taiutc Time scale transformation:  International Atomic Time,
 //  TAI, to Coordinated Universal Time, UTC.
-func CgoTaiutc(tai1, tai2 float64) (utc1, utc2 float64, err en.ErrNum) {
-	var cUtc1, cUtc2 C.double
-	cI := C.iauTaiutc(C.double(tai1), C.double(tai2), &cUtc1, &cUtc2)
-	if n := int(cI); n != 0 {
-		err = errTaiutc.Set(n)
-	}
-	return float64(cUtc1), float64(cUtc2), err
+func Taiutc(tai1, tai2 float64) (utc1, utc2 float64, err en.ErrNum) {
+	return TaiutcWith(CurrentLeapSeconds(), tai1, tai2)
 }
 
-//  GoTaiutc Time scale transformation:  International Atomic Time,
-//  TAI, to Coordinated Universal Time, UTC.
-func GoTaiutc(tai1, tai2 float64) (utc1, utc2 float64, err en.ErrNum) {
+//  TaiutcWith is Taiutc with Delta(AT) taken from ls.
+func TaiutcWith(ls LeapSeconds, tai1, tai2 float64) (
+	utc1, utc2 float64, err en.ErrNum) {
 	var big1 bool
 	var i int
 	var a1, a2, u1, u2, g1, g2 float64
@@ -115,9 +108,9 @@ func GoTaiutc(tai1, tai2 float64) (utc1, utc2 float64, err en.ErrNum) {
 	for i = 0; i < 3; i++ {
 
 		// Guessed UTC to TAI.
-		g1, g2, err = GoUtctai(u1, u2)
-		if err != nil && err.Is() < 0 {
-			err = errTaiutc.Wrap(err)
+		g1, g2, err = UtctaiWith(ls, u1, u2)
+		if err != nil && err.Code() < 0 {
+			err = errTaiutc.Set(-1).Wrap(err)
 			return
 		}
 
@@ -134,5 +127,10 @@ func GoTaiutc(tai1, tai2 float64) (utc1, utc2 float64, err en.ErrNum) {
 		utc1 = u2
 		utc2 = u1
 	}
+
+	// Status, a warning from Utctai.
+	if err != nil {
+		err = errTaiutc.Set(1).Wrap(err)
+	}
 	return
 }
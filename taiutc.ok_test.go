@@ -27,7 +27,7 @@ func TestTaiutc(t *testing.T) {
 		fn  func(a1, a2 float64) (c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTaiutc},
-		{"go", GoTaiutc},
+		{"go", Taiutc},
 	}
 
 	for _, test := range tests {
@@ -47,7 +47,7 @@ func BenchmarkTaiutc(b *testing.B) {
 		fn  func(a1, a2 float64) (c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTaiutc},
-		{"go", GoTaiutc},
+		{"go", Taiutc},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauTcbtdb(double tcb1, double tcb2, double *tdb1, double *tdb2)
@@ -0,0 +1,19 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoTcbtdb Time scale transformation:  Barycentric Coordinate Time,
+//  TCB, to Barycentric Dynamical Time, TDB.
+func CgoTcbtdb(tcb1, tcb2 float64) (tdb1, tdb2 float64, err en.ErrNum) {
+	var cTdb1, cTdb2 C.double
+	cI := C.iauTcbtdb(C.double(tcb1), C.double(tcb2), &cTdb1, &cTdb2)
+	if n := int(cI); n != 0 {
+		err = errTcbtdb.Set(n)
+	}
+	return float64(cTdb1), float64(cTdb2), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -12,7 +10,7 @@ var errTcbtdb = en.New(0, "Tcbtdb", []string{
 	"",
 })
 
-//  CgoTcbtdb Time scale transformation:  Barycentric Coordinate Time,
+//  Tcbtdb Time scale transformation:  Barycentric Coordinate Time,
 //  TCB, to Barycentric Dynamical Time, TDB.
 //
 //  - - - - - - -
@@ -68,20 +66,9 @@ var errTcbtdb = en.New(0, "Tcbtdb", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTcbtdb Time scale transformation:  Barycentric Coordinate Time,
+//  Tcbtdb Time scale transformation:  Barycentric Coordinate Time,
 //  TCB, to Barycentric Dynamical Time, TDB.
-func CgoTcbtdb(tcb1, tcb2 float64) (tdb1, tdb2 float64, err en.ErrNum) {
-	var cTdb1, cTdb2 C.double
-	cI := C.iauTcbtdb(C.double(tcb1), C.double(tcb2), &cTdb1, &cTdb2)
-	if n := int(cI); n != 0 {
-		err = errTcbtdb.Set(n)
-	}
-	return float64(cTdb1), float64(cTdb2), err
-}
-
-//  GoTcbtdb Time scale transformation:  Barycentric Coordinate Time,
-//  TCB, to Barycentric Dynamical Time, TDB.
-func GoTcbtdb(tcb1, tcb2 float64) (tdb1, tdb2 float64, err en.ErrNum) {
+func Tcbtdb(tcb1, tcb2 float64) (tdb1, tdb2 float64, err en.ErrNum) {
 	// 1977 Jan 1 00:00:32.184 TT, as two-part JD
 	const t77td = DJM0 + DJM77
 	const t77tf = TTMTAI / DAYSEC
@@ -27,7 +27,7 @@ func TestTcbtdb(t *testing.T) {
 		fn  func(a1,a2 float64) (c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTcbtdb},
-		{"go", GoTcbtdb},
+		{"go", Tcbtdb},
 	}
 
 	for _, test := range tests {
@@ -47,7 +47,7 @@ func BenchmarkTcbtdb(b *testing.B) {
 		fn  func(a1,a2 float64) (c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTcbtdb},
-		{"go", GoTcbtdb},
+		{"go", Tcbtdb},
 	}
 
 	for _, test := range tests {
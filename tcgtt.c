@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauTcgtt(double tcg1, double tcg2, double *tt1, double *tt2)
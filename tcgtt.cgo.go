@@ -0,0 +1,19 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoTcgtt Time scale transformation:  Geocentric Coordinate Time,
+//  TCG, to Terrestrial Time, TT.
+func CgoTcgtt(tcg1, tcg2 float64) (tt1, tt2 float64, err en.ErrNum) {
+	var cTt1, cTt2 C.double
+	cI := C.iauTcgtt(C.double(tcg1), C.double(tcg2), &cTt1, &cTt2)
+	if n := int(cI); n != 0 {
+		err = errTcgtt.Set(n)
+	}
+	return float64(cTt1), float64(cTt2), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -12,7 +10,7 @@ var errTcgtt = en.New(0, "Tcgtt", []string{
 	"",
 })
 
-//  CgoTcgtt Time scale transformation:  Geocentric Coordinate Time,
+//  Tcgtt Time scale transformation:  Geocentric Coordinate Time,
 //  TCG, to Terrestrial Time, TT.
 //
 //  - - - - - -
@@ -53,20 +51,9 @@ var errTcgtt = en.New(0, "Tcgtt", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTcgtt Time scale transformation:  Geocentric Coordinate Time,
+//  Tcgtt Time scale transformation:  Geocentric Coordinate Time,
 //  TCG, to Terrestrial Time, TT.
-func CgoTcgtt(tcg1, tcg2 float64) (tt1, tt2 float64, err en.ErrNum) {
-	var cTt1, cTt2 C.double
-	cI := C.iauTcgtt(C.double(tcg1), C.double(tcg2), &cTt1, &cTt2)
-	if n := int(cI); n != 0 {
-		err = errTcgtt.Set(n)
-	}
-	return float64(cTt1), float64(cTt2), err
-}
-
-//  GoTcgtt Time scale transformation:  Geocentric Coordinate Time,
-//  TCG, to Terrestrial Time, TT.
-func GoTcgtt(tcg1, tcg2 float64) (tt1, tt2 float64, err en.ErrNum) {
+func Tcgtt(tcg1, tcg2 float64) (tt1, tt2 float64, err en.ErrNum) {
 
 	// 1977 Jan 1 00:00:32.184 TT, as MJD
 	const t77t = DJM77 + TTMTAI/DAYSEC
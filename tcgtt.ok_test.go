@@ -27,7 +27,7 @@ func TestTcgtt(t *testing.T) {
 		fn  func(a1, a2 float64) (c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTcgtt},
-		{"go", GoTcgtt},
+		{"go", Tcgtt},
 	}
 
 	for _, test := range tests {
@@ -47,7 +47,7 @@ func BenchmarkTcgtt(b *testing.B) {
 		fn  func(a1, a2 float64) (c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTcgtt},
-		{"go", GoTcgtt},
+		{"go", Tcgtt},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauTdbtcb(double tdb1, double tdb2, double *tcb1, double *tcb2)
@@ -0,0 +1,19 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoTdbtcb Time scale transformation:  Barycentric Dynamical Time,
+//  TDB, to Barycentric Coordinate Time, TCB.
+func CgoTdbtcb(tdb1, tdb2 float64) (tcb1, tcb2 float64, err en.ErrNum) {
+	var cTcb1, cTcb2 C.double
+	cI := C.iauTdbtcb(C.double(tdb1), C.double(tdb2), &cTcb1, &cTcb2)
+	if n := int(cI); n != 0 {
+		err = errTdbtcb.Set(n)
+	}
+	return float64(cTcb1), float64(cTcb2), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -12,7 +10,7 @@ var errTdbtcb = en.New(0, "Tdbtcb", []string{
 	"",
 })
 
-//  CgoTdbtcb Time scale transformation:  Barycentric Dynamical Time,
+//  Tdbtcb Time scale transformation:  Barycentric Dynamical Time,
 //  TDB, to Barycentric Coordinate Time, TCB.
 //
 //  - - - - - - -
@@ -68,20 +66,9 @@ var errTdbtcb = en.New(0, "Tdbtcb", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTdbtcb Time scale transformation:  Barycentric Dynamical Time,
+//  Tdbtcb Time scale transformation:  Barycentric Dynamical Time,
 //  TDB, to Barycentric Coordinate Time, TCB.
-func CgoTdbtcb(tdb1, tdb2 float64) (tcb1, tcb2 float64, err en.ErrNum) {
-	var cTcb1, cTcb2 C.double
-	cI := C.iauTdbtcb(C.double(tdb1), C.double(tdb2), &cTcb1, &cTcb2)
-	if n := int(cI); n != 0 {
-		err = errTdbtcb.Set(n)
-	}
-	return float64(cTcb1), float64(cTcb2), err
-}
-
-//  GoTdbtcb Time scale transformation:  Barycentric Dynamical Time,
-//  TDB, to Barycentric Coordinate Time, TCB.
-func GoTdbtcb(tdb1, tdb2 float64) (tcb1, tcb2 float64, err en.ErrNum) {
+func Tdbtcb(tdb1, tdb2 float64) (tcb1, tcb2 float64, err en.ErrNum) {
 	// 1977 Jan 1 00:00:32.184 TT, as two-part JD
 	const t77td = DJM0 + DJM77
 	const t77tf = TTMTAI / DAYSEC
@@ -27,7 +27,7 @@ func TestTdbtcb(t *testing.T) {
 		fn  func(a1, a2 float64) (c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTdbtcb},
-		{"go", GoTdbtcb},
+		{"go", Tdbtcb},
 	}
 
 	for _, test := range tests {
@@ -47,7 +47,7 @@ func BenchmarkTdbtcb(b *testing.B) {
 		fn  func(a1, a2 float64) (c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTdbtcb},
-		{"go", GoTdbtcb},
+		{"go", Tdbtcb},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,23 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//
+//  CgoTdbtt Time scale transformation:  Barycentric Dynamical Time,
+//  TDB, to Terrestrial Time, TT.
+func CgoTdbtt(tdb1, tdb2, dtr float64) (
+	tt1, tt2 float64, err en.ErrNum) {
+
+	var cTt1, cTt2 C.double
+	cI := C.iauTdbtt(C.double(tdb1), C.double(tdb2), C.double(dtr),
+		&cTt1, &cTt2)
+		if n := int(cI); n != 0 {
+		err = errTdbtt.Set(n)
+	}
+	return float64(cTt1), float64(cTt2), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -12,7 +10,7 @@ var errTdbtt = en.New(0, "Tdbtt", []string{
 	"",
 })
 
-//  CgoTdbtt Time scale transformation:  Barycentric Dynamical Time,
+//  Tdbtt Time scale transformation:  Barycentric Dynamical Time,
 //  TDB, to Terrestrial Time, TT.
 //
 //  - - - - - -
@@ -66,23 +64,9 @@ var errTdbtt = en.New(0, "Tdbtt", []string{
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
 //
-//  CgoTdbtt Time scale transformation:  Barycentric Dynamical Time,
+//  Tdbtt Time scale transformation:  Barycentric Dynamical Time,
 //  TDB, to Terrestrial Time, TT.
-func CgoTdbtt(tdb1, tdb2, dtr float64) (
-	tt1, tt2 float64, err en.ErrNum) {
-
-	var cTt1, cTt2 C.double
-	cI := C.iauTdbtt(C.double(tdb1), C.double(tdb2), C.double(dtr),
-		&cTt1, &cTt2)
-		if n := int(cI); n != 0 {
-		err = errTdbtt.Set(n)
-	}
-	return float64(cTt1), float64(cTt2), err
-}
-
-//  GoTdbtt Time scale transformation:  Barycentric Dynamical Time,
-//  TDB, to Terrestrial Time, TT.
-func GoTdbtt(tdb1, tdb2, dtr float64) (
+func Tdbtt(tdb1, tdb2, dtr float64) (
 	tt1, tt2 float64, err en.ErrNum) {
 
 	var dtrd float64
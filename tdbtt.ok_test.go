@@ -28,7 +28,7 @@ func TestTdbtt(t *testing.T) {
 			c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTdbtt},
-		{"go", GoTdbtt},
+		{"go", Tdbtt},
 	}
 
 	for _, test := range tests {
@@ -49,7 +49,7 @@ func BenchmarkTdbtt(b *testing.B) {
 			c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTdbtt},
-		{"go", GoTdbtt},
+		{"go", Tdbtt},
 	}
 
 	for _, test := range tests {
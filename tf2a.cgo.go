@@ -0,0 +1,20 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoTf2a Convert hours, minutes, seconds to radians.
+func CgoTf2a(s byte, ihour, imin int, sec float64) (
+	rad float64, err en.ErrNum) {
+	var cRad C.double
+	cI := C.iauTf2a(C.char(s), C.int(ihour), C.int(imin),
+		C.double(sec), &cRad)
+	if n := int(cI); n != 0 {
+		err = errTf2a.Set(n)
+	}
+	return float64(cRad), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -15,7 +13,7 @@ var errTf2a = en.New(0, "Tf2a", []string{
 	"sec outside range 0-59.999...",
 })
 
-//  CgoTf2a Convert hours, minutes, seconds to radians.
+//  Tf2a Convert hours, minutes, seconds to radians.
 //
 //  - - - - -
 //   T f 2 a
@@ -57,20 +55,8 @@ var errTf2a = en.New(0, "Tf2a", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTf2a Convert hours, minutes, seconds to radians.
-func CgoTf2a(s byte, ihour, imin int, sec float64) (
-	rad float64, err en.ErrNum) {
-	var cRad C.double
-	cI := C.iauTf2a(C.char(s), C.int(ihour), C.int(imin),
-		C.double(sec), &cRad)
-	if n := int(cI); n != 0 {
-		err = errTf2a.Set(n)
-	}
-	return float64(cRad), err
-}
-
-//  GoTf2a Convert hours, minutes, seconds to radians.
-func GoTf2a(s byte, ihour, imin int, sec float64) (
+//  Tf2a Convert hours, minutes, seconds to radians.
+func Tf2a(s byte, ihour, imin int, sec float64) (
 	rad float64, err en.ErrNum) {
 	// Compute the interval.
 	rad = (60.0*(60.0*(math.Abs(float64(ihour)))+
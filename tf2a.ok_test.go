@@ -28,7 +28,7 @@ func TestTf2a(t *testing.T) {
 			float64, en.ErrNum)
 	}{
 		{"cgo", CgoTf2a},
-		{"go", GoTf2a},
+		{"go", Tf2a},
 	}
 
 	for _, test := range tests {
@@ -48,7 +48,7 @@ func BenchmarkTf2a(b *testing.B) {
 			float64, en.ErrNum)
 	}{
 		{"cgo", CgoTf2a},
-		{"go", GoTf2a},
+		{"go", Tf2a},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,20 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoTf2d Convert hours, minutes, seconds to days.
+func CgoTf2d(s byte, ihour, imin int, sec float64) (
+	days float64, err en.ErrNum) {
+	var cDays C.double
+	cI := C.iauTf2d(C.char(s), C.int(ihour), C.int(imin),
+		C.double(sec), &cDays)
+	if n := int(cI); n != 0 {
+		err = errTf2d.Set(n)
+	}
+	return float64(cDays), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -15,7 +13,7 @@ var errTf2d = en.New(0, "Tf2d", []string{
 	"sec outside range 0-59.999...",
 })
 
-//  CgoTf2d Convert hours, minutes, seconds to days.
+//  Tf2d Convert hours, minutes, seconds to days.
 //
 //  - - - - -
 //   T f 2 d
@@ -57,20 +55,8 @@ var errTf2d = en.New(0, "Tf2d", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTf2d Convert hours, minutes, seconds to days.
-func CgoTf2d(s byte, ihour, imin int, sec float64) (
-	days float64, err en.ErrNum) {
-	var cDays C.double
-	cI := C.iauTf2d(C.char(s), C.int(ihour), C.int(imin),
-		C.double(sec), &cDays)
-	if n := int(cI); n != 0 {
-		err = errTf2d.Set(n)
-	}
-	return float64(cDays), err
-}
-
-//  GoTf2d Convert hours, minutes, seconds to days.
-func GoTf2d(s byte, ihour, imin int, sec float64) (
+//  Tf2d Convert hours, minutes, seconds to days.
+func Tf2d(s byte, ihour, imin int, sec float64) (
 	days float64, err en.ErrNum) {
 
 	// Compute the interval.
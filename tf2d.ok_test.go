@@ -28,7 +28,7 @@ func TestTf2d(t *testing.T) {
 			float64, en.ErrNum)
 	}{
 		{"cgo", CgoTf2d},
-		{"go", GoTf2d},
+		{"go", Tf2d},
 	}
 
 	for _, test := range tests {
@@ -48,7 +48,7 @@ func BenchmarkTf2d(b *testing.B) {
 			float64, en.ErrNum)
 	}{
 		{"cgo", CgoTf2d},
-		{"go", GoTf2d},
+		{"go", Tf2d},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,359 @@
+package sofa
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/8i8/sofa/en"
+)
+
+var (
+	errISOFormat = errors.New("malformed ISO 8601 date")
+	errISORange  = errors.New("ISO 8601 field out of range")
+	errISOLeap   = errors.New("no leap second at this time")
+	errISOZone   = errors.New("Z designator on a time that is not UTC")
+	errISONdp    = errors.New("decimal places outside range 0-12")
+	errTimesys   = errors.New("unsupported FITS TIMESYS")
+)
+
+// isoFields are the fields of an ISO 8601 date and time.
+type isoFields struct {
+	iy, im, id, ihr, imn int
+	sec                  float64
+	zulu                 bool
+}
+
+// digits returns the value of s, which must be all decimal digits.
+func digits(s string) (int, bool) {
+	if s == "" {
+		return 0, false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil
+}
+
+// parseISO splits an ISO 8601 calendar date, with or without a time
+// of day, into its fields.  The extended format is required; the year
+// may carry a sign and more than four digits, and the seconds any
+// number of decimal places, with either a point or a comma.
+func parseISO(s string) (f isoFields, err error) {
+	bad := fmt.Errorf("%q: %w", s, errISOFormat)
+	str := strings.TrimSpace(s)
+	if strings.HasSuffix(str, "Z") {
+		f.zulu = true
+		str = str[:len(str)-1]
+	}
+
+	// Date and time of day.
+	date, tod := str, ""
+	if i := strings.IndexAny(str, "T "); i >= 0 {
+		date, tod = str[:i], str[i+1:]
+		if tod == "" {
+			return f, bad
+		}
+	}
+	if f.zulu && tod == "" {
+		return f, bad
+	}
+
+	// [±]YYYY[Y...]-MM-DD
+	sign := 1
+	if date != "" && (date[0] == '+' || date[0] == '-') {
+		if date[0] == '-' {
+			sign = -1
+		}
+		date = date[1:]
+	}
+	p := strings.Split(date, "-")
+	if len(p) != 3 || len(p[0]) < 4 || len(p[1]) != 2 || len(p[2]) != 2 {
+		return f, bad
+	}
+	var ok [3]bool
+	f.iy, ok[0] = digits(p[0])
+	f.im, ok[1] = digits(p[1])
+	f.id, ok[2] = digits(p[2])
+	if !ok[0] || !ok[1] || !ok[2] {
+		return f, bad
+	}
+	f.iy *= sign
+	if tod == "" {
+		return f, nil
+	}
+
+	// hh:mm[:ss[.s...]]
+	p = strings.Split(tod, ":")
+	if len(p) < 2 || len(p) > 3 || len(p[0]) != 2 || len(p[1]) != 2 {
+		return f, bad
+	}
+	f.ihr, ok[0] = digits(p[0])
+	f.imn, ok[1] = digits(p[1])
+	if !ok[0] || !ok[1] {
+		return f, bad
+	}
+	if len(p) == 3 {
+		ss := strings.Replace(p[2], ",", ".", 1)
+		i := strings.IndexByte(ss, '.')
+		if i < 0 {
+			i = len(ss)
+		} else if _, fok := digits(ss[i+1:]); !fok {
+			return f, bad
+		}
+		if _, sok := digits(ss[:i]); !sok || i != 2 {
+			return f, bad
+		}
+		f.sec, err = strconv.ParseFloat(ss, 64)
+		if err != nil {
+			return f, bad
+		}
+	}
+	if f.ihr > 23 || f.imn > 59 || f.sec >= 61.0 {
+		return f, fmt.Errorf("%q: %w", s, errISORange)
+	}
+	return f, nil
+}
+
+// ParseISO8601 returns the Time of an ISO 8601 date in the scale s,
+// for example "2016-12-31T23:59:60.25".  The time of day may be
+// omitted, and a trailing "Z" is accepted when s is UTC.  A leap
+// second ":60" is accepted only at the end of a day on which UTC
+// has one.
+func ParseISO8601(str string, s Scale) (Time, error) {
+	return ParseISO8601With(CurrentLeapSeconds(), str, s)
+}
+
+// ParseISO8601With is ParseISO8601 with the leap second table ls.
+func ParseISO8601With(ls LeapSeconds, str string, s Scale) (Time, error) {
+	f, err := parseISO(str)
+	if err != nil {
+		return Time{}, err
+	}
+	if f.zulu && s != ScaleUTC {
+		return Time{}, fmt.Errorf("%q: %w", str, errISOZone)
+	}
+	return timeFromFields(ls, str, s, f)
+}
+
+func timeFromFields(ls LeapSeconds, str string, s Scale, f isoFields) (
+	Time, error) {
+	if s < 0 || s >= numScales {
+		return Time{}, fmt.Errorf("%v: %w", s, errTimeScale)
+	}
+	if s == ScaleGLONASS {
+		return gloFromFields(ls, str, f)
+	}
+	d1, d2, err := Dtf2dWith(ls, s.String(),
+		f.iy, f.im, f.id, f.ihr, f.imn, f.sec)
+	if err != nil {
+		switch {
+		case err.Code() < 0:
+			return Time{}, fmt.Errorf("%q: %w", str, err)
+		case err.Code()&2 != 0:
+			return Time{}, fmt.Errorf("%q: %w", str, errISOLeap)
+		}
+		return Time{JD1: d1, JD2: d2, Scale: s}, err
+	}
+	return Time{JD1: d1, JD2: d2, Scale: s}, nil
+}
+
+// gloFromFields is timeFromFields for GLONASS, the fields of which are
+// those of UTC 3 hours later, leap second included.
+func gloFromFields(ls LeapSeconds, str string, f isoFields) (Time, error) {
+	if f.ihr >= 0 && f.ihr <= 23 {
+		var err en.ErrNum
+		f.iy, f.im, f.id, f.ihr, err = shiftHours(f.iy, f.im, f.id,
+			f.ihr, -int(GLOMUTC/3600.0))
+		if err != nil {
+			return Time{}, fmt.Errorf("%q: %w", str, err)
+		}
+	}
+	u, err := timeFromFields(ls, str, ScaleUTC, f)
+	if err != nil && !IsWarning(err) {
+		return Time{}, err
+	}
+	d1, d2, gerr := UtcgloWith(ls, u.JD1, u.JD2)
+	if gerr != nil && !IsWarning(gerr) {
+		return Time{}, fmt.Errorf("%q: %w", str, gerr)
+	}
+	return Time{JD1: d1, JD2: d2, Scale: ScaleGLONASS}, err
+}
+
+// shiftHours returns the date and hour h hours after the hour ihr of
+// the date iy-im-id.
+func shiftHours(iy, im, id, ihr, h int) (int, int, int, int, en.ErrNum) {
+	ihr += h
+	dd := 0
+	for ; ihr < 0; ihr += 24 {
+		dd--
+	}
+	for ; ihr > 23; ihr -= 24 {
+		dd++
+	}
+	if dd == 0 {
+		return iy, im, id, ihr, nil
+	}
+	dj, w, err := Cal2jd(iy, im, id)
+	if err != nil {
+		return iy, im, id, ihr, err
+	}
+	iy, im, id, _, err = Jd2cal(dj, w+float64(dd))
+	return iy, im, id, ihr, err
+}
+
+// ISO8601 formats t in its own scale as an ISO 8601 date and time with
+// ndp decimal places in the seconds.  Within a UTC leap second the
+// seconds read 60.  Years before 0 or after 9999 are signed.  The
+// result reads back with ParseISO8601 to the same string.
+func (t Time) ISO8601(ndp int) (string, error) {
+	return t.ISO8601With(CurrentLeapSeconds(), ndp)
+}
+
+// ISO8601With is ISO8601 with the leap second table ls.
+func (t Time) ISO8601With(ls LeapSeconds, ndp int) (string, error) {
+	if ndp < 0 || ndp > 12 {
+		return "", errISONdp
+	}
+	if t.Scale < 0 || t.Scale >= numScales {
+		return "", fmt.Errorf("%v: %w", t.Scale, errTimeScale)
+	}
+	var iy, im, id int
+	var ihmsf [4]int
+	var err error
+	if t.Scale == ScaleGLONASS {
+		iy, im, id, ihmsf, err = gloD2dtf(ls, ndp, t.JD1, t.JD2)
+	} else {
+		iy, im, id, ihmsf, err = D2dtfWith(ls, t.Scale.String(), ndp,
+			t.JD1, t.JD2)
+	}
+	if err != nil && !IsWarning(err) {
+		return "", err
+	}
+
+	var b strings.Builder
+	if iy < 0 || iy > 9999 {
+		fmt.Fprintf(&b, "%+05d", iy)
+	} else {
+		fmt.Fprintf(&b, "%04d", iy)
+	}
+	fmt.Fprintf(&b, "-%02d-%02dT%02d:%02d:%02d", im, id,
+		ihmsf[0], ihmsf[1], ihmsf[2])
+	if ndp > 0 {
+		fmt.Fprintf(&b, ".%0*d", ndp, ihmsf[3])
+	}
+	return b.String(), err
+}
+
+// gloD2dtf is D2dtfWith for GLONASS, the fields of which are those of
+// UTC 3 hours later, leap second included.
+func gloD2dtf(ls LeapSeconds, ndp int, d1, d2 float64) (iy, im, id int,
+	ihmsf [4]int, err error) {
+	u1, u2, err := GloutcWith(ls, d1, d2)
+	if err != nil && !IsWarning(err) {
+		return
+	}
+	iy, im, id, ihmsf, err = D2dtfWith(ls, "UTC", ndp, u1, u2)
+	if err != nil && !IsWarning(err) {
+		return
+	}
+	var errn en.ErrNum
+	iy, im, id, ihmsf[0], errn = shiftHours(iy, im, id, ihmsf[0],
+		int(GLOMUTC/3600.0))
+	if errn != nil {
+		err = errn
+	}
+	return
+}
+
+// fitsScales maps the FITS TIMESYS values to scales, including the
+// deprecated synonyms of the FITS time paper (Rots et al. 2015).
+var fitsScales = map[string]Scale{
+	"UTC": ScaleUTC,
+	"GMT": ScaleUTC,
+	"TAI": ScaleTAI,
+	"IAT": ScaleTAI,
+	"TT":  ScaleTT,
+	"TDT": ScaleTT,
+	"ET":  ScaleTT,
+	"TCG": ScaleTCG,
+	"TDB": ScaleTDB,
+	"TCB": ScaleTCB,
+	"UT1": ScaleUT1,
+	"GPS": ScaleGPS,
+}
+
+// FITSTimesys returns the scale named by a FITS TIMESYS value.  An
+// empty value is UTC, the FITS default.
+func FITSTimesys(timesys string) (Scale, error) {
+	v := strings.ToUpper(strings.TrimSpace(timesys))
+	if v == "" {
+		return ScaleUTC, nil
+	}
+	if s, ok := fitsScales[v]; ok {
+		return s, nil
+	}
+	return 0, fmt.Errorf("%q: %w", timesys, errTimesys)
+}
+
+// ParseFITSDate returns the Time of a FITS date keyword, such as
+// DATE-OBS, in the scale given by TIMESYS.  Besides ISO 8601 dates the
+// original "DD/MM/YY" form, for years 1900-1999, is accepted.
+func ParseFITSDate(date, timesys string) (Time, error) {
+	return ParseFITSDateWith(CurrentLeapSeconds(), date, timesys)
+}
+
+// ParseFITSDateWith is ParseFITSDate with the leap second table ls.
+func ParseFITSDateWith(ls LeapSeconds, date, timesys string) (
+	Time, error) {
+	s, err := FITSTimesys(timesys)
+	if err != nil {
+		return Time{}, err
+	}
+	str := strings.TrimSpace(date)
+
+	// DD/MM/YY
+	if p := strings.Split(str, "/"); len(p) == 3 {
+		var f isoFields
+		var ok [3]bool
+		f.id, ok[0] = digits(p[0])
+		f.im, ok[1] = digits(p[1])
+		f.iy, ok[2] = digits(p[2])
+		if !ok[0] || !ok[1] || !ok[2] ||
+			len(p[0]) != 2 || len(p[1]) != 2 || len(p[2]) != 2 {
+			return Time{}, fmt.Errorf("%q: %w", date, errISOFormat)
+		}
+		f.iy += 1900
+		return timeFromFields(ls, date, s, f)
+	}
+
+	// FITS dates have no time zone designator.
+	if strings.HasSuffix(str, "Z") {
+		return Time{}, fmt.Errorf("%q: %w", date, errISOFormat)
+	}
+	f, err := parseISO(str)
+	if err != nil {
+		return Time{}, err
+	}
+	return timeFromFields(ls, date, s, f)
+}
+
+// FITSDate formats t as the value of a FITS date keyword with ndp
+// decimal places in the seconds, and the TIMESYS value for its scale.
+func (t Time) FITSDate(ndp int) (date, timesys string, err error) {
+	return t.FITSDateWith(CurrentLeapSeconds(), ndp)
+}
+
+// FITSDateWith is FITSDate with the leap second table ls.
+func (t Time) FITSDateWith(ls LeapSeconds, ndp int) (
+	date, timesys string, err error) {
+	date, err = t.ISO8601With(ls, ndp)
+	if err != nil && !IsWarning(err) {
+		return "", "", err
+	}
+	return date, t.Scale.String(), err
+}
@@ -0,0 +1,158 @@
+package sofa
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestISO8601(t *testing.T) {
+	const fname = "ISO8601"
+	tests := []struct {
+		ref    string
+		scale  Scale
+		ndp    int
+		d1, d2 float64
+	}{
+		{"2006-01-15T21:24:37.500", ScaleUTC, 3,
+			2453750.5, 0.8921006944444444},
+		{"2016-12-31T23:59:60.250", ScaleUTC, 3,
+			2457753.5, 86400.25 / 86401.0},
+		{"2017-01-01T00:00:00", ScaleUTC, 0, 2457754.5, 0.0},
+		{"1994-06-30T23:59:60.999999", ScaleUTC, 6,
+			2449533.5, 86400.999999 / 86401.0},
+		{"2000-01-01T12:00:00.000000000", ScaleTT, 9, 2451545.0, 0.0},
+		{"-0044-03-15T12:00:00", ScaleTT, 0, 1705063.0, 0.0},
+		{"+12000-01-01T00:00:00.0", ScaleTDB, 1, 6103969.5, 0.0},
+	}
+
+	for _, test := range tests {
+		tname := fname + " " + test.ref
+
+		tm, err := ParseISO8601(test.ref, test.scale)
+		errT(t, nil, err, tname, "parse")
+		vvd(t, tm.JD1+tm.JD2, test.d1+test.d2, 1e-9, tname, "jd")
+		vvd(t, tm.JD2-(test.d1-tm.JD1), test.d2, 1e-15, tname, "jd2")
+
+		s, err := tm.ISO8601(test.ndp)
+		errT(t, nil, err, tname, "format")
+		if s != test.ref {
+			t.Errorf("%s: round trip gave %q", tname, s)
+		}
+	}
+}
+
+func TestISO8601Forms(t *testing.T) {
+	const fname = "ISO8601"
+	want, _ := ParseISO8601("2006-01-15T21:24:00", ScaleUTC)
+	for _, s := range []string{
+		"2006-01-15T21:24", "2006-01-15 21:24:00", "2006-01-15T21:24:00Z",
+		"2006-01-15T21:24:00,0", " 2006-01-15T21:24:00.00000000000000 ",
+	} {
+		tm, err := ParseISO8601(s, ScaleUTC)
+		errT(t, nil, err, fname, s)
+		vvd(t, tm.JD2, want.JD2, 1e-15, fname, s)
+	}
+
+	tm, err := ParseISO8601("2006-01-15", ScaleTAI)
+	errT(t, nil, err, fname, "date")
+	vvd(t, tm.JD1+tm.JD2, 2453750.5, 0.0, fname, "date")
+}
+
+func TestISO8601Errors(t *testing.T) {
+	const fname = "ISO8601"
+	tests := []struct {
+		ref   string
+		scale Scale
+		err   error
+	}{
+		{"2006-1-15T21:24:00", ScaleUTC, errISOFormat},
+		{"06-01-15T21:24:00", ScaleUTC, errISOFormat},
+		{"2006-01-15T21:24:0", ScaleUTC, errISOFormat},
+		{"2006-01-15T21:24:00.", ScaleUTC, errISOFormat},
+		{"2006-01-15T", ScaleUTC, errISOFormat},
+		{"2006-01-15Z", ScaleUTC, errISOFormat},
+		{"2006-01-15T21:24:00+01:00", ScaleUTC, errISOFormat},
+		{"2006-01-15T24:00:00", ScaleUTC, errISORange},
+		{"2006-01-15T21:60:00", ScaleUTC, errISORange},
+		{"2006-01-15T23:59:60", ScaleUTC, errISOLeap},
+		{"2016-12-31T23:59:60", ScaleTAI, errISOLeap},
+		{"2016-12-31T23:58:60", ScaleUTC, errISOLeap},
+		{"2006-01-15T21:24:00Z", ScaleTT, errISOZone},
+	}
+
+	for _, test := range tests {
+		_, err := ParseISO8601(test.ref, test.scale)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s %q: want %v got %v", fname, test.ref,
+				test.err, err)
+		}
+	}
+
+	_, err := ParseISO8601("2006-13-15", ScaleUTC)
+	if err == nil {
+		t.Errorf("%s: want error for month 13", fname)
+	}
+
+	_, err = NewTime(ScaleTT, 2451545.0, 0.0).ISO8601(13)
+	errT(t, errISONdp, err, fname, "ndp")
+}
+
+func TestFITSDate(t *testing.T) {
+	const fname = "FITSDate"
+	tests := []struct {
+		date, timesys string
+		scale         Scale
+		d1, d2        float64
+	}{
+		{"2016-12-31T23:59:60.5", "", ScaleUTC,
+			2457753.5, 86400.5 / 86401.0},
+		{"2000-01-01T12:00:00", "TT", ScaleTT, 2451545.0, 0.0},
+		{"2000-01-01T12:00:00", "tdt", ScaleTT, 2451545.0, 0.0},
+		{"2000-01-01", "TDB", ScaleTDB, 2451544.5, 0.0},
+		{"31/12/98", "UTC", ScaleUTC, 2451178.5, 0.0},
+	}
+
+	for _, test := range tests {
+		tname := fname + " " + test.date
+
+		tm, err := ParseFITSDate(test.date, test.timesys)
+		errT(t, nil, err, tname, "parse")
+		viv(t, int(tm.Scale), int(test.scale), tname, "scale")
+		vvd(t, tm.JD1+tm.JD2, test.d1+test.d2, 1e-9, tname, "jd")
+	}
+
+	tm, _ := ParseFITSDate("2016-12-31T23:59:60.5", "UTC")
+	date, timesys, err := tm.FITSDate(1)
+	errT(t, nil, err, fname, "format")
+	if date != "2016-12-31T23:59:60.5" || timesys != "UTC" {
+		t.Errorf("%s: got %q %q", fname, date, timesys)
+	}
+
+	_, err = ParseFITSDate("2000-01-01T12:00:00", "LOCAL")
+	errT(t, errTimesys, errors.Unwrap(err), fname, "timesys")
+	tm, err = ParseFITSDate("2000-01-01T12:00:00", "GPS")
+	errT(t, nil, err, fname, "gps")
+	viv(t, int(tm.Scale), int(ScaleGPS), fname, "gps")
+	_, err = ParseFITSDate("2000-01-01T12:00:00Z", "UTC")
+	errT(t, errISOFormat, errors.Unwrap(err), fname, "zone")
+	_, err = ParseFITSDate("31/12/1998", "UTC")
+	errT(t, errISOFormat, errors.Unwrap(err), fname, "legacy")
+}
+
+func TestISO8601RoundTrip(t *testing.T) {
+	const fname = "ISO8601 round trip"
+	for i := 0; i < 2000; i++ {
+		for _, ndp := range []int{0, 3, 6, 9} {
+			tm := NewTime(ScaleUTC, 2457753.5, float64(i)/2000.0+
+				float64(i%7)*1e-7)
+			s, err := tm.ISO8601(ndp)
+			errT(t, nil, err, fname, "format")
+			p, err := ParseISO8601(s, ScaleUTC)
+			errT(t, nil, err, fname, s)
+			r, _ := p.ISO8601(ndp)
+			if r != s {
+				t.Fatalf("%s: %q became %q", fname, s, r)
+			}
+		}
+	}
+}
@@ -0,0 +1,410 @@
+package sofa
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/8i8/sofa/en"
+)
+
+var (
+	errTimeScale = errors.New("unknown time scale")
+	errTimePath  = errors.New("no conversion path between time scales")
+)
+
+// Scale identifies the time scale of a Time.
+type Scale int
+
+const (
+	ScaleUTC     Scale = iota // Coordinated Universal Time
+	ScaleTAI                  // International Atomic Time
+	ScaleTT                   // Terrestrial Time
+	ScaleTCG                  // Geocentric Coordinate Time
+	ScaleTDB                  // Barycentric Dynamical Time
+	ScaleTCB                  // Barycentric Coordinate Time
+	ScaleUT1                  // Universal Time
+	ScaleGPS                  // GPS Time
+	ScaleGST                  // Galileo System Time
+	ScaleBDT                  // BeiDou Time
+	ScaleGLONASS              // GLONASS Time, UTC(SU)+3h
+	numScales
+)
+
+var scaleNames = [numScales]string{
+	"UTC", "TAI", "TT", "TCG", "TDB", "TCB", "UT1",
+	"GPS", "GST", "BDT", "GLONASS",
+}
+
+// String returns the abbreviation of the scale, as used by D2dtf
+// and Dtf2d.
+func (s Scale) String() string {
+	if s < 0 || s >= numScales {
+		return fmt.Sprintf("Scale(%d)", int(s))
+	}
+	return scaleNames[s]
+}
+
+// ParseScale returns the scale with the abbreviation name.
+func ParseScale(name string) (Scale, error) {
+	for s, n := range scaleNames {
+		if n == name {
+			return Scale(s), nil
+		}
+	}
+	return 0, fmt.Errorf("%q: %w", name, errTimeScale)
+}
+
+// Time is an instant given as a 2-part Julian Date in a time scale.
+// For UTC the date is a quasi Julian Date, as used by Utctai, and so
+// it is for GLONASS, 3 hours later, as Utcglo has it.
+type Time struct {
+	JD1, JD2 float64
+	Scale    Scale
+}
+
+// NewTime returns the Time jd1+jd2 in scale s.
+func NewTime(s Scale, jd1, jd2 float64) Time {
+	return Time{JD1: jd1, JD2: jd2, Scale: s}
+}
+
+// TDBModel gives TDB-TT (seconds), the quantity dtr of Tttdb and
+// Tdbtt, at a 2-part Julian Date.
+type TDBModel interface {
+	Dtr(d1, d2 float64) float64
+}
+
+// DtdbSite is a TDBModel that evaluates Dtdb for an observer; the
+// zero value is the geocentre.  UT is taken to be the fraction of the
+// day of the date, which is adequate for the topocentric terms.
+type DtdbSite struct {
+	Elong float64 // longitude (east positive, radians)
+	U     float64 // distance from Earth spin axis (km)
+	V     float64 // distance north of equatorial plane (km)
+}
+
+// Dtr returns TDB-TT (seconds) at d1+d2.
+func (s DtdbSite) Dtr(d1, d2 float64) float64 {
+	_, f1 := math.Modf(d1)
+	_, f2 := math.Modf(d2)
+	ut := math.Mod(f1+f2+0.5, 1.0)
+	if ut < 0.0 {
+		ut += 1.0
+	}
+	return Dtdb(d1, d2, ut, s.Elong, s.U, s.V)
+}
+
+// TimeConverter holds the providers of the auxiliary quantities that
+// the conversions between scales need.  A nil provider makes the
+// conversions that need it unavailable, except that Leap defaults to
+// the installed leap second table and TDB to the geocentric DtdbSite.
+//
+// UT1 is reached from UTC when EOP is set and from TT when DeltaT is
+// set.  Where both are set the EOP are preferred and Delta T is used
+// for dates that the EOP do not cover.
+type TimeConverter struct {
+	Leap   LeapSeconds
+	EOP    EOPSource
+	DeltaT DeltaTModel
+	TDB    TDBModel
+}
+
+// DefaultTimeConverter is used by Time.To.  It has no EOP and so takes
+// UT1 from the Espenak & Meeus Delta T model.
+var DefaultTimeConverter = &TimeConverter{DeltaT: EspenakMeeus{}}
+
+func (c *TimeConverter) leap() LeapSeconds {
+	if c.Leap == nil {
+		return CurrentLeapSeconds()
+	}
+	return c.Leap
+}
+
+func (c *TimeConverter) tdb() TDBModel {
+	if c.TDB == nil {
+		return DtdbSite{}
+	}
+	return c.TDB
+}
+
+// dut1 returns UT1-UTC at the UTC d1+d2.
+func (c *TimeConverter) dut1(d1, d2 float64) (float64, error) {
+	e, err := c.EOP.EOP(d1, d2)
+	if err != nil {
+		return 0, err
+	}
+	return e.DUT1, nil
+}
+
+// timeStep is one edge of the conversion graph, of the given cost.
+type timeStep struct {
+	from, to Scale
+	cost     int
+	ok       func(c *TimeConverter) bool
+	conv     func(c *TimeConverter, d1, d2 float64) (float64, float64, error)
+}
+
+// The costs of the steps.  A Delta T step costs more than any path
+// without one, so that the EOP are preferred wherever they serve.
+const (
+	stepCost   = 1
+	deltaTCost = 100
+)
+
+func always(*TimeConverter) bool { return true }
+
+var timeSteps = []timeStep{
+	{ScaleUTC, ScaleTAI, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return UtctaiWith(c.leap(), d1, d2)
+		}},
+	{ScaleTAI, ScaleUTC, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return TaiutcWith(c.leap(), d1, d2)
+		}},
+	{ScaleTAI, ScaleTT, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return Taitt(d1, d2)
+		}},
+	{ScaleTT, ScaleTAI, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return Tttai(d1, d2)
+		}},
+	{ScaleTT, ScaleTCG, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return Tttcg(d1, d2)
+		}},
+	{ScaleTCG, ScaleTT, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return Tcgtt(d1, d2)
+		}},
+	{ScaleTT, ScaleTDB, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return Tttdb(d1, d2, c.tdb().Dtr(d1, d2))
+		}},
+	{ScaleTDB, ScaleTT, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return Tdbtt(d1, d2, c.tdb().Dtr(d1, d2))
+		}},
+	{ScaleTDB, ScaleTCB, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return Tdbtcb(d1, d2)
+		}},
+	{ScaleTCB, ScaleTDB, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return Tcbtdb(d1, d2)
+		}},
+	{ScaleUTC, ScaleUT1, stepCost,
+		func(c *TimeConverter) bool { return c.EOP != nil },
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			dut1, err := c.dut1(d1, d2)
+			if err != nil {
+				return 0, 0, err
+			}
+			return Utcut1With(c.leap(), d1, d2, dut1)
+		}},
+	{ScaleUT1, ScaleUTC, stepCost,
+		func(c *TimeConverter) bool { return c.EOP != nil },
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			// UT1-UTC changes too slowly for the difference
+			// between UT1 and UTC to matter.
+			dut1, err := c.dut1(d1, d2)
+			if err != nil {
+				return 0, 0, err
+			}
+			return Ut1utcWith(c.leap(), d1, d2, dut1)
+		}},
+	{ScaleTT, ScaleUT1, deltaTCost,
+		func(c *TimeConverter) bool { return c.DeltaT != nil },
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return Ttut1DT(c.DeltaT, d1, d2)
+		}},
+	{ScaleUT1, ScaleTT, deltaTCost,
+		func(c *TimeConverter) bool { return c.DeltaT != nil },
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return Ut1ttDT(c.DeltaT, d1, d2)
+		}},
+	{ScaleTAI, ScaleGPS, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return Taigps(d1, d2)
+		}},
+	{ScaleGPS, ScaleTAI, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return Gpstai(d1, d2)
+		}},
+	{ScaleTAI, ScaleGST, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return Taigst(d1, d2)
+		}},
+	{ScaleGST, ScaleTAI, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return Gsttai(d1, d2)
+		}},
+	{ScaleTAI, ScaleBDT, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return Taibdt(d1, d2)
+		}},
+	{ScaleBDT, ScaleTAI, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return Bdttai(d1, d2)
+		}},
+	{ScaleUTC, ScaleGLONASS, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return UtcgloWith(c.leap(), d1, d2)
+		}},
+	{ScaleGLONASS, ScaleUTC, stepCost, always,
+		func(c *TimeConverter, d1, d2 float64) (float64, float64, error) {
+			return GloutcWith(c.leap(), d1, d2)
+		}},
+}
+
+// path returns the sequence of steps of least cost from one scale to
+// another, avoiding the steps in skip.
+func (c *TimeConverter) path(from, to Scale, skip map[int]bool) []int {
+	const inf = int(^uint(0) >> 1)
+	dist := make([]int, numScales)
+	prev := make([]int, numScales)
+	for i := range dist {
+		dist[i], prev[i] = inf, -1
+	}
+	dist[from] = 0
+
+	// The graph is small enough to relax every step once per scale.
+	for n := 1; n < int(numScales); n++ {
+		for i, st := range timeSteps {
+			if dist[st.from] == inf || skip[i] || !st.ok(c) {
+				continue
+			}
+			if d := dist[st.from] + st.cost; d < dist[st.to] {
+				dist[st.to], prev[st.to] = d, i
+			}
+		}
+	}
+	if dist[to] == inf {
+		return nil
+	}
+	var p []int
+	for s := to; s != from; s = timeSteps[prev[s]].from {
+		p = append([]int{prev[s]}, p...)
+	}
+	return p
+}
+
+// IsWarning reports whether err is a status that leaves the result
+// usable, such as the "dubious year" of Utctai and D2dtf or the date
+// warning of Epv00.  Unlike en.IsWarning, it knows the warnings that
+// are not ErrNum.
+func IsWarning(err error) bool {
+	if errors.Is(err, errD2dtfWarn) || errors.Is(err, errEpv00Warn) {
+		return true
+	}
+	return en.IsWarning(err)
+}
+
+// Convert returns t in the scale s, going through as many of the
+// pairwise transformations as it takes.  If a step fails, for example
+// because the date is outside the EOP table, another path is tried.
+//
+// As with the pairwise functions, a warning status is returned along
+// with a usable result.
+func (c *TimeConverter) Convert(t Time, s Scale) (Time, error) {
+	if t.Scale < 0 || t.Scale >= numScales {
+		return t, fmt.Errorf("%v: %w", t.Scale, errTimeScale)
+	}
+	if s < 0 || s >= numScales {
+		return t, fmt.Errorf("%v: %w", s, errTimeScale)
+	}
+	if t.Scale == s {
+		return t, nil
+	}
+
+	skip := map[int]bool{}
+	var lastErr error
+	for {
+		p := c.path(t.Scale, s, skip)
+		if p == nil {
+			if lastErr != nil {
+				return t, lastErr
+			}
+			return t, fmt.Errorf("%v to %v: %w", t.Scale, s, errTimePath)
+		}
+		r, warn, err := c.walk(t, p, skip)
+		if err == nil {
+			return r, warn
+		}
+		lastErr = err
+	}
+}
+
+// walk applies the steps p to t.  A step that fails is added to skip.
+func (c *TimeConverter) walk(t Time, p []int, skip map[int]bool) (
+	r Time, warn, err error) {
+	d1, d2 := t.JD1, t.JD2
+	for _, i := range p {
+		st := timeSteps[i]
+		var e error
+		d1, d2, e = st.conv(c, d1, d2)
+		switch {
+		case e == nil:
+		case IsWarning(e):
+			warn = e
+		default:
+			skip[i] = true
+			return t, nil, fmt.Errorf("%v to %v: %w", st.from, st.to, e)
+		}
+	}
+	return Time{JD1: d1, JD2: d2, Scale: timeSteps[p[len(p)-1]].to}, warn, nil
+}
+
+// To returns t in the scale s using DefaultTimeConverter.
+func (t Time) To(s Scale) (Time, error) {
+	return DefaultTimeConverter.Convert(t, s)
+}
+
+// ToWith returns t in the scale s using the providers of c.
+func (t Time) ToWith(c *TimeConverter, s Scale) (Time, error) {
+	return c.Convert(t, s)
+}
+
+// FromTime returns the UTC Time of the Go time tm.
+func FromTime(tm time.Time) (Time, error) {
+	return FromTimeWith(CurrentLeapSeconds(), tm)
+}
+
+// FromTimeWith returns the UTC Time of the Go time tm using the leap
+// second table ls.
+func FromTimeWith(ls LeapSeconds, tm time.Time) (Time, error) {
+	tm = tm.UTC()
+	sec := float64(tm.Second()) + float64(tm.Nanosecond())/1e9
+	d1, d2, err := Dtf2dWith(ls, "UTC", tm.Year(), int(tm.Month()),
+		tm.Day(), tm.Hour(), tm.Minute(), sec)
+	if err != nil && err.Code() < 0 {
+		return Time{}, err
+	}
+	return Time{JD1: d1, JD2: d2, Scale: ScaleUTC}, err
+}
+
+// AsTime returns t as a Go time in UTC, using DefaultTimeConverter to
+// reach UTC.  Go times have no leap seconds; an instant within a leap
+// second is returned as the start of the following minute.
+func (t Time) AsTime() (time.Time, error) {
+	return t.AsTimeWith(DefaultTimeConverter)
+}
+
+// AsTimeWith returns t as a Go time in UTC, using the providers of c.
+func (t Time) AsTimeWith(c *TimeConverter) (time.Time, error) {
+	u, warn := c.Convert(t, ScaleUTC)
+	if warn != nil && !IsWarning(warn) {
+		return time.Time{}, warn
+	}
+	iy, im, id, ihmsf, err := D2dtfWith(c.leap(), "UTC", 9, u.JD1, u.JD2)
+	if err != nil && !IsWarning(err) {
+		return time.Time{}, err
+	}
+	if warn == nil {
+		warn = err
+	}
+	return time.Date(iy, time.Month(im), id, ihmsf[0], ihmsf[1], ihmsf[2],
+		ihmsf[3], time.UTC), warn
+}
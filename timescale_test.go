@@ -0,0 +1,139 @@
+package sofa
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTimeTo(t *testing.T) {
+	const fname = "Time.To"
+
+	utc := NewTime(ScaleUTC, 2453750.5, 0.892100694)
+
+	// The same chain by hand.
+	tai1, tai2, _ := Utctai(utc.JD1, utc.JD2)
+	tt1, tt2, _ := Taitt(tai1, tai2)
+	tcg1, tcg2, _ := Tttcg(tt1, tt2)
+	tdb1, tdb2, _ := Tttdb(tt1, tt2, DtdbSite{}.Dtr(tt1, tt2))
+	tcb1, tcb2, _ := Tdbtcb(tdb1, tdb2)
+	ut11, ut12, _ := Ttut1DT(EspenakMeeus{}, tt1, tt2)
+
+	tests := []struct {
+		scale  Scale
+		d1, d2 float64
+	}{
+		{ScaleUTC, utc.JD1, utc.JD2},
+		{ScaleTAI, tai1, tai2},
+		{ScaleTT, tt1, tt2},
+		{ScaleTCG, tcg1, tcg2},
+		{ScaleTDB, tdb1, tdb2},
+		{ScaleTCB, tcb1, tcb2},
+		{ScaleUT1, ut11, ut12},
+	}
+
+	for _, test := range tests {
+		tname := fname + " " + test.scale.String()
+
+		r, err := utc.To(test.scale)
+		errT(t, nil, err, tname, "err")
+		if r.Scale != test.scale {
+			t.Errorf("%s: want scale %v got %v", tname, test.scale,
+				r.Scale)
+		}
+		vvd(t, r.JD1, test.d1, 1e-6, tname, "jd1")
+		vvd(t, r.JD2, test.d2, 1e-12, tname, "jd2")
+
+		// And back again.
+		b, err := r.To(ScaleUTC)
+		errT(t, nil, err, tname, "err")
+		vvd(t, (b.JD1-utc.JD1)+b.JD2, utc.JD2, 1e-11, tname, "back")
+	}
+}
+
+func TestTimeConverter(t *testing.T) {
+	const fname = "TimeConverter"
+
+	tbl, err := ParseFinals2000A(strings.NewReader(eopFinals))
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+
+	// With EOP, UT1 comes from UT1-UTC.
+	c := &TimeConverter{EOP: tbl, DeltaT: EspenakMeeus{}}
+	tai := NewTime(ScaleTAI, DJM0, 57753.5+36.0/DAYSEC)
+	r, err := tai.ToWith(c, ScaleUT1)
+	errT(t, nil, err, fname, "eop")
+	vvd(t, (r.JD1-DJM0)+r.JD2, 57753.5-0.40945/DAYSEC, 1e-10, fname,
+		"eop")
+
+	// From TT too, the EOP are preferred to Delta T, through TAI and
+	// UTC, where they cover the date.
+	tt0 := NewTime(ScaleTT, DJM0, 57753.5+(36.0+32.184)/DAYSEC)
+	r, err = tt0.ToWith(c, ScaleUT1)
+	errT(t, nil, err, fname, "tt eop")
+	vvd(t, (r.JD1-DJM0)+r.JD2, 57753.5-0.40945/DAYSEC, 1e-10, fname,
+		"tt eop")
+
+	// Outside the table Delta T is used instead.
+	tt := NewTime(ScaleTT, DJM0, 58000.0)
+	r, err = tt.ToWith(c, ScaleUT1)
+	errT(t, nil, err, fname, "deltat")
+	u1, u2, _ := Ttut1DT(EspenakMeeus{}, tt.JD1, tt.JD2)
+	vvd(t, r.JD2, u2, 1e-12, fname, "deltat")
+	vvd(t, r.JD1, u1, 0.0, fname, "deltat")
+
+	// Without Delta T there is no way to UT1 for that date.
+	c = &TimeConverter{EOP: tbl}
+	_, err = tt.ToWith(c, ScaleUT1)
+	if !errors.Is(err, errEOPRange) {
+		t.Errorf("%s: want %v got %v", fname, errEOPRange, err)
+	}
+
+	// And without either, none at all.
+	c = &TimeConverter{}
+	_, err = tt.ToWith(c, ScaleUT1)
+	errT(t, errTimePath, errors.Unwrap(err), fname, "path")
+
+	_, err = tt.ToWith(c, Scale(99))
+	errT(t, errTimeScale, errors.Unwrap(err), fname, "scale")
+}
+
+func TestParseScale(t *testing.T) {
+	const fname = "ParseScale"
+	for s := ScaleUTC; s < numScales; s++ {
+		p, err := ParseScale(s.String())
+		errT(t, nil, err, fname, s.String())
+		viv(t, int(p), int(s), fname, s.String())
+	}
+	_, err := ParseScale("GMT")
+	errT(t, errTimeScale, errors.Unwrap(err), fname, "GMT")
+}
+
+func TestTimeGo(t *testing.T) {
+	const fname = "Time.AsTime"
+
+	tm := time.Date(2006, time.January, 15, 21, 24, 37, 500000000, time.UTC)
+	u, err := FromTime(tm)
+	errT(t, nil, err, fname, "from")
+	vvd(t, u.JD1, 2453750.5, 0.0, fname, "jd1")
+	vvd(t, u.JD2, 0.8921006944444444, 1e-15, fname, "jd2")
+
+	tt, _ := u.To(ScaleTT)
+	back, err := tt.AsTime()
+	errT(t, nil, err, fname, "as")
+	if d := back.Sub(tm); d < -time.Microsecond || d > time.Microsecond {
+		t.Errorf("%s: want %v got %v", fname, tm, back)
+	}
+
+	// A leap second becomes the start of the next minute.  On the
+	// day of a leap second UTC days have 86401 seconds.
+	l := NewTime(ScaleUTC, DJM0+57753.0, 86400.5/86401.0)
+	back, err = l.AsTime()
+	errT(t, nil, err, fname, "leap")
+	want := time.Date(2017, time.January, 1, 0, 0, 0, 500000000, time.UTC)
+	if !back.Equal(want) {
+		t.Errorf("%s: want %v got %v", fname, want, back)
+	}
+}
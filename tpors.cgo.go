@@ -0,0 +1,20 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoTpors In the tangent plane projection, given the rectangular
+//  coordinates of a star and its spherical coordinates, determine the
+//  spherical coordinates of the tangent point.
+func CgoTpors(xi, eta, a, b float64) (
+	a01, b01, a02, b02 float64, err en.ErrNum) {
+	var cA01, cB01, cA02, cB02 C.double
+	cI := C.iauTpors(C.double(xi), C.double(eta), C.double(a), C.double(b),
+		&cA01, &cB01, &cA02, &cB02)
+	err = errTpors.Set(int(cI)) // a value is required in every case.
+	return float64(cA01), float64(cB01), float64(cA02), float64(cB02), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -17,7 +15,7 @@ var errTpors = en.New(0, "Tpors", []string{
 	"both solutions are useful (Note 6)",
 })
 
-//  CgoTpors In the tangent plane projection, given the rectangular
+//  Tpors In the tangent plane projection, given the rectangular
 //  coordinates of a star and its spherical coordinates, determine the
 //  spherical coordinates of the tangent point.
 //
@@ -108,22 +106,10 @@ var errTpors = en.New(0, "Tpors", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTpors In the tangent plane projection, given the rectangular
+//  Tpors In the tangent plane projection, given the rectangular
 //  coordinates of a star and its spherical coordinates, determine the
 //  spherical coordinates of the tangent point.
-func CgoTpors(xi, eta, a, b float64) (
-	a01, b01, a02, b02 float64, err en.ErrNum) {
-	var cA01, cB01, cA02, cB02 C.double
-	cI := C.iauTpors(C.double(xi), C.double(eta), C.double(a), C.double(b),
-		&cA01, &cB01, &cA02, &cB02)
-	err = errTpors.Set(int(cI)) // a value is required in every case.
-	return float64(cA01), float64(cB01), float64(cA02), float64(cB02), err
-}
-
-//  GoTpors In the tangent plane projection, given the rectangular
-//  coordinates of a star and its spherical coordinates, determine the
-//  spherical coordinates of the tangent point.
-func GoTpors(xi, eta, a, b float64) (
+func Tpors(xi, eta, a, b float64) (
 	a01, b01, a02, b02 float64, err en.ErrNum) {
 	var xi2, r, sb, cb, rsb, rcb, w2, w, s, c float64
 
@@ -141,12 +127,12 @@ func GoTpors(xi, eta, a, b float64) (
 		if xi == 0.0 && w == 0.0 {
 			w = 1.0
 		}
-		a01 = GoAnp(a - math.Atan2(xi, w))
+		a01 = Anp(a - math.Atan2(xi, w))
 		b01 = math.Atan2(s, c)
 		w = -w
 		s = rsb - eta*w
 		c = rsb*eta + w
-		a02 = GoAnp(a - math.Atan2(xi, w))
+		a02 = Anp(a - math.Atan2(xi, w))
 		b02 = math.Atan2(s, c)
 		if math.Abs(rsb) < 1.0 {
 			err = errTpors.Set(1)
@@ -33,7 +33,7 @@ func TestTpors(t *testing.T) {
 			c1, c2, c3, c4 float64, c5 en.ErrNum)
 	}{
 		{"cgo", CgoTpors},
-		{"go", GoTpors},
+		{"go", Tpors},
 	}
 
 	for _, test := range tests {
@@ -65,7 +65,7 @@ func BenchmarkTpors(b *testing.B) {
 			c1, c2, c3, c4 float64, c5 en.ErrNum)
 	}{
 		{"cgo", CgoTpors},
-		{"go", GoTpors},
+		{"go", Tpors},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,21 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoTporv In the tangent plane projection, given the rectangular
+//  coordinates of a star and its direction cosines, determine the
+//  direction cosines of the tangent point.
+func CgoTporv(xi, eta float64, v [3]float64) (
+	v01, v02 [3]float64, err en.ErrNum) {
+	var cV01, cV02 [3]C.double
+	cV := v3sGo2C(v)
+	cI := C.iauTporv(C.double(xi), C.double(eta), &cV[0], &cV01[0],
+		&cV02[0])
+	err = errTporv.Set(int(cI)) // A value is always returned.
+	return v3sC2Go(cV01), v3sC2Go(cV02), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -14,7 +12,7 @@ var errTporv = en.New(0, "Tporv", []string{
 	"both solutions are useful (Note 5)",
 })
 
-//  CgoTporv In the tangent plane projection, given the rectangular
+//  Tporv In the tangent plane projection, given the rectangular
 //  coordinates of a star and its direction cosines, determine the
 //  direction cosines of the tangent point.
 //
@@ -100,23 +98,10 @@ var errTporv = en.New(0, "Tporv", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTporv In the tangent plane projection, given the rectangular
+//  Tporv In the tangent plane projection, given the rectangular
 //  coordinates of a star and its direction cosines, determine the
 //  direction cosines of the tangent point.
-func CgoTporv(xi, eta float64, v [3]float64) (
-	v01, v02 [3]float64, err en.ErrNum) {
-	var cV01, cV02 [3]C.double
-	cV := v3sGo2C(v)
-	cI := C.iauTporv(C.double(xi), C.double(eta), &cV[0], &cV01[0],
-		&cV02[0])
-	err = errTporv.Set(int(cI)) // A value is always returned.
-	return v3sC2Go(cV01), v3sC2Go(cV02), err
-}
-
-//  GoTporv In the tangent plane projection, given the rectangular
-//  coordinates of a star and its direction cosines, determine the
-//  direction cosines of the tangent point.
-func GoTporv(xi, eta float64, v [3]float64) (
+func Tporv(xi, eta float64, v [3]float64) (
 	v01, v02 [3]float64, err en.ErrNum) {
 	var x, y, z, rxy2, xi2, eta2p1, r, rsb, rcb, w2, w, c float64
 
@@ -35,7 +35,7 @@ func TestTporv(t *testing.T) {
 		fnAssist func(a1,a2 float64) [3]float64
 	}{
 		{"cgo", CgoTporv, CgoS2c},
-		{"go", GoTporv, GoS2c},
+		{"go", Tporv, S2c},
 	}
 
 	for _, test := range tests {
@@ -73,7 +73,7 @@ func BenchmarkTporv(b *testing.B) {
 	ra = 1.3
 	dec = 1.5
 
-	v = GoS2c(ra, dec)
+	v = S2c(ra, dec)
 
 	tests := []struct {
 		ref string
@@ -81,7 +81,7 @@ func BenchmarkTporv(b *testing.B) {
 			c1, c2 [3]float64, c4 en.ErrNum)
 	}{
 		{"cgo", CgoTporv},
-		{"go", GoTporv},
+		{"go", Tporv},
 	}
 
 	for _, test := range tests {
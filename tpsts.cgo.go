@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoTpsts In the tangent plane projection, given the star's
+//  rectangular coordinates and the spherical coordinates of the tangent
+//  point, solve for the spherical coordinates of the star.
+func CgoTpsts(xi, eta, a0, b0 float64) (a, b float64) {
+	var cA, cB C.double
+	C.iauTpsts(C.double(xi), C.double(eta), C.double(a0), C.double(b0),
+		&cA, &cB)
+	return float64(cA), float64(cB)
+}
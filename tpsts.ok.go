@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoTpsts In the tangent plane projection, given the star's
+//  Tpsts In the tangent plane projection, given the star's
 //  rectangular coordinates and the spherical coordinates of the tangent
 //  point, solve for the spherical coordinates of the star.
 //
@@ -62,26 +60,16 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTpsts In the tangent plane projection, given the star's
+//  Tpsts In the tangent plane projection, given the star's
 //  rectangular coordinates and the spherical coordinates of the tangent
 //  point, solve for the spherical coordinates of the star.
-func CgoTpsts(xi, eta, a0, b0 float64) (a, b float64) {
-	var cA, cB C.double
-	C.iauTpsts(C.double(xi), C.double(eta), C.double(a0), C.double(b0),
-		&cA, &cB)
-	return float64(cA), float64(cB)
-}
-
-//  GoTpsts In the tangent plane projection, given the star's
-//  rectangular coordinates and the spherical coordinates of the tangent
-//  point, solve for the spherical coordinates of the star.
-func GoTpsts(xi, eta, a0, b0 float64) (a, b float64) {
+func Tpsts(xi, eta, a0, b0 float64) (a, b float64) {
 	var sb0, cb0, d float64
 
 	sb0 = math.Sin(b0)
 	cb0 = math.Cos(b0)
 	d = cb0 - eta*sb0
-	a = GoAnp(math.Atan2(xi, d) + a0)
+	a = Anp(math.Atan2(xi, d) + a0)
 	b = math.Atan2(sb0+eta*cb0, math.Sqrt(xi*xi+d*d))
 	return
 }
@@ -27,7 +27,7 @@ func TestTpsts(t *testing.T) {
 		fn  func(a1, a2, a3, a4 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoTpsts},
-		{"go", GoTpsts},
+		{"go", Tpsts},
 	}
 
 	for _, test := range tests {
@@ -53,7 +53,7 @@ func BenchmarkTpsts(b *testing.B) {
 		fn  func(a1, a2, a3, a4 float64) (c1, c2 float64)
 	}{
 		{"cgo", CgoTpsts},
-		{"go", GoTpsts},
+		{"go", Tpsts},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauTpstv(double xi, double eta, double v0[3], double v[3])
@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoTpstv In the tangent plane projection, given the star's
+//  rectangular coordinates and the direction cosines of the tangent
+//  point, solve for the direction cosines of the star.
+func CgoTpstv(xi, eta float64, v0 [3]float64) (v [3]float64) {
+	var cV [3]C.double
+	cV0 := v3sGo2C(v0)
+	C.iauTpstv(C.double(xi), C.double(eta), &cV0[0], &cV[0])
+	return v3sC2Go(cV)
+}
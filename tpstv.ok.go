@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoTpstv In the tangent plane projection, given the star's
+//  Tpstv In the tangent plane projection, given the star's
 //  rectangular coordinates and the direction cosines of the tangent
 //  point, solve for the direction cosines of the star.
 //
@@ -72,20 +70,10 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTpstv In the tangent plane projection, given the star's
+//  Tpstv In the tangent plane projection, given the star's
 //  rectangular coordinates and the direction cosines of the tangent
 //  point, solve for the direction cosines of the star.
-func CgoTpstv(xi, eta float64, v0 [3]float64) (v [3]float64) {
-	var cV [3]C.double
-	cV0 := v3sGo2C(v0)
-	C.iauTpstv(C.double(xi), C.double(eta), &cV0[0], &cV[0])
-	return v3sC2Go(cV)
-}
-
-//  GoTpstv In the tangent plane projection, given the star's
-//  rectangular coordinates and the direction cosines of the tangent
-//  point, solve for the direction cosines of the star.
-func GoTpstv(xi, eta float64, v0 [3]float64) (v [3]float64) {
+func Tpstv(xi, eta float64, v0 [3]float64) (v [3]float64) {
 	var x, y, z, f, r float64
 
 	// Tangent point.
@@ -29,7 +29,7 @@ func TestTpstv(t *testing.T) {
 		fnAssist func(a1, a2 float64) [3]float64
 	}{
 		{"cgo", CgoTpstv, CgoS2c},
-		{"go", GoTpstv, GoS2c},
+		{"go", Tpstv, S2c},
 	}
 
 	for _, test := range tests {
@@ -60,7 +60,7 @@ func BenchmarkTpstv(b *testing.B) {
 		fnAssist func(a1, a2 float64) [3]float64
 	}{
 		{"cgo", CgoTpstv, CgoS2c},
-		{"go", GoTpstv, GoS2c},
+		{"go", Tpstv, S2c},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,21 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoTpxes In the tangent plane projection, given celestial spherical
+//  coordinates for a star and the tangent point, solve for the star's
+//  rectangular coordinates in the tangent plane.
+func CgoTpxes(a, b, a0, b0 float64) (xi, eta float64, err en.ErrNum) {
+	var cXi, cEta C.double
+	cI := C.iauTpxes(C.double(a), C.double(b), C.double(a0),
+		C.double(b0), &cXi, &cEta)
+	if n := int(cI); n != 0 {
+		err = errTpxex.Set(n)
+	}
+	return float64(cXi), float64(cEta), err
+}
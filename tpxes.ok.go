@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -15,7 +13,7 @@ var errTpxex = en.New(0, "Tpxex", []string{
 	"antistar too far from axis",
 })
 
-//  CgoTpxes In the tangent plane projection, given celestial spherical
+//  Tpxes In the tangent plane projection, given celestial spherical
 //  coordinates for a star and the tangent point, solve for the star's
 //  rectangular coordinates in the tangent plane.
 //
@@ -77,20 +75,10 @@ var errTpxex = en.New(0, "Tpxex", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTpxes In the tangent plane projection, given celestial spherical
+//  Tpxes In the tangent plane projection, given celestial spherical
 //  coordinates for a star and the tangent point, solve for the star's
 //  rectangular coordinates in the tangent plane.
-func CgoTpxes(a, b, a0, b0 float64) (xi, eta float64, err en.ErrNum) {
-	var cXi, cEta C.double
-	C.iauTpxes(C.double(a), C.double(b), C.double(a0), C.double(b0),
-		&cXi, &cEta)
-	return float64(cXi), float64(cEta), err
-}
-
-//  GoTpxes In the tangent plane projection, given celestial spherical
-//  coordinates for a star and the tangent point, solve for the star's
-//  rectangular coordinates in the tangent plane.
-func GoTpxes(a, b, a0, b0 float64) (xi, eta float64, err en.ErrNum) {
+func Tpxes(a, b, a0, b0 float64) (xi, eta float64, err en.ErrNum) {
 	const TINY = 1e-6
 	var sb0, sb, cb0, cb, da, sda, cda, d float64
 
@@ -33,7 +33,7 @@ func TestTpxes(t *testing.T) {
 			c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTpxes},
-		{"go", GoTpxes},
+		{"go", Tpxes},
 	}
 
 	for _, test := range tests {
@@ -62,7 +62,7 @@ func BenchmarkTpxes(b *testing.B) {
 			c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTpxes},
-		{"go", GoTpxes},
+		{"go", Tpxes},
 	}
 
 	for _, test := range tests {
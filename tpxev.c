@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauTpxev(double v[3], double v0[3], double *xi, double *eta)
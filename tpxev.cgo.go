@@ -0,0 +1,21 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoTpxev In the tangent plane projection, given celestial direction
+//  cosines for a star and the tangent point, solve for the star's
+//  rectangular coordinates in the tangent plane.
+func CgoTpxev(v, v0 [3]float64) (xi, eta float64, err en.ErrNum) {
+	var cXi, cEta C.double
+	cV, cV0 := v3sGo2C(v), v3sGo2C(v0)
+	cI := C.iauTpxev(&cV[0], &cV0[0], &cXi, &cEta)
+	if n := int(cI); n != 0 {
+		err = errTpxev.Set(n)
+	}
+	return float64(cXi), float64(cEta), err
+}
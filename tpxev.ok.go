@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -15,7 +13,7 @@ var errTpxev = en.New(0, "Tpxev", []string{
 	"antistar too far from axis",
 })
 
-//  CgoTpxev In the tangent plane projection, given celestial direction
+//  Tpxev In the tangent plane projection, given celestial direction
 //  cosines for a star and the tangent point, solve for the star's
 //  rectangular coordinates in the tangent plane.
 //
@@ -89,23 +87,10 @@ var errTpxev = en.New(0, "Tpxev", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTpxev In the tangent plane projection, given celestial direction
+//  Tpxev In the tangent plane projection, given celestial direction
 //  cosines for a star and the tangent point, solve for the star's
 //  rectangular coordinates in the tangent plane.
-func CgoTpxev(v, v0 [3]float64) (xi, eta float64, err en.ErrNum) {
-	var cXi, cEta C.double
-	cV, cV0 := v3sGo2C(v), v3sGo2C(v0)
-	cI := C.iauTpxev(&cV[0], &cV0[0], &cXi, &cEta)
-	if n := int(cI); n != 0 {
-		err = errTpxev.Set(n)
-	}
-	return float64(cXi), float64(cEta), err
-}
-
-//  GoTpxev In the tangent plane projection, given celestial direction
-//  cosines for a star and the tangent point, solve for the star's
-//  rectangular coordinates in the tangent plane.
-func GoTpxev(v, v0 [3]float64) (xi, eta float64, err en.ErrNum) {
+func Tpxev(v, v0 [3]float64) (xi, eta float64, err en.ErrNum) {
 	const TINY = 1e-6
 	var x, y, z, x0, y0, z0, r2, r, w, d float64
 
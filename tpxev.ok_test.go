@@ -35,7 +35,7 @@ func TestTpxev(t *testing.T) {
 		fnAssist func(a1, a2 float64) [3]float64
 	}{
 		{"cgo", CgoTpxev, CgoS2c},
-		{"go", GoTpxev, GoS2c},
+		{"go", Tpxev, S2c},
 	}
 
 	for _, test := range tests {
@@ -69,7 +69,7 @@ func BenchmarkTpxev(b *testing.B) {
 		fnAssist func(a1, a2 float64) [3]float64
 	}{
 		{"cgo", CgoTpxev, CgoS2c},
-		{"go", GoTpxev, GoS2c},
+		{"go", Tpxev, S2c},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoTr Transpose an r-matrix.
+func CgoTr(r [3][3]float64) (rt [3][3]float64) {
+	var cRt [3][3]C.double
+	cR := v3tGo2C(r)
+	C.iauTr(&cR[0], &cRt[0])
+	return v3tC2Go(cRt)
+}
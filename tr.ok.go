@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoTr Transpose an r-matrix.
+//  Tr Transpose an r-matrix.
 //
 //  - - -
 //   T r
@@ -32,16 +29,8 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTr Transpose an r-matrix.
-func CgoTr(r [3][3]float64) (rt [3][3]float64) {
-	var cRt [3][3]C.double
-	cR := v3tGo2C(r)
-	C.iauTr(&cR[0], &cRt[0])
-	return v3tC2Go(cRt)
-}
-
-// GoTr Transpose an r-matrix.
-func GoTr(r [3][3]float64) (rt [3][3]float64) {
+//  Tr Transpose an r-matrix.
+func Tr(r [3][3]float64) (rt [3][3]float64) {
 	var wm [3][3]float64
 	var i, j int
 
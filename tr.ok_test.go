@@ -34,7 +34,7 @@ func TestTr(t *testing.T) {
 		fn  func([3][3]float64) [3][3]float64
 	}{
 		{"cgo", CgoTr},
-		{"go", GoTr},
+		{"go", Tr},
 	}
 
 	for _, test := range tests {
@@ -75,7 +75,7 @@ func BenchmarkTr(b *testing.B) {
 		fn  func([3][3]float64) [3][3]float64
 	}{
 		{"cgo", CgoTr},
-		{"go", GoTr},
+		{"go", Tr},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauTrxp(double r[3][3], double p[3], double trp[3])
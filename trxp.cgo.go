@@ -0,0 +1,17 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoTrxp Multiply a p-vector by the transpose of an r-matrix.
+// void iauTrxp(double r[3][3], double p[3], double trp[3])
+func CgoTrxp(r [3][3]float64, p [3]float64) (trp [3]float64) {
+	var cTrp [3]C.double
+	cR := v3tGo2C(r)
+	cP := v3sGo2C(p)
+	C.iauTrxp(&cR[0], &cP[0], &cTrp[0])
+	return v3sC2Go(cTrp)
+}
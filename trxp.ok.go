@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoTrxp Multiply a p-vector by the transpose of an r-matrix.
+//  Trxp Multiply a p-vector by the transpose of an r-matrix.
 //
 //  - - - - -
 //   T r x p
@@ -34,24 +31,15 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTrxp Multiply a p-vector by the transpose of an r-matrix.
+//  Trxp Multiply a p-vector by the transpose of an r-matrix.
 // void iauTrxp(double r[3][3], double p[3], double trp[3])
-func CgoTrxp(r [3][3]float64, p [3]float64) (trp [3]float64) {
-	var cTrp [3]C.double
-	cR := v3tGo2C(r)
-	cP := v3sGo2C(p)
-	C.iauTrxp(&cR[0], &cP[0], &cTrp[0])
-	return v3sC2Go(cTrp)
-}
-
-// GoTrxp Multiply a p-vector by the transpose of an r-matrix.
-func GoTrxp(r [3][3]float64, p [3]float64) (trp [3]float64) {
+func Trxp(r [3][3]float64, p [3]float64) (trp [3]float64) {
 
 	// Transpose of matrix r.
-	tr := GoTr(r)
+	tr := Tr(r)
 
 	// Matrix tr * vector p -> vector trp.
-	trp = GoRxp(tr, p)
+	trp = Rxp(tr, p)
 
 	return
 }
@@ -39,7 +39,7 @@ func TestTrxp(t *testing.T) {
 		fn  func([3][3]float64, [3]float64) [3]float64
 	}{
 		{"cgo", CgoTrxp},
-		{"go", GoTrxp},
+		{"go", Trxp},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -76,7 +76,7 @@ func BenchmarkTrxp(b *testing.B) {
 		fn  func([3][3]float64, [3]float64) [3]float64
 	}{
 		{"cgo", CgoTrxp},
-		{"go", GoTrxp},
+		{"go", Trxp},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
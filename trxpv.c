@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 void iauTrxpv(double r[3][3], double pv[2][3], double trpv[2][3])
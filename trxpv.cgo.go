@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoTrxpv Multiply a pv-vector by the transpose of an r-matrix.
+func CgoTrxpv(r [3][3]float64, pv [2][3]float64) (trpv [2][3]float64) {
+	var cTrpv [2][3]C.double
+	cR := v3tGo2C(r)
+	cPv := v3dGo2C(pv)
+	C.iauTrxpv(&cR[0], &cPv[0], &cTrpv[0])
+	return v3dC2Go(cTrpv)
+}
@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoTrxpv Multiply a pv-vector by the transpose of an r-matrix.
+//  Trxpv Multiply a pv-vector by the transpose of an r-matrix.
 //
 //  - - - - - -
 //   T r x p v
@@ -34,24 +31,15 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTrxpv Multiply a pv-vector by the transpose of an r-matrix.
-func CgoTrxpv(r [3][3]float64, pv [2][3]float64) (trpv [2][3]float64) {
-	var cTrpv [2][3]C.double
-	cR := v3tGo2C(r)
-	cPv := v3dGo2C(pv)
-	C.iauTrxpv(&cR[0], &cPv[0], &cTrpv[0])
-	return v3dC2Go(cTrpv)
-}
-
-// GoTrxpv Multiply a pv-vector by the transpose of an r-matrix.
-func GoTrxpv(r [3][3]float64, pv [2][3]float64) (trpv [2][3]float64) {
+//  Trxpv Multiply a pv-vector by the transpose of an r-matrix.
+func Trxpv(r [3][3]float64, pv [2][3]float64) (trpv [2][3]float64) {
 	var tr [3][3]float64
 
 	// Transpose of matrix r.
-	tr = GoTr(r)
+	tr = Tr(r)
 
 	// Matrix tr * vector pv -> vector trpv.
-	trpv = GoRxpv(tr, pv)
+	trpv = Rxpv(tr, pv)
 
 	return
 }
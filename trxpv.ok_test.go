@@ -43,7 +43,7 @@ func TestTrxpv(t *testing.T) {
 		fn  func([3][3]float64, [2][3]float64) [2][3]float64
 	}{
 		{"cgo", CgoTrxpv},
-		{"go", GoTrxpv},
+		{"go", Trxpv},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -88,7 +88,7 @@ func BenchmarkTrxpv(b *testing.B) {
 		fn  func([3][3]float64, [2][3]float64) [2][3]float64
 	}{
 		{"cgo", CgoTrxpv},
-		{"go", GoTrxpv},
+		{"go", Trxpv},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauTttai(double tt1, double tt2, double *tai1, double *tai2)
@@ -0,0 +1,19 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoTttai Time scale transformation:  Terrestrial Time, TT, to
+//  International Atomic Time, TAI.
+func CgoTttai(tt1, tt2 float64) (tai1, tai2 float64, err en.ErrNum) {
+	var cTai1, cTai2 C.double
+	cI := C.iauTttai(C.double(tt1), C.double(tt2), &cTai1, &cTai2)
+	if n := int(cI); n != 0 {
+		err = errTttai.Set(n)
+	}
+	return float64(cTai1), float64(cTai2), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -12,7 +10,7 @@ var errTttai = en.New(0, "Tttai", []string{
 	"",
 })
 
-//  CgoTttai Time scale transformation:  Terrestrial Time, TT, to
+//  Tttai Time scale transformation:  Terrestrial Time, TT, to
 //  International Atomic Time, TAI.
 //
 //  - - - - - -
@@ -54,20 +52,9 @@ var errTttai = en.New(0, "Tttai", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTttai Time scale transformation:  Terrestrial Time, TT, to
+//  Tttai Time scale transformation:  Terrestrial Time, TT, to
 //  International Atomic Time, TAI.
-func CgoTttai(tt1, tt2 float64) (tai1, tai2 float64, err en.ErrNum) {
-	var cTai1, cTai2 C.double
-	cI := C.iauTttai(C.double(tt1), C.double(tt2), &cTai1, &cTai2)
-	if n := int(cI); n != 0 {
-		err = errTttai.Set(n)
-	}
-	return float64(cTai1), float64(cTai2), err
-}
-
-//  GoTttai Time scale transformation:  Terrestrial Time, TT, to
-//  International Atomic Time, TAI.
-func GoTttai(tt1, tt2 float64) (tai1, tai2 float64, err en.ErrNum) {
+func Tttai(tt1, tt2 float64) (tai1, tai2 float64, err en.ErrNum) {
 	// TT minus TAI (days).
 	const dtat = TTMTAI / DAYSEC
 
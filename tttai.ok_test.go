@@ -27,7 +27,7 @@ func TestTttai(t *testing.T) {
 		fn  func(a1,a2 float64) (c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTttai},
-		{"go", GoTttai},
+		{"go", Tttai},
 	}
 
 	for _, test := range tests {
@@ -47,7 +47,7 @@ func BenchmarkTttai(b *testing.B) {
 		fn  func(a1,a2 float64) (c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTttai},
-		{"go", GoTttai},
+		{"go", Tttai},
 	}
 
 	for _, test := range tests {
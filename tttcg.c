@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauTttcg(double tt1, double tt2, double *tcg1, double *tcg2)
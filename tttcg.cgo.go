@@ -0,0 +1,19 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoTttcg Time scale transformation:  Terrestrial Time, TT, to
+//  Geocentric Coordinate Time, TCG.
+func CgoTttcg(tt1, tt2 float64) (tcg1, tcg2 float64, err en.ErrNum) {
+	var cTcg1, cTcg2 C.double
+	cI := C.iauTttcg(C.double(tt1), C.double(tt2), &cTcg1, &cTcg2)
+	if cI != 0 {
+		err = errTttcg.Set(int(cI))
+	}
+	return float64(cTcg1), float64(cTcg2), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -12,7 +10,7 @@ var errTttcg = en.New(0, "Tttcg", []string{
 	"",
 })
 
-//  CgoTttcg Time scale transformation:  Terrestrial Time, TT, to
+//  Tttcg Time scale transformation:  Terrestrial Time, TT, to
 //  Geocentric Coordinate Time, TCG.
 //
 //  - - - - - -
@@ -53,20 +51,9 @@ var errTttcg = en.New(0, "Tttcg", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTttcg Time scale transformation:  Terrestrial Time, TT, to
+//  Tttcg Time scale transformation:  Terrestrial Time, TT, to
 //  Geocentric Coordinate Time, TCG.
-func CgoTttcg(tt1, tt2 float64) (tcg1, tcg2 float64, err en.ErrNum) {
-	var cTcg1, cTcg2 C.double
-	cI := C.iauTttcg(C.double(tt1), C.double(tt2), &cTcg1, &cTcg2)
-	if cI != 0 {
-		err = errTttcg.Set(int(cI))
-	}
-	return float64(cTcg1), float64(cTcg2), err
-}
-
-//  GoTttcg Time scale transformation:  Terrestrial Time, TT, to
-//  Geocentric Coordinate Time, TCG.
-func GoTttcg(tt1, tt2 float64) (tcg1, tcg2 float64, err en.ErrNum) {
+func Tttcg(tt1, tt2 float64) (tcg1, tcg2 float64, err en.ErrNum) {
 
 	// 1977 Jan 1 00:00:32.184 TT, as MJD
 	const t77t = DJM77 + TTMTAI/DAYSEC
@@ -27,7 +27,7 @@ func TestTttcg(t *testing.T) {
 		fn  func(a1, a2 float64) (c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTttcg},
-		{"go", GoTttcg},
+		{"go", Tttcg},
 	}
 
 	for _, test := range tests {
@@ -47,7 +47,7 @@ func BenchmarkTttcg(b *testing.B) {
 		fn  func(a1, a2 float64) (c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTttcg},
-		{"go", GoTttcg},
+		{"go", Tttcg},
 	}
 
 	for _, test := range tests {
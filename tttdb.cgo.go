@@ -0,0 +1,20 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoTttdb Time scale transformation:  Terrestrial Time, TT, to
+//  Barycentric Dynamical Time, TDB.
+func CgoTttdb(tt1, tt2, dtr float64) (tdb1, tdb2 float64, err en.ErrNum) {
+	var cTdb1, cTdb2 C.double
+	cI := C.iauTttdb(C.double(tt1), C.double(tt2), C.double(dtr),
+		&cTdb1, &cTdb2)
+		if n := int(cI); n != 0 {
+		err = errTttdb.Set(n)
+	}
+	return float64(cTdb1), float64(cTdb2), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -12,7 +10,7 @@ var errTttdb = en.New(0, "Tttdb", []string{
 	"",
 })
 
-//  CgoTttdb Time scale transformation:  Terrestrial Time, TT, to
+//  Tttdb Time scale transformation:  Terrestrial Time, TT, to
 //  Barycentric Dynamical Time, TDB.
 //
 //  - - - - - -
@@ -65,21 +63,9 @@ var errTttdb = en.New(0, "Tttdb", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTttdb Time scale transformation:  Terrestrial Time, TT, to
+//  Tttdb Time scale transformation:  Terrestrial Time, TT, to
 //  Barycentric Dynamical Time, TDB.
-func CgoTttdb(tt1, tt2, dtr float64) (tdb1, tdb2 float64, err en.ErrNum) {
-	var cTdb1, cTdb2 C.double
-	cI := C.iauTttdb(C.double(tt1), C.double(tt2), C.double(dtr),
-		&cTdb1, &cTdb2)
-		if n := int(cI); n != 0 {
-		err = errTttdb.Set(n)
-	}
-	return float64(cTdb1), float64(cTdb2), err
-}
-
-//  GoTttdb Time scale transformation:  Terrestrial Time, TT, to
-//  Barycentric Dynamical Time, TDB.
-func GoTttdb(tt1, tt2, dtr float64) (tdb1, tdb2 float64, err en.ErrNum) {
+func Tttdb(tt1, tt2, dtr float64) (tdb1, tdb2 float64, err en.ErrNum) {
 
 	var dtrd float64
 
@@ -28,7 +28,7 @@ func TestTttdb(t *testing.T) {
 			c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTttdb},
-		{"go", GoTttdb},
+		{"go", Tttdb},
 	}
 
 	for _, test := range tests {
@@ -48,7 +48,7 @@ func BenchmarkTttdb(b *testing.B) {
 			c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTttdb},
-		{"go", GoTttdb},
+		{"go", Tttdb},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,20 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoTtut1 Time scale transformation:  Terrestrial Time, TT, to
+//  Universal Time, UT1.
+func CgoTtut1(tt1, tt2, dt float64) (ut11, ut12 float64, err en.ErrNum) {
+	var cUt11, cUt12 C.double
+	cI := C.iauTtut1(C.double(tt1), C.double(tt2), C.double(dt), &cUt11,
+		&cUt12)
+		if n := int(cI); n != 0 {
+		err = errTtut1.Set(n)
+	}
+	return float64(cUt11), float64(cUt12), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -12,7 +10,7 @@ var errTtut1 = en.New(0, "Ttut1", []string{
 	"",
 })
 
-//  CgoTtut1 Time scale transformation:  Terrestrial Time, TT, to
+//  Ttut1 Time scale transformation:  Terrestrial Time, TT, to
 //  Universal Time, UT1.
 //
 //  - - - - - -
@@ -54,21 +52,9 @@ var errTtut1 = en.New(0, "Ttut1", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoTtut1 Time scale transformation:  Terrestrial Time, TT, to
+//  Ttut1 Time scale transformation:  Terrestrial Time, TT, to
 //  Universal Time, UT1.
-func CgoTtut1(tt1, tt2, dt float64) (ut11, ut12 float64, err en.ErrNum) {
-	var cUt11, cUt12 C.double
-	cI := C.iauTtut1(C.double(tt1), C.double(tt2), C.double(dt), &cUt11,
-		&cUt12)
-		if n := int(cI); n != 0 {
-		err = errTtut1.Set(n)
-	}
-	return float64(cUt11), float64(cUt12), err
-}
-
-//  GoTtut1 Time scale transformation:  Terrestrial Time, TT, to
-//  Universal Time, UT1.
-func GoTtut1(tt1, tt2, dt float64) (ut11, ut12 float64, err en.ErrNum) {
+func Ttut1(tt1, tt2, dt float64) (ut11, ut12 float64, err en.ErrNum) {
 
 	var dtd float64
 
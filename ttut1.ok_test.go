@@ -27,7 +27,7 @@ func TestTtut1(t *testing.T) {
 		fn  func(a1,a2,a3 float64) (c1,c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTtut1},
-		{"go", GoTtut1},
+		{"go", Ttut1},
 	}
 
 	for _, test := range tests {
@@ -47,7 +47,7 @@ func BenchmarkTtut1(b *testing.B) {
 		fn  func(a1,a2,a3 float64) (c1,c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoTtut1},
-		{"go", GoTtut1},
+		{"go", Ttut1},
 	}
 
 	for _, test := range tests {
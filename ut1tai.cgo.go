@@ -0,0 +1,21 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoUt1tai Time scale transformation:  Universal Time, UT1, to
+//  International Atomic Time, TAI.
+func CgoUt1tai(ut11, ut12, dta float64) (
+	tai1, tai2 float64, err en.ErrNum) {
+	var cTai1, cTai2 C.double
+	cI := C.iauUt1tai(C.double(ut11), C.double(ut12), C.double(dta),
+		&cTai1, &cTai2)
+	if n := int(cI); n != 0 {
+		err = errUt1tai.Set(n)
+	}
+	return float64(cTai1), float64(cTai2), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -12,7 +10,7 @@ var errUt1tai = en.New(0, "Ut1tai", []string{
 	"",
 })
 
-//  CgoUt1tai Time scale transformation:  Universal Time, UT1, to
+//  Ut1tai Time scale transformation:  Universal Time, UT1, to
 //  International Atomic Time, TAI.
 //
 //  - - - - - - -
@@ -55,22 +53,9 @@ var errUt1tai = en.New(0, "Ut1tai", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoUt1tai Time scale transformation:  Universal Time, UT1, to
+//  Ut1tai Time scale transformation:  Universal Time, UT1, to
 //  International Atomic Time, TAI.
-func CgoUt1tai(ut11, ut12, dta float64) (
-	tai1, tai2 float64, err en.ErrNum) {
-	var cTai1, cTai2 C.double
-	cI := C.iauUt1tai(C.double(ut11), C.double(ut12), C.double(dta),
-		&cTai1, &cTai2)
-	if n := int(cI); n != 0 {
-		err = errUt1tai.Set(n)
-	}
-	return float64(cTai1), float64(cTai2), err
-}
-
-//  GoUt1tai Time scale transformation:  Universal Time, UT1, to
-//  International Atomic Time, TAI.
-func GoUt1tai(ut11, ut12, dta float64) (
+func Ut1tai(ut11, ut12, dta float64) (
 	tai1, tai2 float64, err en.ErrNum) {
 
 	var dtad float64
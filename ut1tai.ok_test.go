@@ -27,7 +27,7 @@ func TestUt1tai(t *testing.T) {
 		fn  func(a1, a2, a3 float64) (c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoUt1tai},
-		{"go", GoUt1tai},
+		{"go", Ut1tai},
 	}
 
 	for _, test := range tests {
@@ -46,7 +46,7 @@ func BenchmarkUt1tai(b *testing.B) {
 		fn  func(a1, a2, a3 float64) (c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoUt1tai},
-		{"go", GoUt1tai},
+		{"go", Ut1tai},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,20 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoUt1tt Time scale transformation:  Universal Time, UT1, to
+//  Terrestrial Time, TT.
+func CgoUt1tt(ut11, ut12, dt float64) (tt1, tt2 float64, err en.ErrNum) {
+	var cTt1, cTt2 C.double
+	cI := C.iauUt1tt(C.double(ut11), C.double(ut12), C.double(dt),
+		&cTt1, &cTt2)
+	if n := int(cI); n != 0 {
+		err = errUt1tt.Set(n)
+	}
+	return float64(cTt1), float64(cTt2), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -12,7 +10,7 @@ var errUt1tt = en.New(0, "Ut1tt", []string{
 	"",
 })
 
-//  CgoUt1tt Time scale transformation:  Universal Time, UT1, to
+//  Ut1tt Time scale transformation:  Universal Time, UT1, to
 //  Terrestrial Time, TT.
 //
 //  - - - - - -
@@ -54,21 +52,9 @@ var errUt1tt = en.New(0, "Ut1tt", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoUt1tt Time scale transformation:  Universal Time, UT1, to
+//  Ut1tt Time scale transformation:  Universal Time, UT1, to
 //  Terrestrial Time, TT.
-func CgoUt1tt(ut11, ut12, dt float64) (tt1, tt2 float64, err en.ErrNum) {
-	var cTt1, cTt2 C.double
-	cI := C.iauUt1tt(C.double(ut11), C.double(ut12), C.double(dt),
-		&cTt1, &cTt2)
-	if n := int(cI); n != 0 {
-		err = errUt1tt.Set(n)
-	}
-	return float64(cTt1), float64(cTt2), err
-}
-
-//  GoUt1tt Time scale transformation:  Universal Time, UT1, to
-//  Terrestrial Time, TT.
-func GoUt1tt(ut11, ut12, dt float64) (tt1, tt2 float64, err en.ErrNum) {
+func Ut1tt(ut11, ut12, dt float64) (tt1, tt2 float64, err en.ErrNum) {
 	var dtd float64
 
 	// Result, safeguarding precision. 
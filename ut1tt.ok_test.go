@@ -28,7 +28,7 @@ func TestUt1tt(t *testing.T) {
 			c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoUt1tt},
-		{"go", GoUt1tt},
+		{"go", Ut1tt},
 	}
 
 	for _, test := range tests {
@@ -49,7 +49,7 @@ func BenchmarkUt1tt(b *testing.B) {
 			c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoUt1tt},
-		{"go", GoUt1tt},
+		{"go", Ut1tt},
 	}
 
 	for _, test := range tests {
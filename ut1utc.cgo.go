@@ -0,0 +1,21 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoUt1utc Time scale transformation:  Universal Time, UT1, to
+//  Coordinated Universal Time, UTC.
+func CgoUt1utc(ut11, ut12, dut1 float64) (
+	utc1, utc2 float64, err en.ErrNum) {
+	var cUtc1, cUtc2 C.double
+	cI := C.iauUt1utc(C.double(ut11), C.double(ut12), C.double(dut1),
+		&cUtc1, &cUtc2)
+	if n := int(cI); n != 0 {
+		err = errUt1utc.Set(n)
+	}
+	return float64(cUtc1), float64(cUtc2), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -14,7 +12,7 @@ var errUt1utc = en.New(1, "Ut1utc", []string{
 	"dubious year (Note 5)",
 })
 
-//  CgoUt1utc Time scale transformation:  Universal Time, UT1, to
+//  Ut1utc Time scale transformation:  Universal Time, UT1, to
 //  Coordinated Universal Time, UTC.
 //
 //  - - - - - - -
@@ -84,22 +82,15 @@ var errUt1utc = en.New(1, "Ut1utc", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoUt1utc Time scale transformation:  Universal Time, UT1, to
+//  Ut1utc Time scale transformation:  Universal Time, UT1, to
 //  Coordinated Universal Time, UTC.
-func CgoUt1utc(ut11, ut12, dut1 float64) (
+func Ut1utc(ut11, ut12, dut1 float64) (
 	utc1, utc2 float64, err en.ErrNum) {
-	var cUtc1, cUtc2 C.double
-	cI := C.iauUt1utc(C.double(ut11), C.double(ut12), C.double(dut1),
-		&cUtc1, &cUtc2)
-	if n := int(cI); n != 0 {
-		err = errUt1utc.Set(n)
-	}
-	return float64(cUtc1), float64(cUtc2), err
+	return Ut1utcWith(CurrentLeapSeconds(), ut11, ut12, dut1)
 }
 
-//  GoUt1utc Time scale transformation:  Universal Time, UT1, to
-//  Coordinated Universal Time, UTC.
-func GoUt1utc(ut11, ut12, dut1 float64) (
+//  Ut1utcWith is Ut1utc with Delta(AT) taken from ls.
+func Ut1utcWith(ls LeapSeconds, ut11, ut12, dut1 float64) (
 	utc1, utc2 float64, err en.ErrNum) {
 	var big1 bool
 	var i, iy, im, id int
@@ -124,13 +115,13 @@ func GoUt1utc(ut11, ut12, dut1 float64) (
 	dats1 = 0
 	for i = -1; i <= 3; i++ {
 		d2 = u2 + float64(i)
-		iy, im, id, fd, err = GoJd2cal(d1, d2)
+		iy, im, id, fd, err = Jd2cal(d1, d2)
 		if err != nil {
 			err = errUt1utc.Set(-1)
 			return
 		}
-		dats2, err = GoDat(iy, im, id, 0.0)
-		if err != nil && err.Is() < 0 {
+		dats2, err = ls.Dat(iy, im, id, 0.0)
+		if err != nil && err.Code() < 0 {
 			err = errUt1utc.Set(-1)
 			return
 		}
@@ -148,7 +139,7 @@ func GoUt1utc(ut11, ut12, dut1 float64) (
 
 			// UT1 for the start of the UTC day that ends in
 			// a leap.
-			d1, d2, err = GoCal2jd(iy, im, id)
+			d1, d2, err = Cal2jd(iy, im, id)
 			if err != nil {
 				err = errUt1utc.Set(-1)
 				return
@@ -28,7 +28,7 @@ func TestUt1utc(t *testing.T) {
 			c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoUt1utc},
-		{"go", GoUt1utc},
+		{"go", Ut1utc},
 	}
 
 	for _, test := range tests {
@@ -49,7 +49,7 @@ func BenchmarkUt1utc(b *testing.B) {
 			c1, c2 float64, c3 en.ErrNum)
 	}{
 		{"cgo", CgoUt1utc},
-		{"go", GoUt1utc},
+		{"go", Ut1utc},
 	}
 
 	for _, test := range tests {
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include "sofa.h"
 
 int iauUtctai(double utc1, double utc2, double *tai1, double *tai2)
@@ -0,0 +1,25 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import "github.com/8i8/sofa/en"
+
+//  CgoUtctai Time scale transformation:  Coordinated Universal Time,
+//  UTC, to International Atomic Time, TAI.
+func CgoUtctai(utc1, utc2 float64) (tai1, tai2 float64, err en.ErrNum) {
+	var cTai1, cTai2 C.double
+	cI := C.iauUtctai(C.double(utc1), C.double(utc2), &cTai1, &cTai2)
+	switch int(cI) {
+	case 0:
+	case -1:
+		err = errUtctai.Set(-1)
+	case 1:
+		err = errUtctai.Set(1)
+	default:
+		err = errUtctai.Set(0)
+	}
+	return float64(cTai1), float64(cTai2), err
+}
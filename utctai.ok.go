@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"math"
 
@@ -14,7 +12,7 @@ var errUtctai = en.New(1, "Utctai", []string{
 	"dubious year (Note 2)",
 })
 
-//  CgoUtctai Time scale transformation:  Coordinated Universal Time,
+//  Utctai Time scale transformation:  Coordinated Universal Time,
 //  UTC, to International Atomic Time, TAI.
 //
 //  - - - - - - -
@@ -81,30 +79,20 @@ var errUtctai = en.New(1, "Utctai", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoUtctai Time scale transformation:  Coordinated Universal Time,
+//  Utctai Time scale transformation:  Coordinated Universal Time,
 //  UTC, to International Atomic Time, TAI.
-func CgoUtctai(utc1, utc2 float64) (tai1, tai2 float64, err en.ErrNum) {
-	var cTai1, cTai2 C.double
-	cI := C.iauUtctai(C.double(utc1), C.double(utc2), &cTai1, &cTai2)
-	switch int(cI) {
-	case 0:
-	case -1:
-		err = errUtctai.Set(-1)
-	case 1:
-		err = errUtctai.Set(1)
-	default:
-		err = errUtctai.Set(0)
-	}
-	return float64(cTai1), float64(cTai2), err
+func Utctai(utc1, utc2 float64) (tai1, tai2 float64, err en.ErrNum) {
+	return UtctaiWith(CurrentLeapSeconds(), utc1, utc2)
 }
 
-// GoUtctai Time scale transformation:  Coordinated Universal Time, UTC,
-// to International Atomic Time, TAI.
-func GoUtctai(utc1, utc2 float64) (tai1, tai2 float64, err en.ErrNum) {
+// UtctaiWith is Utctai with Delta(AT) taken from ls.
+func UtctaiWith(ls LeapSeconds, utc1, utc2 float64) (
+	tai1, tai2 float64, err en.ErrNum) {
 	var big1 bool
 	var iy, im, id, iyt, imt, idt int
 	var u1, u2, fd, dat0, dat12, dat24,
 		dlod, dleap, z1, z2, a2 float64
+	var js en.ErrNum
 
 	// Put the two parts of the UTC into big-first order.
 	if math.Abs(utc1) >= math.Abs(utc2) {
@@ -119,34 +107,47 @@ func GoUtctai(utc1, utc2 float64) (tai1, tai2 float64, err en.ErrNum) {
 	}
 
 	// Get TAI-UTC at 0h today.
-	iy, im, id, fd, err = GoJd2cal(u1, u2)
+	iy, im, id, fd, err = Jd2cal(u1, u2)
 	if err != nil {
-		err = errUtctai.Wrap(err)
+		err = errUtctai.Set(-1).Wrap(err)
 		return
 	}
-	dat0, err = GoDat(iy, im, id, 0.0)
-	if err != nil && err.Is() < 0 {
-		err = errUtctai.Wrap(err)
-		return
+	dat0, err = ls.Dat(iy, im, id, 0.0)
+	if err != nil {
+		if err.Code() < 0 {
+			err = errUtctai.Set(err.Code()).Wrap(err)
+			return
+		}
+		js = err
 	}
 
 	// Get TAI-UTC at 12h today (to detect drift).
-	dat12, err = GoDat(iy, im, id, 0.5)
-	if err != nil && err.Is() < 0 {
-		err = errUtctai.Wrap(err)
-		return
+	dat12, err = ls.Dat(iy, im, id, 0.5)
+	if err != nil {
+		if err.Code() < 0 {
+			err = errUtctai.Set(err.Code()).Wrap(err)
+			return
+		}
+		if js == nil {
+			js = err
+		}
 	}
 
 	// Get TAI-UTC at 0h tomorrow (to detect jumps).
-	iyt, imt, idt, _, err = GoJd2cal(u1+1.5, u2-fd)
+	iyt, imt, idt, _, err = Jd2cal(u1+1.5, u2-fd)
 	if err != nil {
-		err = errUtctai.Wrap(err)
+		err = errUtctai.Set(-1).Wrap(err)
 		return
 	}
-	dat24, err = GoDat(iyt, imt, idt, 0.0)
-	if err != nil && err.Is() < 0 {
-		err = errUtctai.Wrap(err)
-		return
+	dat24, err = ls.Dat(iyt, imt, idt, 0.0)
+	if err != nil {
+		if err.Code() < 0 {
+			err = errUtctai.Set(err.Code()).Wrap(err)
+			return
+		}
+		if js == nil {
+			js = err
+		}
 	}
 
 	// Separate TAI-UTC change into per-day (DLOD) and any jump (DLEAP).
@@ -160,9 +161,9 @@ func GoUtctai(utc1, utc2 float64) (tai1, tai2 float64, err en.ErrNum) {
 	fd *= (DAYSEC + dlod) / DAYSEC
 
 	// Today's calendar date to 2-part JD.
-	z1, z2, err = GoCal2jd(iy, im, id)
+	z1, z2, err = Cal2jd(iy, im, id)
 	if err != nil {
-		err = errUtctai.Wrap(err)
+		err = errUtctai.Set(-1).Wrap(err)
 		return
 	}
 
@@ -177,5 +178,10 @@ func GoUtctai(utc1, utc2 float64) (tai1, tai2 float64, err en.ErrNum) {
 		tai1 = a2
 		tai2 = u1
 	}
+
+	// Status, the first warning from Delta(AT).
+	if js != nil {
+		err = errUtctai.Set(1).Wrap(js)
+	}
 	return
 }
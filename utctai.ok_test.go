@@ -25,7 +25,7 @@ func TestUtctai(t *testing.T) {
 		fn  func(a1, a2 float64) (b1, b2 float64, b3 en.ErrNum)
 	}{
 		{"cgo", CgoUtctai},
-		{"go", GoUtctai},
+		{"go", Utctai},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -34,6 +34,9 @@ func TestUtctai(t *testing.T) {
 		vvd(t, u1, 2453750.5, 1e-6, tname, "u1")
 		vvd(t, u2, 0.8924826384444444444, 1e-12, tname, "u2")
 		errT(t, nil, err, tname, "err")
+
+		_, _, err = test.fn(2378496.5, 0.25)
+		errEN(t, 1, err, tname, "dubious year")
 	}
 }
 
@@ -43,7 +46,7 @@ func BenchmarkUtctai(b *testing.B) {
 		fn  func(a1, a2 float64) (b1, b2 float64, b3 en.ErrNum)
 	}{
 		{"cgo", CgoUtctai},
-		{"go", GoUtctai},
+		{"go", Utctai},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
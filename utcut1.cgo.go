@@ -0,0 +1,28 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+import (
+	"github.com/8i8/sofa/en"
+)
+
+//  CgoUtcut1 Time" scale transformation:  Coordinated Universal Time,
+//  UTC, to Universal Time, UT1.
+func CgoUtcut1(utc1, utc2, dut1 float64) (ut11, ut12 float64, err en.ErrNum) {
+	var cUt11, cUt12 C.double
+	cI := C.iauUtcut1(C.double(utc1), C.double(utc2), C.double(dut1),
+		&cUt11, &cUt12)
+	switch int(cI) {
+	case 0:
+	case -1:
+		err = errUtcut1.Set(-1)
+	case 1:
+		err = errUtcut1.Set(1)
+	default:
+		err = errUtcut1.Set(0)
+	}
+	return float64(cUt11), float64(cUt12), err
+}
@@ -1,7 +1,5 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import (
 	"github.com/8i8/sofa/en"
 )
@@ -12,7 +10,7 @@ var errUtcut1 = en.New(1, "Utcut1", []string{
 	"dubious year (Note 3)",
 })
 
-//  CgoUtcut1 Time scale transformation:  Coordinated Universal Time,
+//  Utcut1 Time scale transformation:  Coordinated Universal Time,
 //  UTC, to Universal Time, UT1.
 //
 //  - - - - - - -
@@ -83,63 +81,56 @@ var errUtcut1 = en.New(1, "Utcut1", []string{
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoUtcut1 Time" scale transformation:  Coordinated Universal Time,
+//  Utcut1 Time" scale transformation:  Coordinated Universal Time,
 //  UTC, to Universal Time, UT1.
-func CgoUtcut1(utc1, utc2, dut1 float64) (ut11, ut12 float64, err en.ErrNum) {
-	var cUt11, cUt12 C.double
-	cI := C.iauUtcut1(C.double(utc1), C.double(utc2), C.double(dut1),
-		&cUt11, &cUt12)
-	switch int(cI) {
-	case 0:
-	case -1:
-		err = errUtcut1.Set(-1)
-	case 1:
-		err = errUtcut1.Set(1)
-	default:
-		err = errUtcut1.Set(0)
-	}
-	return float64(cUt11), float64(cUt12), err
+func Utcut1(utc1, utc2, dut1 float64) (ut11, ut12 float64, err en.ErrNum) {
+	return Utcut1With(CurrentLeapSeconds(), utc1, utc2, dut1)
 }
 
-// GoUtcut1 Time scale transformation:  Coordinated Universal Time,
-// UTC, to Universal Time, UT1.
-func GoUtcut1(utc1, utc2, dut1 float64) (ut11, ut12 float64, err en.ErrNum) {
+// Utcut1With is Utcut1 with Delta(AT) taken from ls.
+func Utcut1With(ls LeapSeconds, utc1, utc2, dut1 float64) (
+	ut11, ut12 float64, err en.ErrNum) {
 
 	var iy, im, id int
 	var dat, dta, tai1, tai2 float64
+	var js en.ErrNum
 
 	// Look up TAI-UTC.
-	iy, im, id, _, err = GoJd2cal(utc1, utc2)
+	iy, im, id, _, err = Jd2cal(utc1, utc2)
 	if err != nil {
-		err = errUtcut1.Wrap(err)
+		err = errUtcut1.Set(-1).Wrap(err)
 		return
 	}
-	dat, err = GoDat(iy, im, id, 0.0)
+	dat, err = ls.Dat(iy, im, id, 0.0)
 	if err != nil {
-		if err.Is() < 0 {
-			err = errUtcut1.Wrap(err)
+		if err.Code() < 0 {
+			err = errUtcut1.Set(-1).Wrap(err)
 			return
 		}
-		err = errUtcut1.Wrap(err)
+		js = err
 	}
 
 	// Form UT1-TAI.
 	dta = dut1 - dat
 
 	// UTC to TAI to UT1.
-	tai1, tai2, err = GoUtctai(utc1, utc2)
+	tai1, tai2, err = UtctaiWith(ls, utc1, utc2)
 	if err != nil {
-		if err.Is() < 0 {
-			err = errUtcut1.Wrap(err)
+		if err.Code() < 0 {
+			err = errUtcut1.Set(-1).Wrap(err)
 			return
 		}
-		err = errUtcut1.Wrap(err)
+		js = err
 	}
-	ut11, ut12, err = GoTaiut1(tai1, tai2, dta)
+	ut11, ut12, err = Taiut1(tai1, tai2, dta)
 	if err != nil {
-		err = errUtcut1.Wrap(err)
+		err = errUtcut1.Set(-1).Wrap(err)
 		return
 	}
 
+	// Status, a warning from Delta(AT).
+	if js != nil {
+		err = errUtcut1.Set(1).Wrap(js)
+	}
 	return
 }
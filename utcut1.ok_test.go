@@ -24,7 +24,7 @@ func TestUtcut1(t *testing.T) {
 		fn  func(a1, a2, a3 float64) (b1, b2 float64, b3 en.ErrNum)
 	}{
 		{"cgo", CgoUtcut1},
-		{"go", GoUtcut1},
+		{"go", Utcut1},
 	}
 	for _, test := range tests {
 		tname := fname + " " + test.ref
@@ -42,7 +42,7 @@ func BenchmarkUtcut1(b *testing.B) {
 		fn  func(a1, a2, a3 float64) (b1, b2 float64, b3 en.ErrNum)
 	}{
 		{"cgo", CgoUtcut1},
-		{"go", GoUtcut1},
+		{"go", Utcut1},
 	}
 	for _, test := range tests {
 		b.Run(test.ref, func(b *testing.B) {
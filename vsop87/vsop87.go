@@ -0,0 +1,400 @@
+// Package vsop87 evaluates the VSOP87 planetary theory of Bretagnon and
+// Francou (1988), a higher accuracy alternative to sofa.Plan94.
+//
+// The theory comes as series files from the IMCCE, one for each
+// version and body, such as VSOP87A.ear or VSOP87E.jup, which are read
+// by Open or Read.  The files, some megabytes for each version, are not
+// part of this package;  they are at ftp://ftp.imcce.fr/pub/ephem/
+// planets/vsop87.  The versions are
+//
+//	A  heliocentric rectangular, ecliptic and equinox J2000.0
+//	B  heliocentric spherical, ecliptic and equinox J2000.0
+//	C  heliocentric rectangular, ecliptic and equinox of date
+//	D  heliocentric spherical, ecliptic and equinox of date
+//	E  barycentric rectangular, ecliptic and equinox J2000.0
+//
+// The full series give the positions of the planets to about 1 mas
+// over 1900-2100 AD, degrading slowly for the outer planets over some
+// thousands of years.  Terms may be dropped with Truncate, trading
+// accuracy for speed.
+//
+// Whatever the version, positions and velocities are returned as
+// sofa.Plan94 returns them:  pv-vectors in au and au/day with respect
+// to the mean equator and equinox of J2000.0, at a TDB 2-part Julian
+// Date, with the planets numbered as by Plan94.
+package vsop87
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/8i8/sofa"
+)
+
+var (
+	errHeader  = errors.New("vsop87: bad series header")
+	errTerm    = errors.New("vsop87: bad series term")
+	errCount   = errors.New("vsop87: wrong number of terms in series")
+	errEmpty   = errors.New("vsop87: no series")
+	errMixed   = errors.New("vsop87: mixed versions or bodies")
+	errVersion = errors.New("vsop87: unknown version")
+)
+
+// Version is a version of VSOP87, numbered as in the series files.
+type Version int
+
+// The versions of VSOP87.  Main, the osculating elements of the
+// planets, is not evaluated by this package.
+const (
+	Main Version = iota
+	A
+	B
+	C
+	D
+	E
+)
+
+func (v Version) String() string {
+	if v < Main || v > E {
+		return "Version(" + strconv.Itoa(int(v)) + ")"
+	}
+	return [...]string{"VSOP87", "VSOP87A", "VSOP87B", "VSOP87C",
+		"VSOP87D", "VSOP87E"}[v]
+}
+
+// spherical reports whether the coordinates of v are longitude,
+// latitude and radius, rather than rectangular.
+func (v Version) spherical() bool { return v == B || v == D }
+
+// ofDate reports whether v is referred to the ecliptic and equinox of
+// date, rather than of J2000.0.
+func (v Version) ofDate() bool { return v == C || v == D }
+
+// The bodies, numbered as by sofa.Plan94, with the Earth and the Sun
+// after them.  The Earth-Moon barycentre is only in version A and the
+// Sun only in version E.
+const (
+	Mercury = 1
+	Venus   = 2
+	EMB     = 3 // Earth-Moon barycentre
+	Mars    = 4
+	Jupiter = 5
+	Saturn  = 6
+	Uranus  = 7
+	Neptune = 8
+	Earth   = 9
+	Sun     = 10
+)
+
+// bodyNames are the bodies by their names in the series headers.
+var bodyNames = map[string]int{
+	"MERCURY": Mercury, "VENUS": Venus, "EMB": EMB, "MARS": Mars,
+	"JUPITER": Jupiter, "SATURN": Saturn, "URANUS": Uranus,
+	"NEPTUNE": Neptune, "EARTH": Earth, "SUN": Sun,
+}
+
+// BodyError is returned for a body with no series.
+type BodyError struct {
+	Body int
+}
+
+func (e *BodyError) Error() string {
+	return fmt.Sprintf("vsop87: no series for body %d", e.Body)
+}
+
+// term is A cos(B + C t), t in Julian millennia from J2000.0 TDB.
+type term struct {
+	a, b, c float64
+}
+
+// Series is the theory of one body in one version:  for each of the
+// three coordinates, the series for each power of time.
+type Series struct {
+	Version Version
+	Body    int
+
+	coord [3][][]term
+}
+
+// Open reads the series file name.
+func Open(name string) (*Series, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// Read reads a series file in the format of the IMCCE:  for each
+// coordinate and power of time a header line, such as
+//
+//	VSOP87 VERSION A1    EARTH     VARIABLE 1 (XYZ)       *T**0   1007 TERMS ...
+//
+// followed by the terms, each ending in its amplitude A, phase B and
+// frequency C.
+func Read(r io.Reader) (*Series, error) {
+	s := &Series{Version: -1}
+	sc := bufio.NewScanner(r)
+	var cur *[]term
+	want := 0
+	for sc.Scan() {
+		line := sc.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		f := strings.Fields(line)
+		if f[0] == "VSOP87" {
+			if cur != nil && len(*cur) != want {
+				return nil, errCount
+			}
+			v, body, ic, it, n, err := header(f)
+			if err != nil {
+				return nil, err
+			}
+			if s.Version < 0 {
+				s.Version, s.Body = v, body
+			} else if v != s.Version || body != s.Body {
+				return nil, errMixed
+			}
+			for len(s.coord[ic]) <= it {
+				s.coord[ic] = append(s.coord[ic], nil)
+			}
+			cur, want = &s.coord[ic][it], n
+			*cur = make([]term, 0, n)
+			continue
+		}
+		if cur == nil || len(f) < 4 {
+			return nil, errTerm
+		}
+		var t term
+		var err error
+		for i, p := range []*float64{&t.a, &t.b, &t.c} {
+			*p, err = strconv.ParseFloat(f[len(f)-3+i], 64)
+			if err != nil {
+				return nil, errTerm
+			}
+		}
+		*cur = append(*cur, t)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if cur == nil {
+		return nil, errEmpty
+	}
+	if len(*cur) != want {
+		return nil, errCount
+	}
+	return s, nil
+}
+
+// header returns the version, body, coordinate, power of time and
+// number of terms of the fields f of a series header.
+func header(f []string) (v Version, body, ic, it, n int, err error) {
+	if len(f) < 9 || f[1] != "VERSION" || len(f[2]) != 2 ||
+		f[4] != "VARIABLE" || !strings.HasPrefix(f[7], "*T**") {
+		err = errHeader
+		return
+	}
+	iv, err1 := strconv.Atoi(f[2][1:])
+	ic, err2 := strconv.Atoi(f[5])
+	it, err3 := strconv.Atoi(f[7][4:])
+	n, err4 := strconv.Atoi(f[8])
+	body, ok := bodyNames[f[3]]
+	if err1 != nil || err2 != nil || err3 != nil || err4 != nil || !ok ||
+		ic < 1 || ic > 3 || it < 0 || it > 5 || n < 0 {
+		err = errHeader
+		return
+	}
+	v = Version(iv)
+	if v < A || v > E {
+		err = errVersion
+	}
+	return v, body, ic - 1, it, n, err
+}
+
+// Truncate returns the series without the terms of amplitude less than
+// prec, in radians for the angles of versions B and D and in au
+// otherwise.  The terms of the higher powers of time are judged alike,
+// which is fair within a millennium or so of J2000.0.
+func (s *Series) Truncate(prec float64) *Series {
+	t := &Series{Version: s.Version, Body: s.Body}
+	for ic := range s.coord {
+		for _, ts := range s.coord[ic] {
+			var k []term
+			for _, x := range ts {
+				if math.Abs(x.a) >= prec {
+					k = append(k, x)
+				}
+			}
+			t.coord[ic] = append(t.coord[ic], k)
+		}
+	}
+	return t
+}
+
+// Terms returns the number of terms in the series.
+func (s *Series) Terms() int {
+	n := 0
+	for ic := range s.coord {
+		for _, ts := range s.coord[ic] {
+			n += len(ts)
+		}
+	}
+	return n
+}
+
+// eval returns the coordinates of the series and their rates per Julian
+// millennium at t millennia from J2000.0.
+func (s *Series) eval(t float64) (p, v [3]float64) {
+	for ic := range s.coord {
+		tp := 1.0 // t to the power it
+		dtp := 0.0
+		for it, ts := range s.coord[ic] {
+			var sum, dsum float64
+			for _, x := range ts {
+				a := x.b + x.c*t
+				sum += x.a * math.Cos(a)
+				dsum -= x.a * x.c * math.Sin(a)
+			}
+			p[ic] += sum * tp
+			v[ic] += dsum*tp + sum*dtp
+			dtp = float64(it+1) * tp
+			tp *= t
+		}
+	}
+	return p, v
+}
+
+// vsopFK5 rotates the dynamical ecliptic and equinox J2000.0 of VSOP87
+// to the mean equator and equinox J2000.0 (FK5).
+var vsopFK5 = [3][3]float64{
+	{1.0, 0.000000440360, -0.000000190919},
+	{-0.000000479966, 0.917482137087, -0.397776982902},
+	{0.0, 0.397776982902, 0.917482137087},
+}
+
+// PV returns the position and velocity of the body at the TDB
+// date1+date2, heliocentric, or barycentric for version E, in au and
+// au/day with respect to the mean equator and equinox of J2000.0.
+//
+// For versions C and D the ecliptic of date is first rotated to that of
+// J2000.0 with the IAU 2006 precession of sofa.Ecm06, including the
+// rate of the rotation in the velocity, so that all the versions share
+// the frame of vsopFK5.
+func (s *Series) PV(date1, date2 float64) (pv [2][3]float64) {
+	t := ((date1 - sofa.DJ00) + date2) / sofa.DJM
+	p, v := s.eval(t)
+	for i := range v {
+		v[i] /= sofa.DJM
+	}
+	if s.Version.spherical() {
+		pv = sofa.S2pv(p[0], p[1], p[2], v[0], v[1], v[2])
+	} else {
+		pv = [2][3]float64{p, v}
+	}
+	if !s.Version.ofDate() {
+		return sofa.Rxpv(vsopFK5, pv)
+	}
+
+	// Ecliptic of date to J2000.0, and so to the mean equator, with
+	// the rate of the rotation from its change over a day.  The frame
+	// bias of Ecm06 cancels.
+	const h = 0.5
+	k := sofa.Rxr(vsopFK5, sofa.Ecm06(sofa.DJ00, 0.0))
+	rpv := sofa.Rxpv(sofa.Rxr(k, sofa.Tr(sofa.Ecm06(date1, date2))), pv)
+	r0 := sofa.Rxr(k, sofa.Tr(sofa.Ecm06(date1, date2-h)))
+	r1 := sofa.Rxr(k, sofa.Tr(sofa.Ecm06(date1, date2+h)))
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			rpv[1][i] += (r1[i][j] - r0[i][j]) / (2 * h) * pv[0][j]
+		}
+	}
+	return rpv
+}
+
+// Planets is a set of series of one version, by body.
+type Planets struct {
+	Version Version
+	series  map[int]*Series
+}
+
+// OpenPlanets reads the series files of version v in the directory dir
+// under their IMCCE names, such as VSOP87A.mer, skipping the bodies
+// for which there is no file.
+func OpenPlanets(dir string, v Version) (*Planets, error) {
+	if v < A || v > E {
+		return nil, errVersion
+	}
+	ps := &Planets{Version: v}
+	for _, ext := range []string{"mer", "ven", "ear", "mar", "jup",
+		"sat", "ura", "nep", "emb", "sun"} {
+		s, err := Open(filepath.Join(dir, v.String()+"."+ext))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", v, ext, err)
+		}
+		if err = ps.Add(s); err != nil {
+			return nil, err
+		}
+	}
+	if len(ps.series) == 0 {
+		return nil, errEmpty
+	}
+	return ps, nil
+}
+
+// Add adds the series s, replacing any of the same body.
+func (ps *Planets) Add(s *Series) error {
+	if ps.series == nil {
+		ps.Version = s.Version
+		ps.series = map[int]*Series{}
+	} else if s.Version != ps.Version {
+		return errMixed
+	}
+	ps.series[s.Body] = s
+	return nil
+}
+
+// Bodies returns the bodies of the set in order.
+func (ps *Planets) Bodies() []int {
+	var b []int
+	for k := range ps.series {
+		b = append(b, k)
+	}
+	sort.Ints(b)
+	return b
+}
+
+// Truncate returns the set with each of its series truncated to prec,
+// as by Series.Truncate.
+func (ps *Planets) Truncate(prec float64) *Planets {
+	t := &Planets{Version: ps.Version, series: map[int]*Series{}}
+	for k, s := range ps.series {
+		t.series[k] = s.Truncate(prec)
+	}
+	return t
+}
+
+// PV returns the position and velocity of the planet np as
+// sofa.Plan94 does, though barycentric for version E.  A *BodyError is
+// returned for a body without a series.
+func (ps *Planets) PV(date1, date2 float64, np int) (
+	pv [2][3]float64, err error) {
+	s, ok := ps.series[np]
+	if !ok {
+		return pv, &BodyError{np}
+	}
+	return s.PV(date1, date2), nil
+}
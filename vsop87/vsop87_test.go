@@ -0,0 +1,322 @@
+package vsop87
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/8i8/sofa"
+)
+
+// testTerms are the terms of a made up series, for each coordinate and
+// power of time.
+var testTerms = [3][][]term{
+	{
+		{{1.5, 0.1, 0.0}, {0.02, 1.2, 6283.07585}, {1e-7, 2.0, 7.0}},
+		{{0.003, 0.4, 6283.07585}},
+		{{1e-5, 0.0, 0.0}},
+	},
+	{
+		{{0.8, 4.0, 6283.07585}, {3e-4, 0.3, 12566.1517}},
+		{{-0.002, 1.0, 0.0}},
+	},
+	{
+		{{0.01, 5.0, 6283.07585}},
+	},
+}
+
+// The directory of the series files and vsop87.chk of the IMCCE, for
+// TestCheck.
+var chkDir = flag.String("vsop87dir", "testdata",
+	"directory of the VSOP87 series files and vsop87.chk")
+
+var coordNames = [...]string{"(XYZ)", "(LBR)"}
+
+// writeSeries returns a series file of testTerms in the format of the
+// IMCCE.
+func writeSeries(v Version, name string, terms [3][][]term) []byte {
+	var b bytes.Buffer
+	cn := coordNames[0]
+	if v.spherical() {
+		cn = coordNames[1]
+	}
+	for ic := range terms {
+		for it, ts := range terms[ic] {
+			fmt.Fprintf(&b, " VSOP87 VERSION %c%d    %-7s   "+
+				"  VARIABLE %d %s       *T**%d %6d TERMS    "+
+				"TEST SERIES\n", 'A'+rune(v)-1, v, name, ic+1, cn,
+				it, len(ts))
+			for k, x := range ts {
+				fmt.Fprintf(&b, " %d%d%d%d%5d", v, 3, ic+1, it, k+1)
+				for j := 0; j < 12; j++ {
+					b.WriteString("  0")
+				}
+				fmt.Fprintf(&b, "%15.11f%18.11f%18.11f%14.11f%20.11f\n",
+					0.0, 0.0, x.a, x.b, x.c)
+			}
+		}
+	}
+	return b.Bytes()
+}
+
+// want returns the coordinates of terms at t millennia.
+func want(terms [3][][]term, t float64) (p [3]float64) {
+	for ic := range terms {
+		for it, ts := range terms[ic] {
+			for _, x := range ts {
+				p[ic] += x.a * math.Cos(x.b+x.c*t) *
+					math.Pow(t, float64(it))
+			}
+		}
+	}
+	return
+}
+
+func vvd(t *testing.T, val, valok, dval float64, fname, test string) {
+	t.Helper()
+	if math.Abs(val-valok) > dval || math.IsNaN(val) {
+		t.Errorf("%s failed: %s want %.20g got %.20g", fname, test,
+			valok, val)
+	}
+}
+
+func TestRead(t *testing.T) {
+	const fname = "Read"
+	s, err := Read(bytes.NewReader(writeSeries(A, "EARTH", testTerms)))
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	if s.Version != A || s.Body != Earth || s.Terms() != 9 {
+		t.Errorf("%s: got %v body %d terms %d", fname, s.Version,
+			s.Body, s.Terms())
+	}
+	for ic := range testTerms {
+		for it, ts := range testTerms[ic] {
+			for k, x := range ts {
+				y := s.coord[ic][it][k]
+				vvd(t, y.a, x.a, 1e-11, fname, "A")
+				vvd(t, y.b, x.b, 1e-11, fname, "B")
+				vvd(t, y.c, x.c, 1e-11, fname, "C")
+			}
+		}
+	}
+}
+
+func TestPV(t *testing.T) {
+	const fname = "PV"
+	const date1 = sofa.DJ00
+	for _, v := range []Version{A, B, C, D, E} {
+		s, err := Read(bytes.NewReader(writeSeries(v, "EARTH",
+			testTerms)))
+		if err != nil {
+			t.Fatalf("%s: %v", fname, err)
+		}
+		tname := fname + " " + v.String()
+		for _, date2 := range []float64{-3e5, -100.0, 0.0, 4567.8} {
+			p := want(testTerms, date2/sofa.DJM)
+			var r [3]float64
+			if v.spherical() {
+				r = sofa.S2p(p[0], p[1], p[2])
+			} else {
+				r = p
+			}
+			if v.ofDate() {
+				r = sofa.Trxp(sofa.Ecm06(date1, date2), r)
+				r = sofa.Rxp(sofa.Ecm06(sofa.DJ00, 0.0), r)
+			}
+			r = sofa.Rxp(vsopFK5, r)
+			pv := s.PV(date1, date2)
+			for j := 0; j < 3; j++ {
+				vvd(t, pv[0][j], r[j], 1e-14, tname, "p")
+			}
+
+			// The velocity is the rate of the position.
+			d0, d1 := date2-0.01, date2+0.01
+			p0 := s.PV(date1, d0)
+			p1 := s.PV(date1, d1)
+			for j := 0; j < 3; j++ {
+				vvd(t, pv[1][j], (p1[0][j]-p0[0][j])/(d1-d0), 1e-9,
+					tname, "v")
+			}
+		}
+	}
+}
+
+func TestFrame(t *testing.T) {
+	const fname = "Frame"
+
+	// At J2000.0 the ecliptic of date of C is that of A, and so is the
+	// frame they are rotated to.
+	a, _ := Read(bytes.NewReader(writeSeries(A, "EARTH", testTerms)))
+	c, _ := Read(bytes.NewReader(writeSeries(C, "EARTH", testTerms)))
+	pa := a.PV(sofa.DJ00, 0.0)
+	pc := c.PV(sofa.DJ00, 0.0)
+	for j := 0; j < 3; j++ {
+		vvd(t, pc[0][j], pa[0][j], 1e-15, fname, "p")
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	const fname = "Truncate"
+	s, _ := Read(bytes.NewReader(writeSeries(A, "EARTH", testTerms)))
+	u := s.Truncate(1e-4)
+	if u.Terms() != 7 || s.Terms() != 9 {
+		t.Errorf("%s: want 7 and 9 terms got %d and %d", fname,
+			u.Terms(), s.Terms())
+	}
+	if s.Truncate(0).Terms() != s.Terms() {
+		t.Errorf("%s: zero precision dropped terms", fname)
+	}
+	p := s.PV(sofa.DJ00, 1000.0)
+	q := u.PV(sofa.DJ00, 1000.0)
+	for j := 0; j < 3; j++ {
+		vvd(t, q[0][j], p[0][j], 2e-4, fname, "p")
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	const fname = "Read errors"
+	b := writeSeries(A, "EARTH", testTerms)
+	i := bytes.LastIndexByte(b[:len(b)-1], '\n')
+	if _, err := Read(bytes.NewReader(b[:i+1])); err != errCount {
+		t.Errorf("%s: want %v got %v", fname, errCount, err)
+	}
+	b = append(writeSeries(A, "EARTH", testTerms),
+		writeSeries(A, "MARS", testTerms)...)
+	if _, err := Read(bytes.NewReader(b)); err != errMixed {
+		t.Errorf("%s: want %v got %v", fname, errMixed, err)
+	}
+	b = writeSeries(A, "PLUTO", testTerms)
+	if _, err := Read(bytes.NewReader(b)); err != errHeader {
+		t.Errorf("%s: want %v got %v", fname, errHeader, err)
+	}
+	if _, err := Read(bytes.NewReader(nil)); err != errEmpty {
+		t.Errorf("%s: want %v got %v", fname, errEmpty, err)
+	}
+}
+
+func TestOpenPlanets(t *testing.T) {
+	const fname = "OpenPlanets"
+	dir, err := ioutil.TempDir("", "vsop87")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for ext, name := range map[string]string{"ear": "EARTH",
+		"jup": "JUPITER"} {
+		err = ioutil.WriteFile(filepath.Join(dir, "VSOP87E."+ext),
+			writeSeries(E, name, testTerms), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	ps, err := OpenPlanets(dir, E)
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	if b := ps.Bodies(); len(b) != 2 || b[0] != Jupiter || b[1] != Earth {
+		t.Errorf("%s: want bodies 5 and 9 got %v", fname, b)
+	}
+	pv, err := ps.PV(sofa.DJ00, 10.0, Jupiter)
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	s, _ := Read(bytes.NewReader(writeSeries(E, "JUPITER", testTerms)))
+	if pv != s.PV(sofa.DJ00, 10.0) {
+		t.Errorf("%s: Jupiter differs from its series", fname)
+	}
+
+	_, err = ps.PV(sofa.DJ00, 10.0, Mars)
+	var be *BodyError
+	if !errors.As(err, &be) || be.Body != Mars {
+		t.Errorf("%s: want no series for Mars got %v", fname, err)
+	}
+	if _, err = OpenPlanets(dir, A); err != errEmpty {
+		t.Errorf("%s: want %v got %v", fname, errEmpty, err)
+	}
+}
+
+// chkHeader matches the line of vsop87.chk that begins each case, such
+// as "VSOP87A   MERCURY   JD2451545.0".
+var chkHeader = regexp.MustCompile(`VSOP87([A-E])\s+(\w+)\s+JD\s*([0-9.]+)`)
+
+// TestCheck evaluates the full series at the dates of vsop87.chk, the
+// check values of the IMCCE, in the coordinates of the theory:  the
+// position in au, or radians and au, and its rate per day, to their
+// ten places.  Without the files the test is skipped.
+func TestCheck(t *testing.T) {
+	const fname = "Check"
+	f, err := os.Open(filepath.Join(*chkDir, "vsop87.chk"))
+	if os.IsNotExist(err) {
+		t.Skipf("%s: %v", fname, err)
+	}
+	if err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	defer f.Close()
+
+	planets := map[Version]*Planets{}
+	var s *Series
+	var tname string
+	var jd float64
+	var x []float64
+	n := 0
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		l := sc.Text()
+		if m := chkHeader.FindStringSubmatch(l); m != nil {
+			v := Version(m[1][0] - 'A' + 1)
+			ps, ok := planets[v]
+			if !ok {
+				if ps, err = OpenPlanets(*chkDir, v); err != nil {
+					t.Fatalf("%s: %v", fname, err)
+				}
+				planets[v] = ps
+			}
+			s = ps.series[bodyNames[m[2]]]
+			jd, _ = strconv.ParseFloat(m[3], 64)
+			tname = m[1] + " " + m[2] + " " + m[3]
+			x = x[:0]
+			continue
+		}
+		if s == nil {
+			continue
+		}
+		for _, w := range strings.Fields(strings.Replace(l, "D", "E",
+			-1)) {
+			if y, err := strconv.ParseFloat(w, 64); err == nil {
+				x = append(x, y)
+			}
+		}
+		if len(x) < 6 {
+			continue
+		}
+		p, v := s.eval((jd - sofa.DJ00) / sofa.DJM)
+		if s.Version.spherical() {
+			p[0] = sofa.Anp(p[0])
+		}
+		for j := 0; j < 3; j++ {
+			vvd(t, p[j], x[j], 1e-10, fname, tname+" p")
+			vvd(t, v[j]/sofa.DJM, x[3+j], 1e-10, fname, tname+" v")
+		}
+		s = nil
+		n++
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatalf("%s: %v", fname, err)
+	}
+	if n == 0 {
+		t.Errorf("%s: no cases in vsop87.chk", fname)
+	}
+}
@@ -0,0 +1,15 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoXy06 X,Y coordinates of celestial intermediate pole from series
+//  based on IAU 2006 precession and IAU 2000A nutation.
+func CgoXy06(date1, date2 float64) (x, y float64) {
+	var cX, cY C.double
+	C.iauXy06(C.double(date1), C.double(date2), &cX, &cY)
+	return float64(cX), float64(cY)
+}
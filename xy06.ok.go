@@ -1,10 +1,8 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
 import "math"
 
-//  CgoXy06 X,Y coordinates of celestial intermediate pole from series
+//  Xy06 X,Y coordinates of celestial intermediate pole from series
 //  based on IAU 2006 precession and IAU 2000A nutation.
 //
 //  - - - - -
@@ -98,17 +96,9 @@ import "math"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoXy06 X,Y coordinates of celestial intermediate pole from series
+//  Xy06 X,Y coordinates of celestial intermediate pole from series
 //  based on IAU 2006 precession and IAU 2000A nutation.
-func CgoXy06(date1, date2 float64) (x, y float64) {
-	var cX, cY C.double
-	C.iauXy06(C.double(date1), C.double(date2), &cX, &cY)
-	return float64(cX), float64(cY)
-}
-
-//  GoXy06 X,Y coordinates of celestial intermediate pole from series
-//  based on IAU 2006 precession and IAU 2000A nutation.
-func GoXy06(date1, date2 float64) (x, y float64) {
+func Xy06(date1, date2 float64) (x, y float64) {
 	// Maximum power of T in the polynomials for X and Y
 	const MAXPT = 5
 
@@ -2603,32 +2593,32 @@ func GoXy06(date1, date2 float64) (x, y float64) {
 	// ---------------------------------
 
 	// Mean anomaly of the Moon.
-	fa[0] = GoFal03(t)
+	fa[0] = Fal03(t)
 
 	// Mean anomaly of the Sun.
-	fa[1] = GoFalp03(t)
+	fa[1] = Falp03(t)
 
 	// Mean argument of the latitude of the Moon.
-	fa[2] = GoFaf03(t)
+	fa[2] = Faf03(t)
 
 	// Mean elongation of the Moon from the Sun.
-	fa[3] = GoFad03(t)
+	fa[3] = Fad03(t)
 
 	// Mean longitude of the ascending node of the Moon.
-	fa[4] = GoFaom03(t)
+	fa[4] = Faom03(t)
 
 	// Planetary longitudes, Mercury through Neptune.
-	fa[5] = GoFame03(t)
-	fa[6] = GoFave03(t)
-	fa[7] = GoFae03(t)
-	fa[8] = GoFama03(t)
-	fa[9] = GoFaju03(t)
-	fa[10] = GoFasa03(t)
-	fa[11] = GoFaur03(t)
-	fa[12] = GoFane03(t)
+	fa[5] = Fame03(t)
+	fa[6] = Fave03(t)
+	fa[7] = Fae03(t)
+	fa[8] = Fama03(t)
+	fa[9] = Faju03(t)
+	fa[10] = Fasa03(t)
+	fa[11] = Faur03(t)
+	fa[12] = Fane03(t)
 
 	// General accumulated precession in longitude.
-	fa[13] = GoFapa03(t)
+	fa[13] = Fapa03(t)
 
 	// --------------------------------------
 	// Polynomial part of precession-nutation
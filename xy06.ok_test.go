@@ -22,7 +22,7 @@ func TestXy06(t *testing.T) {
 		fn  func(a1,a2 float64) (c1,c2 float64) 
 	}{
 		{"cgo", CgoXy06},
-		{"go", GoXy06},
+		{"go", Xy06},
 	}
 
 	for _, test := range tests {
@@ -41,7 +41,7 @@ func BenchmarkXy06(b *testing.B) {
 		fn  func(a1,a2 float64) (c1,c2 float64) 
 	}{
 		{"cgo", CgoXy06},
-		{"go", GoXy06},
+		{"go", Xy06},
 	}
 
 	for _, test := range tests {
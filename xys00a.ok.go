@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoXys00a For a given TT date, compute the X,Y coordinates of the
+//  Xys00a For a given TT date, compute the X,Y coordinates of the
 //  Celestial Intermediate Pole and the CIO locator s, using the IAU
 //  2000A precession-nutation model.
 //
@@ -70,29 +67,20 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoXys00a For a given TT date, compute the X,Y coordinates of the
-//  Celestial Intermediate Pole and the CIO locator s, using the IAU
-//  2000A precession-nutation model.
-func CgoXys00a(date1, date2 float64) (x, y, s float64) {
-	var cX, cY, cS C.double
-	C.iauXys00a(C.double(date1), C.double(date2), &cX, &cY, &cS)
-	return float64(cX), float64(cY), float64(cS)
-}
-
-//  GoXys00a For a given TT date, compute the X,Y coordinates of the
+//  Xys00a For a given TT date, compute the X,Y coordinates of the
 //  Celestial Intermediate Pole and the CIO locator s, using the IAU
 //  2000A precession-nutation model.
-func GoXys00a(date1, date2 float64) (x, y, s float64) {
+func Xys00a(date1, date2 float64) (x, y, s float64) {
 
 	var rbpn [3][3]float64
 
 	// Form the bias-precession-nutation matrix, IAU 2000A.
-	rbpn = GoPnm00a(date1, date2)
+	rbpn = Pnm00a(date1, date2)
 
 	// Extract X,Y.
-	x, y = GoBpn2xy(rbpn)
+	x, y = Bpn2xy(rbpn)
 
 	// Obtain s.
-	s = GoS00(date1, date2, x, y)
+	s = S00(date1, date2, x, y)
 	return
 }
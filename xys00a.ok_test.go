@@ -23,7 +23,7 @@ func TestXys00a(t *testing.T) {
 		fn  func(a1, a2 float64) (c1, c2, c3 float64)
 	}{
 		{"cgo", CgoXys00a},
-		{"go", GoXys00a},
+		{"go", Xys00a},
 	}
 
 	for _, test := range tests {
@@ -43,7 +43,7 @@ func BenchmarkXys00a(b *testing.B) {
 		fn  func(a1, a2 float64) (c1, c2, c3 float64)
 	}{
 		{"cgo", CgoXys00a},
-		{"go", GoXys00a},
+		{"go", Xys00a},
 	}
 
 	for _, test := range tests {
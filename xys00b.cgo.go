@@ -0,0 +1,16 @@
+//go:build cgo
+// +build cgo
+
+package sofa
+
+// #include "sofa.h"
+import "C"
+
+//  CgoXys00b For a given TT date, compute the X,Y coordinates of the
+//  Celestial Intermediate Pole and the CIO locator s, using the IAU
+//  2000B precession-nutation model.
+func CgoXys00b(date1, date2 float64) (x, y, s float64) {
+	var cX, cY, cS C.double
+	C.iauXys00b(C.double(date1), C.double(date2), &cX, &cY, &cS)
+	return float64(cX), float64(cY), float64(cS)
+}
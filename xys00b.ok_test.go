@@ -22,7 +22,7 @@ func TestXys00b(t *testing.T) {
 		fn  func(a1, a2 float64) (c1, c2, c3 float64)
 	}{
 		{"cgo", CgoXys00b},
-		{"go", GoXys00b},
+		{"go", Xys00b},
 	}
 
 	for _, test := range tests {
@@ -42,7 +42,7 @@ func BenchmarkXys00b(b *testing.B) {
 		fn  func(a1, a2 float64) (c1, c2, c3 float64)
 	}{
 		{"cgo", CgoXys00b},
-		{"go", GoXys00b},
+		{"go", Xys00b},
 	}
 
 	for _, test := range tests {
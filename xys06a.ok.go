@@ -1,9 +1,6 @@
 package sofa
 
-// #include "sofa.h"
-import "C"
-
-//  CgoXys06a For a given TT date, compute the X,Y coordinates of the
+//  Xys06a For a given TT date, compute the X,Y coordinates of the
 //  Celestial Intermediate Pole and the CIO locator s, using the IAU
 //  2006 precession and IAU 2000A nutation models.
 //
@@ -70,29 +67,20 @@ import "C"
 //
 //  Copyright (C) 2020 IAU SOFA Board.  See notes at end.
 //
-//  CgoXys06a For a given TT date, compute the X,Y coordinates of the
-//  Celestial Intermediate Pole and the CIO locator s, using the IAU
-//  2006 precession and IAU 2000A nutation models.
-func CgoXys06a(date1, date2 float64) (x, y, s float64) {
-	var cX, cY, cS C.double
-	C.iauXys06a(C.double(date1), C.double(date2), &cX, &cY, &cS)
-	return float64(cX), float64(cY), float64(cS)
-}
-
-//  GoXys06a For a given TT date, compute the X,Y coordinates of the
+//  Xys06a For a given TT date, compute the X,Y coordinates of the
 //  Celestial Intermediate Pole and the CIO locator s, using the IAU
 //  2006 precession and IAU 2000A nutation models.
-func GoXys06a(date1, date2 float64) (x, y, s float64) {
+func Xys06a(date1, date2 float64) (x, y, s float64) {
 
 	var rbpn [3][3]float64
 
 	// Form the bias-precession-nutation matrix, IAU 2006/2000A.
-	rbpn = GoPnm06a(date1, date2)
+	rbpn = Pnm06a(date1, date2)
 
 	// Extract X,Y.
-	x, y = GoBpn2xy(rbpn)
+	x, y = Bpn2xy(rbpn)
 
 	// Obtain s.
-	s = GoS06(date1, date2, x, y)
+	s = S06(date1, date2, x, y)
 	return
 }
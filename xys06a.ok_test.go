@@ -22,7 +22,7 @@ func TestXys06a(t *testing.T) {
 		fn  func(a1, a2 float64) (c1, c2, c3 float64)
 	}{
 		{"cgo", CgoXys06a},
-		{"go", GoXys06a},
+		{"go", Xys06a},
 	}
 
 	for _, test := range tests {
@@ -42,7 +42,7 @@ func BenchmarkXys06a(b *testing.B) {
 		fn  func(a1, a2 float64) (c1, c2, c3 float64)
 	}{
 		{"cgo", CgoXys06a},
-		{"go", GoXys06a},
+		{"go", Xys06a},
 	}
 
 	for _, test := range tests {